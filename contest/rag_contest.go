@@ -15,9 +15,8 @@
 package contest
 
 import (
+	"context"
 	"fmt"
-	"regexp"
-	"strings"
 
 	"github.com/casibase/casibase/model"
 	"github.com/casibase/casibase/object"
@@ -49,35 +48,6 @@ func deleteExistingStore(name string) error {
 	return nil
 }
 
-func cleanAnswer(answer string) string {
-	answer = strings.TrimSpace(answer)
-
-	re := regexp.MustCompile(`^[ABCD]+$|^[ABCD](,[ABCD])*$|^[ABCD](-[ABCD])*$|^[ABCD]( [ABCD])*$`)
-
-	if re.MatchString(answer) {
-		answer = strings.ReplaceAll(answer, ",", "")
-		answer = strings.ReplaceAll(answer, "-", "")
-		answer = strings.ReplaceAll(answer, " ", "")
-		return answer
-	}
-
-	// try to extract options from text
-	re = regexp.MustCompile(`[ABCD]+`)
-	matches := re.FindAllString(answer, -1)
-
-	if len(matches) > 0 {
-		var longestMatch string
-		for _, match := range matches {
-			if len(match) > len(longestMatch) {
-				longestMatch = match
-			}
-		}
-		return longestMatch
-	}
-
-	return answer
-}
-
 func sendMessage(store *object.Store, question string, modelProviderName string, embeddingProviderName string, lang string) (string, *model.ModelResult, error) {
 	modelProvider, _, err := object.GetModelProviderFromContext("admin", modelProviderName, lang)
 	if err != nil {
@@ -89,10 +59,10 @@ func sendMessage(store *object.Store, question string, modelProviderName string,
 		return "", nil, err
 	}
 
-	knowledge, _, _, err := object.GetNearestKnowledge(store.Name, store.VectorStores, store.SearchProvider, embeddingProvider, embeddingProviderObj, modelProvider, "admin", question, store.KnowledgeCount, lang)
+	knowledge, _, _, err := object.GetNearestKnowledge(store, embeddingProvider, embeddingProviderObj, modelProvider, "admin", question, store.KnowledgeCount, nil, context.Background(), lang)
 	if err != nil {
 		return "", nil, err
 	}
 	history := []*model.RawMessage{}
-	return object.GetAnswerWithContext(modelProviderName, question, history, knowledge, store.Prompt, lang)
+	return object.GetAnswerWithContext(modelProviderName, question, history, knowledge, store.Prompt, context.Background(), lang)
 }
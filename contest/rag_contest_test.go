@@ -20,6 +20,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/casibase/casibase/eval"
 	"github.com/casibase/casibase/object"
 	"github.com/casibase/casibase/proxy"
 	"github.com/casibase/casibase/util"
@@ -92,7 +93,7 @@ func TestProcessPapers(t *testing.T) {
 					break
 				}
 
-				correctAnswer := cleanAnswer(answer)
+				correctAnswer := eval.CleanChoiceAnswer(answer)
 				fmt.Printf("received answer: %s\n", correctAnswer)
 				questionsData[i]["correct_answer"] = correctAnswer
 				processedQuestions[generateQuestionKey(question)] = true
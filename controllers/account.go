@@ -234,6 +234,7 @@ func (c *ApiController) addInitialChatAndMessage(user *casdoorsdk.User) error {
 		Text:         store.Welcome,
 		IsHidden:     true,
 		VectorScores: []object.VectorScore{},
+		Sources:      []object.MessageSource{},
 	}
 	_, err = object.AddMessage(userMessage)
 	if err != nil {
@@ -252,6 +253,7 @@ func (c *ApiController) addInitialChatAndMessage(user *casdoorsdk.User) error {
 		Author:       "AI",
 		Text:         "",
 		VectorScores: []object.VectorScore{},
+		Sources:      []object.MessageSource{},
 	}
 	_, err = object.AddMessage(answerMessage)
 	return err
@@ -16,6 +16,8 @@ package controllers
 
 import (
 	"bytes"
+
+	"github.com/casibase/casibase/model"
 )
 
 type CarrierWriter struct {
@@ -40,6 +42,13 @@ func (w *CarrierWriter) Write(p []byte) (n int, err error) {
 	return len(p), nil
 }
 
+func (w *CarrierWriter) HandleStreamEvent(event *model.StreamEvent) error {
+	if event.Type == model.StreamEventTextDelta {
+		w.messageBuf = append(w.messageBuf, []byte(event.Text)...)
+	}
+	return nil
+}
+
 func (w *CarrierWriter) MessageString() string {
 	return string(w.messageBuf)
 }
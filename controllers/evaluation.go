@@ -0,0 +1,245 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/beego/beego/logs"
+	"github.com/beego/beego/utils/pagination"
+	"github.com/casibase/casibase/object"
+	"github.com/casibase/casibase/util"
+)
+
+// GetGlobalEvaluations
+// @Title GetGlobalEvaluations
+// @Tag Evaluation API
+// @Description get global evaluations
+// @Success 200 {array} object.Evaluation The Response object
+// @router /get-global-evaluations [get]
+func (c *ApiController) GetGlobalEvaluations() {
+	evaluations, err := object.GetGlobalEvaluations()
+	if err != nil {
+		c.ResponseError(err.Error())
+		return
+	}
+
+	c.ResponseOk(object.GetMaskedEvaluations(evaluations, true))
+}
+
+// GetEvaluations
+// @Title GetEvaluations
+// @Tag Evaluation API
+// @Description get evaluations
+// @Param owner query string true "The owner of evaluation"
+// @Success 200 {array} object.Evaluation The Response object
+// @router /get-evaluations [get]
+func (c *ApiController) GetEvaluations() {
+	owner := c.Input().Get("owner")
+	limit := c.Input().Get("pageSize")
+	page := c.Input().Get("p")
+	field := c.Input().Get("field")
+	value := c.Input().Get("value")
+	sortField := c.Input().Get("sortField")
+	sortOrder := c.Input().Get("sortOrder")
+
+	if limit == "" || page == "" {
+		evaluations, err := object.GetEvaluations(owner)
+		if err != nil {
+			c.ResponseError(err.Error())
+			return
+		}
+
+		c.ResponseOk(object.GetMaskedEvaluations(evaluations, true))
+	} else {
+		limit := util.ParseInt(limit)
+		count, err := object.GetEvaluationCount(owner, field, value)
+		if err != nil {
+			c.ResponseError(err.Error())
+			return
+		}
+
+		paginator := pagination.SetPaginator(c.Ctx, limit, count)
+		evaluations, err := object.GetPaginationEvaluations(owner, paginator.Offset(), limit, field, value, sortField, sortOrder)
+		if err != nil {
+			c.ResponseError(err.Error())
+			return
+		}
+		c.ResponseOk(evaluations, paginator.Nums())
+	}
+}
+
+// GetEvaluation
+// @Title GetEvaluation
+// @Tag Evaluation API
+// @Description get evaluation
+// @Param id query string true "The id (owner/name) of evaluation"
+// @Success 200 {object} object.Evaluation The Response object
+// @router /get-evaluation [get]
+func (c *ApiController) GetEvaluation() {
+	id := c.Input().Get("id")
+
+	evaluation, err := object.GetEvaluation(id)
+	if err != nil {
+		c.ResponseError(err.Error())
+		return
+	}
+
+	c.ResponseOk(object.GetMaskedEvaluation(evaluation, true))
+}
+
+// UpdateEvaluation
+// @Title UpdateEvaluation
+// @Tag Evaluation API
+// @Description update evaluation
+// @Param id query string true "The id (owner/name) of the evaluation"
+// @Param body body object.Evaluation true "The details of the evaluation"
+// @Success 200 {object} controllers.Response The Response object
+// @router /update-evaluation [post]
+func (c *ApiController) UpdateEvaluation() {
+	id := c.Input().Get("id")
+
+	var evaluation object.Evaluation
+	err := json.Unmarshal(c.Ctx.Input.RequestBody, &evaluation)
+	if err != nil {
+		c.ResponseError(err.Error())
+		return
+	}
+
+	success, err := object.UpdateEvaluation(id, &evaluation)
+	if err != nil {
+		c.ResponseError(err.Error())
+		return
+	}
+
+	c.ResponseOk(success)
+}
+
+// AddEvaluation
+// @Title AddEvaluation
+// @Tag Evaluation API
+// @Description add evaluation
+// @Param body body object.Evaluation true "The details of the evaluation"
+// @Success 200 {object} controllers.Response The Response object
+// @router /add-evaluation [post]
+func (c *ApiController) AddEvaluation() {
+	var evaluation object.Evaluation
+	err := json.Unmarshal(c.Ctx.Input.RequestBody, &evaluation)
+	if err != nil {
+		c.ResponseError(err.Error())
+		return
+	}
+
+	success, err := object.AddEvaluation(&evaluation)
+	if err != nil {
+		c.ResponseError(err.Error())
+		return
+	}
+
+	c.ResponseOk(success)
+}
+
+// DeleteEvaluation
+// @Title DeleteEvaluation
+// @Tag Evaluation API
+// @Description delete evaluation
+// @Param body body object.Evaluation true "The details of the evaluation"
+// @Success 200 {object} controllers.Response The Response object
+// @router /delete-evaluation [post]
+func (c *ApiController) DeleteEvaluation() {
+	var evaluation object.Evaluation
+	err := json.Unmarshal(c.Ctx.Input.RequestBody, &evaluation)
+	if err != nil {
+		c.ResponseError(err.Error())
+		return
+	}
+
+	success, err := object.DeleteEvaluation(&evaluation)
+	if err != nil {
+		c.ResponseError(err.Error())
+		return
+	}
+
+	c.ResponseOk(success)
+}
+
+// RunEvaluation
+// @Title RunEvaluation
+// @Tag Evaluation API
+// @Description run evaluation in the background, the results are saved to the evaluation as the questions are answered
+// @Param id query string true "The id (owner/name) of the evaluation"
+// @Success 200 {object} controllers.Response The Response object
+// @router /run-evaluation [post]
+func (c *ApiController) RunEvaluation() {
+	id := c.Input().Get("id")
+
+	evaluation, err := object.GetEvaluation(id)
+	if err != nil {
+		c.ResponseError(err.Error())
+		return
+	}
+	if evaluation == nil {
+		c.ResponseError(fmt.Sprintf(c.T("evaluation:The evaluation: %s is not found"), id))
+		return
+	}
+
+	started, err := object.StartEvaluation(evaluation)
+	if err != nil {
+		c.ResponseError(err.Error())
+		return
+	}
+	if !started {
+		c.ResponseError(fmt.Sprintf(c.T("evaluation:The evaluation: %s is already running"), id))
+		return
+	}
+
+	lang := c.GetAcceptLanguage()
+	go func() {
+		err := object.RunEvaluation(evaluation, lang)
+		if err != nil {
+			logs.Error("failed to run evaluation %s: %s", id, err.Error())
+		}
+	}()
+
+	c.ResponseOk(true)
+}
+
+// CompareEvaluations
+// @Title CompareEvaluations
+// @Tag Evaluation API
+// @Description compare the metrics and the per-question results of two evaluations
+// @Param base query string true "The id (owner/name) of the base evaluation"
+// @Param target query string true "The id (owner/name) of the target evaluation"
+// @Success 200 {object} object.EvaluationComparison The Response object
+// @router /compare-evaluations [get]
+func (c *ApiController) CompareEvaluations() {
+	evaluations := []*object.Evaluation{}
+	for _, id := range []string{c.Input().Get("base"), c.Input().Get("target")} {
+		evaluation, err := object.GetEvaluation(id)
+		if err != nil {
+			c.ResponseError(err.Error())
+			return
+		}
+		if evaluation == nil {
+			c.ResponseError(fmt.Sprintf(c.T("evaluation:The evaluation: %s is not found"), id))
+			return
+		}
+
+		evaluations = append(evaluations, evaluation)
+	}
+
+	c.ResponseOk(object.CompareEvaluations(evaluations[0], evaluations[1]))
+}
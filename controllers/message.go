@@ -191,6 +191,12 @@ func (c *ApiController) AddMessage() {
 		return
 	}
 
+	_, err = object.ParseVectorFilter(message.Filter, c.GetAcceptLanguage())
+	if err != nil {
+		c.ResponseError(err.Error())
+		return
+	}
+
 	id := util.GetIdFromOwnerAndName(message.Owner, message.Name)
 	originMessage, err := object.GetMessage(id)
 	if err != nil {
@@ -336,6 +342,7 @@ func (c *ApiController) AddMessage() {
 				Text:          "",
 				FileName:      message.FileName,
 				VectorScores:  []object.VectorScore{},
+				Sources:       []object.MessageSource{},
 				ModelProvider: message.ModelProvider,
 			}
 			_, err = object.AddMessage(answerMessage)
@@ -15,6 +15,8 @@
 package controllers
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -69,6 +71,11 @@ func (c *ApiController) GetMessageAnswer() {
 		return
 	}
 
+	// The answer is canceled when the client goes away or when it is stopped,
+	// the model and the search then stop too.
+	ctx, done := startAnswer(c.Ctx.Request.Context(), message.GetId())
+	defer done()
+
 	chatId := util.GetIdFromOwnerAndName(message.Owner, message.Chat)
 	chat, err := object.GetChat(chatId)
 	if err != nil {
@@ -138,12 +145,7 @@ func (c *ApiController) GetMessageAnswer() {
 		}
 	}
 
-	modelProviderName := store.ModelProvider
-	if chat.ModelProvider != "" {
-		modelProviderName = chat.ModelProvider
-	}
-
-	modelProvider, modelProviderObj, err := object.GetModelProviderFromContext("admin", modelProviderName, c.GetAcceptLanguage())
+	modelProvider, modelProviderObj, err := object.GetRoutedModelProvider(store, chat.ModelProvider, question, c.GetAcceptLanguage())
 	if err != nil {
 		c.ResponseErrorStream(message, err.Error())
 		return
@@ -174,8 +176,54 @@ func (c *ApiController) GetMessageAnswer() {
 		knowledgeCount = 10
 	}
 
-	knowledge, vectorScores, embeddingResult, err := object.GetNearestKnowledge(store.Name, store.VectorStores, store.SearchProvider, embeddingProvider, embeddingProviderObj, modelProvider, "admin", question, knowledgeCount, c.GetAcceptLanguage())
-	if err != nil && err.Error() != "no knowledge vectors found" {
+	filterText := ""
+	if questionMessage != nil {
+		filterText = questionMessage.Filter
+	}
+	filter, err := object.ParseVectorFilter(filterText, c.GetAcceptLanguage())
+	if err != nil {
+		c.ResponseErrorStream(message, err.Error())
+		return
+	}
+
+	fileAccess, err := object.GetFileAccess(c.GetSessionUser())
+	if err != nil {
+		c.ResponseErrorStream(message, err.Error())
+		return
+	}
+	filter = filter.WithAccess(fileAccess)
+
+	history, err := object.GetRecentRawMessages(chat.Name, message.CreatedTime, store.MemoryLimit)
+	if err != nil {
+		c.ResponseErrorStream(message, err.Error())
+		return
+	}
+
+	// An answer only depends on the question and the knowledge when the model
+	// sees no history and calls no tools, only such answers are cached. The
+	// answers of stores with guarded files depend on the user too.
+	var cacheQuery *object.AnswerCacheQuery
+	var cacheEmbeddingResult *embedding.EmbeddingResult
+	relatedStores := append([]string{store.Name}, store.VectorStores...)
+	if store.EnableAnswerCache && agentClients == nil && len(history) == 0 && !fileAccess.HasPermissions(relatedStores) {
+		var cachedAnswer *object.CachedAnswer
+		cachedAnswer, cacheQuery, cacheEmbeddingResult, err = object.GetCachedAnswer(store, embeddingProvider.Name, embeddingProviderObj, modelProvider.Name, filterText, question, ctx, c.GetAcceptLanguage())
+		if err != nil {
+			c.ResponseErrorStream(message, err.Error())
+			return
+		}
+
+		if cachedAnswer != nil {
+			c.responseCachedAnswer(message, questionMessage, chat, cachedAnswer, cacheEmbeddingResult, modelProvider)
+			return
+		}
+
+		// The question is embedded once, for the cache and the search
+		embeddingProviderObj = cacheQuery.GetEmbeddingProvider(embeddingProviderObj)
+	}
+
+	knowledge, sources, embeddingResult, err := object.GetNearestKnowledge(store, embeddingProvider, embeddingProviderObj, modelProvider, "admin", question, knowledgeCount, filter, ctx, c.GetAcceptLanguage())
+	if err != nil && !errors.Is(err, object.ErrNoKnowledgeVectors) {
 		err = fmt.Errorf(c.T("message_answer:object.GetNearestKnowledge() error, %s"), err.Error())
 		c.ResponseErrorStream(message, err.Error())
 		return
@@ -183,9 +231,38 @@ func (c *ApiController) GetMessageAnswer() {
 	if embeddingResult == nil {
 		embeddingResult = &embedding.EmbeddingResult{}
 	}
+	if cacheEmbeddingResult != nil {
+		embeddingResult.TokenCount += cacheEmbeddingResult.TokenCount
+		embeddingResult.Price += cacheEmbeddingResult.Price
+		if embeddingResult.Currency == "" {
+			embeddingResult.Currency = cacheEmbeddingResult.Currency
+		}
+	}
 
 	writer := &RefinedWriter{*c.Ctx.ResponseWriter, *NewCleaner(6), []byte{}, []byte{}, []byte{}}
 
+	prompt := store.Prompt
+	if store.CrossLingualSearch != "" {
+		prompt = object.GetPromptWithLanguage(prompt, question)
+	}
+	if len(sources) != 0 {
+		prompt = object.GetPromptWithCitations(prompt)
+
+		var sourcesData []byte
+		sourcesData, err = json.Marshal(sources)
+		if err != nil {
+			c.ResponseErrorStream(message, err.Error())
+			return
+		}
+
+		_, err = writer.ResponseWriter.Write([]byte(fmt.Sprintf("event: sources\ndata: %s\n\n", sourcesData)))
+		if err != nil {
+			c.ResponseErrorStream(message, err.Error())
+			return
+		}
+		writer.Flush()
+	}
+
 	if questionMessage != nil {
 		questionMessage.TokenCount = embeddingResult.TokenCount
 		questionMessage.Price = embeddingResult.Price
@@ -198,12 +275,6 @@ func (c *ApiController) GetMessageAnswer() {
 		}
 	}
 
-	history, err := object.GetRecentRawMessages(chat.Name, message.CreatedTime, store.MemoryLimit)
-	if err != nil {
-		c.ResponseErrorStream(message, err.Error())
-		return
-	}
-
 	fmt.Printf("Question: [%s]\n", question)
 	fmt.Printf("Knowledge: [\n")
 	for i, k := range knowledge {
@@ -230,15 +301,19 @@ func (c *ApiController) GetMessageAnswer() {
 			AgentClients:  agentClients,
 			AgentMessages: messages,
 		}
-		modelResult, err = model.QueryTextWithTools(modelProviderObj, question, writer, history, store.Prompt, knowledge, agentInfo, c.GetAcceptLanguage())
+		modelResult, err = model.QueryTextWithTools(modelProviderObj, question, writer, history, prompt, knowledge, agentInfo, ctx, c.GetAcceptLanguage())
 	} else {
 		if isReasonModel(modelProvider.SubType) {
-			modelResult, err = QueryCarrierText(question, writer, history, store.Prompt, knowledge, modelProviderObj, chat.NeedTitle, store.SuggestionCount, c.GetAcceptLanguage())
+			modelResult, err = QueryCarrierText(question, writer, history, prompt, knowledge, modelProviderObj, chat.NeedTitle, store.SuggestionCount, ctx, c.GetAcceptLanguage())
 		} else {
-			modelResult, err = modelProviderObj.QueryText(question, writer, history, store.Prompt, knowledge, nil, c.GetAcceptLanguage())
+			modelResult, err = modelProviderObj.QueryText(question, writer, history, prompt, knowledge, nil, ctx, c.GetAcceptLanguage())
 		}
 	}
-	if err != nil {
+
+	// A stopped answer keeps the text answered so far, the providers that do
+	// not stream have answered nothing when they are stopped.
+	isStopped := ctx.Err() != nil
+	if err != nil && !isStopped {
 		if strings.Contains(err.Error(), "write tcp") {
 			c.ResponseError(err.Error())
 			return
@@ -246,6 +321,9 @@ func (c *ApiController) GetMessageAnswer() {
 		c.ResponseErrorStream(message, err.Error())
 		return
 	}
+	if modelResult == nil {
+		modelResult = &model.ModelResult{}
+	}
 
 	if writer.writerCleaner.cleaned == false {
 		cleanedData := writer.writerCleaner.GetCleanedData()
@@ -257,7 +335,7 @@ func (c *ApiController) GetMessageAnswer() {
 		}
 
 		_, err = writer.ResponseWriter.Write([]byte(fmt.Sprintf("event: message\ndata: %s\n\n", jsonData)))
-		if err != nil {
+		if err != nil && !isStopped {
 			c.ResponseErrorStream(message, err.Error())
 			return
 		}
@@ -270,13 +348,14 @@ func (c *ApiController) GetMessageAnswer() {
 
 	event := fmt.Sprintf("event: end\ndata: %s\n\n", "end")
 	_, err = c.Ctx.ResponseWriter.Write([]byte(event))
-	if err != nil {
+	if err != nil && !isStopped {
 		c.ResponseErrorStream(message, err.Error())
 		return
 	}
 
 	answer := writer.MessageString()
 	message.ReasonText = writer.ReasonString()
+	message.ModelProvider = model.GetAnsweredBy(modelResult, modelProvider.Name)
 	message.TokenCount = modelResult.TotalTokenCount
 	message.Price = modelResult.TotalPrice
 	message.Currency = modelResult.Currency
@@ -298,13 +377,22 @@ func (c *ApiController) GetMessageAnswer() {
 
 	message.Suggestions = textSuggestions
 
-	message.VectorScores = vectorScores
+	message.VectorScores = object.GetVectorScores(sources)
+	message.Sources = object.GetCitedSources(message.Text, sources)
 	_, err = object.UpdateMessage(message.GetId(), message, false)
 	if err != nil {
 		c.ResponseErrorStream(message, err.Error())
 		return
 	}
 
+	if cacheQuery != nil && message.Text != "" && !isStopped {
+		err = object.AddCachedAnswer(cacheQuery, message)
+		if err != nil {
+			c.ResponseErrorStream(message, err.Error())
+			return
+		}
+	}
+
 	chat.TokenCount += message.TokenCount
 	chat.Price += message.Price
 	if chat.Currency == "" {
@@ -330,6 +418,77 @@ func (c *ApiController) GetMessageAnswer() {
 	}
 }
 
+// responseCachedAnswer streams a cached answer as if the model had written it,
+// the answer costs nothing but the embedding of the question.
+func (c *ApiController) responseCachedAnswer(message *object.Message, questionMessage *object.Message, chat *object.Chat, cachedAnswer *object.CachedAnswer, embeddingResult *embedding.EmbeddingResult, modelProvider *object.Provider) {
+	if len(cachedAnswer.Sources) != 0 {
+		sourcesData, err := json.Marshal(cachedAnswer.Sources)
+		if err != nil {
+			c.ResponseErrorStream(message, err.Error())
+			return
+		}
+
+		_, err = c.Ctx.ResponseWriter.Write([]byte(fmt.Sprintf("event: sources\ndata: %s\n\n", sourcesData)))
+		if err != nil {
+			c.ResponseErrorStream(message, err.Error())
+			return
+		}
+	}
+
+	jsonData, err := ConvertMessageDataToJSON(cachedAnswer.Text)
+	if err != nil {
+		c.ResponseErrorStream(message, err.Error())
+		return
+	}
+
+	_, err = c.Ctx.ResponseWriter.Write([]byte(fmt.Sprintf("event: message\ndata: %s\n\nevent: end\ndata: %s\n\n", jsonData, "end")))
+	if err != nil {
+		c.ResponseErrorStream(message, err.Error())
+		return
+	}
+	c.Ctx.ResponseWriter.Flush()
+
+	message.Text = cachedAnswer.Text
+	message.ReasonText = cachedAnswer.ReasonText
+	message.Suggestions = cachedAnswer.Suggestions
+	message.VectorScores = cachedAnswer.VectorScores
+	message.Sources = cachedAnswer.Sources
+	message.ModelProvider = modelProvider.Name
+	message.TokenCount = 0
+	message.Price = 0
+	message.Currency = modelProvider.Currency
+	message.ErrorText = ""
+	message.IsAlerted = false
+	_, err = object.UpdateMessage(message.GetId(), message, false)
+	if err != nil {
+		c.ResponseErrorStream(message, err.Error())
+		return
+	}
+
+	if questionMessage != nil {
+		questionMessage.TokenCount = embeddingResult.TokenCount
+		questionMessage.Price = embeddingResult.Price
+		questionMessage.Currency = embeddingResult.Currency
+		_, err = object.UpdateMessage(questionMessage.GetId(), questionMessage, false)
+		if err != nil {
+			c.ResponseErrorStream(message, err.Error())
+			return
+		}
+
+		if chat.Currency == "" || chat.Currency == questionMessage.Currency {
+			chat.TokenCount += questionMessage.TokenCount
+			chat.Price += questionMessage.Price
+			chat.Currency = questionMessage.Currency
+		}
+
+		_, err = object.UpdateChat(chat.GetId(), chat)
+		if err != nil {
+			c.ResponseErrorStream(message, err.Error())
+			return
+		}
+	}
+}
+
 // GetAnswer
 // @Title GetAnswer
 // @Tag Message API
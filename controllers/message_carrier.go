@@ -15,6 +15,7 @@
 package controllers
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"sync"
@@ -88,7 +89,7 @@ func isReasonModel(typ string) bool {
 	return false
 }
 
-func getResultWithSuggestionsAndTitle(writer *CarrierWriter, question string, modelProviderObj model.ModelProvider, needTitle bool, suggestionCount int, lang string) (*model.ModelResult, error) {
+func getResultWithSuggestionsAndTitle(writer *CarrierWriter, question string, modelProviderObj model.ModelProvider, needTitle bool, suggestionCount int, ctx context.Context, lang string) (*model.ModelResult, error) {
 	var fullPrompt strings.Builder
 
 	fullPrompt.WriteString(fmt.Sprintf("User question: %s\n\n", question))
@@ -115,7 +116,7 @@ Examples of generated title:
 - Do NOT include any explanations or extra text—just output the title.`)
 	}
 
-	carrierResult, err := modelProviderObj.QueryText(fullPrompt.String(), writer, nil, "", nil, nil, lang)
+	carrierResult, err := modelProviderObj.QueryText(fullPrompt.String(), writer, nil, "", nil, nil, ctx, lang)
 	if err != nil {
 		return nil, err
 	}
@@ -123,7 +124,7 @@ Examples of generated title:
 	return carrierResult, nil
 }
 
-func QueryCarrierText(question string, writer *RefinedWriter, history []*model.RawMessage, prompt string, knowledge []*model.RawMessage, modelProviderObj model.ModelProvider, needTitle bool, suggestionCount int, lang string) (*model.ModelResult, error) {
+func QueryCarrierText(question string, writer *RefinedWriter, history []*model.RawMessage, prompt string, knowledge []*model.RawMessage, modelProviderObj model.ModelProvider, needTitle bool, suggestionCount int, ctx context.Context, lang string) (*model.ModelResult, error) {
 	var (
 		wg         sync.WaitGroup
 		mainErr    error
@@ -136,7 +137,7 @@ func QueryCarrierText(question string, writer *RefinedWriter, history []*model.R
 	go func() {
 		defer wg.Done()
 		var err error
-		modelResult, err = modelProviderObj.QueryText(question, writer, history, prompt, knowledge, nil, lang)
+		modelResult, err = modelProviderObj.QueryText(question, writer, history, prompt, knowledge, nil, ctx, lang)
 		if err != nil {
 			mainErr = err
 		}
@@ -149,7 +150,7 @@ func QueryCarrierText(question string, writer *RefinedWriter, history []*model.R
 	go func() {
 		defer wg.Done()
 		var err error
-		carrierResult, err = getResultWithSuggestionsAndTitle(CarrierWriter, question, modelProviderObj, needTitle, suggestionCount, lang)
+		carrierResult, err = getResultWithSuggestionsAndTitle(CarrierWriter, question, modelProviderObj, needTitle, suggestionCount, ctx, lang)
 		if err != nil {
 			carrierErr = err
 		}
@@ -0,0 +1,86 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/casibase/casibase/object"
+)
+
+var (
+	answerCancelMap = map[string]context.CancelFunc{} // message id -> cancel of its running answer
+	answerCancelMu  sync.Mutex
+)
+
+// startAnswer returns the context of the answer of the message, it is canceled
+// when the client goes away, when the answer is stopped or when done is called.
+func startAnswer(parent context.Context, messageId string) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(parent)
+
+	answerCancelMu.Lock()
+	answerCancelMap[messageId] = cancel
+	answerCancelMu.Unlock()
+
+	done := func() {
+		answerCancelMu.Lock()
+		delete(answerCancelMap, messageId)
+		answerCancelMu.Unlock()
+		cancel()
+	}
+	return ctx, done
+}
+
+func stopAnswer(messageId string) bool {
+	answerCancelMu.Lock()
+	defer answerCancelMu.Unlock()
+
+	cancel, ok := answerCancelMap[messageId]
+	if !ok {
+		return false
+	}
+
+	cancel()
+	return true
+}
+
+// StopMessageAnswer
+// @Title StopMessageAnswer
+// @Tag Message API
+// @Description stop the running answer of the message, the text answered so far is kept
+// @Param   id     query    string  true        "The id of message"
+// @Success 200 {object} controllers.Response The Response object
+// @router /stop-message-answer [post]
+func (c *ApiController) StopMessageAnswer() {
+	id := c.Input().Get("id")
+
+	message, err := object.GetMessage(id)
+	if err != nil {
+		c.ResponseError(err.Error())
+		return
+	}
+	if message == nil {
+		c.ResponseError(c.T("message_stop:The message is not found"))
+		return
+	}
+
+	ok := c.IsCurrentUser(message.User)
+	if !ok {
+		return
+	}
+
+	c.ResponseOk(stopAnswer(message.GetId()))
+}
@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	"github.com/beego/beego/context"
+	"github.com/casibase/casibase/model"
 )
 
 type RefinedWriter struct {
@@ -51,6 +52,32 @@ func (w *RefinedWriter) Write(p []byte) (n int, err error) {
 		data = string(bytes.TrimSuffix(bytes.TrimPrefix(p, prefix), suffix))
 	}
 
+	return w.writeEvent(eventType, data)
+}
+
+// HandleStreamEvent writes the text and reasoning of the model as "message"
+// and "reason" events, a finished tool call is shown in the reasoning.
+func (w *RefinedWriter) HandleStreamEvent(event *model.StreamEvent) error {
+	var err error
+	switch event.Type {
+	case model.StreamEventTextDelta:
+		_, err = w.writeEvent("message", event.Text)
+	case model.StreamEventReasoningDelta:
+		_, err = w.writeEvent("reason", event.Text)
+	case model.StreamEventToolCallEnd:
+		_, err = w.writeEvent("reason", "\n"+"Call result from "+event.ToolCall.Name+"\n")
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	w.Flush()
+	return nil
+}
+
+func (w *RefinedWriter) writeEvent(eventType string, data string) (n int, err error) {
 	// Add data to the buffer
 	w.buf = append(w.buf, []byte(data)...)
 	if eventType == "message" {
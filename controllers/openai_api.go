@@ -16,68 +16,250 @@ package controllers
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
+	"time"
 
+	"github.com/casibase/casibase/agent"
+	"github.com/casibase/casibase/embedding"
 	"github.com/casibase/casibase/model"
 	"github.com/casibase/casibase/object"
 	"github.com/casibase/casibase/util"
 	"github.com/sashabaranov/go-openai"
 )
 
+// ChatCompletionResponse is the OpenAI chat completion response with the
+// knowledge sources used for the answer.
+type ChatCompletionResponse struct {
+	openai.ChatCompletionResponse
+	Sources []object.MessageSource `json:"sources,omitempty"`
+}
+
+// ChatCompletionStreamResponse is the OpenAI chat completion chunk with the
+// knowledge sources used for the answer, which the usage chunk carries.
+type ChatCompletionStreamResponse struct {
+	openai.ChatCompletionStreamResponse
+	Sources []object.MessageSource `json:"sources,omitempty"`
+}
+
+// ChatCompletionRequest is the OpenAI chat completion request, with a response
+// format whose JSON schema can be read and a stop that can be a string.
+type ChatCompletionRequest struct {
+	openai.ChatCompletionRequest
+	ResponseFormat *ChatCompletionResponseFormat `json:"response_format,omitempty"`
+	Stop           ChatCompletionStop            `json:"stop,omitempty"`
+}
+
+// ModelListResponse is the OpenAI model list response
+type ModelListResponse struct {
+	Object string         `json:"object"`
+	Data   []openai.Model `json:"data"`
+}
+
+// maxChatCompletionChoices is the largest n of a chat completion request
+const maxChatCompletionChoices = 8
+
+type ChatCompletionResponseFormat struct {
+	Type       string                                  `json:"type"`
+	JSONSchema *ChatCompletionResponseFormatJSONSchema `json:"json_schema,omitempty"`
+}
+
+type ChatCompletionResponseFormatJSONSchema struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Schema      map[string]interface{} `json:"schema"`
+	Strict      bool                   `json:"strict"`
+}
+
+func (f *ChatCompletionResponseFormat) getResponseFormat() (*model.ResponseFormat, error) {
+	if f == nil || f.Type == "" || f.Type == model.ResponseFormatText {
+		return nil, nil
+	}
+	if f.Type != model.ResponseFormatJsonObject && f.Type != model.ResponseFormatJsonSchema {
+		return nil, fmt.Errorf("Unsupported response format type: %s", f.Type)
+	}
+
+	res := &model.ResponseFormat{Type: f.Type}
+	if f.JSONSchema != nil {
+		res.Name = f.JSONSchema.Name
+		res.Description = f.JSONSchema.Description
+		res.Schema = f.JSONSchema.Schema
+		res.Strict = f.JSONSchema.Strict
+	}
+	return res, nil
+}
+
+// responseOpenAIError responds with an error object like the OpenAI API does, so
+// that the OpenAI SDKs raise it as an API error. Once a stream has started,
+// the error is sent as its last message instead.
+func (c *ApiController) responseOpenAIError(writer *OpenAIWriter, status int, errorType string, message string) {
+	apiError := &openai.APIError{Type: errorType, Message: message, HTTPStatusCode: status}
+	if writer != nil && writer.StreamSent {
+		_ = writer.WriteError(apiError)
+		c.EnableRender = false
+		return
+	}
+
+	c.Ctx.Output.SetStatus(status)
+	c.Data["json"] = openai.ErrorResponse{Error: apiError}
+	c.ServeJSON()
+}
+
+// getOpenAIApiKey returns the API key of the Authorization header
+func (c *ApiController) getOpenAIApiKey() (string, bool) {
+	apiKey := c.Ctx.Request.Header.Get("Authorization")
+	if !strings.HasPrefix(apiKey, "Bearer ") {
+		c.responseOpenAIError(nil, http.StatusUnauthorized, "authentication_error", "Invalid API key format. Expected 'Bearer API_KEY'")
+		return "", false
+	}
+
+	return strings.TrimPrefix(apiKey, "Bearer "), true
+}
+
+// ListModels lists the model providers that can be used with the API key
+// @Title ListModels
+// @Tag OpenAI Compatible API
+// @Description OpenAI compatible model list API
+// @Success 200 {object} controllers.ModelListResponse
+// @router /api/models [get]
+func (c *ApiController) ListModels() {
+	apiKey, ok := c.getOpenAIApiKey()
+	if !ok {
+		return
+	}
+
+	providers, err := object.GetModelProvidersByProviderKey(apiKey, c.GetAcceptLanguage())
+	if err != nil {
+		c.responseOpenAIError(nil, http.StatusInternalServerError, "api_error", err.Error())
+		return
+	}
+	if len(providers) == 0 {
+		c.responseOpenAIError(nil, http.StatusUnauthorized, "authentication_error", c.T("object:The provider is not found"))
+		return
+	}
+
+	models := []openai.Model{}
+	for _, provider := range providers {
+		created := int64(0)
+		createdTime, err := time.Parse(time.RFC3339, provider.CreatedTime)
+		if err == nil {
+			created = createdTime.Unix()
+		}
+
+		models = append(models, openai.Model{
+			ID:         provider.Name,
+			Object:     "model",
+			CreatedAt:  created,
+			OwnedBy:    provider.Owner,
+			Permission: []openai.Permission{},
+			Root:       provider.Name,
+		})
+	}
+
+	c.Data["json"] = ModelListResponse{Object: "list", Data: models}
+	c.ServeJSON()
+}
+
 // ChatCompletions implements the OpenAI-compatible chat completions API
 // @Title ChatCompletions
 // @Tag OpenAI Compatible API
 // @Description OpenAI compatible chat completions API
-// @Param   body    body    openai.ChatCompletionRequest  true    "The OpenAI chat request"
-// @Success 200 {object} openai.ChatCompletionResponse
+// @Param   body    body    controllers.ChatCompletionRequest  true    "The OpenAI chat request"
+// @Param   store   query   string  false   "The store to retrieve knowledge from"
+// @Success 200 {object} controllers.ChatCompletionResponse
 // @router /api/chat/completions [post]
 func (c *ApiController) ChatCompletions() {
 	// Authenticate using API key
-	apiKey := c.Ctx.Request.Header.Get("Authorization")
-	if !strings.HasPrefix(apiKey, "Bearer ") {
-		c.ResponseError("Invalid API key format. Expected 'Bearer API_KEY'")
+	apiKey, ok := c.getOpenAIApiKey()
+	if !ok {
 		return
 	}
 
-	apiKey = strings.TrimPrefix(apiKey, "Bearer ")
+	// Parse request body
+	var request ChatCompletionRequest
+	err := json.Unmarshal(c.Ctx.Input.RequestBody, &request)
+	if err != nil {
+		c.responseOpenAIError(nil, http.StatusBadRequest, "invalid_request_error", fmt.Sprintf("Failed to parse request: %s", err.Error()))
+		return
+	}
 
-	// Get the model provider based on API key
-	modelProvider, err := object.GetModelProviderByProviderKey(apiKey, c.GetAcceptLanguage())
+	// The model of the request is one of the providers of the API key, the first one by default
+	provider, modelProvider, err := object.GetModelProviderByProviderKeyAndName(apiKey, request.Model, c.GetAcceptLanguage())
 	if err != nil {
-		c.ResponseError(fmt.Sprintf("Authentication failed: %s", err.Error()))
+		c.responseOpenAIError(nil, http.StatusUnauthorized, "authentication_error", fmt.Sprintf("Authentication failed: %s", err.Error()))
 		return
 	}
 
-	// Parse request body
-	var request openai.ChatCompletionRequest
-	err = json.Unmarshal(c.Ctx.Input.RequestBody, &request)
+	responseFormat, err := request.ResponseFormat.getResponseFormat()
 	if err != nil {
-		c.ResponseError(fmt.Sprintf("Failed to parse request: %s", err.Error()))
+		c.responseOpenAIError(nil, http.StatusBadRequest, "invalid_request_error", err.Error())
 		return
 	}
 
-	// Extract messages content
-	var question string
-	var systemPrompt string
+	n := request.N
+	if n <= 0 {
+		n = 1
+	}
+	if n > maxChatCompletionChoices {
+		c.responseOpenAIError(nil, http.StatusBadRequest, "invalid_request_error", fmt.Sprintf("n must be at most %d", maxChatCompletionChoices))
+		return
+	}
 
-	for _, msg := range request.Messages {
-		if msg.Role == "system" {
-			systemPrompt = msg.Content
-		} else if msg.Role == "user" {
-			// Keep the last user message
-			question = msg.Content
-		}
+	// The whole conversation is passed on, the tools are run by the client
+	messages, err := getChatCompletionMessages(request.Messages, provider.SubType)
+	if err != nil {
+		c.responseOpenAIError(nil, http.StatusBadRequest, "invalid_request_error", err.Error())
+		return
 	}
 
-	if question == "" {
-		c.ResponseError("No user message found in the request")
+	tools, err := getChatCompletionTools(request.Tools, request.ToolChoice)
+	if err != nil {
+		c.responseOpenAIError(nil, http.StatusBadRequest, "invalid_request_error", err.Error())
 		return
 	}
 
-	// Combine system prompt with user question if available
-	if systemPrompt != "" {
-		question = fmt.Sprintf("System: %s\n\nUser: %s", systemPrompt, question)
+	maxTokens := request.MaxCompletionTokens
+	if maxTokens <= 0 {
+		maxTokens = request.MaxTokens
+	}
+	options := &model.GenerationOptions{MaxTokens: maxTokens, Stop: request.Stop}
+
+	// Retrieve knowledge if a store is given, the answer cites it like in the chat
+	question := messages.Question
+	prompt := messages.Prompt
+	knowledge := []*model.RawMessage{}
+	sources := []object.MessageSource{}
+	embeddingResult := &embedding.EmbeddingResult{}
+	storeName := c.Input().Get("store")
+	if storeName != "" {
+		var store *object.Store
+		store, knowledge, sources, embeddingResult, err = c.getChatCompletionKnowledge(storeName, provider, question)
+		if err != nil {
+			c.responseOpenAIError(nil, http.StatusBadRequest, "invalid_request_error", err.Error())
+			return
+		}
+
+		// The store answers through its routing, starting with the provider of the request
+		if store.ModelRouting != "" {
+			provider, modelProvider, err = object.GetRoutedModelProvider(store, provider.Name, question, c.GetAcceptLanguage())
+			if err != nil {
+				c.responseOpenAIError(nil, http.StatusInternalServerError, "api_error", err.Error())
+				return
+			}
+		}
+
+		prompt = store.Prompt
+		if store.CrossLingualSearch != "" {
+			prompt = object.GetPromptWithLanguage(prompt, question)
+		}
+		if len(sources) != 0 {
+			prompt = object.GetPromptWithCitations(prompt)
+		}
+		if messages.Prompt != "" {
+			prompt = fmt.Sprintf("%s\n\n%s", prompt, messages.Prompt)
+		}
 	}
 
 	// Setup for streaming if enabled
@@ -98,64 +280,127 @@ func (c *ApiController) ChatCompletions() {
 		Model:     request.Model,
 	}
 
-	// Prepare empty history and knowledge for the model
-	history := []*model.RawMessage{}
-	knowledge := []*model.RawMessage{}
+	// Call the model provider once per choice, a JSON answer is validated before it is sent
+	choices := []openai.ChatCompletionChoice{}
+	// The knowledge retrieval is counted in the prompt, like on the question message of a chat
+	usage := openai.Usage{PromptTokens: embeddingResult.TokenCount}
+	for i := 0; i < n; i++ {
+		writer.StartChoice(i)
 
-	// Call the model provider
-	modelResult, err := modelProvider.QueryText(question, writer, history, "", knowledge, nil, c.GetAcceptLanguage())
-	if err != nil {
-		c.ResponseError(err.Error())
-		return
+		var modelResult *model.ModelResult
+		if responseFormat.IsJson() {
+			model.SetGenerationOptions(modelProvider, options)
+			modelResult, err = model.QueryStructuredText(modelProvider, question, writer, messages.History, prompt, knowledge, responseFormat, c.Ctx.Request.Context(), c.GetAcceptLanguage())
+		} else {
+			// The agent messages are given to each choice, the providers add their tool calls to them
+			agentInfo := &model.AgentInfo{
+				AgentMessages: &model.AgentMessages{Messages: append([]*model.RawMessage{}, messages.AgentMessages...)},
+			}
+			if len(tools) != 0 {
+				agentInfo.AgentClients = &agent.AgentClients{Tools: tools}
+			}
+
+			modelResult, err = model.QueryTextWithOptions(modelProvider, question, writer, messages.History, prompt, knowledge, agentInfo, options, c.Ctx.Request.Context(), c.GetAcceptLanguage())
+		}
+		if err != nil {
+			c.responseOpenAIError(writer, http.StatusInternalServerError, "api_error", err.Error())
+			return
+		}
+
+		// The prompt is the same for all the choices
+		if i == 0 {
+			usage.PromptTokens += modelResult.PromptTokenCount
+		}
+		usage.CompletionTokens += modelResult.ResponseTokenCount
+
+		err = writer.FinishChoice()
+		if err != nil {
+			c.responseOpenAIError(writer, http.StatusInternalServerError, "api_error", err.Error())
+			return
+		}
+
+		choices = append(choices, writer.GetChoice())
 	}
+	usage.TotalTokens = usage.PromptTokens + usage.CompletionTokens
 
 	// Handle response based on streaming mode
 	if !request.Stream {
 		// For non-streaming, send complete response at once
-		answer := writer.MessageString()
-
-		// Create response using go-openai structures
-		response := openai.ChatCompletionResponse{
+		chatCompletionResponse := openai.ChatCompletionResponse{
 			ID:      "chatcmpl-" + requestId,
 			Object:  "chat.completion",
 			Created: util.GetCurrentUnixTime(),
 			Model:   request.Model,
-			Choices: []openai.ChatCompletionChoice{
-				{
-					Index: 0,
-					Message: openai.ChatCompletionMessage{
-						Role:    "assistant",
-						Content: answer,
-					},
-					FinishReason: openai.FinishReasonStop,
-				},
-			},
-			Usage: openai.Usage{
-				PromptTokens:     modelResult.PromptTokenCount,
-				CompletionTokens: modelResult.ResponseTokenCount,
-				TotalTokens:      modelResult.TotalTokenCount,
-			},
+			Choices: choices,
+			Usage:   usage,
+		}
+		response := ChatCompletionResponse{
+			ChatCompletionResponse: chatCompletionResponse,
+			Sources:                object.GetCitedSources(choices[0].Message.Content, sources),
 		}
 
 		jsonResponse, err := json.Marshal(response)
 		if err != nil {
-			c.ResponseError(err.Error())
+			c.responseOpenAIError(nil, http.StatusInternalServerError, "api_error", err.Error())
 			return
 		}
 
 		c.Ctx.Output.Header("Content-Type", "application/json")
 		c.Ctx.Output.Body(jsonResponse)
 	} else {
-		// For streaming, close the stream with token counts
-		err = writer.Close(
-			modelResult.PromptTokenCount,
-			modelResult.ResponseTokenCount,
-			modelResult.TotalTokenCount,
-		)
+		// For streaming, close the stream with token counts and sources
+		writer.Sources = object.GetCitedSources(choices[0].Message.Content, sources)
+		err = writer.Close(usage)
 		if err != nil {
-			c.ResponseError(err.Error())
+			c.responseOpenAIError(writer, http.StatusInternalServerError, "api_error", err.Error())
 			return
 		}
 	}
 	c.EnableRender = false
 }
+
+// getChatCompletionKnowledge returns the knowledge of the store for the question,
+// with the cost of the embedding and the search, which is part of the usage.
+func (c *ApiController) getChatCompletionKnowledge(storeName string, modelProvider *object.Provider, question string) (*object.Store, []*model.RawMessage, []object.MessageSource, *embedding.EmbeddingResult, error) {
+	store, err := object.GetStore(util.GetIdFromOwnerAndName("admin", storeName))
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	if store == nil {
+		return nil, nil, nil, nil, fmt.Errorf(c.T("openai_api:The store: %s is not found"), storeName)
+	}
+
+	// The API key only reaches the stores that answer with its model provider
+	if !c.IsAdmin() && !store.HasModelProvider(modelProvider) {
+		return nil, nil, nil, nil, fmt.Errorf(c.T("openai_api:The model provider: %s is not allowed to use the store: %s"), modelProvider.Name, storeName)
+	}
+
+	embeddingProvider, embeddingProviderObj, err := object.GetEmbeddingProviderFromContext("admin", store.EmbeddingProvider, c.GetAcceptLanguage())
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	knowledgeCount := store.KnowledgeCount
+	if knowledgeCount <= 0 {
+		knowledgeCount = 10
+	}
+
+	fileAccess, err := object.GetFileAccess(c.GetSessionUser())
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	filter := fileAccess.GetVectorFilter()
+	knowledge, sources, embeddingResult, err := object.GetNearestKnowledge(store, embeddingProvider, embeddingProviderObj, modelProvider, "admin", question, knowledgeCount, filter, c.Ctx.Request.Context(), c.GetAcceptLanguage())
+	if embeddingResult == nil {
+		embeddingResult = &embedding.EmbeddingResult{}
+	}
+	if err != nil {
+		if errors.Is(err, object.ErrNoKnowledgeVectors) {
+			return store, []*model.RawMessage{}, []object.MessageSource{}, embeddingResult, nil
+		}
+		return nil, nil, nil, nil, err
+	}
+
+	return store, knowledge, sources, embeddingResult, nil
+}
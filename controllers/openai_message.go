@@ -0,0 +1,201 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ThinkInAIXYZ/go-mcp/protocol"
+	"github.com/casibase/casibase/model"
+	"github.com/sashabaranov/go-openai"
+)
+
+// ChatCompletionStop is the stop of a chat completion request, which is
+// either a single sequence or a list of them.
+type ChatCompletionStop []string
+
+func (s *ChatCompletionStop) UnmarshalJSON(data []byte) error {
+	var stop string
+	if json.Unmarshal(data, &stop) == nil {
+		if stop == "" {
+			*s = nil
+		} else {
+			*s = []string{stop}
+		}
+		return nil
+	}
+
+	var stops []string
+	err := json.Unmarshal(data, &stops)
+	if err != nil {
+		return err
+	}
+
+	*s = stops
+	return nil
+}
+
+// ChatCompletionMessages is the conversation of a chat completion request as
+// the model providers take it: the system messages are the prompt, the last
+// user message is the question, the messages before it are the history,
+// newest first, and the tool calls and results after it continue the answer.
+type ChatCompletionMessages struct {
+	Prompt        string
+	Question      string
+	History       []*model.RawMessage
+	AgentMessages []*model.RawMessage
+}
+
+// getChatCompletionText returns the text of a message, with its images as
+// <img> tags like in the chat.
+func getChatCompletionText(message openai.ChatCompletionMessage) string {
+	if len(message.MultiContent) == 0 {
+		return message.Content
+	}
+
+	texts := []string{}
+	for _, part := range message.MultiContent {
+		if part.Type == openai.ChatMessagePartTypeText {
+			texts = append(texts, part.Text)
+		} else if part.Type == openai.ChatMessagePartTypeImageURL && part.ImageURL != nil {
+			texts = append(texts, fmt.Sprintf("<img src=\"%s\">", part.ImageURL.URL))
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+func getChatCompletionRawMessages(message openai.ChatCompletionMessage, subType string) ([]*model.RawMessage, error) {
+	// The token count only trims the history, a message without it is kept as a whole
+	text := getChatCompletionText(message)
+	textTokenCount, err := model.GetTokenSize(subType, text)
+	if err != nil {
+		textTokenCount = 0
+	}
+
+	switch message.Role {
+	case openai.ChatMessageRoleAssistant:
+		if len(message.ToolCalls) == 0 {
+			return []*model.RawMessage{{Text: text, Author: "AI", TextTokenCount: textTokenCount}}, nil
+		}
+
+		// The tool calls are one message each, the first one has the text
+		res := []*model.RawMessage{}
+		for i, toolCall := range message.ToolCalls {
+			toolCall.Index = nil
+			rawMessage := &model.RawMessage{Author: "AI", ToolCall: toolCall}
+			if i == 0 {
+				rawMessage.Text = text
+				rawMessage.TextTokenCount = textTokenCount
+			}
+			res = append(res, rawMessage)
+		}
+		return res, nil
+	case openai.ChatMessageRoleTool:
+		return []*model.RawMessage{{Text: text, Author: "Tool", TextTokenCount: textTokenCount, ToolCallID: message.ToolCallID}}, nil
+	case openai.ChatMessageRoleUser:
+		return []*model.RawMessage{{Text: text, Author: "User", TextTokenCount: textTokenCount}}, nil
+	default:
+		return nil, fmt.Errorf("Unsupported message role: %s", message.Role)
+	}
+}
+
+func getChatCompletionMessages(messages []openai.ChatCompletionMessage, subType string) (*ChatCompletionMessages, error) {
+	questionIndex := -1
+	for i, message := range messages {
+		if message.Role == openai.ChatMessageRoleUser {
+			questionIndex = i
+		}
+	}
+	if questionIndex == -1 {
+		return nil, fmt.Errorf("No user message found in the request")
+	}
+
+	res := &ChatCompletionMessages{
+		Question:      getChatCompletionText(messages[questionIndex]),
+		History:       []*model.RawMessage{},
+		AgentMessages: []*model.RawMessage{},
+	}
+
+	prompts := []string{}
+	for i, message := range messages {
+		if i == questionIndex {
+			continue
+		}
+
+		if message.Role == openai.ChatMessageRoleSystem || message.Role == openai.ChatMessageRoleDeveloper {
+			prompts = append(prompts, getChatCompletionText(message))
+			continue
+		}
+
+		rawMessages, err := getChatCompletionRawMessages(message, subType)
+		if err != nil {
+			return nil, err
+		}
+
+		if i < questionIndex {
+			res.History = append(res.History, rawMessages...)
+		} else {
+			res.AgentMessages = append(res.AgentMessages, rawMessages...)
+		}
+	}
+	res.Prompt = strings.Join(prompts, "\n\n")
+
+	// The history of the model providers is newest first
+	for i, j := 0, len(res.History)-1; i < j; i, j = i+1, j-1 {
+		res.History[i], res.History[j] = res.History[j], res.History[i]
+	}
+
+	return res, nil
+}
+
+// getChatCompletionTools returns the tools of a request as MCP tools, the
+// model providers pass them to the model and the client runs them.
+func getChatCompletionTools(tools []openai.Tool, toolChoice any) ([]*protocol.Tool, error) {
+	if toolChoice == "none" {
+		return nil, nil
+	}
+
+	res := []*protocol.Tool{}
+	for _, tool := range tools {
+		if tool.Type != openai.ToolTypeFunction || tool.Function == nil {
+			return nil, fmt.Errorf("Unsupported tool type: %s", tool.Type)
+		}
+
+		inputSchema := protocol.InputSchema{}
+		if tool.Function.Parameters != nil {
+			parameters, err := json.Marshal(tool.Function.Parameters)
+			if err != nil {
+				return nil, err
+			}
+
+			err = json.Unmarshal(parameters, &inputSchema)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid parameters of the tool: %s: %s", tool.Function.Name, err.Error())
+			}
+		}
+		if inputSchema.Type == "" {
+			inputSchema.Type = protocol.Object
+		}
+
+		res = append(res, &protocol.Tool{
+			Name:        tool.Function.Name,
+			Description: tool.Function.Description,
+			InputSchema: inputSchema,
+		})
+	}
+	return res, nil
+}
@@ -20,20 +20,39 @@ import (
 	"fmt"
 
 	"github.com/beego/beego/context"
+	"github.com/casibase/casibase/model"
+	"github.com/casibase/casibase/object"
 	"github.com/casibase/casibase/util"
 	"github.com/sashabaranov/go-openai"
 )
 
-// OpenAIWriter implements a writer that formats responses in OpenAI format
+// OpenAIWriter implements a writer that formats responses in OpenAI format.
+// An answer with several choices is written one choice after another, each
+// one started by StartChoice and finished by FinishChoice.
 type OpenAIWriter struct {
 	context.Response
-	Cleaner    Cleaner
-	Buffer     []byte
-	MessageBuf []byte
-	RequestID  string
-	Stream     bool
-	StreamSent bool
-	Model      string
+	Cleaner      Cleaner
+	Buffer       []byte
+	MessageBuf   []byte
+	RequestID    string
+	Stream       bool
+	StreamSent   bool
+	Model        string
+	Sources      []object.MessageSource
+	FinishReason string
+	Index        int
+	ToolCalls    []openai.ToolCall
+	roleSent     bool
+}
+
+// StartChoice resets the writer for the choice at index
+func (w *OpenAIWriter) StartChoice(index int) {
+	w.Buffer = []byte{}
+	w.MessageBuf = []byte{}
+	w.FinishReason = ""
+	w.Index = index
+	w.ToolCalls = nil
+	w.roleSent = false
 }
 
 // Write processes incoming data chunks and formats them for OpenAI compatibility
@@ -74,6 +93,133 @@ func (w *OpenAIWriter) Write(p []byte) (n int, err error) {
 		return len(p), nil
 	}
 
+	err = w.writeDelta(openai.ChatCompletionStreamChoiceDelta{Content: content}, "")
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// HandleStreamEvent sends the text and the tool calls of the model as chunks,
+// the reasoning is stored but not exposed, and the finish reason is kept for
+// FinishChoice.
+func (w *OpenAIWriter) HandleStreamEvent(event *model.StreamEvent) error {
+	switch event.Type {
+	case model.StreamEventTextDelta:
+		w.MessageBuf = append(w.MessageBuf, []byte(event.Text)...)
+		w.Buffer = append(w.Buffer, []byte(event.Text)...)
+		if !w.Stream || event.Text == "" {
+			return nil
+		}
+		return w.writeDelta(openai.ChatCompletionStreamChoiceDelta{Content: event.Text}, "")
+	case model.StreamEventReasoningDelta:
+		w.Buffer = append(w.Buffer, []byte(event.Text)...)
+	case model.StreamEventToolCallStart:
+		index := event.ToolCall.Index
+		toolCall := openai.ToolCall{
+			Index:    &index,
+			ID:       event.ToolCall.Id,
+			Type:     openai.ToolTypeFunction,
+			Function: openai.FunctionCall{Name: event.ToolCall.Name},
+		}
+		w.ToolCalls = append(w.ToolCalls, toolCall)
+		return w.writeToolCallDelta(toolCall)
+	case model.StreamEventToolCallArgs:
+		toolCall := w.getToolCall(event.ToolCall.Index)
+		if toolCall == nil {
+			return nil
+		}
+
+		toolCall.Function.Arguments += event.ToolCall.Arguments
+		return w.writeToolCallDelta(openai.ToolCall{
+			Index:    toolCall.Index,
+			Type:     openai.ToolTypeFunction,
+			Function: openai.FunctionCall{Arguments: event.ToolCall.Arguments},
+		})
+	case model.StreamEventToolCallEnd:
+		// The providers that do not stream the tool calls only end them
+		toolCall := w.getToolCall(event.ToolCall.Index)
+		if toolCall != nil {
+			toolCall.Function.Arguments = event.ToolCall.Arguments
+			return nil
+		}
+
+		index := event.ToolCall.Index
+		toolCall = &openai.ToolCall{
+			Index:    &index,
+			ID:       event.ToolCall.Id,
+			Type:     openai.ToolTypeFunction,
+			Function: openai.FunctionCall{Name: event.ToolCall.Name, Arguments: event.ToolCall.Arguments},
+		}
+		w.ToolCalls = append(w.ToolCalls, *toolCall)
+		return w.writeToolCallDelta(*toolCall)
+	case model.StreamEventFinish:
+		w.FinishReason = event.FinishReason
+	}
+	return nil
+}
+
+func (w *OpenAIWriter) getToolCall(index int) *openai.ToolCall {
+	for i := range w.ToolCalls {
+		if *w.ToolCalls[i].Index == index {
+			return &w.ToolCalls[i]
+		}
+	}
+	return nil
+}
+
+// GetFinishReason returns the finish reason of the answer in OpenAI format
+func (w *OpenAIWriter) GetFinishReason() openai.FinishReason {
+	if len(w.ToolCalls) != 0 && (w.FinishReason == "" || w.FinishReason == model.FinishReasonStop) {
+		return openai.FinishReasonToolCalls
+	}
+	if w.FinishReason == "" {
+		return openai.FinishReasonStop
+	}
+	return openai.FinishReason(w.FinishReason)
+}
+
+// GetChoice returns the complete choice for a non-streaming response
+func (w *OpenAIWriter) GetChoice() openai.ChatCompletionChoice {
+	toolCalls := []openai.ToolCall{}
+	for _, toolCall := range w.ToolCalls {
+		toolCall.Index = nil
+		toolCalls = append(toolCalls, toolCall)
+	}
+	if len(toolCalls) == 0 {
+		toolCalls = nil
+	}
+
+	return openai.ChatCompletionChoice{
+		Index: w.Index,
+		Message: openai.ChatCompletionMessage{
+			Role:      openai.ChatMessageRoleAssistant,
+			Content:   w.MessageString(),
+			ToolCalls: toolCalls,
+		},
+		FinishReason: w.GetFinishReason(),
+	}
+}
+
+func (w *OpenAIWriter) writeToolCallDelta(toolCall openai.ToolCall) error {
+	if !w.Stream {
+		return nil
+	}
+
+	return w.writeDelta(openai.ChatCompletionStreamChoiceDelta{ToolCalls: []openai.ToolCall{toolCall}}, "")
+}
+
+// writeDelta sends a chunk of the current choice, the first chunk of a
+// choice carries the role
+func (w *OpenAIWriter) writeDelta(delta openai.ChatCompletionStreamChoiceDelta, finishReason openai.FinishReason) error {
+	if !w.roleSent {
+		delta.Role = openai.ChatMessageRoleAssistant
+		w.roleSent = true
+	}
+	if finishReason == "" {
+		finishReason = openai.FinishReasonNull
+	}
+
 	// Create SSE chunk using go-openai library structure
 	chunk := openai.ChatCompletionStreamResponse{
 		ID:      "chatcmpl-" + w.RequestID,
@@ -82,30 +228,47 @@ func (w *OpenAIWriter) Write(p []byte) (n int, err error) {
 		Model:   w.Model,
 		Choices: []openai.ChatCompletionStreamChoice{
 			{
-				Index: 0,
-				Delta: openai.ChatCompletionStreamChoiceDelta{
-					Content: content,
-				},
-				FinishReason: openai.FinishReasonNull,
+				Index:        w.Index,
+				Delta:        delta,
+				FinishReason: finishReason,
 			},
 		},
 	}
 
 	jsonData, err := json.Marshal(chunk)
 	if err != nil {
-		return 0, err
+		return err
 	}
 
+	return w.writeData(jsonData)
+}
+
+func (w *OpenAIWriter) writeData(data []byte) error {
 	// Send as SSE data chunk - use ResponseWriter to avoid recursion
-	_, err = w.ResponseWriter.Write([]byte(fmt.Sprintf("data: %s\n\n", jsonData)))
+	_, err := w.ResponseWriter.Write([]byte(fmt.Sprintf("data: %s\n\n", data)))
 	if err != nil {
-		return 0, err
+		return err
 	}
 
 	w.StreamSent = true
 	w.Flush()
+	return nil
+}
 
-	return len(p), nil
+// WriteError sends the error as the last message of a stream that has already started
+func (w *OpenAIWriter) WriteError(apiError *openai.APIError) error {
+	jsonData, err := json.Marshal(openai.ErrorResponse{Error: apiError})
+	if err != nil {
+		return err
+	}
+
+	err = w.writeData(jsonData)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.ResponseWriter.Write([]byte("data: [DONE]\n\n"))
+	return err
 }
 
 // MessageString returns the complete buffered message
@@ -113,65 +276,50 @@ func (w *OpenAIWriter) MessageString() string {
 	return string(w.MessageBuf)
 }
 
-// Close finalizes the stream by sending completion message and DONE marker
-func (w *OpenAIWriter) Close(promptTokens, completionTokens, totalTokens int) error {
+// FinishChoice sends the finish reason of the current choice
+func (w *OpenAIWriter) FinishChoice() error {
+	if !w.Stream {
+		return nil
+	}
+
+	return w.writeDelta(openai.ChatCompletionStreamChoiceDelta{}, w.GetFinishReason())
+}
+
+// Close finalizes the stream by sending the usage with the knowledge sources and the DONE marker
+func (w *OpenAIWriter) Close(usage openai.Usage) error {
 	if !w.Stream {
 		return nil
 	}
 
-	if w.StreamSent {
-		// Send final message with finish_reason
-		chunk := openai.ChatCompletionStreamResponse{
+	// The usage chunk has no choices, like the one of OpenAI with include_usage
+	chunk := ChatCompletionStreamResponse{
+		ChatCompletionStreamResponse: openai.ChatCompletionStreamResponse{
 			ID:      "chatcmpl-" + w.RequestID,
 			Object:  "chat.completion.chunk",
 			Created: util.GetCurrentUnixTime(),
 			Model:   w.Model,
-			Choices: []openai.ChatCompletionStreamChoice{
-				{
-					Index:        0,
-					Delta:        openai.ChatCompletionStreamChoiceDelta{}, // Empty delta
-					FinishReason: openai.FinishReasonStop,
-				},
-			},
-		}
-
-		jsonData, err := json.Marshal(chunk)
-		if err != nil {
-			return err
-		}
-
-		_, err = w.ResponseWriter.Write([]byte(fmt.Sprintf("data: %s\n\n", jsonData)))
-		if err != nil {
-			return err
-		}
-
-		// Send usage information as a custom message
-		usage := map[string]interface{}{
-			"usage": openai.Usage{
-				PromptTokens:     promptTokens,
-				CompletionTokens: completionTokens,
-				TotalTokens:      totalTokens,
-			},
-		}
-
-		usageData, err := json.Marshal(usage)
-		if err != nil {
-			return err
-		}
+			Choices: []openai.ChatCompletionStreamChoice{},
+			Usage:   &usage,
+		},
+		Sources: w.Sources,
+	}
 
-		_, err = w.ResponseWriter.Write([]byte(fmt.Sprintf("data: %s\n\n", usageData)))
-		if err != nil {
-			return err
-		}
+	jsonData, err := json.Marshal(chunk)
+	if err != nil {
+		return err
+	}
 
-		// Final [DONE] marker for SSE
-		_, err = w.ResponseWriter.Write([]byte("data: [DONE]\n\n"))
-		if err != nil {
-			return err
-		}
+	err = w.writeData(jsonData)
+	if err != nil {
+		return err
+	}
 
-		w.Flush()
+	// Final [DONE] marker for SSE
+	_, err = w.ResponseWriter.Write([]byte("data: [DONE]\n\n"))
+	if err != nil {
+		return err
 	}
 
+	w.Flush()
 	return nil
 }
@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 
 	"github.com/casdoor/casdoor-go-sdk/casdoorsdk"
+	"github.com/casibase/casibase/object"
 	"github.com/casibase/casibase/util"
 )
 
@@ -71,6 +72,12 @@ func (c *ApiController) UpdatePermission() {
 		panic(err)
 	}
 
+	err = object.CheckFilePermission(&permission, c.GetAcceptLanguage())
+	if err != nil {
+		c.ResponseError(err.Error())
+		return
+	}
+
 	success, err := casdoorsdk.UpdatePermission(&permission)
 	if err != nil {
 		c.ResponseError(err.Error())
@@ -95,6 +102,12 @@ func (c *ApiController) AddPermission() {
 		return
 	}
 
+	err = object.CheckFilePermission(&permission, c.GetAcceptLanguage())
+	if err != nil {
+		c.ResponseError(err.Error())
+		return
+	}
+
 	success, err := casdoorsdk.AddPermission(&permission)
 	if err != nil {
 		c.ResponseError(err.Error())
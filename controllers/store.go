@@ -128,6 +128,14 @@ func (c *ApiController) GetStore() {
 			c.ResponseOk(store, err.Error())
 			return
 		}
+
+		var fileAccess *object.FileAccess
+		fileAccess, err = object.GetFileAccess(c.GetSessionUser())
+		if err != nil {
+			c.ResponseError(err.Error())
+			return
+		}
+		store.FileTree = fileAccess.FilterTreeFile(store.Name, store.FileTree)
 	}
 
 	c.ResponseOk(store)
@@ -162,6 +170,12 @@ func (c *ApiController) UpdateStore() {
 		return
 	}
 
+	err = object.CheckStoreFilePermissions(&store, c.GetAcceptLanguage())
+	if err != nil {
+		c.ResponseError(err.Error())
+		return
+	}
+
 	success, err := object.UpdateStore(id, &store)
 	if err != nil {
 		c.ResponseError(err.Error())
@@ -285,13 +299,13 @@ func (c *ApiController) RefreshStoreVectors() {
 		return
 	}
 
-	ok, err := object.RefreshStoreVectors(&store, c.GetAcceptLanguage())
+	res, err := object.RefreshStoreVectors(&store, c.GetAcceptLanguage())
 	if err != nil {
 		c.ResponseError(err.Error())
 		return
 	}
 
-	c.ResponseOk(ok)
+	c.ResponseOk(res)
 }
 
 // GetStoreNames ...
@@ -148,7 +148,7 @@ func (c *ApiController) AddVector() {
 		}
 	}
 
-	success, err := object.AddVector(&vector)
+	success, err := object.AddVector(&vector, c.GetAcceptLanguage())
 	if err != nil {
 		c.ResponseError(err.Error())
 		return
@@ -172,7 +172,7 @@ func (c *ApiController) DeleteVector() {
 		return
 	}
 
-	success, err := object.DeleteVector(&vector)
+	success, err := object.DeleteVector(&vector, c.GetAcceptLanguage())
 	if err != nil {
 		c.ResponseError(err.Error())
 		return
@@ -198,7 +198,7 @@ func (c *ApiController) DeleteAllVectors() {
 
 	allSuccess := true
 	for i := range vectors {
-		success, err := object.DeleteVector(vectors[i])
+		success, err := object.DeleteVector(vectors[i], c.GetAcceptLanguage())
 		if err != nil {
 			c.ResponseError(err.Error())
 			return
@@ -15,10 +15,13 @@
 package controllers
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/beego/beego/logs"
+	"github.com/casibase/casibase/i18n"
 	"github.com/casibase/casibase/model"
 	"github.com/casibase/casibase/object"
 	"github.com/casibase/casibase/util"
@@ -166,12 +169,27 @@ func sendMessage(store *object.Store, question string, lang string) (string, err
 		return "", err
 	}
 
-	knowledge, _, _, err := object.GetNearestKnowledge(store.Name, store.VectorStores, store.SearchProvider, embeddingProvider, embeddingProviderObj, modelProvider, "admin", question, store.KnowledgeCount, lang)
+	// The WeCom users are not Casdoor users, they only read the files that no
+	// permission guards.
+	fileAccess, err := object.GetFileAccess(nil)
 	if err != nil {
 		return "", err
 	}
+
+	knowledge, _, _, err := object.GetNearestKnowledge(store, embeddingProvider, embeddingProviderObj, modelProvider, "admin", question, store.KnowledgeCount, fileAccess.GetVectorFilter(), context.Background(), lang)
+	if errors.Is(err, object.ErrNoKnowledgeVectors) {
+		return "", fmt.Errorf(i18n.Translate(lang, "object:no knowledge vectors found"))
+	}
+	if err != nil {
+		return "", err
+	}
+	prompt := store.Prompt
+	if store.CrossLingualSearch != "" {
+		prompt = object.GetPromptWithLanguage(prompt, question)
+	}
+
 	var history []*model.RawMessage
-	answer, _, err := object.GetAnswerWithContext(store.ModelProvider, question, history, knowledge, store.Prompt, lang)
+	answer, _, err := object.GetStoreAnswerWithContext(store, store.ModelProvider, question, history, knowledge, prompt, context.Background(), lang)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,178 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embedding
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/casibase/casibase/i18n"
+	"github.com/casibase/casibase/model"
+	"golang.org/x/time/rate"
+)
+
+// BatchEmbeddingProvider is implemented by the providers whose API can embed
+// several texts in one request.
+type BatchEmbeddingProvider interface {
+	EmbeddingProvider
+	GetMaxBatchSize() int
+	QueryVectors(texts []string, ctx context.Context, lang string) ([][]float32, *EmbeddingResult, error)
+}
+
+// TokenLimitedEmbeddingProvider is implemented by the batch providers whose API
+// also limits the summed tokens of the texts of one request.
+type TokenLimitedEmbeddingProvider interface {
+	BatchEmbeddingProvider
+	GetMaxBatchTokenCount() int
+}
+
+// requestsPerMinuteMap holds the request rate used for the provider types when
+// embedding many texts, a bit below the limits of their entry tiers. Self-hosted
+// providers are not limited.
+var requestsPerMinuteMap = map[string]int{
+	"OpenAI":        3000,
+	"Azure":         720,
+	"Jina":          500,
+	"Cohere":        2000,
+	"Gemini":        1500,
+	"Hugging Face":  300,
+	"Baidu Cloud":   600,
+	"Alibaba Cloud": 1200,
+	"Tencent Cloud": 1200,
+	"MiniMax":       120,
+}
+
+var (
+	rateLimiterMap = map[string]*rate.Limiter{}
+	rateLimiterMu  sync.Mutex
+)
+
+// GetMaxBatchSize returns how many texts one QueryVectors request of the
+// provider can hold, which is 1 for providers without a batch API.
+func GetMaxBatchSize(p EmbeddingProvider) int {
+	if batchProvider, ok := p.(BatchEmbeddingProvider); ok && batchProvider.GetMaxBatchSize() > 0 {
+		return batchProvider.GetMaxBatchSize()
+	}
+	return 1
+}
+
+// GetBatchEnd returns the end of the batch of texts that starts at start, the
+// batch holds at most GetMaxBatchSize texts and, for the providers that limit
+// it, at most their summed token count. A batch always holds at least one text.
+func GetBatchEnd(p EmbeddingProvider, texts []string, start int) int {
+	end := min(start+GetMaxBatchSize(p), len(texts))
+
+	tokenLimitedProvider, ok := p.(TokenLimitedEmbeddingProvider)
+	if !ok || tokenLimitedProvider.GetMaxBatchTokenCount() <= 0 {
+		return end
+	}
+
+	maxTokenCount := tokenLimitedProvider.GetMaxBatchTokenCount()
+	tokenCount := 0
+	for i := start; i < end; i++ {
+		tokenCount += getTextTokenCount(texts[i])
+		if tokenCount > maxTokenCount && i > start {
+			return i
+		}
+	}
+	return end
+}
+
+func getTextTokenCount(text string) int {
+	tokenCount, err := model.GetTokenSize("text-embedding-3-small", text)
+	if err != nil {
+		// Without the tokenizer, count every character as a token, which is
+		// more than the tokens of the text.
+		return utf8.RuneCountInString(text)
+	}
+	return tokenCount
+}
+
+// QueryVectors embeds the texts with as few requests as the provider allows and
+// falls back to one QueryVector call per text. The embedding result is the sum
+// over all requests.
+func QueryVectors(p EmbeddingProvider, texts []string, ctx context.Context, lang string) ([][]float32, *EmbeddingResult, error) {
+	res := make([][]float32, 0, len(texts))
+	embeddingResult := &EmbeddingResult{}
+
+	batchProvider, ok := p.(BatchEmbeddingProvider)
+	for start, end := 0, 0; start < len(texts); start = end {
+		end = GetBatchEnd(p, texts, start)
+
+		var vectors [][]float32
+		var result *EmbeddingResult
+		var err error
+		if ok {
+			vectors, result, err = batchProvider.QueryVectors(texts[start:end], ctx, lang)
+		} else {
+			var vector []float32
+			vector, result, err = p.QueryVector(texts[start], ctx, lang)
+			vectors = [][]float32{vector}
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(vectors) != end-start {
+			return nil, nil, fmt.Errorf(i18n.Translate(lang, "embedding:expected %d embeddings in the response, got: %d"), end-start, len(vectors))
+		}
+
+		res = append(res, vectors...)
+		addEmbeddingResult(embeddingResult, result)
+	}
+
+	return res, embeddingResult, nil
+}
+
+func addEmbeddingResult(res *EmbeddingResult, result *EmbeddingResult) {
+	if result == nil {
+		return
+	}
+
+	res.TokenCount += result.TokenCount
+	res.Price += result.Price
+	if res.Currency == "" {
+		res.Currency = result.Currency
+	}
+}
+
+// GetRateLimiter returns the limiter shared by every request sent with the
+// named provider, or nil when its type is not limited.
+func GetRateLimiter(providerName string, typ string) *rate.Limiter {
+	requestsPerMinute, ok := requestsPerMinuteMap[typ]
+	if !ok {
+		return nil
+	}
+
+	rateLimiterMu.Lock()
+	defer rateLimiterMu.Unlock()
+
+	limiter, ok := rateLimiterMap[providerName]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(float64(requestsPerMinute)/60), max(1, requestsPerMinute/60))
+		rateLimiterMap[providerName] = limiter
+	}
+	return limiter
+}
+
+func checkVectors(vectors [][]float32, lang string) error {
+	for i, vector := range vectors {
+		if len(vector) == 0 {
+			return fmt.Errorf(i18n.Translate(lang, "embedding:no embedding found in the response for input: %d"), i)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package embedding
+
+import (
+	"context"
+	"testing"
+)
+
+type countingEmbeddingProvider struct {
+	batchSize    int
+	requestCount int
+}
+
+func (p *countingEmbeddingProvider) GetPricing() string {
+	return ""
+}
+
+func (p *countingEmbeddingProvider) QueryVector(text string, ctx context.Context, lang string) ([]float32, *EmbeddingResult, error) {
+	p.requestCount++
+	return []float32{float32(len(text))}, &EmbeddingResult{TokenCount: 1, Price: 0.5, Currency: "USD"}, nil
+}
+
+type countingBatchEmbeddingProvider struct {
+	countingEmbeddingProvider
+}
+
+func (p *countingBatchEmbeddingProvider) GetMaxBatchSize() int {
+	return p.batchSize
+}
+
+func (p *countingBatchEmbeddingProvider) QueryVectors(texts []string, ctx context.Context, lang string) ([][]float32, *EmbeddingResult, error) {
+	p.requestCount++
+	res := [][]float32{}
+	for _, text := range texts {
+		res = append(res, []float32{float32(len(text))})
+	}
+	return res, &EmbeddingResult{TokenCount: len(texts), Price: 0.5 * float64(len(texts)), Currency: "USD"}, nil
+}
+
+func TestQueryVectors(t *testing.T) {
+	texts := []string{"a", "bb", "ccc", "dddd", "eeeee"}
+
+	providers := []struct {
+		name             string
+		provider         EmbeddingProvider
+		expectedRequests int
+	}{
+		{"fallback", &countingEmbeddingProvider{}, 5},
+		{"batch", &countingBatchEmbeddingProvider{countingEmbeddingProvider{batchSize: 2}}, 3},
+	}
+
+	for _, p := range providers {
+		vectors, embeddingResult, err := QueryVectors(p.provider, texts, context.Background(), "en")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, vector := range vectors {
+			if len(vector) != 1 || vector[0] != float32(len(texts[i])) {
+				t.Errorf("%s: vector %d = %v, want the embedding of %q", p.name, i, vector, texts[i])
+			}
+		}
+		if embeddingResult.TokenCount != 5 || embeddingResult.Price != 2.5 || embeddingResult.Currency != "USD" {
+			t.Errorf("%s: embedding result = %+v, want 5 tokens for 2.5 USD", p.name, embeddingResult)
+		}
+
+		requestCount := 0
+		switch provider := p.provider.(type) {
+		case *countingEmbeddingProvider:
+			requestCount = provider.requestCount
+		case *countingBatchEmbeddingProvider:
+			requestCount = provider.requestCount
+		}
+		if requestCount != p.expectedRequests {
+			t.Errorf("%s: sent %d requests, want %d", p.name, requestCount, p.expectedRequests)
+		}
+	}
+}
+
+type tokenLimitedEmbeddingProvider struct {
+	countingBatchEmbeddingProvider
+	maxTokenCount int
+}
+
+func (p *tokenLimitedEmbeddingProvider) GetMaxBatchTokenCount() int {
+	return p.maxTokenCount
+}
+
+func TestGetBatchEnd(t *testing.T) {
+	texts := []string{"a", "bb", "ccc", "dddd", "eeeee"}
+
+	tests := []struct {
+		name     string
+		provider EmbeddingProvider
+		expected []int
+	}{
+		{"batch", &countingBatchEmbeddingProvider{countingEmbeddingProvider{batchSize: 2}}, []int{2, 4, 5}},
+		{"large token limit", &tokenLimitedEmbeddingProvider{countingBatchEmbeddingProvider{countingEmbeddingProvider{batchSize: 2}}, 1000}, []int{2, 4, 5}},
+		{"small token limit", &tokenLimitedEmbeddingProvider{countingBatchEmbeddingProvider{countingEmbeddingProvider{batchSize: 2}}, 1}, []int{1, 2, 3, 4, 5}},
+	}
+
+	for _, test := range tests {
+		ends := []int{}
+		for start, end := 0, 0; start < len(texts); start = end {
+			end = GetBatchEnd(test.provider, texts, start)
+			ends = append(ends, end)
+		}
+
+		if len(ends) != len(test.expected) {
+			t.Errorf("%s: batch ends = %v, want %v", test.name, ends, test.expected)
+			continue
+		}
+		for i := range ends {
+			if ends[i] != test.expected[i] {
+				t.Errorf("%s: batch ends = %v, want %v", test.name, ends, test.expected)
+				break
+			}
+		}
+	}
+}
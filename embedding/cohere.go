@@ -16,7 +16,9 @@ package embedding
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/casibase/casibase/i18n"
 	cohere "github.com/cohere-ai/cohere-go/v2"
 	cohereclient "github.com/cohere-ai/cohere-go/v2/client"
 )
@@ -54,12 +56,25 @@ func NewCohereEmbeddingProvider(subType string, inputType string, secretKey stri
 	}, nil
 }
 
+func (p *CohereEmbeddingProvider) GetMaxBatchSize() int {
+	return 96
+}
+
 func (p *CohereEmbeddingProvider) QueryVector(text string, ctx context.Context, lang string) ([]float32, *EmbeddingResult, error) {
+	vectors, embeddingResult, err := p.QueryVectors([]string{text}, ctx, lang)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return vectors[0], embeddingResult, nil
+}
+
+func (p *CohereEmbeddingProvider) QueryVectors(texts []string, ctx context.Context, lang string) ([][]float32, *EmbeddingResult, error) {
 	client := cohereclient.NewClient(
 		cohereclient.WithToken(p.secretKey),
 	)
 
-	embeddingResult, embed, err := cohereEmbed(ctx, client, &p.subType, &p.inputType, []string{text})
+	embeddingResult, embed, err := cohereEmbed(ctx, client, &p.subType, &p.inputType, texts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -69,8 +84,16 @@ func (p *CohereEmbeddingProvider) QueryVector(text string, ctx context.Context,
 		return nil, nil, err
 	}
 
-	vector := float64ToFloat32(embed[0])
-	return vector, embeddingResult, nil
+	vectors := make([][]float32, len(embed))
+	for i, vector := range embed {
+		vectors[i] = float64ToFloat32(vector)
+	}
+
+	if len(vectors) != len(texts) {
+		return nil, nil, fmt.Errorf(i18n.Translate(lang, "embedding:expected %d embeddings in the response, got: %d"), len(texts), len(vectors))
+	}
+
+	return vectors, embeddingResult, nil
 }
 
 func cohereEmbed(ctx context.Context, client *cohereclient.Client, model *string, inputType *string, texts []string) (*EmbeddingResult, [][]float64, error) {
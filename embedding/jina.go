@@ -57,24 +57,39 @@ func (p *JinaEmbeddingProvider) calculatePrice(res *EmbeddingResult) error {
 	return nil
 }
 
+func (p *JinaEmbeddingProvider) GetMaxBatchSize() int {
+	return 512
+}
+
 func (p *JinaEmbeddingProvider) QueryVector(text string, ctx context.Context, lang string) ([]float32, *EmbeddingResult, error) {
 	if text == "" {
 		return nil, nil, fmt.Errorf(i18n.Translate(lang, "embedding:text cannot be empty"))
 	}
 
+	vectors, embeddingResult, err := p.QueryVectors([]string{text}, ctx, lang)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return vectors[0], embeddingResult, nil
+}
+
+func (p *JinaEmbeddingProvider) QueryVectors(texts []string, ctx context.Context, lang string) ([][]float32, *EmbeddingResult, error) {
 	url := "https://api.jina.ai/v1/embeddings"
 	token := p.apiKey
 	model := p.subType
 
-	if text == "" {
-		return nil, nil, fmt.Errorf(i18n.Translate(lang, "embedding:text can not be empty."))
+	for _, text := range texts {
+		if text == "" {
+			return nil, nil, fmt.Errorf(i18n.Translate(lang, "embedding:text can not be empty."))
+		}
 	}
 
 	payload := map[string]interface{}{
 		"model":          model,
 		"normalized":     true,
 		"embedding_type": "float",
-		"input":          []string{text},
+		"input":          texts,
 	}
 
 	reqBody, err := json.Marshal(payload)
@@ -124,7 +139,18 @@ func (p *JinaEmbeddingProvider) QueryVector(text string, ctx context.Context, la
 	if len(apiResponse.Data) == 0 {
 		return nil, nil, fmt.Errorf(i18n.Translate(lang, "embedding:no embeddings found in the response"))
 	}
-	embedding := apiResponse.Data[0].Embedding
+
+	vectors := make([][]float32, len(texts))
+	for _, data := range apiResponse.Data {
+		if data.Index >= 0 && data.Index < len(vectors) {
+			vectors[data.Index] = data.Embedding
+		}
+	}
+
+	err = checkVectors(vectors, lang)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	embeddingResult := &EmbeddingResult{
 		TokenCount: apiResponse.Usage.TotalTokens,
@@ -135,5 +161,5 @@ func (p *JinaEmbeddingProvider) QueryVector(text string, ctx context.Context, la
 		return nil, nil, fmt.Errorf(i18n.Translate(lang, "embedding:failed to calculate price: %v"), err)
 	}
 
-	return embedding, embeddingResult, nil
+	return vectors, embeddingResult, nil
 }
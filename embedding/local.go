@@ -98,7 +98,35 @@ func (p *LocalEmbeddingProvider) calculatePrice(res *EmbeddingResult, lang strin
 	return nil
 }
 
+func (p *LocalEmbeddingProvider) GetMaxBatchSize() int {
+	if p.typ == "OpenAI" {
+		return 2048
+	} else if p.typ == "Azure" {
+		// Older Azure deployments of text-embedding-ada-002 accept at most 16 inputs per request.
+		return 16
+	}
+	return 64
+}
+
+// GetMaxBatchTokenCount returns the limit of OpenAI on the summed tokens of the
+// inputs of one request, which 2048 long chunks easily exceed.
+func (p *LocalEmbeddingProvider) GetMaxBatchTokenCount() int {
+	if p.typ == "OpenAI" {
+		return 300000
+	}
+	return 0
+}
+
 func (p *LocalEmbeddingProvider) QueryVector(text string, ctx context.Context, lang string) ([]float32, *EmbeddingResult, error) {
+	vectors, embeddingResult, err := p.QueryVectors([]string{text}, ctx, lang)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return vectors[0], embeddingResult, nil
+}
+
+func (p *LocalEmbeddingProvider) QueryVectors(texts []string, ctx context.Context, lang string) ([][]float32, *EmbeddingResult, error) {
 	var client *openai.Client
 	if p.typ == "Local" {
 		client = getLocalClientFromUrl(p.secretKey, p.providerUrl)
@@ -117,7 +145,7 @@ func (p *LocalEmbeddingProvider) QueryVector(text string, ctx context.Context, l
 	}
 
 	resp, err := client.CreateEmbeddings(ctx, openai.EmbeddingRequest{
-		Input: []string{text},
+		Input: texts,
 		Model: openai.EmbeddingModel(model),
 	})
 	if err != nil {
@@ -134,6 +162,17 @@ func (p *LocalEmbeddingProvider) QueryVector(text string, ctx context.Context, l
 		}
 	}
 
-	vector := resp.Data[0].Embedding
-	return vector, embeddingResult, nil
+	vectors := make([][]float32, len(texts))
+	for _, data := range resp.Data {
+		if data.Index >= 0 && data.Index < len(vectors) {
+			vectors[data.Index] = data.Embedding
+		}
+	}
+
+	err = checkVectors(vectors, lang)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return vectors, embeddingResult, nil
 }
@@ -0,0 +1,63 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eval
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/casibase/casibase/i18n"
+)
+
+// Case is one line of a JSONL dataset, e.g.
+// {"id": "q1", "question": "What is the price of SKU 1234?", "answer": "9.99", "files": ["prices.xlsx"]}
+// The expected answer and the expected source files are both optional.
+type Case struct {
+	Id       string   `json:"id"`
+	Question string   `json:"question"`
+	Answer   string   `json:"answer"`
+	Files    []string `json:"files"`
+}
+
+func ParseDataset(text string, lang string) ([]*Case, error) {
+	res := []*Case{}
+	for i, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		c := &Case{}
+		err := json.Unmarshal([]byte(line), c)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.Translate(lang, "eval:failed to parse line %d of the dataset: %s"), i+1, err.Error())
+		}
+
+		if strings.TrimSpace(c.Question) == "" {
+			return nil, fmt.Errorf(i18n.Translate(lang, "eval:the question on line %d of the dataset is empty"), i+1)
+		}
+		if c.Id == "" {
+			c.Id = fmt.Sprintf("case_%d", len(res)+1)
+		}
+
+		res = append(res, c)
+	}
+
+	if len(res) == 0 {
+		return nil, fmt.Errorf(i18n.Translate(lang, "eval:the dataset is empty"))
+	}
+	return res, nil
+}
@@ -0,0 +1,160 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eval
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	choicePattern       = regexp.MustCompile(`^[ABCD]+$|^[ABCD](,\s*[ABCD])*$|^[ABCD](-[ABCD])*$|^[ABCD]( [ABCD])*$`)
+	choiceLetterPattern = regexp.MustCompile(`[ABCD]+`)
+	judgeScorePattern   = regexp.MustCompile(`[1-5]`)
+)
+
+// isSameFile tells whether a retrieved file is the expected one, an expected
+// file without a directory matches the file of that name in any directory.
+func isSameFile(expected string, retrieved string) bool {
+	expected = strings.TrimPrefix(path.Clean("/"+expected), "/")
+	retrieved = strings.TrimPrefix(path.Clean("/"+retrieved), "/")
+	if expected == retrieved {
+		return true
+	}
+	return !strings.Contains(expected, "/") && path.Base(retrieved) == expected
+}
+
+func containsFile(files []string, file string) bool {
+	for _, f := range files {
+		if isSameFile(f, file) {
+			return true
+		}
+	}
+	return false
+}
+
+// RecallAtK is the share of the expected files found in the first k retrieved
+// files.
+func RecallAtK(expectedFiles []string, retrievedFiles []string, k int) float64 {
+	if len(expectedFiles) == 0 {
+		return 0
+	}
+	if k < len(retrievedFiles) {
+		retrievedFiles = retrievedFiles[:k]
+	}
+
+	found := 0
+	for _, expected := range expectedFiles {
+		for _, retrieved := range retrievedFiles {
+			if isSameFile(expected, retrieved) {
+				found++
+				break
+			}
+		}
+	}
+	return float64(found) / float64(len(expectedFiles))
+}
+
+// ReciprocalRank is 1/rank of the first retrieved file that is expected, its
+// mean over the cases is the MRR.
+func ReciprocalRank(expectedFiles []string, retrievedFiles []string) float64 {
+	for i, retrieved := range retrievedFiles {
+		if containsFile(expectedFiles, retrieved) {
+			return 1 / float64(i+1)
+		}
+	}
+	return 0
+}
+
+// CleanChoiceAnswer extracts the letters of a multiple-choice answer like
+// "A, C" or "The answers are AC." as "AC".
+func CleanChoiceAnswer(answer string) string {
+	answer = strings.TrimSpace(answer)
+
+	if choicePattern.MatchString(answer) {
+		return strings.Map(func(r rune) rune {
+			if r == ',' || r == '-' || unicode.IsSpace(r) {
+				return -1
+			}
+			return r
+		}, answer)
+	}
+
+	// try to extract options from text
+	longestMatch := ""
+	for _, match := range choiceLetterPattern.FindAllString(answer, -1) {
+		if len(match) > len(longestMatch) {
+			longestMatch = match
+		}
+	}
+	if longestMatch != "" {
+		return longestMatch
+	}
+
+	return answer
+}
+
+// NormalizeAnswer lowercases the answer and drops punctuation and extra spaces.
+func NormalizeAnswer(answer string) string {
+	answer = strings.Map(func(r rune) rune {
+		if unicode.IsPunct(r) || unicode.IsSymbol(r) {
+			return ' '
+		}
+		return unicode.ToLower(r)
+	}, answer)
+	return strings.Join(strings.Fields(answer), " ")
+}
+
+// IsExactMatch compares the normalized answers, multiple-choice answers are
+// compared by their letters.
+func IsExactMatch(expected string, answer string) bool {
+	expected = strings.TrimSpace(expected)
+	if choicePattern.MatchString(expected) {
+		return CleanChoiceAnswer(expected) == CleanChoiceAnswer(answer)
+	}
+
+	return NormalizeAnswer(expected) == NormalizeAnswer(answer)
+}
+
+// GetJudgeQuestion asks a model to rate how well the answer is supported by the
+// knowledge, from 1 (made up) to 5 (fully supported).
+func GetJudgeQuestion(question string, knowledge []string, answer string) string {
+	var builder strings.Builder
+	builder.WriteString("You are grading the faithfulness of an answer. Rate from 1 to 5 how well every claim of the answer is supported by the knowledge: ")
+	builder.WriteString("5 means fully supported, 3 means partly supported, 1 means not supported or contradicted. Reply with only the number.\n\n")
+	for i, text := range knowledge {
+		builder.WriteString(fmt.Sprintf("Knowledge %d: %s\n\n", i+1, text))
+	}
+	builder.WriteString(fmt.Sprintf("Question: %s\n\nAnswer: %s", question, answer))
+	return builder.String()
+}
+
+// ParseJudgeScore reads the rating of the judge and scales it to [0, 1].
+func ParseJudgeScore(text string) (float64, bool) {
+	match := judgeScorePattern.FindString(text)
+	if match == "" {
+		return 0, false
+	}
+
+	score, err := strconv.Atoi(match)
+	if err != nil {
+		return 0, false
+	}
+	return float64(score-1) / 4, true
+}
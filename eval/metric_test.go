@@ -0,0 +1,102 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package eval
+
+import "testing"
+
+func TestRetrievalMetrics(t *testing.T) {
+	retrieved := []string{"docs/intro.md", "docs/prices.xlsx", "faq.md"}
+
+	tests := []struct {
+		expected []string
+		k        int
+		recall   float64
+		rank     float64
+	}{
+		{[]string{"prices.xlsx"}, 3, 1, 0.5},
+		{[]string{"prices.xlsx"}, 1, 0, 0.5},
+		{[]string{"docs/intro.md", "missing.md"}, 3, 0.5, 1},
+		{[]string{"other/faq.md"}, 3, 0, 0},
+		{[]string{}, 3, 0, 0},
+	}
+
+	for _, test := range tests {
+		if recall := RecallAtK(test.expected, retrieved, test.k); recall != test.recall {
+			t.Errorf("RecallAtK(%v, %d) = %v, want %v", test.expected, test.k, recall, test.recall)
+		}
+		if rank := ReciprocalRank(test.expected, retrieved); rank != test.rank {
+			t.Errorf("ReciprocalRank(%v) = %v, want %v", test.expected, rank, test.rank)
+		}
+	}
+}
+
+func TestIsExactMatch(t *testing.T) {
+	tests := []struct {
+		expected string
+		answer   string
+		want     bool
+	}{
+		{"Paris", "paris.", true},
+		{"9.99 USD", "9 99 usd", true},
+		{"Paris", "It is Paris", false},
+		{"AC", "A, C", true},
+		{"A,C", "The correct options are AC.", true},
+		{"AC", "ACD", false},
+	}
+
+	for _, test := range tests {
+		if got := IsExactMatch(test.expected, test.answer); got != test.want {
+			t.Errorf("IsExactMatch(%q, %q) = %v, want %v", test.expected, test.answer, got, test.want)
+		}
+	}
+}
+
+func TestParseJudgeScore(t *testing.T) {
+	tests := []struct {
+		text  string
+		score float64
+		ok    bool
+	}{
+		{"5", 1, true},
+		{"Score: 3", 0.5, true},
+		{"1", 0, true},
+		{"none", 0, false},
+	}
+
+	for _, test := range tests {
+		score, ok := ParseJudgeScore(test.text)
+		if score != test.score || ok != test.ok {
+			t.Errorf("ParseJudgeScore(%q) = %v, %v, want %v, %v", test.text, score, ok, test.score, test.ok)
+		}
+	}
+}
+
+func TestParseDataset(t *testing.T) {
+	cases, err := ParseDataset("{\"question\": \"Q1\", \"answer\": \"A\"}\n\n{\"id\": \"x\", \"question\": \"Q2\", \"files\": [\"a.md\"]}\n", "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cases) != 2 || cases[0].Id != "case_1" || cases[1].Id != "x" || len(cases[1].Files) != 1 {
+		t.Errorf("unexpected cases: %+v, %+v", cases[0], cases[1])
+	}
+
+	_, err = ParseDataset("{\"question\": \"Q1\"}\n{\"answer\": \"A\"}", "en")
+	if err == nil {
+		t.Errorf("expected an error for a case without a question")
+	}
+}
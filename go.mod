@@ -67,6 +67,7 @@ require (
 	github.com/workweixin/weworkapi_golang v0.0.0-20200831071321-c1fdfd3d6e7d
 	golang.org/x/net v0.38.0
 	golang.org/x/text v0.25.0
+	golang.org/x/time v0.9.0
 	google.golang.org/genai v1.10.0
 	google.golang.org/grpc v1.71.0
 	k8s.io/api v0.30.0
@@ -115,7 +116,6 @@ require (
 	go.yaml.in/yaml/v2 v2.4.2 // indirect
 	go.yaml.in/yaml/v3 v3.0.3 // indirect
 	golang.org/x/image v0.27.0 // indirect
-	golang.org/x/time v0.9.0 // indirect
 	gopkg.in/evanphx/json-patch.v4 v4.12.0 // indirect
 )
 
@@ -41,6 +41,7 @@ func main() {
 	util.InitParser()
 	object.InitCleanupChats()
 	object.InitStoreCount()
+	object.InitEvaluations()
 	object.InitCommitRecordsTask()
 
 	beego.InsertFilter("*", beego.BeforeRouter, cors.Allow(&cors.Options{
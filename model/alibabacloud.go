@@ -15,6 +15,7 @@
 package model
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -93,7 +94,7 @@ func (p *AlibabacloudModelProvider) calculatePrice(modelResult *ModelResult, lan
 	return nil
 }
 
-func (p *AlibabacloudModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, lang string) (*ModelResult, error) {
+func (p *AlibabacloudModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
 	const BaseUrl = "https://dashscope.aliyuncs.com/compatible-mode/v1"
 	// Create a new LocalModelProvider to handle the request
 	localProvider, err := NewLocalModelProvider("Custom-think", "custom-model", p.apiKey, p.temperature, p.topP, 0, 0, BaseUrl, p.subType, 0, 0, "CNY")
@@ -101,7 +102,7 @@ func (p *AlibabacloudModelProvider) QueryText(question string, writer io.Writer,
 		return nil, err
 	}
 
-	modelResult, err := localProvider.QueryText(question, writer, history, prompt, knowledgeMessages, agentInfo, lang)
+	modelResult, err := localProvider.QueryText(question, writer, history, prompt, knowledgeMessages, agentInfo, ctx, lang)
 	if err != nil {
 		return nil, err
 	}
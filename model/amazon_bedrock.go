@@ -108,8 +108,8 @@ func (p *AmazonBedrockModelProvider) calculatePrice(modelResult *ModelResult, la
 	return nil
 }
 
-func (p *AmazonBedrockModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, lang string) (*ModelResult, error) {
-	cfg, err := config.LoadDefaultConfig(context.TODO(), config.WithRegion("us-west-2"))
+func (p *AmazonBedrockModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
+	cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion("us-west-2"))
 	if err != nil {
 		return nil, err
 	}
@@ -138,7 +138,7 @@ func (p *AmazonBedrockModelProvider) QueryText(question string, writer io.Writer
 		}
 	}
 
-	resp, err := client.InvokeModel(context.TODO(), &bedrockruntime.InvokeModelInput{
+	resp, err := client.InvokeModel(ctx, &bedrockruntime.InvokeModelInput{
 		ModelId:     aws.String(p.subType),
 		Body:        requestBody,
 		ContentType: aws.String("application/json"),
@@ -154,7 +154,12 @@ func (p *AmazonBedrockModelProvider) QueryText(question string, writer io.Writer
 		return nil, err
 	}
 
-	_, err = writer.Write([]byte(result.Generation))
+	stream, err := NewEventStream(writer, ctx, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stream.TextDelta(result.Generation)
 	if err != nil {
 		return nil, err
 	}
@@ -168,5 +173,9 @@ func (p *AmazonBedrockModelProvider) QueryText(question string, writer io.Writer
 		return nil, err
 	}
 
+	err = stream.End(modelResult, FinishReasonStop)
+	if err != nil {
+		return nil, err
+	}
 	return modelResult, nil
 }
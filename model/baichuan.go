@@ -15,6 +15,7 @@
 package model
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -78,7 +79,7 @@ func (p *BaichuanModelProvider) calculatePrice(modelResult *ModelResult, lang st
 	return nil
 }
 
-func (p *BaichuanModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, lang string) (*ModelResult, error) {
+func (p *BaichuanModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
 	const BaseUrl = "https://api.baichuan-ai.com/v1"
 	// Create a new LocalModelProvider to handle the request
 	localProvider, err := NewLocalModelProvider("Custom", "custom-model", p.apiKey, p.temperature, p.topP, 0, 0, BaseUrl, p.subType, 0, 0, "CNY")
@@ -86,7 +87,7 @@ func (p *BaichuanModelProvider) QueryText(question string, writer io.Writer, his
 		return nil, err
 	}
 
-	modelResult, err := localProvider.QueryText(question, writer, history, prompt, knowledgeMessages, agentInfo, lang)
+	modelResult, err := localProvider.QueryText(question, writer, history, prompt, knowledgeMessages, agentInfo, ctx, lang)
 	if err != nil {
 		return nil, err
 	}
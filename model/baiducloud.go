@@ -15,6 +15,7 @@
 package model
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -122,7 +123,7 @@ func (p *BaiduCloudModelProvider) calculatePrice(modelResult *ModelResult, lang
 	return nil
 }
 
-func (p *BaiduCloudModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, lang string) (*ModelResult, error) {
+func (p *BaiduCloudModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
 	const BaseUrl = "https://qianfan.baidubce.com/v2"
 	// Create a new LocalModelProvider to handle the request
 	localProvider, err := NewLocalModelProvider("Custom-think", "custom-model", p.apiKey, p.temperature, p.topP, 0, 0, BaseUrl, p.subType, 0, 0, "CNY")
@@ -130,7 +131,7 @@ func (p *BaiduCloudModelProvider) QueryText(question string, writer io.Writer, h
 		return nil, err
 	}
 
-	modelResult, err := localProvider.QueryText(question, writer, history, prompt, knowledgeMessages, agentInfo, lang)
+	modelResult, err := localProvider.QueryText(question, writer, history, prompt, knowledgeMessages, agentInfo, ctx, lang)
 	if err != nil {
 		return nil, err
 	}
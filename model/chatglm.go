@@ -15,9 +15,9 @@
 package model
 
 import (
+	"context"
 	"fmt"
 	"io"
-	"net/http"
 	"strings"
 	"time"
 
@@ -64,7 +64,7 @@ func (p *ChatGLMModelProvider) calculatePrice(modelResult *ModelResult, lang str
 	return nil
 }
 
-func (p *ChatGLMModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, lang string) (*ModelResult, error) {
+func (p *ChatGLMModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
 	proxy := client.NewChatGLMClient(p.clientSecret, 30*time.Second)
 	messages := []client.Message{{Role: "user", Content: question}}
 	taskId, err := proxy.AsyncInvoke(p.subType, 0.2, messages)
@@ -72,17 +72,9 @@ func (p *ChatGLMModelProvider) QueryText(question string, writer io.Writer, hist
 		return nil, err
 	}
 
-	flusher, ok := writer.(http.Flusher)
-	if !ok {
-		return nil, fmt.Errorf(i18n.Translate(lang, "model:writer does not implement http.Flusher"))
-	}
-
-	flushData := func(data string) error {
-		if _, err = fmt.Fprintf(writer, "event: message\ndata: %s\n\n", data); err != nil {
-			return err
-		}
-		flusher.Flush()
-		return nil
+	stream, err := NewEventStream(writer, ctx, lang)
+	if err != nil {
+		return nil, err
 	}
 
 	if strings.HasPrefix(question, "$CasibaseDryRun$") {
@@ -104,7 +96,7 @@ func (p *ChatGLMModelProvider) QueryText(question string, writer io.Writer, hist
 
 	content := (*response.Choices)[0].Content
 
-	err = flushData(content)
+	err = stream.TextDelta(content)
 	if err != nil {
 		return nil, err
 	}
@@ -119,5 +111,9 @@ func (p *ChatGLMModelProvider) QueryText(question string, writer io.Writer, hist
 		return nil, err
 	}
 
+	err = stream.End(modelResult, FinishReasonStop)
+	if err != nil {
+		return nil, err
+	}
 	return modelResult, nil
 }
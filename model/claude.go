@@ -18,7 +18,6 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"net/http"
 	"strings"
 
 	"github.com/anthropics/anthropic-sdk-go"
@@ -32,12 +31,56 @@ type ClaudeModelProvider struct {
 	secretKey      string
 	budgetTokens   int
 	enableThinking bool
+	responseFormat *ResponseFormat
+	options        *GenerationOptions
 }
 
 func NewClaudeModelProvider(subType string, secretKey string, enableThinking bool, budgetTokens int) (*ClaudeModelProvider, error) {
 	return &ClaudeModelProvider{subType: subType, secretKey: secretKey, enableThinking: enableThinking, budgetTokens: budgetTokens}, nil
 }
 
+// SetResponseFormat makes Claude answer by calling a tool whose input schema is
+// the schema of the format, the input of the call is the answer.
+func (p *ClaudeModelProvider) SetResponseFormat(format *ResponseFormat) {
+	p.responseFormat = format
+}
+
+func (p *ClaudeModelProvider) SetGenerationOptions(options *GenerationOptions) {
+	p.options = options
+}
+
+func getClaudeOutputTool(format *ResponseFormat) anthropic.ToolParam {
+	inputSchema := anthropic.ToolInputSchemaParam{ExtraFields: map[string]any{}}
+	for key, value := range format.Schema {
+		switch key {
+		case "type":
+		case "properties":
+			inputSchema.Properties = value
+		case "required":
+			if required, ok := value.([]interface{}); ok {
+				for _, name := range required {
+					if s, ok := name.(string); ok {
+						inputSchema.Required = append(inputSchema.Required, s)
+					}
+				}
+			}
+		default:
+			inputSchema.ExtraFields[key] = value
+		}
+	}
+
+	description := format.Description
+	if description == "" {
+		description = "Answer with the input of this tool."
+	}
+
+	return anthropic.ToolParam{
+		Name:        format.GetName(),
+		Description: anthropic.String(description),
+		InputSchema: inputSchema,
+	}
+}
+
 func (p *ClaudeModelProvider) GetPricing() string {
 	return `URL:
 https://docs.anthropic.com/en/docs/about-claude/pricing
@@ -88,7 +131,7 @@ func (p *ClaudeModelProvider) calculatePrice(modelResult *ModelResult, lang stri
 	return nil
 }
 
-func (p *ClaudeModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, lang string) (*ModelResult, error) {
+func (p *ClaudeModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
 	client := anthropic.NewClient(
 		option.WithAPIKey(p.secretKey),
 		option.WithHTTPClient(proxy.ProxyHttpClient),
@@ -131,31 +174,38 @@ func (p *ClaudeModelProvider) QueryText(question string, writer io.Writer, histo
 		StopSequences: []string{"```\n"},
 		System:        textBlockList,
 	}
-	if p.enableThinking {
+	if !p.options.IsEmpty() {
+		if p.options.MaxTokens > 0 && p.options.MaxTokens < maxTokens {
+			messageParams.MaxTokens = int64(p.options.MaxTokens)
+		}
+		messageParams.StopSequences = append(messageParams.StopSequences, p.options.Stop...)
+	}
+
+	// A forced tool call can not come with thinking.
+	if p.responseFormat.IsJson() {
+		tool := getClaudeOutputTool(p.responseFormat)
+		messageParams.StopSequences = nil
+		messageParams.Tools = []anthropic.ToolUnionParam{{OfTool: &tool}}
+		messageParams.ToolChoice = anthropic.ToolChoiceUnionParam{OfTool: &anthropic.ToolChoiceToolParam{Name: tool.Name}}
+	} else if p.enableThinking {
 		messageParams.Thinking = anthropic.ThinkingConfigParamUnion{
 			OfEnabled: &anthropic.ThinkingConfigEnabledParam{
 				BudgetTokens: int64(p.budgetTokens),
 			},
 		}
 	}
-	stream := client.Messages.NewStreaming(context.TODO(), messageParams)
+	respStream := client.Messages.NewStreaming(ctx, messageParams)
 
-	flusher, ok := writer.(http.Flusher)
-	if !ok {
-		return nil, fmt.Errorf(i18n.Translate(lang, "model:writer does not implement http.Flusher"))
-	}
-
-	flushData := func(event string, data string) error {
-		if _, err := fmt.Fprintf(writer, "event: %s\ndata: %s\n\n", event, data); err != nil {
-			return err
-		}
-		flusher.Flush()
-		return nil
+	stream, err := NewEventStream(writer, ctx, lang)
+	if err != nil {
+		return nil, err
 	}
 
 	modelResult := &ModelResult{}
-	for stream.Next() {
-		event := stream.Current()
+	finishReason := FinishReasonStop
+	var answerData strings.Builder
+	for respStream.Next() {
+		event := respStream.Current()
 
 		switch eventVariant := event.AsAny().(type) {
 		case anthropic.MessageStartEvent:
@@ -164,31 +214,54 @@ func (p *ClaudeModelProvider) QueryText(question string, writer io.Writer, histo
 		case anthropic.ContentBlockDeltaEvent:
 			switch deltaVariant := eventVariant.Delta.AsAny().(type) {
 			case anthropic.ThinkingDelta:
-				err := flushData("reason", deltaVariant.Thinking)
+				err := stream.ReasoningDelta(deltaVariant.Thinking)
 				if err != nil {
 					return nil, err
 				}
 			case anthropic.TextDelta:
-				err := flushData("message", deltaVariant.Text)
+				err := stream.TextDelta(deltaVariant.Text)
+				if err != nil {
+					return nil, err
+				}
+				answerData.WriteString(deltaVariant.Text)
+			case anthropic.InputJSONDelta:
+				err := stream.TextDelta(deltaVariant.PartialJSON)
 				if err != nil {
 					return nil, err
 				}
+				answerData.WriteString(deltaVariant.PartialJSON)
 			}
 		case anthropic.MessageDeltaEvent:
 			outputTokens := int(eventVariant.Usage.OutputTokens)
 			modelResult.ResponseTokenCount = outputTokens
+			if eventVariant.Delta.StopReason == anthropic.StopReasonMaxTokens {
+				finishReason = FinishReasonLength
+			}
 		}
 	}
 
-	if stream.Err() != nil {
-		return nil, stream.Err()
+	if respStream.Err() != nil && !stream.Stopped() {
+		return nil, stream.Error(respStream.Err())
+	}
+
+	// The output tokens only come at the end of the answer.
+	if stream.Stopped() && modelResult.ResponseTokenCount == 0 {
+		answerResult, err := getDefaultModelResult(p.subType, "", answerData.String())
+		if err != nil {
+			return nil, err
+		}
+		modelResult.ResponseTokenCount = answerResult.ResponseTokenCount
 	}
 	modelResult.TotalTokenCount = modelResult.PromptTokenCount + modelResult.ResponseTokenCount
 
-	err := p.calculatePrice(modelResult, lang)
+	err = p.calculatePrice(modelResult, lang)
 	if err != nil {
 		return nil, err
 	}
 
+	err = stream.End(modelResult, finishReason)
+	if err != nil {
+		return nil, err
+	}
 	return modelResult, nil
 }
@@ -91,11 +91,10 @@ func (p *CohereModelProvider) calculatePrice(modelResult *ModelResult, lang stri
 	return nil
 }
 
-func (p *CohereModelProvider) QueryText(message string, writer io.Writer, chat_history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, lang string) (*ModelResult, error) {
+func (p *CohereModelProvider) QueryText(message string, writer io.Writer, chat_history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
 	client := cohereclient.NewClient(
 		cohereclient.WithToken(p.secretKey),
 	)
-	ctx := context.Background()
 
 	// if p.maxTokens > 0, use p.maxTokens, otherwise use model's default Maxtokens
 	maxTokens := getContextLength(p.subType)
@@ -129,7 +128,12 @@ func (p *CohereModelProvider) QueryText(message string, writer io.Writer, chat_h
 	output := generation.Generations[0].Text
 	resp := strings.Split(output, "\n")[0]
 
-	_, err = fmt.Fprint(writer, resp)
+	stream, err := NewEventStream(writer, ctx, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stream.TextDelta(resp)
 	if err != nil {
 		return nil, err
 	}
@@ -144,5 +148,9 @@ func (p *CohereModelProvider) QueryText(message string, writer io.Writer, chat_h
 		return nil, err
 	}
 
+	err = stream.End(modelResult, FinishReasonStop)
+	if err != nil {
+		return nil, err
+	}
 	return modelResult, nil
 }
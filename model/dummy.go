@@ -15,6 +15,7 @@
 package model
 
 import (
+	"context"
 	"io"
 	"strings"
 )
@@ -39,14 +40,25 @@ This is a dummy module provider.
 `
 }
 
-func (p *DummyModelProvider) QueryText(message string, writer io.Writer, chat_history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, lang string) (*ModelResult, error) {
+func (p *DummyModelProvider) QueryText(message string, writer io.Writer, chat_history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
 	answer := "this is the answer for \"" + message + "\""
 	if strings.HasPrefix(message, "$CasibaseDryRun$") {
 		return &ModelResult{}, nil
 	}
-	err := flushDataAzure(answer, writer, lang)
+	stream, err := NewEventStream(writer, ctx, lang)
 	if err != nil {
 		return nil, err
 	}
-	return &ModelResult{}, nil
+
+	err = flushDataAzure(answer, stream)
+	if err != nil {
+		return nil, err
+	}
+
+	modelResult := &ModelResult{}
+	err = stream.End(modelResult, FinishReasonStop)
+	if err != nil {
+		return nil, err
+	}
+	return modelResult, nil
 }
@@ -16,9 +16,9 @@ package model
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
-	"net/http"
 	"strings"
 
 	"github.com/casibase/casibase/i18n"
@@ -27,11 +27,13 @@ import (
 )
 
 type GeminiModelProvider struct {
-	subType     string
-	secretKey   string
-	temperature float32
-	topP        float32
-	topK        int
+	subType        string
+	secretKey      string
+	temperature    float32
+	topP           float32
+	topK           int
+	responseFormat *ResponseFormat
+	options        *GenerationOptions
 }
 
 func NewGeminiModelProvider(subType string, secretKey string, temperature float32, topP float32, topK int) (*GeminiModelProvider, error) {
@@ -45,6 +47,79 @@ func NewGeminiModelProvider(subType string, secretKey string, temperature float3
 	return p, nil
 }
 
+func (p *GeminiModelProvider) SetResponseFormat(format *ResponseFormat) {
+	p.responseFormat = format
+}
+
+// getGenaiSchema converts a JSON schema to the schema of Gemini, whose types
+// are in upper case.
+func getGenaiSchema(schema map[string]interface{}) (*genai.Schema, error) {
+	var upperTypes func(value interface{}) interface{}
+	upperTypes = func(value interface{}) interface{} {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			res := map[string]interface{}{}
+			for key, item := range v {
+				if typ, ok := item.(string); ok && key == "type" {
+					res[key] = strings.ToUpper(typ)
+				} else {
+					res[key] = upperTypes(item)
+				}
+			}
+			return res
+		case []interface{}:
+			res := []interface{}{}
+			for _, item := range v {
+				res = append(res, upperTypes(item))
+			}
+			return res
+		default:
+			return v
+		}
+	}
+
+	data, err := json.Marshal(upperTypes(schema))
+	if err != nil {
+		return nil, err
+	}
+
+	res := &genai.Schema{}
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (p *GeminiModelProvider) SetGenerationOptions(options *GenerationOptions) {
+	p.options = options
+}
+
+func (p *GeminiModelProvider) getGenerateContentConfig() (*genai.GenerateContentConfig, error) {
+	if !p.responseFormat.IsJson() && p.options.IsEmpty() {
+		return nil, nil
+	}
+
+	config := &genai.GenerateContentConfig{}
+	if !p.options.IsEmpty() {
+		config.MaxOutputTokens = int32(p.options.MaxTokens)
+		config.StopSequences = p.options.Stop
+	}
+	if !p.responseFormat.IsJson() {
+		return config, nil
+	}
+
+	config.ResponseMIMEType = "application/json"
+	if p.responseFormat.Type == ResponseFormatJsonSchema && p.responseFormat.Schema != nil {
+		schema, err := getGenaiSchema(p.responseFormat.Schema)
+		if err != nil {
+			return nil, err
+		}
+		config.ResponseSchema = schema
+	}
+	return config, nil
+}
+
 func (p *GeminiModelProvider) GetPricing() string {
 	return `URL: https://ai.google.dev/gemini-api/docs/pricing
 | Model                                    | Input Price (per 1M tokens)              | Output Price (per 1M tokens)            |
@@ -203,8 +278,7 @@ func (p *GeminiModelProvider) calculatePrice(modelResult *ModelResult, lang stri
 	return nil
 }
 
-func (p *GeminiModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, lang string) (*ModelResult, error) {
-	ctx := context.Background()
+func (p *GeminiModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
 	// Access your API key as an environment variable (see "Set up your API key" above)
 	client, err := genai.NewClient(ctx,
 		&genai.ClientConfig{
@@ -241,29 +315,35 @@ func (p *GeminiModelProvider) QueryText(question string, writer io.Writer, histo
 	}
 
 	messages := GenaiRawMessagesToMessages(question, history)
-	resp, err := model.GenerateContent(ctx, p.subType, messages, nil)
+	config, err := p.getGenerateContentConfig()
 	if err != nil {
 		return nil, err
 	}
 
-	flusher, ok := writer.(http.Flusher)
-	if !ok {
-		return nil, fmt.Errorf(i18n.Translate(lang, "model:writer does not implement http.Flusher"))
+	resp, err := model.GenerateContent(ctx, p.subType, messages, config)
+	if err != nil {
+		return nil, err
 	}
 
-	flushData := func(data []*genai.Part) error {
-		for _, message := range data {
-			if _, err := fmt.Fprintf(writer, "event: message\ndata: %s\n\n", message.Text); err != nil {
-				return err
-			}
-			flusher.Flush()
+	stream, err := NewEventStream(writer, ctx, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, part := range resp.Candidates[0].Content.Parts {
+		if part.Thought {
+			err = stream.ReasoningDelta(part.Text)
+		} else {
+			err = stream.TextDelta(part.Text)
+		}
+		if err != nil {
+			return nil, err
 		}
-		return nil
 	}
 
-	err = flushData(resp.Candidates[0].Content.Parts)
-	if err != nil {
-		return nil, err
+	finishReason := FinishReasonStop
+	if resp.Candidates[0].FinishReason == genai.FinishReasonMaxTokens {
+		finishReason = FinishReasonLength
 	}
 
 	respTokenCount := int(resp.Candidates[0].TokenCount)
@@ -279,5 +359,9 @@ func (p *GeminiModelProvider) QueryText(question string, writer io.Writer, histo
 		return nil, err
 	}
 
+	err = stream.End(modelResult, finishReason)
+	if err != nil {
+		return nil, err
+	}
 	return modelResult, nil
 }
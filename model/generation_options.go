@@ -0,0 +1,186 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// GenerationOptions limit the answer of a query: MaxTokens is the maximum
+// number of tokens of the answer, and the answer ends before the first of the
+// Stop sequences. Zero values mean no limit.
+type GenerationOptions struct {
+	MaxTokens int
+	Stop      []string
+}
+
+// GenerationOptionsProvider is implemented by the model providers that can
+// pass the generation options to their model. The options apply to the
+// following queries of the provider.
+type GenerationOptionsProvider interface {
+	SetGenerationOptions(options *GenerationOptions)
+}
+
+func (o *GenerationOptions) IsEmpty() bool {
+	return o == nil || (o.MaxTokens <= 0 && len(o.Stop) == 0)
+}
+
+// SetGenerationOptions passes the options to the provider when it supports
+// them.
+func SetGenerationOptions(p ModelProvider, options *GenerationOptions) {
+	if optionsProvider, ok := p.(GenerationOptionsProvider); ok {
+		optionsProvider.SetGenerationOptions(options)
+	}
+}
+
+// QueryTextWithOptions answers within the generation options. The providers
+// that support them pass them to their model, and the answers of all of them
+// are checked too: the answer is stopped once it reaches a stop sequence or
+// the maximum number of tokens, with the finish reason "stop" or "length".
+func QueryTextWithOptions(p ModelProvider, question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, options *GenerationOptions, ctx context.Context, lang string) (*ModelResult, error) {
+	if options.IsEmpty() {
+		return p.QueryText(question, writer, history, prompt, knowledgeMessages, agentInfo, ctx, lang)
+	}
+
+	SetGenerationOptions(p, options)
+
+	stream, err := NewEventStream(writer, ctx, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	limitCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	limitWriter := &generationLimitWriter{stream: stream, options: options, cancel: cancel}
+	for _, stop := range options.Stop {
+		limitWriter.stopLength = max(limitWriter.stopLength, utf8.RuneCountInString(stop))
+	}
+
+	modelResult, err := p.QueryText(question, limitWriter, history, prompt, knowledgeMessages, agentInfo, limitCtx, lang)
+	if err != nil && limitWriter.finishReason == "" {
+		return nil, err
+	}
+	if modelResult == nil {
+		modelResult = &ModelResult{}
+	}
+
+	err = limitWriter.flush()
+	if err != nil {
+		return nil, err
+	}
+
+	finishReason := limitWriter.finishReason
+	if finishReason == "" {
+		finishReason = limitWriter.modelFinishReason
+	}
+	if finishReason == "" {
+		finishReason = FinishReasonStop
+	}
+	return modelResult, stream.Finish(finishReason)
+}
+
+// generationLimitWriter passes the events of a model provider on, and stops
+// the answer at the limits of the options. The text that could be the start
+// of a stop sequence is held back until it is known not to be one.
+type generationLimitWriter struct {
+	stream  *EventStream
+	options *GenerationOptions
+	cancel  context.CancelFunc
+
+	stopLength        int
+	pending           string
+	tokenCount        int
+	finishReason      string
+	modelFinishReason string
+}
+
+func (w *generationLimitWriter) Write(p []byte) (int, error) {
+	return len(p), w.HandleStreamEvent(&StreamEvent{Type: StreamEventTextDelta, Text: string(p)})
+}
+
+func (w *generationLimitWriter) HandleStreamEvent(event *StreamEvent) error {
+	if w.finishReason != "" {
+		return nil
+	}
+
+	switch event.Type {
+	case StreamEventTextDelta:
+		return w.handleText(event.Text)
+	case StreamEventFinish:
+		w.modelFinishReason = event.FinishReason
+		return nil
+	default:
+		return w.stream.emit(event)
+	}
+}
+
+func (w *generationLimitWriter) handleText(text string) error {
+	w.pending += text
+
+	for _, stop := range w.options.Stop {
+		if stop == "" {
+			continue
+		}
+
+		if index := strings.Index(w.pending, stop); index >= 0 {
+			w.pending = w.pending[:index]
+			w.end(FinishReasonStop)
+			return w.flush()
+		}
+	}
+
+	if w.options.MaxTokens > 0 {
+		// Without the tokenizer, a token is about four bytes of text
+		tokenCount, err := GetTokenSize("", text)
+		if err != nil {
+			tokenCount = (len(text) + 3) / 4
+		}
+		w.tokenCount += tokenCount
+		if w.tokenCount >= w.options.MaxTokens {
+			w.end(FinishReasonLength)
+			return w.flush()
+		}
+	}
+
+	runes := []rune(w.pending)
+	if len(runes) < w.stopLength {
+		return nil
+	}
+
+	keepLength := max(w.stopLength-1, 0)
+	text = string(runes[:len(runes)-keepLength])
+	w.pending = string(runes[len(runes)-keepLength:])
+	return w.stream.TextDelta(text)
+}
+
+// end stops the answer, the provider returns the part answered so far.
+func (w *generationLimitWriter) end(finishReason string) {
+	w.finishReason = finishReason
+	w.cancel()
+}
+
+func (w *generationLimitWriter) flush() error {
+	if w.pending == "" {
+		return nil
+	}
+
+	text := w.pending
+	w.pending = ""
+	return w.stream.TextDelta(text)
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package model
+
+import (
+	"context"
+	"io"
+	"testing"
+)
+
+type testOptionsProvider struct {
+	deltas    []string
+	options   *GenerationOptions
+	deltaNum  int
+	isStopped bool
+}
+
+func (p *testOptionsProvider) GetPricing() string {
+	return ""
+}
+
+func (p *testOptionsProvider) SetGenerationOptions(options *GenerationOptions) {
+	p.options = options
+}
+
+func (p *testOptionsProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
+	stream, err := NewEventStream(writer, ctx, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, delta := range p.deltas {
+		if ctx.Err() != nil {
+			p.isStopped = true
+			return &ModelResult{}, nil
+		}
+
+		p.deltaNum++
+		err = stream.TextDelta(delta)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &ModelResult{}, stream.Finish(FinishReasonStop)
+}
+
+func TestQueryTextWithOptions(t *testing.T) {
+	tests := []struct {
+		options      *GenerationOptions
+		expected     string
+		finishReason string
+	}{
+		{&GenerationOptions{}, "Hello world. Bye now", FinishReasonStop},
+		{&GenerationOptions{Stop: []string{"Bye"}}, "Hello world. ", FinishReasonStop},
+		{&GenerationOptions{Stop: []string{"d. B"}}, "Hello worl", FinishReasonStop},
+		{&GenerationOptions{Stop: []string{"Hi"}}, "Hello world. Bye now", FinishReasonStop},
+		{&GenerationOptions{MaxTokens: 1}, "Hello", FinishReasonLength},
+	}
+
+	for _, test := range tests {
+		provider := &testOptionsProvider{deltas: []string{"Hello", " wor", "ld. B", "ye", " now"}}
+		writer := &testEventWriter{}
+		_, err := QueryTextWithOptions(provider, "Hi", writer, nil, "", nil, nil, test.options, context.Background(), "en")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		text := ""
+		finishReason := ""
+		for _, event := range writer.events {
+			if event.Type == StreamEventTextDelta {
+				text += event.Text
+			} else if event.Type == StreamEventFinish {
+				if finishReason != "" {
+					t.Errorf("got more than one finish event for %v", test.options)
+				}
+				finishReason = event.FinishReason
+			}
+		}
+
+		if text != test.expected || finishReason != test.finishReason {
+			t.Errorf("got %q and %q for %v, expected %q and %q", text, finishReason, test.options, test.expected, test.finishReason)
+		}
+		if !test.options.IsEmpty() && provider.options != test.options {
+			t.Errorf("the options should be set on a provider that supports them")
+		}
+		if test.finishReason == FinishReasonLength && !provider.isStopped {
+			t.Errorf("the provider should be stopped at the maximum number of tokens")
+		}
+	}
+}
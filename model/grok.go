@@ -15,6 +15,7 @@
 package model
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"strings"
@@ -98,7 +99,7 @@ func (p *GrokModelProvider) calculatePrice(modelResult *ModelResult, lang string
 	return nil
 }
 
-func (p *GrokModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, lang string) (*ModelResult, error) {
+func (p *GrokModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
 	// Create a LocalModelProvider to handle the request
 	const BaseUrl = "https://api.x.ai/v1"
 	localProvider, err := NewLocalModelProvider("Custom", "custom-model", p.secretKey, p.temperature, p.topP, 0, 0, BaseUrl, p.subType, 0, 0, "USD")
@@ -106,7 +107,7 @@ func (p *GrokModelProvider) QueryText(question string, writer io.Writer, history
 		return nil, err
 	}
 
-	modelResult, err := localProvider.QueryText(question, writer, history, prompt, knowledgeMessages, agentInfo, lang)
+	modelResult, err := localProvider.QueryText(question, writer, history, prompt, knowledgeMessages, agentInfo, ctx, lang)
 	if err != nil {
 		return nil, err
 	}
@@ -48,8 +48,7 @@ func (p *HuggingFaceModelProvider) calculatePrice(modelResult *ModelResult) erro
 	return nil
 }
 
-func (p *HuggingFaceModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, lang string) (*ModelResult, error) {
-	ctx := context.Background()
+func (p *HuggingFaceModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
 	client := huggingface.NewInferenceClient(p.secretKey, func(o *huggingface.InferenceClientOptions) {
 		o.HTTPClient = proxy.ProxyHttpClient
 	})
@@ -82,7 +81,12 @@ func (p *HuggingFaceModelProvider) QueryText(question string, writer io.Writer,
 
 	respText := strings.Split(resp[0].GeneratedText, "\n")[0]
 
-	_, err = fmt.Fprint(writer, respText)
+	stream, err := NewEventStream(writer, ctx, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stream.TextDelta(respText)
 	if err != nil {
 		return nil, err
 	}
@@ -97,5 +101,9 @@ func (p *HuggingFaceModelProvider) QueryText(question string, writer io.Writer,
 		return nil, err
 	}
 
+	err = stream.End(modelResult, FinishReasonStop)
+	if err != nil {
+		return nil, err
+	}
 	return modelResult, nil
 }
@@ -44,6 +44,7 @@ type LocalModelProvider struct {
 	inputPricePerThousandTokens  float64
 	outputPricePerThousandTokens float64
 	currency                     string
+	options                      *GenerationOptions
 }
 
 func NewLocalModelProvider(typ string, subType string, secretKey string, temperature float32, topP float32, frequencyPenalty float32, presencePenalty float32, providerUrl string, compatibleProvider string, inputPricePerThousandTokens float64, outputPricePerThousandTokens float64, Currency string) (*LocalModelProvider, error) {
@@ -76,6 +77,10 @@ func getLocalClientFromUrl(authToken string, url string) *openai.Client {
 	return c
 }
 
+func (p *LocalModelProvider) SetGenerationOptions(options *GenerationOptions) {
+	p.options = options
+}
+
 func (p *LocalModelProvider) GetPricing() string {
 	return getOpenAIModelPrice()
 }
@@ -92,20 +97,19 @@ func (p *LocalModelProvider) CalculatePrice(modelResult *ModelResult, lang strin
 	return CalculateOpenAIModelPrice(p.subType, modelResult, lang)
 }
 
-func flushDataAzure(data string, writer io.Writer, lang string) error {
-	flusher, ok := writer.(http.Flusher)
-	if !ok {
-		return fmt.Errorf(i18n.Translate(lang, "model:writer does not implement http.Flusher"))
-	}
+// flushDataAzure emits the text one character at a time with a typing delay.
+func flushDataAzure(data string, stream *EventStream) error {
 	for _, runeValue := range data {
+		if stream.Stopped() {
+			return nil
+		}
+
 		char := string(runeValue)
-		_, err := fmt.Fprintf(writer, "event: message\ndata: %s\n\n", char)
+		err := stream.TextDelta(char)
 		if err != nil {
 			return err
 		}
 
-		flusher.Flush()
-
 		delta := 0
 		if !unicode.In(runeValue, unicode.Latin) {
 			delta = 50
@@ -133,55 +137,30 @@ func flushDataAzure(data string, writer io.Writer, lang string) error {
 	return nil
 }
 
-func flushDataOpenai(data string, writer io.Writer, lang string) error {
-	flusher, ok := writer.(http.Flusher)
-	if !ok {
-		return fmt.Errorf(i18n.Translate(lang, "model:writer does not implement http.Flusher"))
-	}
-	if _, err := fmt.Fprintf(writer, "event: message\ndata: %s\n\n", data); err != nil {
-		return err
-	}
-	flusher.Flush()
-	return nil
-}
-
-func flushDataThink(data string, eventType string, writer io.Writer, lang string) error {
-	flusher, ok := writer.(http.Flusher)
-	if !ok {
-		return fmt.Errorf(i18n.Translate(lang, "model:writer does not implement http.Flusher"))
-	}
-	if _, err := fmt.Fprintf(writer, "event: %s\ndata: %s\n\n", eventType, data); err != nil {
-		return err
-	}
-	flusher.Flush()
-	return nil
-}
-
-func (p *LocalModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, lang string) (*ModelResult, error) {
+func (p *LocalModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
 	var client *openai.Client
-	var flushData interface{} // Can be either flushData or flushDataThink
-
 	if p.typ == "Local" {
 		client = getLocalClientFromUrl(p.secretKey, p.providerUrl)
-		flushData = flushDataOpenai
 	} else if p.typ == "Azure" {
 		client = getAzureClientFromToken(p.deploymentName, p.secretKey, p.providerUrl, p.apiVersion)
-		flushData = flushDataAzure
 	} else if p.typ == "GitHub" {
 		client = getGitHubClientFromToken(p.secretKey, p.providerUrl)
-		flushData = flushDataOpenai
 	} else if p.typ == "Custom" {
 		client = getLocalClientFromUrl(p.secretKey, p.providerUrl)
-		flushData = flushDataOpenai
 	} else if p.typ == "Custom-think" {
 		client = getLocalClientFromUrl(p.secretKey, p.providerUrl)
-		flushData = flushDataThink
 	}
 
-	ctx := context.Background()
-	flusher, ok := writer.(http.Flusher)
-	if !ok {
-		return nil, fmt.Errorf(i18n.Translate(lang, "model:writer does not implement http.Flusher"))
+	stream, err := NewEventStream(writer, ctx, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	flushText := stream.TextDelta
+	if p.typ == "Azure" {
+		flushText = func(data string) error {
+			return flushDataAzure(data, stream)
+		}
 	}
 
 	model := p.subType
@@ -240,6 +219,12 @@ func (p *LocalModelProvider) QueryText(question string, writer io.Writer, histor
 		}
 
 		req := ChatCompletionRequest(model, messages, temperature, topP, frequencyPenalty, presencePenalty)
+		if !p.options.IsEmpty() {
+			if p.options.MaxTokens > 0 {
+				req.MaxTokens = p.options.MaxTokens
+			}
+			req.Stop = append(req.Stop, p.options.Stop...)
+		}
 		if agentInfo != nil && agentInfo.AgentClients != nil {
 			tools, err := reverseToolsToOpenAi(agentInfo.AgentClients.Tools)
 			if err != nil {
@@ -259,6 +244,7 @@ func (p *LocalModelProvider) QueryText(question string, writer io.Writer, histor
 		defer respStream.Close()
 
 		isLeadingReturn := true
+		finishReason := FinishReasonStop
 		var (
 			answerData   strings.Builder
 			toolCalls    []openai.ToolCall
@@ -268,79 +254,61 @@ func (p *LocalModelProvider) QueryText(question string, writer io.Writer, histor
 		for {
 			completion, streamErr := respStream.Recv()
 			if streamErr != nil {
-				if streamErr == io.EOF {
+				if streamErr == io.EOF || stream.Stopped() {
 					break
 				}
-				return nil, streamErr
+				return nil, stream.Error(streamErr)
 			}
 
 			if len(completion.Choices) == 0 {
 				continue
 			}
-			if completion.Choices[0].Delta.ToolCalls != nil {
-				for _, toolCall := range completion.Choices[0].Delta.ToolCalls {
-					toolCalls, toolCallsMap = handleToolCallsParameters(toolCall, toolCalls, toolCallsMap)
-				}
+			choice := completion.Choices[0]
+			if choice.FinishReason != "" {
+				finishReason = string(choice.FinishReason)
 			}
 
-			// Handle both regular content and reasoning content
-			if p.typ == "Custom-think" {
-				// For Custom-think type, we'll handle both reasoning and regular content
-				flushThink := flushData.(func(string, string, io.Writer, string) error)
-
-				// Check if we have reasoning content (think_content)
-				if completion.Choices[0].Delta.ReasoningContent != "" {
-					reasoningData := completion.Choices[0].Delta.ReasoningContent
-					err = flushThink(reasoningData, "reason", writer, lang)
-					if err != nil {
-						return nil, err
-					}
-				}
-
-				// Handle regular content
-				if completion.Choices[0].Delta.Content != "" {
-					data := completion.Choices[0].Delta.Content
-					if isLeadingReturn && len(data) != 0 {
-						if strings.Count(data, "\n") == len(data) {
-							continue
-						} else {
-							isLeadingReturn = false
-						}
-					}
-
-					err = flushThink(data, "message", writer, lang)
-					if err != nil {
-						return nil, err
-					}
-
-					answerData.WriteString(data)
-				}
-			} else {
-				// For all other provider types, use the standard flush function
-				flushStandard := flushData.(func(string, io.Writer, string) error)
-
-				data := completion.Choices[0].Delta.Content
-				if isLeadingReturn && len(data) != 0 {
-					if strings.Count(data, "\n") == len(data) {
-						continue
-					} else {
-						isLeadingReturn = false
-					}
+			for _, toolCall := range choice.Delta.ToolCalls {
+				err = handleToolCallDelta(stream, toolCall, &toolCalls, &toolCallsMap)
+				if err != nil {
+					return nil, err
 				}
+			}
 
-				err = flushStandard(data, writer, lang)
+			if choice.Delta.ReasoningContent != "" {
+				err = stream.ReasoningDelta(choice.Delta.ReasoningContent)
 				if err != nil {
 					return nil, err
 				}
+			}
 
-				answerData.WriteString(data)
+			data := choice.Delta.Content
+			if data == "" {
+				continue
 			}
+			if isLeadingReturn {
+				if strings.Count(data, "\n") == len(data) {
+					continue
+				} else {
+					isLeadingReturn = false
+				}
+			}
+
+			err = flushText(data)
+			if err != nil {
+				return nil, err
+			}
+
+			answerData.WriteString(data)
 		}
 
-		err = handleToolCalls(toolCalls, flushData, writer, lang)
+		err = stream.ToolCallsEnd(toolCalls)
 		if err != nil {
 			return nil, err
 		}
+		if len(toolCalls) != 0 {
+			finishReason = FinishReasonToolCalls
+		}
 
 		if agentInfo != nil && agentInfo.AgentMessages != nil {
 			agentInfo.AgentMessages.ToolCalls = toolCalls
@@ -358,6 +326,11 @@ func (p *LocalModelProvider) QueryText(question string, writer io.Writer, histor
 		if err != nil {
 			return nil, err
 		}
+
+		err = stream.End(modelResult, finishReason)
+		if err != nil {
+			return nil, err
+		}
 		return modelResult, nil
 	} else if getOpenAiModelType(p.subType) == "imagesGenerations" {
 		if strings.HasPrefix(question, "$CasibaseDryRun$") {
@@ -377,8 +350,10 @@ func (p *LocalModelProvider) QueryText(question string, writer io.Writer, histor
 		}
 
 		url := fmt.Sprintf("<img src=\"%s\" width=\"100%%\" height=\"auto\">", respUrl.Data[0].URL)
-		fmt.Fprint(writer, url)
-		flusher.Flush()
+		err = stream.TextDelta(url)
+		if err != nil {
+			return nil, err
+		}
 
 		modelResult.ImageCount = 1
 		modelResult.TotalTokenCount = modelResult.ImageCount
@@ -386,6 +361,11 @@ func (p *LocalModelProvider) QueryText(question string, writer io.Writer, histor
 		if err != nil {
 			return nil, err
 		}
+
+		err = stream.End(modelResult, FinishReasonStop)
+		if err != nil {
+			return nil, err
+		}
 		return modelResult, nil
 	} else if getOpenAiModelType(p.subType) == "Completion" {
 		respStream, err := client.CreateCompletionStream(
@@ -406,14 +386,19 @@ func (p *LocalModelProvider) QueryText(question string, writer io.Writer, histor
 		defer respStream.Close()
 
 		isLeadingReturn := true
+		finishReason := FinishReasonStop
 		var response strings.Builder
 		for {
 			completion, streamErr := respStream.Recv()
 			if streamErr != nil {
-				if streamErr == io.EOF {
+				if streamErr == io.EOF || stream.Stopped() {
 					break
 				}
-				return nil, streamErr
+				return nil, stream.Error(streamErr)
+			}
+
+			if completion.Choices[0].FinishReason != "" {
+				finishReason = completion.Choices[0].FinishReason
 			}
 
 			data := completion.Choices[0].Text
@@ -425,15 +410,7 @@ func (p *LocalModelProvider) QueryText(question string, writer io.Writer, histor
 				}
 			}
 
-			// Here we also need to handle the different flush functions
-			if p.typ == "Custom-think" {
-				flushThink := flushData.(func(string, string, io.Writer, string) error)
-				err = flushThink(data, "message", writer, lang)
-			} else {
-				flushStandard := flushData.(func(string, io.Writer, string) error)
-				err = flushStandard(data, writer, lang)
-			}
-
+			err = flushText(data)
 			if err != nil {
 				return nil, err
 			}
@@ -445,6 +422,14 @@ func (p *LocalModelProvider) QueryText(question string, writer io.Writer, histor
 		}
 
 		modelResult, err = getDefaultModelResult(model, question, response.String())
+		if err != nil {
+			return nil, err
+		}
+
+		err = stream.End(modelResult, finishReason)
+		if err != nil {
+			return nil, err
+		}
 		return modelResult, nil
 	} else {
 		return nil, fmt.Errorf(i18n.Translate(lang, "model:QueryText() error: unknown model type: %s"), p.subType)
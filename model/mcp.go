@@ -29,7 +29,7 @@ import (
 
 type AgentMessages struct {
 	Messages  []*RawMessage
-	ToolCalls any
+	ToolCalls []openai.ToolCall
 }
 
 type AgentInfo struct {
@@ -68,19 +68,23 @@ func reverseToolsToOpenAi(tools []*protocol.Tool) ([]openai.Tool, error) {
 	return openaiTools, nil
 }
 
-func handleToolCalls(toolCalls []openai.ToolCall, flushData interface{}, writer io.Writer, lang string) error {
-	if toolCalls == nil {
-		return nil
-	}
+// handleToolCallDelta merges a streamed piece of a tool call into the tool
+// calls and emits its start and arguments events.
+func handleToolCallDelta(stream *EventStream, toolCall openai.ToolCall, toolCalls *[]openai.ToolCall, toolCallsMap *map[int]int) error {
+	_, exists := (*toolCallsMap)[*toolCall.Index]
+	*toolCalls, *toolCallsMap = handleToolCallsParameters(toolCall, *toolCalls, *toolCallsMap)
 
-	if flushThink, ok := flushData.(func(string, string, io.Writer, string) error); ok {
-		for _, toolCall := range toolCalls {
-			err := flushThink("\n"+"Call result from "+toolCall.Function.Name+"\n", "reason", writer, lang)
-			if err != nil {
-				return err
-			}
+	index := (*toolCallsMap)[*toolCall.Index]
+	if !exists {
+		err := stream.ToolCallStart(index, toolCall.ID, toolCall.Function.Name)
+		if err != nil {
+			return err
 		}
 	}
+
+	if toolCall.Function.Arguments != "" {
+		return stream.ToolCallArgs(index, toolCall.Function.Arguments)
+	}
 	return nil
 }
 
@@ -105,29 +109,34 @@ func handleToolCallsParameters(toolCall openai.ToolCall, toolCalls []openai.Tool
 	return toolCalls, toolCallsMap
 }
 
-func QueryTextWithTools(p ModelProvider, question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, lang string) (*ModelResult, error) {
+func getToolCallsFromResponses(responseFunctionToolCalls []responses.ResponseFunctionToolCall) []openai.ToolCall {
+	if responseFunctionToolCalls == nil {
+		return nil
+	}
+
+	toolCalls := []openai.ToolCall{}
+	for _, responseFunctionToolCall := range responseFunctionToolCalls {
+		toolCalls = append(toolCalls, openai.ToolCall{
+			ID:       responseFunctionToolCall.ID,
+			Type:     "function",
+			Function: openai.FunctionCall{Name: responseFunctionToolCall.Name, Arguments: responseFunctionToolCall.Arguments},
+		})
+	}
+	return toolCalls
+}
+
+func QueryTextWithTools(p ModelProvider, question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
 	var messages []*RawMessage
-	modelResult, err := p.QueryText(question, writer, history, prompt, knowledgeMessages, agentInfo, lang)
+	modelResult, err := p.QueryText(question, writer, history, prompt, knowledgeMessages, agentInfo, ctx, lang)
 	if err != nil {
 		return nil, err
 	}
 
-	if agentInfo.AgentMessages.ToolCalls == nil {
+	toolCalls := agentInfo.AgentMessages.ToolCalls
+	if toolCalls == nil {
 		return modelResult, nil
 	}
 
-	toolCalls, ok := agentInfo.AgentMessages.ToolCalls.([]openai.ToolCall)
-	if !ok {
-		responseFunctionToolCalls := agentInfo.AgentMessages.ToolCalls.([]responses.ResponseFunctionToolCall)
-		for _, responseFunctionToolCall := range responseFunctionToolCalls {
-			toolCalls = append(toolCalls, openai.ToolCall{
-				ID:       responseFunctionToolCall.ID,
-				Type:     "function",
-				Function: openai.FunctionCall{Name: responseFunctionToolCall.Name, Arguments: responseFunctionToolCall.Arguments},
-			})
-		}
-	}
-
 	for len(toolCalls) > 0 {
 		for _, toolCall := range toolCalls {
 			serverName, toolName := agent.GetServerNameAndToolNameFromId(toolCall.Function.Name)
@@ -138,28 +147,17 @@ func QueryTextWithTools(p ModelProvider, question string, writer io.Writer, hist
 				ToolCall: toolCall,
 			})
 
-			messages, err = callTools(toolCall, serverName, toolName, agentInfo.AgentClients, messages, lang)
+			messages, err = callTools(toolCall, serverName, toolName, agentInfo.AgentClients, messages, ctx, lang)
 			if err != nil {
 				return nil, err
 			}
 		}
 		agentInfo.AgentMessages.Messages = messages
-		modelResult, err = p.QueryText(question, writer, history, prompt, knowledgeMessages, agentInfo, lang)
+		modelResult, err = p.QueryText(question, writer, history, prompt, knowledgeMessages, agentInfo, ctx, lang)
 		if err != nil {
 			return nil, err
 		}
-		toolCalls, ok = agentInfo.AgentMessages.ToolCalls.([]openai.ToolCall)
-		if !ok {
-			toolCalls = []openai.ToolCall{}
-			responseFunctionToolCalls := agentInfo.AgentMessages.ToolCalls.([]responses.ResponseFunctionToolCall)
-			for _, responseFunctionToolCall := range responseFunctionToolCalls {
-				toolCalls = append(toolCalls, openai.ToolCall{
-					ID:       responseFunctionToolCall.ID,
-					Type:     "function",
-					Function: openai.FunctionCall{Name: responseFunctionToolCall.Name, Arguments: responseFunctionToolCall.Arguments},
-				})
-			}
-		}
+		toolCalls = agentInfo.AgentMessages.ToolCalls
 	}
 
 	for _, mcpClient := range agentInfo.AgentClients.Clients {
@@ -176,9 +174,8 @@ func createToolMessage(toolCall openai.ToolCall, text string) *RawMessage {
 	}
 }
 
-func callTools(toolCall openai.ToolCall, serverName, toolName string, agentClients *agent.AgentClients, messages []*RawMessage, lang string) ([]*RawMessage, error) {
+func callTools(toolCall openai.ToolCall, serverName, toolName string, agentClients *agent.AgentClients, messages []*RawMessage, ctx context.Context, lang string) ([]*RawMessage, error) {
 	var arguments map[string]interface{}
-	ctx := context.Background()
 
 	if err := json.Unmarshal([]byte(toolCall.Function.Arguments), &arguments); err != nil {
 		return nil, fmt.Errorf(i18n.Translate(lang, "model:failed to parse tool arguments: %v"), err)
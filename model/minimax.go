@@ -18,7 +18,6 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"net/http"
 	"strings"
 
 	textv1 "github.com/ConnectAI-E/go-minimax/gen/go/minimax/text/v1"
@@ -74,8 +73,7 @@ func (p *MiniMaxModelProvider) calculatePrice(modelResult *ModelResult, lang str
 	return nil
 }
 
-func (p *MiniMaxModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, lang string) (*ModelResult, error) {
-	ctx := context.Background()
+func (p *MiniMaxModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
 	client, err := minimax.New(
 		minimax.WithApiToken(p.apiKey),
 		minimax.WithGroupId(p.groupID),
@@ -111,20 +109,12 @@ func (p *MiniMaxModelProvider) QueryText(question string, writer io.Writer, hist
 		return nil, err
 	}
 
-	flusher, ok := writer.(http.Flusher)
-	if !ok {
-		return nil, fmt.Errorf(i18n.Translate(lang, "model:writer does not implement http.Flusher"))
-	}
-
-	flushData := func(data string) error {
-		if _, err = fmt.Fprintf(writer, "event: message\ndata: %s\n\n", data); err != nil {
-			return err
-		}
-		flusher.Flush()
-		return nil
+	stream, err := NewEventStream(writer, ctx, lang)
+	if err != nil {
+		return nil, err
 	}
 
-	err = flushData(res.Choices[0].Text)
+	err = stream.TextDelta(res.Choices[0].Text)
 	if err != nil {
 		return nil, err
 	}
@@ -137,5 +127,9 @@ func (p *MiniMaxModelProvider) QueryText(question string, writer io.Writer, hist
 		return nil, err
 	}
 
+	err = stream.End(modelResult, FinishReasonStop)
+	if err != nil {
+		return nil, err
+	}
 	return modelResult, nil
 }
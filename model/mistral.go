@@ -15,6 +15,7 @@
 package model
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"strings"
@@ -85,7 +86,7 @@ func (c *MistralModelProvider) calculatePrice(modelResult *ModelResult, lang str
 	return nil
 }
 
-func (c *MistralModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, lang string) (*ModelResult, error) {
+func (c *MistralModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
 	chatRes, err := c.client.Chat(c.modelName, []mistral.ChatMessage{{Content: question, Role: mistral.RoleUser}}, nil)
 	if err != nil {
 		return nil, fmt.Errorf(i18n.Translate(lang, "model:error getting chat completion: %v"), err)
@@ -94,7 +95,12 @@ func (c *MistralModelProvider) QueryText(question string, writer io.Writer, hist
 	respText := chatRes.Choices[0].Message.Content
 	respText = strings.TrimSpace(respText)
 
-	_, err = fmt.Fprint(writer, respText)
+	stream, err := NewEventStream(writer, ctx, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stream.TextDelta(respText)
 	if err != nil {
 		return nil, fmt.Errorf(i18n.Translate(lang, "model:failed to write response: %v"), err)
 	}
@@ -110,5 +116,9 @@ func (c *MistralModelProvider) QueryText(question string, writer io.Writer, hist
 	}
 	modelResult.PromptTokenCount += len(question)
 
+	err = stream.End(modelResult, FinishReasonStop)
+	if err != nil {
+		return nil, err
+	}
 	return modelResult, nil
 }
@@ -19,7 +19,6 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"net/http"
 	"strings"
 
 	"github.com/casibase/casibase/i18n"
@@ -72,7 +71,7 @@ func (p *MoonshotModelProvider) calculatePrice(modelResult *ModelResult, lang st
 	return nil
 }
 
-func (p *MoonshotModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, lang string) (*ModelResult, error) {
+func (p *MoonshotModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
 	if p.secretKey == "" {
 		return nil, errors.New("missing moonshot_key")
 	}
@@ -116,7 +115,7 @@ func (p *MoonshotModelProvider) QueryText(question string, writer io.Writer, his
 	})
 
 	// Chat completions
-	resp, err := cli.Chat().Completions(context.Background(), &moonshot.ChatCompletionsRequest{
+	resp, err := cli.Chat().Completions(ctx, &moonshot.ChatCompletionsRequest{
 		Model:       moonshot.ChatCompletionsModelID(p.subType),
 		Messages:    messages,
 		Temperature: p.temperature,
@@ -125,20 +124,12 @@ func (p *MoonshotModelProvider) QueryText(question string, writer io.Writer, his
 		return nil, err
 	}
 
-	flusher, ok := writer.(http.Flusher)
-	if !ok {
-		return nil, fmt.Errorf(i18n.Translate(lang, "model:writer does not implement http.Flusher"))
-	}
-
-	flushData := func(data string) error {
-		if _, err = fmt.Fprintf(writer, "event: message\ndata: %s\n\n", data); err != nil {
-			return err
-		}
-		flusher.Flush()
-		return nil
+	stream, err := NewEventStream(writer, ctx, lang)
+	if err != nil {
+		return nil, err
 	}
 
-	err = flushData(resp.Choices[0].Message.Content)
+	err = stream.TextDelta(resp.Choices[0].Message.Content)
 	if err != nil {
 		return nil, err
 	}
@@ -154,5 +145,9 @@ func (p *MoonshotModelProvider) QueryText(question string, writer io.Writer, his
 		return nil, err
 	}
 
+	err = stream.End(modelResult, FinishReasonStop)
+	if err != nil {
+		return nil, err
+	}
 	return modelResult, nil
 }
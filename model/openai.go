@@ -19,7 +19,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"net/http"
 	"strings"
 
 	"github.com/ThinkInAIXYZ/go-mcp/protocol"
@@ -29,6 +28,7 @@ import (
 	"github.com/openai/openai-go/v2/option"
 	"github.com/openai/openai-go/v2/packages/param"
 	"github.com/openai/openai-go/v2/responses"
+	"github.com/openai/openai-go/v2/shared"
 	"github.com/pkoukk/tiktoken-go"
 )
 
@@ -39,6 +39,8 @@ type OpenAiModelProvider struct {
 	topP             float32
 	frequencyPenalty float32
 	presencePenalty  float32
+	responseFormat   *ResponseFormat
+	options          *GenerationOptions
 }
 
 func NewOpenAiModelProvider(subType string, secretKey string, temperature float32, topP float32, frequencyPenalty float32, presencePenalty float32) (*OpenAiModelProvider, error) {
@@ -199,23 +201,49 @@ func (p *OpenAiModelProvider) GetPricing() string {
 	return getOpenAIModelPrice()
 }
 
+func (p *OpenAiModelProvider) SetResponseFormat(format *ResponseFormat) {
+	p.responseFormat = format
+}
+
+// SetGenerationOptions passes the maximum number of tokens to the model, the
+// Responses API has no stop sequences.
+func (p *OpenAiModelProvider) SetGenerationOptions(options *GenerationOptions) {
+	p.options = options
+}
+
+func getOpenAiTextConfig(format *ResponseFormat) responses.ResponseTextConfigParam {
+	if format.Type == ResponseFormatJsonSchema && format.Schema != nil {
+		return responses.ResponseTextConfigParam{
+			Format: responses.ResponseFormatTextConfigUnionParam{
+				OfJSONSchema: &responses.ResponseFormatTextJSONSchemaConfigParam{
+					Name:        format.GetName(),
+					Description: param.NewOpt(format.Description),
+					Schema:      format.Schema,
+					Strict:      param.NewOpt(format.Strict),
+				},
+			},
+		}
+	}
+
+	return responses.ResponseTextConfigParam{
+		Format: responses.ResponseFormatTextConfigUnionParam{
+			OfJSONObject: &shared.ResponseFormatJSONObjectParam{},
+		},
+	}
+}
+
 func GetOpenAiClientFromToken(authToken string) openai.Client {
 	httpClient := proxy.ProxyHttpClient
 	c := openai.NewClient(option.WithHTTPClient(httpClient), option.WithAPIKey(authToken))
 	return c
 }
 
-func (p *OpenAiModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, lang string) (*ModelResult, error) {
-	var client openai.Client
-	var flushData interface{}
+func (p *OpenAiModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
+	client := GetOpenAiClientFromToken(p.secretKey)
 
-	client = GetOpenAiClientFromToken(p.secretKey)
-	flushData = flushDataOpenai
-
-	ctx := context.Background()
-	flusher, ok := writer.(http.Flusher)
-	if !ok {
-		return nil, fmt.Errorf(i18n.Translate(lang, "model:writer does not implement http.Flusher"))
+	stream, err := NewEventStream(writer, ctx, lang)
+	if err != nil {
+		return nil, err
 	}
 
 	model := p.subType
@@ -275,6 +303,12 @@ func (p *OpenAiModelProvider) QueryText(question string, writer io.Writer, histo
 			Temperature:  param.NewOpt[float64](float64(temperature)),
 			TopP:         param.NewOpt[float64](float64(topP)),
 		}
+		if p.responseFormat.IsJson() {
+			req.Text = getOpenAiTextConfig(p.responseFormat)
+		}
+		if !p.options.IsEmpty() && p.options.MaxTokens > 0 {
+			req.MaxOutputTokens = param.NewOpt(int64(p.options.MaxTokens))
+		}
 		if agentInfo != nil && agentInfo.AgentClients != nil {
 			tools, err := reverseMcpToolsToOpenAi(agentInfo.AgentClients.Tools)
 			if err != nil {
@@ -290,8 +324,10 @@ func (p *OpenAiModelProvider) QueryText(question string, writer io.Writer, histo
 		defer respStream.Close()
 
 		isLeadingReturn := true
+		finishReason := FinishReasonStop
+		var answerData strings.Builder
+		toolCallIndexMap := map[int64]int{} // output index -> tool call index
 		for respStream.Next() {
-			flushStandard := flushData.(func(string, io.Writer, string) error)
 			response := respStream.Current()
 			switch variant := response.AsAny().(type) {
 			case responses.ResponseTextDeltaEvent:
@@ -304,39 +340,82 @@ func (p *OpenAiModelProvider) QueryText(question string, writer io.Writer, histo
 					}
 				}
 
-				err = flushStandard(data, writer, lang)
+				err = stream.TextDelta(data)
+				if err != nil {
+					return nil, err
+				}
+				answerData.WriteString(data)
+			case responses.ResponseReasoningSummaryTextDeltaEvent:
+				err = stream.ReasoningDelta(variant.Delta)
+				if err != nil {
+					return nil, err
+				}
+			case responses.ResponseOutputItemAddedEvent:
+				switch v := variant.Item.AsAny().(type) {
+				case responses.ResponseFunctionToolCall:
+					index := len(toolCallIndexMap)
+					toolCallIndexMap[variant.OutputIndex] = index
+					err = stream.ToolCallStart(index, v.CallID, v.Name)
+					if err != nil {
+						return nil, err
+					}
+				}
+			case responses.ResponseFunctionCallArgumentsDeltaEvent:
+				err = stream.ToolCallArgs(toolCallIndexMap[variant.OutputIndex], variant.Delta)
 				if err != nil {
 					return nil, err
 				}
 			case responses.ResponseOutputItemDoneEvent:
 				switch v := variant.Item.AsAny().(type) {
 				case responses.ResponseFunctionToolCall:
+					err = stream.ToolCallEnd(toolCallIndexMap[variant.OutputIndex], v.CallID, v.Name, v.Arguments)
+					if err != nil {
+						return nil, err
+					}
 					toolCalls = append(toolCalls, v)
 				}
+			case responses.ResponseIncompleteEvent:
+				finishReason = FinishReasonLength
 			case responses.ResponseCompletedEvent:
 				modelResult.ResponseTokenCount = int(variant.Response.Usage.OutputTokens)
 				modelResult.PromptTokenCount = int(variant.Response.Usage.InputTokens)
 				modelResult.TotalTokenCount = int(variant.Response.Usage.TotalTokens)
-				break
 			}
 		}
-		if respStream.Err() != nil {
-			return nil, respStream.Err()
+		if respStream.Err() != nil && !stream.Stopped() {
+			return nil, stream.Error(respStream.Err())
 		}
 
-		err = handleMcpToolCalls(toolCalls, flushData, writer, lang)
-		if err != nil {
-			return nil, err
+		// The usage only comes with the completed response.
+		if stream.Stopped() && modelResult.TotalTokenCount == 0 {
+			modelResult, err = getDefaultModelResult(model, "", answerData.String())
+			if err != nil {
+				return nil, err
+			}
+
+			modelResult.PromptTokenCount, err = openaiNumTokensFromMessages(messages, model)
+			if err != nil {
+				return nil, err
+			}
+			modelResult.TotalTokenCount = modelResult.PromptTokenCount + modelResult.ResponseTokenCount
 		}
 
+		if len(toolCalls) != 0 {
+			finishReason = FinishReasonToolCalls
+		}
 		if agentInfo != nil && agentInfo.AgentMessages != nil {
-			agentInfo.AgentMessages.ToolCalls = toolCalls
+			agentInfo.AgentMessages.ToolCalls = getToolCallsFromResponses(toolCalls)
 		}
 
 		err = CalculateOpenAIModelPrice(model, modelResult, lang)
 		if err != nil {
 			return nil, err
 		}
+
+		err = stream.End(modelResult, finishReason)
+		if err != nil {
+			return nil, err
+		}
 		return modelResult, nil
 	} else if getOpenAiModelType(model) == "imagesGenerations" {
 		if strings.HasPrefix(question, "$CasibaseDryRun$") {
@@ -358,8 +437,10 @@ func (p *OpenAiModelProvider) QueryText(question string, writer io.Writer, histo
 		}
 
 		url := fmt.Sprintf("<img src=\"%s\" width=\"100%%\" height=\"auto\">", respUrl.Data[0].URL)
-		fmt.Fprint(writer, url)
-		flusher.Flush()
+		err = stream.TextDelta(url)
+		if err != nil {
+			return nil, err
+		}
 
 		modelResult.ImageCount = 1
 		modelResult.TotalTokenCount = modelResult.ImageCount
@@ -368,6 +449,10 @@ func (p *OpenAiModelProvider) QueryText(question string, writer io.Writer, histo
 			return nil, err
 		}
 
+		err = stream.End(modelResult, FinishReasonStop)
+		if err != nil {
+			return nil, err
+		}
 		return modelResult, nil
 	} else if getOpenAiModelType(model) == "Completion" {
 		respStream := client.Completions.NewStreaming(ctx, openai.CompletionNewParams{
@@ -383,6 +468,7 @@ func (p *OpenAiModelProvider) QueryText(question string, writer io.Writer, histo
 		defer respStream.Close()
 
 		isLeadingReturn := true
+		finishReason := FinishReasonStop
 		var response strings.Builder
 
 		for respStream.Next() {
@@ -397,8 +483,7 @@ func (p *OpenAiModelProvider) QueryText(question string, writer io.Writer, histo
 				}
 			}
 
-			flushStandard := flushData.(func(string, io.Writer, string) error)
-			err := flushStandard(data, writer, lang)
+			err := stream.TextDelta(data)
 			if err != nil {
 				return nil, err
 			}
@@ -409,6 +494,7 @@ func (p *OpenAiModelProvider) QueryText(question string, writer io.Writer, histo
 			}
 
 			if completion.Choices[0].FinishReason != "" {
+				finishReason = string(completion.Choices[0].FinishReason)
 				if completion.Choices[0].FinishReason == openai.CompletionChoiceFinishReasonStop {
 					modelResult.PromptTokenCount = int(completion.Usage.PromptTokens)
 					modelResult.ResponseTokenCount = int(completion.Usage.CompletionTokens)
@@ -424,10 +510,21 @@ func (p *OpenAiModelProvider) QueryText(question string, writer io.Writer, histo
 			}
 		}
 
-		if respStream.Err() != nil {
-			return nil, respStream.Err()
+		if respStream.Err() != nil && !stream.Stopped() {
+			return nil, stream.Error(respStream.Err())
 		}
 
+		if stream.Stopped() && modelResult.TotalTokenCount == 0 {
+			modelResult, err = getDefaultModelResult(model, question, response.String())
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err = stream.End(modelResult, finishReason)
+		if err != nil {
+			return nil, err
+		}
 		return modelResult, nil
 	} else {
 		return nil, fmt.Errorf(i18n.Translate(lang, "model:QueryText() error: unknown model type: %s"), model)
@@ -656,19 +753,3 @@ func reverseMcpToolsToOpenAi(tools []*protocol.Tool) ([]responses.ToolUnionParam
 	}
 	return openaiTools, nil
 }
-
-func handleMcpToolCalls(toolCalls []responses.ResponseFunctionToolCall, flushData interface{}, writer io.Writer, lang string) error {
-	if toolCalls == nil {
-		return nil
-	}
-
-	if flushThink, ok := flushData.(func(string, string, io.Writer, string) error); ok {
-		for _, toolCall := range toolCalls {
-			err := flushThink("\n"+"Call result from "+toolCall.Name+"\n", "reason", writer, lang)
-			if err != nil {
-				return err
-			}
-		}
-	}
-	return nil
-}
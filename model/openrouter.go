@@ -18,7 +18,6 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"net/http"
 	"strings"
 
 	"github.com/casibase/casibase/i18n"
@@ -127,13 +126,12 @@ func (p *OpenRouterModelProvider) getProxyClientFromToken() *openrouter.Client {
 	return c
 }
 
-func (p *OpenRouterModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, lang string) (*ModelResult, error) {
+func (p *OpenRouterModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
 	client := p.getProxyClientFromToken()
 
-	ctx := context.Background()
-	flusher, ok := writer.(http.Flusher)
-	if !ok {
-		return nil, fmt.Errorf(i18n.Translate(lang, "model:writer does not implement http.Flusher"))
+	stream, err := NewEventStream(writer, ctx, lang)
+	if err != nil {
+		return nil, err
 	}
 
 	model := p.subType
@@ -199,10 +197,10 @@ func (p *OpenRouterModelProvider) QueryText(question string, writer io.Writer, h
 	for {
 		completion, streamErr := respStream.Recv()
 		if streamErr != nil {
-			if streamErr == io.EOF {
+			if streamErr == io.EOF || stream.Stopped() {
 				break
 			}
-			return nil, streamErr
+			return nil, stream.Error(streamErr)
 		}
 
 		data := completion.Choices[0].Message.Content
@@ -214,14 +212,13 @@ func (p *OpenRouterModelProvider) QueryText(question string, writer io.Writer, h
 			}
 		}
 
-		if _, err = fmt.Fprintf(writer, "event: message\ndata: %s\n\n", data); err != nil {
+		err = stream.TextDelta(data)
+		if err != nil {
 			return nil, err
 		}
 
 		// save the response for token count
 		_, _ = responseStringBuilder.WriteString(data)
-
-		flusher.Flush()
 	}
 
 	modelResult, err := getDefaultModelResult(p.subType, question, responseStringBuilder.String())
@@ -234,5 +231,9 @@ func (p *OpenRouterModelProvider) QueryText(question string, writer io.Writer, h
 		return nil, err
 	}
 
+	err = stream.End(modelResult, FinishReasonStop)
+	if err != nil {
+		return nil, err
+	}
 	return modelResult, nil
 }
@@ -15,6 +15,7 @@
 package model
 
 import (
+	"context"
 	"io"
 )
 
@@ -25,6 +26,10 @@ type ModelResult struct {
 	ImageCount         int
 	TotalPrice         float64
 	Currency           string
+
+	// AnsweredBy is the name of the model provider that answered, it is only
+	// set by a router.
+	AnsweredBy string
 }
 
 func newModelResult(promptTokenCount int, responseTokenCount int, totalTokenCount int) *ModelResult {
@@ -37,7 +42,7 @@ func newModelResult(promptTokenCount int, responseTokenCount int, totalTokenCoun
 
 type ModelProvider interface {
 	GetPricing() string
-	QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, lang string) (*ModelResult, error)
+	QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error)
 }
 
 func GetModelProvider(typ string, subType string, clientId string, clientSecret string, userKey string, temperature float32, topP float32, topK int, frequencyPenalty float32, presencePenalty float32, providerUrl string, apiVersion string, compatibleProvider string, inputPricePerThousandTokens float64, outputPricePerThousandTokens float64, Currency string, enableThinking bool) (ModelProvider, error) {
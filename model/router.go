@@ -0,0 +1,367 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/beego/beego/logs"
+	"github.com/casibase/casibase/i18n"
+)
+
+// The policies that order the model providers of a router.
+const (
+	RoutingPolicyFallback       = "Fallback"
+	RoutingPolicyCheapest       = "Cheapest"
+	RoutingPolicyFastest        = "Fastest"
+	RoutingPolicyQuestionLength = "Question Length"
+)
+
+const (
+	// breakerFailureThreshold is the number of failures in a row after which a
+	// model provider is skipped for breakerOpenDuration.
+	breakerFailureThreshold = 3
+	breakerOpenDuration     = time.Minute
+
+	// DefaultRoutingLengthThreshold is the length in characters up to which a
+	// question is short for the "Question Length" policy.
+	DefaultRoutingLengthThreshold = 200
+)
+
+// ModelRoute is a model provider a router can answer with.
+type ModelRoute struct {
+	Name     string
+	Provider ModelProvider
+}
+
+// modelHealth is what the routers learned about a model provider: its
+// failures in a row, and its latency to the first output and its price per
+// thousand tokens, both averaged over its answers.
+type modelHealth struct {
+	failureCount int
+	openUntil    time.Time
+
+	answerCount            int
+	latency                time.Duration
+	pricePerThousandTokens float64
+}
+
+var (
+	modelHealthMap = map[string]*modelHealth{}
+	modelHealthMu  sync.Mutex
+)
+
+func getModelHealth(name string) *modelHealth {
+	health, ok := modelHealthMap[name]
+	if !ok {
+		health = &modelHealth{}
+		modelHealthMap[name] = health
+	}
+	return health
+}
+
+// isModelAvailable reports whether the circuit of the model provider is
+// closed. Once breakerOpenDuration has passed, the provider gets another try,
+// and a failure opens the circuit again right away.
+func isModelAvailable(name string) bool {
+	modelHealthMu.Lock()
+	defer modelHealthMu.Unlock()
+
+	return !time.Now().Before(getModelHealth(name).openUntil)
+}
+
+func recordModelFailure(name string) {
+	modelHealthMu.Lock()
+	defer modelHealthMu.Unlock()
+
+	health := getModelHealth(name)
+	health.failureCount++
+	if health.failureCount >= breakerFailureThreshold {
+		health.openUntil = time.Now().Add(breakerOpenDuration)
+	}
+}
+
+func recordModelSuccess(name string, latency time.Duration, modelResult *ModelResult) {
+	modelHealthMu.Lock()
+	defer modelHealthMu.Unlock()
+
+	health := getModelHealth(name)
+	health.failureCount = 0
+	health.openUntil = time.Time{}
+
+	pricePerThousandTokens := 0.0
+	if modelResult != nil && modelResult.TotalTokenCount > 0 {
+		pricePerThousandTokens = modelResult.TotalPrice * 1000 / float64(modelResult.TotalTokenCount)
+	}
+
+	// A moving average, so that the recent answers weigh the most.
+	if health.answerCount == 0 {
+		health.latency = latency
+		health.pricePerThousandTokens = pricePerThousandTokens
+	} else {
+		health.latency = (health.latency*3 + latency) / 4
+		health.pricePerThousandTokens = (health.pricePerThousandTokens*3 + pricePerThousandTokens) / 4
+	}
+	health.answerCount++
+}
+
+// SortModelRoutes orders the routes by the policy. "Cheapest" and "Fastest"
+// use the prices and latencies of the past answers, the providers that have
+// not answered yet come first so that they get measured. The prices are
+// compared as they are, so the providers should share a currency. "Question
+// Length" answers the questions up to lengthThreshold characters by the
+// cheapest provider and the longer ones in the configured order. Any other
+// policy keeps the configured order.
+func SortModelRoutes(routes []*ModelRoute, policy string, question string, lengthThreshold int) []*ModelRoute {
+	if policy == RoutingPolicyQuestionLength {
+		if lengthThreshold <= 0 {
+			lengthThreshold = DefaultRoutingLengthThreshold
+		}
+		if utf8.RuneCountInString(question) > lengthThreshold {
+			return routes
+		}
+		policy = RoutingPolicyCheapest
+	}
+	if policy != RoutingPolicyCheapest && policy != RoutingPolicyFastest {
+		return routes
+	}
+
+	modelHealthMu.Lock()
+	healths := map[string]modelHealth{}
+	for _, route := range routes {
+		healths[route.Name] = *getModelHealth(route.Name)
+	}
+	modelHealthMu.Unlock()
+
+	res := append([]*ModelRoute{}, routes...)
+	sort.SliceStable(res, func(i, j int) bool {
+		healthI := healths[res[i].Name]
+		healthJ := healths[res[j].Name]
+		if healthI.answerCount == 0 || healthJ.answerCount == 0 {
+			return healthI.answerCount == 0 && healthJ.answerCount != 0
+		}
+
+		if policy == RoutingPolicyCheapest {
+			return healthI.pricePerThousandTokens < healthJ.pricePerThousandTokens
+		}
+		return healthI.latency < healthJ.latency
+	})
+	return res
+}
+
+// RouterModelProvider answers with the first of its model providers that
+// answers. A provider that fails, or that writes nothing within the timeout,
+// is followed by the next one, as long as it has not written any part of the
+// answer. The providers whose circuit is open are skipped, unless all of them
+// are.
+type RouterModelProvider struct {
+	routes  []*ModelRoute
+	timeout time.Duration
+
+	// The provider that answered last, which the next queries try first.
+	answeredBy string
+	mu         sync.Mutex
+}
+
+func NewRouterModelProvider(routes []*ModelRoute, timeout time.Duration) (*RouterModelProvider, error) {
+	if len(routes) == 0 {
+		return nil, fmt.Errorf("the router has no model providers")
+	}
+
+	return &RouterModelProvider{routes: routes, timeout: timeout}, nil
+}
+
+func (p *RouterModelProvider) GetPricing() string {
+	return p.routes[0].Provider.GetPricing()
+}
+
+// SetResponseFormat passes the format on to the model providers that support
+// it.
+func (p *RouterModelProvider) SetResponseFormat(format *ResponseFormat) {
+	for _, route := range p.routes {
+		if structuredProvider, ok := route.Provider.(StructuredOutputProvider); ok {
+			structuredProvider.SetResponseFormat(format)
+		}
+	}
+}
+
+// SetGenerationOptions passes the options on to the model providers that
+// support them.
+func (p *RouterModelProvider) SetGenerationOptions(options *GenerationOptions) {
+	for _, route := range p.routes {
+		SetGenerationOptions(route.Provider, options)
+	}
+}
+
+// getAvailableRoutes returns the routes to try in order. The provider that
+// already answered comes first, so that the tool calls of an answer are
+// followed up by the same provider.
+func (p *RouterModelProvider) getAvailableRoutes() []*ModelRoute {
+	p.mu.Lock()
+	answeredBy := p.answeredBy
+	p.mu.Unlock()
+
+	res := []*ModelRoute{}
+	for _, route := range p.routes {
+		if route.Name == answeredBy {
+			res = append([]*ModelRoute{route}, res...)
+		} else if isModelAvailable(route.Name) {
+			res = append(res, route)
+		}
+	}
+
+	if len(res) == 0 {
+		return p.routes
+	}
+	return res
+}
+
+func (p *RouterModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
+	var err error
+	for _, route := range p.getAvailableRoutes() {
+		var modelResult *ModelResult
+		var routeWriter *modelRouteWriter
+		routeWriter, err = newModelRouteWriter(writer, ctx, lang)
+		if err != nil {
+			return nil, err
+		}
+
+		modelResult, err = p.queryRoute(route, routeWriter, question, history, prompt, knowledgeMessages, agentInfo, ctx, lang)
+		if err == nil || ctx.Err() != nil {
+			p.mu.Lock()
+			p.answeredBy = route.Name
+			p.mu.Unlock()
+
+			if modelResult != nil {
+				modelResult.AnsweredBy = route.Name
+			}
+			return modelResult, err
+		}
+
+		recordModelFailure(route.Name)
+		if routeWriter.hasWritten() {
+			return nil, err
+		}
+
+		logs.Warn("The model provider: %s failed to answer, falling back to the next one: %s", route.Name, err.Error())
+	}
+
+	return nil, err
+}
+
+func (p *RouterModelProvider) queryRoute(route *ModelRoute, routeWriter *modelRouteWriter, question string, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
+	routeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if p.timeout > 0 {
+		timer := time.AfterFunc(p.timeout, func() {
+			if !routeWriter.hasWritten() {
+				cancel()
+			}
+		})
+		defer timer.Stop()
+	}
+
+	start := time.Now()
+	modelResult, err := route.Provider.QueryText(question, routeWriter, history, prompt, knowledgeMessages, agentInfo, routeCtx, lang)
+
+	// A provider stopped by the timeout may return what it answered so far,
+	// which is nothing.
+	if routeCtx.Err() != nil && ctx.Err() == nil {
+		return nil, fmt.Errorf(i18n.Translate(lang, "model:The model provider: %s has not answered within %d seconds"), route.Name, int(p.timeout.Seconds()))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	latency := routeWriter.getLatency(start)
+	recordModelSuccess(route.Name, latency, modelResult)
+	return modelResult, nil
+}
+
+// GetAnsweredBy returns the name of the model provider that gave the result,
+// which is the given name unless the provider is a router.
+func GetAnsweredBy(modelResult *ModelResult, name string) string {
+	if modelResult == nil || modelResult.AnsweredBy == "" {
+		return name
+	}
+	return modelResult.AnsweredBy
+}
+
+// modelRouteWriter passes the events of a model provider on to the writer of
+// the answer, and tells whether any part of the answer has been written.
+type modelRouteWriter struct {
+	stream *EventStream
+
+	mu          sync.Mutex
+	firstOutput time.Time
+}
+
+func newModelRouteWriter(writer io.Writer, ctx context.Context, lang string) (*modelRouteWriter, error) {
+	stream, err := NewEventStream(writer, ctx, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	return &modelRouteWriter{stream: stream}, nil
+}
+
+func (w *modelRouteWriter) markWritten() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.firstOutput.IsZero() {
+		w.firstOutput = time.Now()
+	}
+}
+
+func (w *modelRouteWriter) hasWritten() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return !w.firstOutput.IsZero()
+}
+
+// getLatency returns the time to the first output, or to the end for the
+// providers that do not stream.
+func (w *modelRouteWriter) getLatency(start time.Time) time.Duration {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.firstOutput.IsZero() {
+		return time.Since(start)
+	}
+	return w.firstOutput.Sub(start)
+}
+
+func (w *modelRouteWriter) Write(p []byte) (int, error) {
+	w.markWritten()
+	return w.stream.writer.Write(p)
+}
+
+func (w *modelRouteWriter) HandleStreamEvent(event *StreamEvent) error {
+	switch event.Type {
+	case StreamEventTextDelta, StreamEventReasoningDelta, StreamEventToolCallStart, StreamEventToolCallArgs, StreamEventToolCallEnd:
+		w.markWritten()
+	}
+
+	return w.stream.emit(event)
+}
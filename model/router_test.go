@@ -0,0 +1,146 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+)
+
+type testRouteProvider struct {
+	text     string
+	err      error
+	delay    time.Duration
+	price    float64
+	queryNum int
+}
+
+func (p *testRouteProvider) GetPricing() string {
+	return ""
+}
+
+func (p *testRouteProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
+	p.queryNum++
+	stream, err := NewEventStream(writer, ctx, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-time.After(p.delay):
+	case <-ctx.Done():
+		return &ModelResult{}, nil
+	}
+
+	if p.text != "" {
+		_ = stream.TextDelta(p.text)
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &ModelResult{TotalTokenCount: 1000, TotalPrice: p.price}, nil
+}
+
+func TestRouterModelProvider(t *testing.T) {
+	failing := &testRouteProvider{err: fmt.Errorf("error, status code: 429")}
+	slow := &testRouteProvider{text: "slow", delay: time.Second}
+	answering := &testRouteProvider{text: "Hello"}
+	router, err := NewRouterModelProvider([]*ModelRoute{
+		{Name: "test-failing", Provider: failing},
+		{Name: "test-slow", Provider: slow},
+		{Name: "test-answering", Provider: answering},
+	}, 50*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < breakerFailureThreshold+1; i++ {
+		writer := &testEventWriter{}
+		modelResult, err := router.QueryText("Hi", writer, nil, "", nil, nil, context.Background(), "en")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if GetAnsweredBy(modelResult, "test-failing") != "test-answering" {
+			t.Errorf("got answer by %s, expected test-answering", GetAnsweredBy(modelResult, "test-failing"))
+		}
+		if len(writer.events) == 0 || writer.events[0].Text != "Hello" {
+			t.Errorf("got events %v, expected the answer of test-answering only", writer.events)
+		}
+	}
+
+	// The router sticks to the provider that answered.
+	if failing.queryNum != 1 || slow.queryNum != 1 {
+		t.Errorf("got %d and %d queries, expected the failed providers to be queried once", failing.queryNum, slow.queryNum)
+	}
+
+	if !isModelAvailable("test-failing") {
+		t.Errorf("the circuit should be closed until the failures reach the threshold")
+	}
+	for i := 1; i < breakerFailureThreshold; i++ {
+		recordModelFailure("test-failing")
+	}
+	if isModelAvailable("test-failing") {
+		t.Errorf("the circuit should be open once the failures reach the threshold")
+	}
+
+	writtenFailing := &testRouteProvider{text: "Hel", err: fmt.Errorf("connection reset")}
+	router, err = NewRouterModelProvider([]*ModelRoute{
+		{Name: "test-written-failing", Provider: writtenFailing},
+		{Name: "test-answering", Provider: answering},
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = router.QueryText("Hi", &testEventWriter{}, nil, "", nil, nil, context.Background(), "en")
+	if err == nil {
+		t.Errorf("a provider that has written a part of the answer should not be fallen back from")
+	}
+
+	recordModelSuccess("test-cheap", time.Second, &ModelResult{TotalTokenCount: 1000, TotalPrice: 0.1})
+	recordModelSuccess("test-expensive", time.Millisecond, &ModelResult{TotalTokenCount: 1000, TotalPrice: 1})
+	routes := []*ModelRoute{{Name: "test-expensive"}, {Name: "test-cheap"}, {Name: "test-new"}}
+
+	tests := []struct {
+		policy   string
+		question string
+		expected string
+	}{
+		{RoutingPolicyFallback, "Hi", "test-expensive,test-cheap,test-new"},
+		{RoutingPolicyCheapest, "Hi", "test-new,test-cheap,test-expensive"},
+		{RoutingPolicyFastest, "Hi", "test-new,test-expensive,test-cheap"},
+		{RoutingPolicyQuestionLength, "Hi", "test-new,test-cheap,test-expensive"},
+		{RoutingPolicyQuestionLength, "What is the meaning of life?", "test-expensive,test-cheap,test-new"},
+	}
+
+	for _, test := range tests {
+		names := ""
+		for i, route := range SortModelRoutes(routes, test.policy, test.question, 10) {
+			if i > 0 {
+				names += ","
+			}
+			names += route.Name
+		}
+		if names != test.expected {
+			t.Errorf("got routes %s for %s, expected %s", names, test.policy, test.expected)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/casibase/casibase/i18n"
+	"github.com/sashabaranov/go-openai"
+)
+
+// The types of the events a model provider streams while answering.
+const (
+	StreamEventTextDelta      = "TextDelta"
+	StreamEventReasoningDelta = "ReasoningDelta"
+	StreamEventToolCallStart  = "ToolCallStart"
+	StreamEventToolCallArgs   = "ToolCallArgs"
+	StreamEventToolCallEnd    = "ToolCallEnd"
+	StreamEventUsage          = "Usage"
+	StreamEventFinish         = "Finish"
+	StreamEventError          = "Error"
+)
+
+// The finish reasons of an answer.
+const (
+	FinishReasonStop      = "stop"
+	FinishReasonLength    = "length"
+	FinishReasonToolCalls = "tool_calls"
+	FinishReasonError     = "error"
+)
+
+// StreamToolCall is the tool call of a tool call event. The start event has
+// the id and the name, the args events have a piece of the arguments, and
+// the end event has the whole call.
+type StreamToolCall struct {
+	Index     int
+	Id        string
+	Name      string
+	Arguments string
+}
+
+// StreamEvent is an event of the answer of a model provider. Text is set for
+// the text and reasoning deltas, ToolCall for the tool call events, Usage for
+// the usage event, FinishReason for the finish event and Error for the error
+// event.
+type StreamEvent struct {
+	Type         string
+	Text         string
+	ToolCall     *StreamToolCall
+	Usage        *ModelResult
+	FinishReason string
+	Error        error
+}
+
+// StreamEventHandler is implemented by the writers that consume the events of
+// the model providers. A writer passed to QueryText that does not implement it
+// gets the text and reasoning deltas written as "message" and "reason" server
+// sent events instead.
+type StreamEventHandler interface {
+	HandleStreamEvent(event *StreamEvent) error
+}
+
+// EventStream is what the model providers emit their answers to. Once the
+// context is canceled, the answer is stopped: the events are dropped and the
+// provider returns the model result of the part answered so far.
+type EventStream struct {
+	writer  io.Writer
+	ctx     context.Context
+	handler StreamEventHandler
+	flusher http.Flusher
+}
+
+func NewEventStream(writer io.Writer, ctx context.Context, lang string) (*EventStream, error) {
+	if handler, ok := writer.(StreamEventHandler); ok {
+		return &EventStream{writer: writer, ctx: ctx, handler: handler}, nil
+	}
+
+	flusher, ok := writer.(http.Flusher)
+	if !ok {
+		return nil, fmt.Errorf(i18n.Translate(lang, "model:writer does not implement http.Flusher"))
+	}
+	return &EventStream{writer: writer, ctx: ctx, flusher: flusher}, nil
+}
+
+// Stopped reports whether the answer was stopped, by the user or because the
+// client went away.
+func (s *EventStream) Stopped() bool {
+	return s.ctx.Err() != nil
+}
+
+func (s *EventStream) emit(event *StreamEvent) error {
+	if s.Stopped() {
+		return nil
+	}
+
+	err := s.send(event)
+	if err != nil && s.Stopped() {
+		return nil
+	}
+	return err
+}
+
+func (s *EventStream) send(event *StreamEvent) error {
+	if s.handler != nil {
+		return s.handler.HandleStreamEvent(event)
+	}
+
+	var eventType string
+	switch event.Type {
+	case StreamEventTextDelta:
+		eventType = "message"
+	case StreamEventReasoningDelta:
+		eventType = "reason"
+	default:
+		return nil
+	}
+
+	if _, err := fmt.Fprintf(s.writer, "event: %s\ndata: %s\n\n", eventType, event.Text); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+func (s *EventStream) TextDelta(text string) error {
+	return s.emit(&StreamEvent{Type: StreamEventTextDelta, Text: text})
+}
+
+func (s *EventStream) ReasoningDelta(text string) error {
+	return s.emit(&StreamEvent{Type: StreamEventReasoningDelta, Text: text})
+}
+
+func (s *EventStream) ToolCallStart(index int, id string, name string) error {
+	return s.emit(&StreamEvent{Type: StreamEventToolCallStart, ToolCall: &StreamToolCall{Index: index, Id: id, Name: name}})
+}
+
+func (s *EventStream) ToolCallArgs(index int, arguments string) error {
+	return s.emit(&StreamEvent{Type: StreamEventToolCallArgs, ToolCall: &StreamToolCall{Index: index, Arguments: arguments}})
+}
+
+func (s *EventStream) ToolCallEnd(index int, id string, name string, arguments string) error {
+	return s.emit(&StreamEvent{Type: StreamEventToolCallEnd, ToolCall: &StreamToolCall{Index: index, Id: id, Name: name, Arguments: arguments}})
+}
+
+func (s *EventStream) Usage(modelResult *ModelResult) error {
+	return s.emit(&StreamEvent{Type: StreamEventUsage, Usage: modelResult})
+}
+
+func (s *EventStream) Finish(finishReason string) error {
+	return s.emit(&StreamEvent{Type: StreamEventFinish, FinishReason: finishReason})
+}
+
+// Error reports an error that ends the answer, and returns it so that the
+// provider can return it too.
+func (s *EventStream) Error(err error) error {
+	emitErr := s.emit(&StreamEvent{Type: StreamEventError, Error: err})
+	if emitErr != nil {
+		return emitErr
+	}
+	return err
+}
+
+// End reports the usage and the finish reason of a whole answer.
+func (s *EventStream) End(modelResult *ModelResult, finishReason string) error {
+	if modelResult != nil {
+		err := s.Usage(modelResult)
+		if err != nil {
+			return err
+		}
+	}
+	return s.Finish(finishReason)
+}
+
+// ToolCallsEnd reports the end of the tool calls of an answer.
+func (s *EventStream) ToolCallsEnd(toolCalls []openai.ToolCall) error {
+	for i, toolCall := range toolCalls {
+		err := s.ToolCallEnd(i, toolCall.ID, toolCall.Function.Name, toolCall.Function.Arguments)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
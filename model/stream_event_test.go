@@ -0,0 +1,102 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package model
+
+import (
+	"bytes"
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type testEventWriter struct {
+	bytes.Buffer
+	events []*StreamEvent
+}
+
+func (w *testEventWriter) HandleStreamEvent(event *StreamEvent) error {
+	w.events = append(w.events, event)
+	return nil
+}
+
+func TestEventStream(t *testing.T) {
+	writer := &testEventWriter{}
+	stream, err := NewEventStream(writer, context.Background(), "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = stream.ReasoningDelta("thinking")
+	_ = stream.TextDelta("Hello")
+	_ = stream.ToolCallStart(0, "call_1", "search")
+	_ = stream.ToolCallArgs(0, "{}")
+	_ = stream.End(&ModelResult{TotalTokenCount: 3}, FinishReasonToolCalls)
+
+	types := []string{}
+	for _, event := range writer.events {
+		types = append(types, event.Type)
+	}
+	expected := "ReasoningDelta,TextDelta,ToolCallStart,ToolCallArgs,Usage,Finish"
+	if strings.Join(types, ",") != expected {
+		t.Errorf("got events %v, expected %s", types, expected)
+	}
+	if writer.events[5].FinishReason != FinishReasonToolCalls {
+		t.Errorf("got finish reason %s, expected %s", writer.events[5].FinishReason, FinishReasonToolCalls)
+	}
+	if writer.Len() != 0 {
+		t.Errorf("a handler should not be written to, got %q", writer.String())
+	}
+
+	recorder := httptest.NewRecorder()
+	stream, err = NewEventStream(recorder, context.Background(), "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = stream.ReasoningDelta("thinking")
+	_ = stream.TextDelta("Hello")
+	_ = stream.End(&ModelResult{}, FinishReasonStop)
+
+	expectedBody := "event: reason\ndata: thinking\n\nevent: message\ndata: Hello\n\n"
+	if recorder.Body.String() != expectedBody {
+		t.Errorf("got body %q, expected %q", recorder.Body.String(), expectedBody)
+	}
+
+	_, err = NewEventStream(&bytes.Buffer{}, context.Background(), "en")
+	if err == nil {
+		t.Errorf("a writer that is neither a handler nor a flusher should be rejected")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	writer = &testEventWriter{}
+	stream, err = NewEventStream(writer, ctx, "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = stream.TextDelta("Hello")
+	cancel()
+	if !stream.Stopped() {
+		t.Errorf("the stream should be stopped once the context is canceled")
+	}
+	_ = stream.TextDelta(" world")
+	if len(writer.events) != 1 {
+		t.Errorf("got %d events, the events after the stop should be dropped", len(writer.events))
+	}
+}
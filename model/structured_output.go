@@ -0,0 +1,220 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/beego/beego/logs"
+	"github.com/casibase/casibase/i18n"
+	"github.com/sashabaranov/go-openai/jsonschema"
+)
+
+// The types of the response formats, as in the OpenAI API.
+const (
+	ResponseFormatText       = "text"
+	ResponseFormatJsonObject = "json_object"
+	ResponseFormatJsonSchema = "json_schema"
+)
+
+// structuredOutputMaxRetries is the number of times an answer that is not
+// valid is asked again.
+const structuredOutputMaxRetries = 2
+
+// ResponseFormat is the format an answer must be written in. Schema is the
+// JSON schema of a "json_schema" answer.
+type ResponseFormat struct {
+	Type        string
+	Name        string
+	Description string
+	Schema      map[string]interface{}
+	Strict      bool
+}
+
+// StructuredOutputProvider is implemented by the model providers that can be
+// asked natively for an answer in a response format. The format applies to
+// the following queries of the provider.
+type StructuredOutputProvider interface {
+	SetResponseFormat(format *ResponseFormat)
+}
+
+func (f *ResponseFormat) IsJson() bool {
+	return f != nil && (f.Type == ResponseFormatJsonObject || f.Type == ResponseFormatJsonSchema)
+}
+
+func (f *ResponseFormat) GetName() string {
+	if f.Name == "" {
+		return "response"
+	}
+	return f.Name
+}
+
+func (f *ResponseFormat) getPrompt(prompt string) (string, error) {
+	res := "Answer with a single JSON object only, without any text or code fences around it."
+	if f.Type == ResponseFormatJsonSchema && f.Schema != nil {
+		schema, err := json.Marshal(f.Schema)
+		if err != nil {
+			return "", err
+		}
+		res = fmt.Sprintf("Answer with a single JSON object only, without any text or code fences around it. The object must match this JSON schema:\n%s", schema)
+	}
+
+	if prompt == "" {
+		return res, nil
+	}
+	return fmt.Sprintf("%s\n\n%s", prompt, res), nil
+}
+
+// GetJsonText returns the JSON of the answer, without the code fences the
+// models like to put around it, or an error when it is not valid. The
+// answer is checked against the schema when the schema has a type the
+// validator knows, otherwise it only needs to be valid JSON.
+func (f *ResponseFormat) GetJsonText(answer string, lang string) (string, error) {
+	text := strings.TrimSpace(answer)
+	if strings.HasPrefix(text, "```") {
+		text = strings.TrimPrefix(text, "```json")
+		text = strings.TrimPrefix(text, "```")
+		text = strings.TrimSuffix(text, "```")
+		text = strings.TrimSpace(text)
+	}
+
+	var data interface{}
+	err := json.Unmarshal([]byte(text), &data)
+	if err != nil {
+		return "", fmt.Errorf(i18n.Translate(lang, "model:The answer is not valid JSON: %s"), err.Error())
+	}
+
+	if f.Type != ResponseFormatJsonSchema || f.Schema == nil {
+		return text, nil
+	}
+
+	schemaData, err := json.Marshal(f.Schema)
+	if err != nil {
+		return "", err
+	}
+
+	var definition jsonschema.Definition
+	err = json.Unmarshal(schemaData, &definition)
+	if err != nil || definition.Type == "" {
+		return text, nil
+	}
+
+	if !jsonschema.Validate(definition, data) {
+		return "", fmt.Errorf(i18n.Translate(lang, "model:The answer does not match the JSON schema: %s"), f.GetName())
+	}
+	return text, nil
+}
+
+// structuredAnswerWriter keeps the answer of a structured query, which is
+// only written once it is valid.
+type structuredAnswerWriter struct {
+	text strings.Builder
+}
+
+func (w *structuredAnswerWriter) Write(p []byte) (int, error) {
+	return w.text.Write(p)
+}
+
+func (w *structuredAnswerWriter) HandleStreamEvent(event *StreamEvent) error {
+	if event.Type == StreamEventTextDelta {
+		w.text.WriteString(event.Text)
+	}
+	return nil
+}
+
+// QueryStructuredText answers in the response format. The providers that
+// support it are asked for the format natively, all of them are told the
+// format in the prompt. An answer that is not valid is asked again with the
+// reason, up to structuredOutputMaxRetries times. The answer is written
+// as a whole once it is valid, and the model result covers all the tries.
+func QueryStructuredText(p ModelProvider, question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, format *ResponseFormat, ctx context.Context, lang string) (*ModelResult, error) {
+	if !format.IsJson() {
+		return p.QueryText(question, writer, history, prompt, knowledgeMessages, nil, ctx, lang)
+	}
+
+	stream, err := NewEventStream(writer, ctx, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	if structuredProvider, ok := p.(StructuredOutputProvider); ok {
+		structuredProvider.SetResponseFormat(format)
+	}
+
+	prompt, err = format.getPrompt(prompt)
+	if err != nil {
+		return nil, err
+	}
+
+	modelResult := &ModelResult{}
+	currentQuestion := question
+	for i := 0; ; i++ {
+		answerWriter := &structuredAnswerWriter{}
+		var result *ModelResult
+		result, err = p.QueryText(currentQuestion, answerWriter, history, prompt, knowledgeMessages, nil, ctx, lang)
+		if err != nil {
+			return nil, err
+		}
+		addModelResult(modelResult, result)
+
+		if stream.Stopped() {
+			return modelResult, nil
+		}
+
+		var text string
+		text, err = format.GetJsonText(answerWriter.text.String(), lang)
+		if err == nil {
+			err = stream.TextDelta(text)
+			if err != nil {
+				return nil, err
+			}
+
+			err = stream.End(modelResult, FinishReasonStop)
+			if err != nil {
+				return nil, err
+			}
+			return modelResult, nil
+		}
+
+		if i == structuredOutputMaxRetries {
+			return nil, stream.Error(err)
+		}
+
+		logs.Warn("The structured answer is not valid, asking again: %s", err.Error())
+		currentQuestion = fmt.Sprintf("%s\n\nYour last answer was:\n%s\n\nIt was rejected: %s. Answer again with the JSON only.", question, answerWriter.text.String(), err.Error())
+	}
+}
+
+func addModelResult(modelResult *ModelResult, result *ModelResult) {
+	if result == nil {
+		return
+	}
+
+	modelResult.PromptTokenCount += result.PromptTokenCount
+	modelResult.ResponseTokenCount += result.ResponseTokenCount
+	modelResult.TotalTokenCount += result.TotalTokenCount
+	modelResult.ImageCount += result.ImageCount
+	modelResult.TotalPrice = AddPrices(modelResult.TotalPrice, result.TotalPrice)
+	if result.Currency != "" {
+		modelResult.Currency = result.Currency
+	}
+	if result.AnsweredBy != "" {
+		modelResult.AnsweredBy = result.AnsweredBy
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package model
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+)
+
+type testStructuredProvider struct {
+	answers   []string
+	questions []string
+	format    *ResponseFormat
+}
+
+func (p *testStructuredProvider) GetPricing() string {
+	return ""
+}
+
+func (p *testStructuredProvider) SetResponseFormat(format *ResponseFormat) {
+	p.format = format
+}
+
+func (p *testStructuredProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
+	stream, err := NewEventStream(writer, ctx, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	answer := p.answers[len(p.questions)]
+	p.questions = append(p.questions, question)
+	_ = stream.TextDelta(answer)
+	return &ModelResult{TotalTokenCount: 10, TotalPrice: 0.01, Currency: "USD"}, nil
+}
+
+func TestQueryStructuredText(t *testing.T) {
+	format := &ResponseFormat{
+		Type: ResponseFormatJsonSchema,
+		Name: "city",
+		Schema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"name":       map[string]interface{}{"type": "string"},
+				"population": map[string]interface{}{"type": "integer"},
+			},
+			"required": []interface{}{"name", "population"},
+		},
+	}
+
+	tests := []struct {
+		answer   string
+		expected string
+	}{
+		{`{"name": "Paris", "population": 2102650}`, `{"name": "Paris", "population": 2102650}`},
+		{"```json\n{\"name\": \"Paris\", \"population\": 2102650}\n```", `{"name": "Paris", "population": 2102650}`},
+		{`{"name": "Paris"}`, ""},
+		{`{"name": "Paris", "population": "many"}`, ""},
+		{`The city is Paris.`, ""},
+	}
+
+	for _, test := range tests {
+		text, err := format.GetJsonText(test.answer, "en")
+		if test.expected == "" && err == nil {
+			t.Errorf("the answer %q should be rejected", test.answer)
+		}
+		if test.expected != "" && text != test.expected {
+			t.Errorf("got %q for %q, expected %q, error: %v", text, test.answer, test.expected, err)
+		}
+	}
+
+	provider := &testStructuredProvider{answers: []string{`The city is Paris.`, `{"name": "Paris", "population": 2102650}`}}
+	writer := &testEventWriter{}
+	modelResult, err := QueryStructuredText(provider, "Which is the capital of France?", writer, nil, "", nil, format, context.Background(), "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if provider.format != format {
+		t.Errorf("the format should be set on a provider that supports it")
+	}
+	if len(provider.questions) != 2 || !strings.Contains(provider.questions[1], "The city is Paris.") {
+		t.Errorf("got questions %v, expected the invalid answer to be asked again", provider.questions)
+	}
+	if writer.events[0].Type != StreamEventTextDelta || writer.events[0].Text != `{"name": "Paris", "population": 2102650}` {
+		t.Errorf("got events %v, expected only the valid answer to be written", writer.events)
+	}
+	if modelResult.TotalTokenCount != 20 {
+		t.Errorf("got %d tokens, expected the tokens of both tries", modelResult.TotalTokenCount)
+	}
+
+	provider = &testStructuredProvider{answers: []string{"a", "b", "c"}}
+	_, err = QueryStructuredText(provider, "Which is the capital of France?", &testEventWriter{}, nil, "", nil, format, context.Background(), "en")
+	if err == nil || len(provider.questions) != structuredOutputMaxRetries+1 {
+		t.Errorf("got %d questions and error %v, expected an error after the retries", len(provider.questions), err)
+	}
+}
@@ -15,6 +15,7 @@
 package model
 
 import (
+	"context"
 	"io"
 	"strings"
 )
@@ -44,7 +45,7 @@ func (c *TencentCloudClient) GetPricing() string {
 	return `Pricing information for Tencent Cloud models is not yet available.`
 }
 
-func (c *TencentCloudClient) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, lang string) (*ModelResult, error) {
+func (c *TencentCloudClient) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
 	baseUrl := c.endpoint
 	// Get model name
 	model := ""
@@ -60,7 +61,7 @@ func (c *TencentCloudClient) QueryText(question string, writer io.Writer, histor
 		return nil, err
 	}
 
-	modelResult, err := localProvider.QueryText(question, writer, history, prompt, knowledgeMessages, agentInfo, lang)
+	modelResult, err := localProvider.QueryText(question, writer, history, prompt, knowledgeMessages, agentInfo, ctx, lang)
 	if err != nil {
 		return nil, err
 	}
@@ -148,9 +148,12 @@ func containsZh(str string) bool {
 	return false
 }
 
+// DefaultPrompt is the system prompt used when the store has no prompt.
+const DefaultPrompt = "You are an expert in your field and you specialize in using your knowledge to answer or solve people's problems."
+
 func getSystemMessages(prompt string, knowledgeMessages []*RawMessage) []*RawMessage {
 	if prompt == "" {
-		prompt = "You are an expert in your field and you specialize in using your knowledge to answer or solve people's problems."
+		prompt = DefaultPrompt
 	}
 
 	res := []*RawMessage{{Text: prompt, Author: "System"}}
@@ -18,8 +18,8 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"net/http"
 	"regexp"
+	"strings"
 
 	"github.com/beego/beego/logs"
 	"github.com/casibase/casibase/i18n"
@@ -175,11 +175,10 @@ func (p *VolcengineModelProvider) calculatePrice(modelResult *ModelResult, lang
 	return nil
 }
 
-func (p *VolcengineModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, lang string) (*ModelResult, error) {
-	ctx := context.Background()
-	flusher, ok := writer.(http.Flusher)
-	if !ok {
-		return nil, fmt.Errorf(i18n.Translate(lang, "model:writer does not implement http.Flusher"))
+func (p *VolcengineModelProvider) QueryText(question string, writer io.Writer, history []*RawMessage, prompt string, knowledgeMessages []*RawMessage, agentInfo *AgentInfo, ctx context.Context, lang string) (*ModelResult, error) {
+	stream, err := NewEventStream(writer, ctx, lang)
+	if err != nil {
+		return nil, err
 	}
 	client := arkruntime.NewClientWithApiKey(p.apiKey)
 
@@ -201,24 +200,18 @@ func (p *VolcengineModelProvider) QueryText(question string, writer io.Writer, h
 		StreamOptions: &model.StreamOptions{IncludeUsage: true},
 	}
 
-	flushData := func(data string) error {
-		if _, err := fmt.Fprintf(writer, "event: message\ndata: %s\n\n", data); err != nil {
-			return err
-		}
-		flusher.Flush()
-		return nil
-	}
-
-	stream, err := client.CreateChatCompletionStream(ctx, request)
+	respStream, err := client.CreateChatCompletionStream(ctx, request)
 	if err != nil {
 		logs.Error("stream chat error: %v\n", err)
 		return nil, err
 	}
-	defer stream.Close()
+	defer respStream.Close()
 	modelResult := newModelResult(0, 0, 0)
+	finishReason := FinishReasonStop
+	var answerData strings.Builder
 
 	for {
-		response, err := stream.Recv()
+		response, err := respStream.Recv()
 
 		if response.Usage != nil {
 			modelResult.PromptTokenCount += response.Usage.PromptTokens
@@ -227,18 +220,31 @@ func (p *VolcengineModelProvider) QueryText(question string, writer io.Writer, h
 		}
 
 		if err != nil {
-			if err == io.EOF {
+			if err == io.EOF || stream.Stopped() {
 				break
 			}
-			return nil, err
+			return nil, stream.Error(err)
 		}
 
 		if len(response.Choices) == 0 {
 			continue
 		}
 
+		if response.Choices[0].FinishReason != "" {
+			finishReason = string(response.Choices[0].FinishReason)
+		}
+
 		data := response.Choices[0].Delta.Content
-		err = flushData(data)
+		err = stream.TextDelta(data)
+		if err != nil {
+			return nil, err
+		}
+		answerData.WriteString(data)
+	}
+
+	// The usage only comes at the end of the answer.
+	if stream.Stopped() && modelResult.TotalTokenCount == 0 {
+		modelResult, err = getDefaultModelResult(p.subType, question, answerData.String())
 		if err != nil {
 			return nil, err
 		}
@@ -249,5 +255,9 @@ func (p *VolcengineModelProvider) QueryText(question string, writer io.Writer, h
 		return nil, err
 	}
 
+	err = stream.End(modelResult, finishReason)
+	if err != nil {
+		return nil, err
+	}
 	return modelResult, nil
 }
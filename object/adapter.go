@@ -319,4 +319,14 @@ func (a *Adapter) createTable() {
 	if err != nil {
 		panic(err)
 	}
+
+	err = a.engine.Sync2(new(Evaluation))
+	if err != nil {
+		panic(err)
+	}
+
+	err = a.engine.Sync2(new(CachedAnswer))
+	if err != nil {
+		panic(err)
+	}
 }
@@ -0,0 +1,319 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/casibase/casibase/embedding"
+	"github.com/casibase/casibase/util"
+	"xorm.io/core"
+)
+
+const (
+	// defaultAnswerCacheThreshold is the similarity above which two questions
+	// are taken as the same question when the store does not set one.
+	defaultAnswerCacheThreshold = 0.95
+
+	// answerCacheLimit is how many answers are kept per store, the oldest ones
+	// are dropped first.
+	answerCacheLimit = 1000
+)
+
+// CachedAnswer is an answer of a store, served again for the later questions
+// that are similar enough to its question. It is only valid for the knowledge
+// version of the store it was answered with.
+type CachedAnswer struct {
+	Owner       string `xorm:"varchar(100) notnull pk" json:"owner"`
+	Name        string `xorm:"varchar(100) notnull pk" json:"name"`
+	CreatedTime string `xorm:"varchar(100)" json:"createdTime"`
+
+	Store             string          `xorm:"varchar(100) index" json:"store"`
+	KnowledgeVersion  string          `xorm:"varchar(100)" json:"knowledgeVersion"`
+	EmbeddingProvider string          `xorm:"varchar(100)" json:"embeddingProvider"`
+	ModelProvider     string          `xorm:"varchar(100)" json:"modelProvider"`
+	Filter            string          `xorm:"varchar(500)" json:"filter"`
+	Question          string          `xorm:"mediumtext" json:"question"`
+	Text              string          `xorm:"mediumtext" json:"text"`
+	ReasonText        string          `xorm:"mediumtext" json:"reasonText"`
+	Suggestions       []Suggestion    `xorm:"mediumtext" json:"suggestions"`
+	VectorScores      []VectorScore   `xorm:"mediumtext" json:"vectorScores"`
+	Sources           []MessageSource `xorm:"mediumtext" json:"sources"`
+	HitCount          int             `json:"hitCount"`
+
+	Data VectorData `xorm:"mediumblob" json:"data"`
+}
+
+// AnswerCacheQuery is a question looked up in the answer cache, kept to cache
+// its answer if it was not found.
+type AnswerCacheQuery struct {
+	store             *Store
+	knowledgeVersion  string
+	embeddingProvider string
+	modelProvider     string
+	filter            string
+	question          string
+	data              []float32
+}
+
+var (
+	answerCacheMap = map[string][]*CachedAnswer{} // store id -> answers, oldest first
+	answerCacheMu  sync.Mutex
+)
+
+func getAnswerCacheThreshold(store *Store) float32 {
+	if store.CacheThreshold <= 0 {
+		return defaultAnswerCacheThreshold
+	}
+	return float32(store.CacheThreshold)
+}
+
+// getStoreCachedAnswers must be called with answerCacheMu held.
+func getStoreCachedAnswers(store *Store) ([]*CachedAnswer, error) {
+	if answers, ok := answerCacheMap[store.GetId()]; ok {
+		return answers, nil
+	}
+
+	answers := []*CachedAnswer{}
+	err := adapter.engine.Asc("created_time").Find(&answers, &CachedAnswer{Owner: store.Owner, Store: store.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	answerCacheMap[store.GetId()] = answers
+	return answers, nil
+}
+
+// getNearestCachedAnswer returns the most similar answer to the query that is
+// above the threshold, nil if there is none.
+func getNearestCachedAnswer(answers []*CachedAnswer, query *AnswerCacheQuery, threshold float32) *CachedAnswer {
+	var res *CachedAnswer
+	var maxSimilarity float32
+	targetNorm := norm(query.data)
+	for _, answer := range answers {
+		if answer.KnowledgeVersion != query.knowledgeVersion || answer.EmbeddingProvider != query.embeddingProvider ||
+			answer.ModelProvider != query.modelProvider || answer.Filter != query.filter || len(answer.Data) != len(query.data) {
+			continue
+		}
+
+		similarity := cosineSimilarity(query.data, answer.Data, targetNorm)
+		if similarity >= threshold && (res == nil || similarity > maxSimilarity) {
+			res = answer
+			maxSimilarity = similarity
+		}
+	}
+	return res
+}
+
+// GetCachedAnswer embeds the question and returns the cached answer of the most
+// similar question asked to the store with the same knowledge, providers and
+// filter. The query is returned to cache the answer when none was found.
+func GetCachedAnswer(store *Store, embeddingProviderName string, embeddingProviderObj embedding.EmbeddingProvider, modelProviderName string, filter string, question string, ctx context.Context, lang string) (*CachedAnswer, *AnswerCacheQuery, *embedding.EmbeddingResult, error) {
+	data, embeddingResult, err := queryVectorSafe(embeddingProviderObj, question, ctx, lang)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if embeddingResult == nil {
+		embeddingResult = &embedding.EmbeddingResult{}
+	}
+
+	query := &AnswerCacheQuery{
+		store:             store,
+		knowledgeVersion:  store.KnowledgeVersion,
+		embeddingProvider: embeddingProviderName,
+		modelProvider:     modelProviderName,
+		filter:            filter,
+		question:          question,
+		data:              data,
+	}
+
+	answerCacheMu.Lock()
+	defer answerCacheMu.Unlock()
+
+	answers, err := getStoreCachedAnswers(store)
+	if err != nil {
+		return nil, nil, embeddingResult, err
+	}
+
+	answer := getNearestCachedAnswer(answers, query, getAnswerCacheThreshold(store))
+	if answer == nil {
+		return nil, query, embeddingResult, nil
+	}
+
+	answer.HitCount++
+	_, err = adapter.engine.ID(core.PK{answer.Owner, answer.Name}).Cols("hit_count").Update(answer)
+	if err != nil {
+		return nil, nil, embeddingResult, err
+	}
+
+	res := *answer
+	return &res, query, embeddingResult, nil
+}
+
+// questionEmbeddingProvider returns the vector of the question that was already
+// embedded for the answer cache, so that the search does not pay for it again,
+// and embeds the other texts with its provider.
+type questionEmbeddingProvider struct {
+	embedding.EmbeddingProvider
+	question string
+	data     []float32
+}
+
+func (p *questionEmbeddingProvider) QueryVector(text string, ctx context.Context, lang string) ([]float32, *embedding.EmbeddingResult, error) {
+	if text == p.question {
+		return p.data, &embedding.EmbeddingResult{}, nil
+	}
+	return p.EmbeddingProvider.QueryVector(text, ctx, lang)
+}
+
+func (p *questionEmbeddingProvider) GetMaxBatchSize() int {
+	return embedding.GetMaxBatchSize(p.EmbeddingProvider)
+}
+
+// QueryVectors embeds the texts other than the question, the question keeps
+// its vector.
+func (p *questionEmbeddingProvider) QueryVectors(texts []string, ctx context.Context, lang string) ([][]float32, *embedding.EmbeddingResult, error) {
+	otherTexts := []string{}
+	for _, text := range texts {
+		if text != p.question {
+			otherTexts = append(otherTexts, text)
+		}
+	}
+	vectors, embeddingResult, err := embedding.QueryVectors(p.EmbeddingProvider, otherTexts, ctx, lang)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res := make([][]float32, 0, len(texts))
+	for _, text := range texts {
+		if text == p.question {
+			res = append(res, p.data)
+		} else {
+			res = append(res, vectors[0])
+			vectors = vectors[1:]
+		}
+	}
+	return res, embeddingResult, nil
+}
+
+// GetEmbeddingProvider returns the embedding provider for the search of the
+// question of the query, which reuses the vector of the question.
+func (query *AnswerCacheQuery) GetEmbeddingProvider(embeddingProviderObj embedding.EmbeddingProvider) embedding.EmbeddingProvider {
+	if len(query.data) == 0 {
+		return embeddingProviderObj
+	}
+	return &questionEmbeddingProvider{EmbeddingProvider: embeddingProviderObj, question: query.question, data: query.data}
+}
+
+// AddCachedAnswer caches the answer of a question that was not found in the
+// cache, unless the store knowledge changed while it was answered.
+func AddCachedAnswer(query *AnswerCacheQuery, message *Message) error {
+	store, err := getStore(query.store.Owner, query.store.Name)
+	if err != nil {
+		return err
+	}
+	if store == nil || store.KnowledgeVersion != query.knowledgeVersion {
+		return nil
+	}
+
+	answer := &CachedAnswer{
+		Owner:             store.Owner,
+		Name:              fmt.Sprintf("answer_%s", util.GetRandomName()),
+		CreatedTime:       util.GetCurrentTime(),
+		Store:             store.Name,
+		KnowledgeVersion:  query.knowledgeVersion,
+		EmbeddingProvider: query.embeddingProvider,
+		ModelProvider:     query.modelProvider,
+		Filter:            query.filter,
+		Question:          query.question,
+		Text:              message.Text,
+		ReasonText:        message.ReasonText,
+		Suggestions:       message.Suggestions,
+		VectorScores:      message.VectorScores,
+		Sources:           message.Sources,
+		Data:              query.data,
+	}
+
+	answerCacheMu.Lock()
+	defer answerCacheMu.Unlock()
+
+	answers, err := getStoreCachedAnswers(store)
+	if err != nil {
+		return err
+	}
+
+	_, err = adapter.engine.Insert(answer)
+	if err != nil {
+		return err
+	}
+	answers = append(answers, answer)
+
+	for len(answers) > answerCacheLimit {
+		_, err = adapter.engine.ID(core.PK{answers[0].Owner, answers[0].Name}).Delete(&CachedAnswer{})
+		if err != nil {
+			return err
+		}
+		answers = answers[1:]
+	}
+
+	answerCacheMap[store.GetId()] = answers
+	return nil
+}
+
+// invalidateAnswerCache moves the store, and the stores that search its vectors
+// too, to a new knowledge version and deletes the answers cached for the
+// previous ones.
+func invalidateAnswerCache(store *Store) error {
+	stores, err := GetStores(store.Owner)
+	if err != nil {
+		return err
+	}
+
+	err = invalidateStoreAnswerCache(store)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range stores {
+		if s.Name != store.Name && util.InSlice(s.VectorStores, store.Name) {
+			err = invalidateStoreAnswerCache(s)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func invalidateStoreAnswerCache(store *Store) error {
+	store.KnowledgeVersion = util.GetRandomName()
+	_, err := adapter.engine.ID(core.PK{store.Owner, store.Name}).Cols("knowledge_version").Update(store)
+	if err != nil {
+		return err
+	}
+
+	answerCacheMu.Lock()
+	defer answerCacheMu.Unlock()
+
+	_, err = adapter.engine.Delete(&CachedAnswer{Owner: store.Owner, Store: store.Name})
+	if err != nil {
+		return err
+	}
+
+	delete(answerCacheMap, store.GetId())
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package object
+
+import (
+	"context"
+	"testing"
+
+	"github.com/casibase/casibase/embedding"
+)
+
+func TestGetNearestCachedAnswer(t *testing.T) {
+	newAnswer := func(name string, version string, filter string, data []float32) *CachedAnswer {
+		return &CachedAnswer{Name: name, KnowledgeVersion: version, EmbeddingProvider: "embedding", ModelProvider: "model", Filter: filter, Data: data}
+	}
+
+	answers := []*CachedAnswer{
+		newAnswer("a1", "v1", "", []float32{1, 0, 0}),
+		newAnswer("a2", "v2", "", []float32{1, 0.1, 0}),
+		newAnswer("a3", "v2", "", []float32{1, 0.01, 0}),
+		newAnswer("a4", "v2", "lang = en", []float32{1, 0, 0}),
+		newAnswer("a5", "v2", "", []float32{1, 0}),
+	}
+
+	query := &AnswerCacheQuery{knowledgeVersion: "v2", embeddingProvider: "embedding", modelProvider: "model", data: []float32{1, 0, 0}}
+	res := getNearestCachedAnswer(answers, query, 0.95)
+	if res == nil || res.Name != "a3" {
+		t.Errorf("getNearestCachedAnswer() = %v, want a3", res)
+	}
+
+	query.filter = "lang = en"
+	res = getNearestCachedAnswer(answers, query, 0.95)
+	if res == nil || res.Name != "a4" {
+		t.Errorf("getNearestCachedAnswer() with a filter = %v, want a4", res)
+	}
+
+	query.filter = ""
+	query.data = []float32{0, 1, 0}
+	res = getNearestCachedAnswer(answers, query, 0.95)
+	if res != nil {
+		t.Errorf("getNearestCachedAnswer() for another question = %v, want nil", res.Name)
+	}
+
+	query.data = []float32{1, 0, 0}
+	query.knowledgeVersion = "v3"
+	res = getNearestCachedAnswer(answers, query, 0.95)
+	if res != nil {
+		t.Errorf("getNearestCachedAnswer() for another knowledge version = %v, want nil", res.Name)
+	}
+}
+
+type testCountingEmbeddingProvider struct {
+	texts []string
+}
+
+func (p *testCountingEmbeddingProvider) GetPricing() string {
+	return ""
+}
+
+func (p *testCountingEmbeddingProvider) QueryVector(text string, ctx context.Context, lang string) ([]float32, *embedding.EmbeddingResult, error) {
+	p.texts = append(p.texts, text)
+	return []float32{float32(len(text))}, &embedding.EmbeddingResult{TokenCount: 1}, nil
+}
+
+func TestQuestionEmbeddingProvider(t *testing.T) {
+	inner := &testCountingEmbeddingProvider{}
+	query := &AnswerCacheQuery{question: "question", data: []float32{-1}}
+	provider := query.GetEmbeddingProvider(inner)
+
+	vectors, embeddingResult, err := embedding.QueryVectors(provider, []string{"a", "question", "bb"}, context.Background(), "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vectors) != 3 || vectors[0][0] != 1 || vectors[1][0] != -1 || vectors[2][0] != 2 {
+		t.Errorf("got vectors %v, expected the question to keep its vector", vectors)
+	}
+	if len(inner.texts) != 2 || embeddingResult.TokenCount != 2 {
+		t.Errorf("got %v embedded with %d tokens, expected only the other texts", inner.texts, embeddingResult.TokenCount)
+	}
+
+	vector, embeddingResult, err := provider.QueryVector("question", context.Background(), "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vector[0] != -1 || embeddingResult.TokenCount != 0 || len(inner.texts) != 2 {
+		t.Errorf("the question should not be embedded again")
+	}
+}
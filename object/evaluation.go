@@ -0,0 +1,202 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"fmt"
+
+	"github.com/casibase/casibase/util"
+	"xorm.io/core"
+)
+
+type EvaluationMetrics struct {
+	CaseCount  int `json:"caseCount"`
+	ErrorCount int `json:"errorCount"`
+
+	// Retrieval metrics, over the cases with expected files.
+	RetrievalCaseCount int     `json:"retrievalCaseCount"`
+	RecallAtK          float64 `json:"recallAtK"`
+	Mrr                float64 `json:"mrr"`
+
+	// Answer metrics, over the cases with an expected answer and the cases rated
+	// by the judge.
+	AnswerCaseCount int     `json:"answerCaseCount"`
+	ExactMatch      float64 `json:"exactMatch"`
+	JudgeCaseCount  int     `json:"judgeCaseCount"`
+	Faithfulness    float64 `json:"faithfulness"`
+}
+
+type EvaluationResult struct {
+	Id             string   `json:"id"`
+	Question       string   `json:"question"`
+	ExpectedAnswer string   `json:"expectedAnswer"`
+	ExpectedFiles  []string `json:"expectedFiles"`
+
+	Answer         string   `json:"answer"`
+	RetrievedFiles []string `json:"retrievedFiles"`
+	RecallAtK      float64  `json:"recallAtK"`
+	ReciprocalRank float64  `json:"reciprocalRank"`
+	IsExactMatch   bool     `json:"isExactMatch"`
+	Faithfulness   float64  `json:"faithfulness"`
+	IsJudged       bool     `json:"isJudged"`
+
+	TokenCount int     `json:"tokenCount"`
+	Price      float64 `json:"price"`
+	ErrorText  string  `json:"errorText"`
+}
+
+type Evaluation struct {
+	Owner       string `xorm:"varchar(100) notnull pk" json:"owner"`
+	Name        string `xorm:"varchar(100) notnull pk" json:"name"`
+	CreatedTime string `xorm:"varchar(100)" json:"createdTime"`
+	DisplayName string `xorm:"varchar(100)" json:"displayName"`
+
+	Store             string `xorm:"varchar(100)" json:"store"`
+	ModelProvider     string `xorm:"varchar(100)" json:"modelProvider"`
+	EmbeddingProvider string `xorm:"varchar(100)" json:"embeddingProvider"`
+	SearchProvider    string `xorm:"varchar(100)" json:"searchProvider"`
+	JudgeProvider     string `xorm:"varchar(100)" json:"judgeProvider"`
+	KnowledgeCount    int    `json:"knowledgeCount"`
+	Dataset           string `xorm:"mediumtext" json:"dataset"`
+
+	State       string              `xorm:"varchar(100)" json:"state"`
+	ErrorText   string              `xorm:"mediumtext" json:"errorText"`
+	StartedTime string              `xorm:"varchar(100)" json:"startedTime"`
+	EndedTime   string              `xorm:"varchar(100)" json:"endedTime"`
+	Metrics     *EvaluationMetrics  `xorm:"mediumtext" json:"metrics"`
+	Results     []*EvaluationResult `xorm:"mediumtext" json:"results"`
+	TokenCount  int                 `json:"tokenCount"`
+	Price       float64             `json:"price"`
+	Currency    string              `xorm:"varchar(100)" json:"currency"`
+}
+
+func GetMaskedEvaluation(evaluation *Evaluation, isMaskEnabled bool) *Evaluation {
+	if !isMaskEnabled {
+		return evaluation
+	}
+
+	if evaluation == nil {
+		return nil
+	}
+
+	return evaluation
+}
+
+func GetMaskedEvaluations(evaluations []*Evaluation, isMaskEnabled bool) []*Evaluation {
+	if !isMaskEnabled {
+		return evaluations
+	}
+
+	for _, evaluation := range evaluations {
+		evaluation = GetMaskedEvaluation(evaluation, isMaskEnabled)
+	}
+	return evaluations
+}
+
+func GetGlobalEvaluations() ([]*Evaluation, error) {
+	evaluations := []*Evaluation{}
+	err := adapter.engine.Asc("owner").Desc("created_time").Find(&evaluations)
+	if err != nil {
+		return evaluations, err
+	}
+
+	return evaluations, nil
+}
+
+func GetEvaluations(owner string) ([]*Evaluation, error) {
+	evaluations := []*Evaluation{}
+	err := adapter.engine.Desc("created_time").Find(&evaluations, &Evaluation{Owner: owner})
+	if err != nil {
+		return evaluations, err
+	}
+
+	return evaluations, nil
+}
+
+func getEvaluation(owner string, name string) (*Evaluation, error) {
+	evaluation := Evaluation{Owner: owner, Name: name}
+	existed, err := adapter.engine.Get(&evaluation)
+	if err != nil {
+		return &evaluation, err
+	}
+
+	if existed {
+		return &evaluation, nil
+	} else {
+		return nil, nil
+	}
+}
+
+func GetEvaluation(id string) (*Evaluation, error) {
+	owner, name := util.GetOwnerAndNameFromId(id)
+	return getEvaluation(owner, name)
+}
+
+func UpdateEvaluation(id string, evaluation *Evaluation) (bool, error) {
+	owner, name := util.GetOwnerAndNameFromId(id)
+	_, err := getEvaluation(owner, name)
+	if err != nil {
+		return false, err
+	}
+	if evaluation == nil {
+		return false, nil
+	}
+
+	_, err = adapter.engine.ID(core.PK{owner, name}).AllCols().Update(evaluation)
+	if err != nil {
+		return false, err
+	}
+
+	// return affected != 0
+	return true, nil
+}
+
+func AddEvaluation(evaluation *Evaluation) (bool, error) {
+	affected, err := adapter.engine.Insert(evaluation)
+	if err != nil {
+		return false, err
+	}
+
+	return affected != 0, nil
+}
+
+func DeleteEvaluation(evaluation *Evaluation) (bool, error) {
+	affected, err := adapter.engine.ID(core.PK{evaluation.Owner, evaluation.Name}).Delete(&Evaluation{})
+	if err != nil {
+		return false, err
+	}
+
+	return affected != 0, nil
+}
+
+func (evaluation *Evaluation) GetId() string {
+	return fmt.Sprintf("%s/%s", evaluation.Owner, evaluation.Name)
+}
+
+func GetEvaluationCount(owner string, field, value string) (int64, error) {
+	session := GetDbSession(owner, -1, -1, field, value, "", "")
+	return session.Count(&Evaluation{})
+}
+
+func GetPaginationEvaluations(owner string, offset, limit int, field, value, sortField, sortOrder string) ([]*Evaluation, error) {
+	evaluations := []*Evaluation{}
+	session := GetDbSession(owner, offset, limit, field, value, sortField, sortOrder)
+	err := session.Find(&evaluations)
+	if err != nil {
+		return evaluations, err
+	}
+
+	return evaluations, nil
+}
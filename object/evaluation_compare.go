@@ -0,0 +1,109 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+type EvaluationMetricDelta struct {
+	Name   string  `json:"name"`
+	Base   float64 `json:"base"`
+	Target float64 `json:"target"`
+	Delta  float64 `json:"delta"`
+}
+
+type EvaluationCaseComparison struct {
+	Id       string            `json:"id"`
+	Question string            `json:"question"`
+	Base     *EvaluationResult `json:"base"`
+	Target   *EvaluationResult `json:"target"`
+}
+
+type EvaluationComparison struct {
+	Base    string                      `json:"base"`
+	Target  string                      `json:"target"`
+	Metrics []*EvaluationMetricDelta    `json:"metrics"`
+	Cases   []*EvaluationCaseComparison `json:"cases"`
+}
+
+func getEvaluationMetricValues(evaluation *Evaluation) []float64 {
+	metrics := evaluation.Metrics
+	if metrics == nil {
+		metrics = getEvaluationMetrics(evaluation.Results)
+	}
+	return []float64{metrics.RecallAtK, metrics.Mrr, metrics.ExactMatch, metrics.Faithfulness, float64(metrics.ErrorCount), float64(evaluation.TokenCount), evaluation.Price}
+}
+
+var evaluationMetricNames = []string{"Recall@K", "MRR", "Exact match", "Faithfulness", "Errors", "Tokens", "Price"}
+
+func isSameEvaluationResult(a *EvaluationResult, b *EvaluationResult) bool {
+	return a.RecallAtK == b.RecallAtK && a.ReciprocalRank == b.ReciprocalRank && a.IsExactMatch == b.IsExactMatch &&
+		a.Faithfulness == b.Faithfulness && a.IsJudged == b.IsJudged && (a.ErrorText == "") == (b.ErrorText == "")
+}
+
+// CompareEvaluations gives the metric deltas from the base run to the target run,
+// and the cases whose scores changed between the two, matched by case id.
+func CompareEvaluations(base *Evaluation, target *Evaluation) *EvaluationComparison {
+	res := &EvaluationComparison{
+		Base:    base.GetId(),
+		Target:  target.GetId(),
+		Metrics: []*EvaluationMetricDelta{},
+		Cases:   []*EvaluationCaseComparison{},
+	}
+
+	baseValues := getEvaluationMetricValues(base)
+	targetValues := getEvaluationMetricValues(target)
+	for i, name := range evaluationMetricNames {
+		res.Metrics = append(res.Metrics, &EvaluationMetricDelta{
+			Name:   name,
+			Base:   baseValues[i],
+			Target: targetValues[i],
+			Delta:  targetValues[i] - baseValues[i],
+		})
+	}
+
+	baseIds := map[string]bool{}
+	targetResults := map[string]*EvaluationResult{}
+	for _, result := range base.Results {
+		baseIds[result.Id] = true
+	}
+	for _, result := range target.Results {
+		targetResults[result.Id] = result
+	}
+
+	for _, baseResult := range base.Results {
+		targetResult := targetResults[baseResult.Id]
+		if targetResult != nil && isSameEvaluationResult(baseResult, targetResult) {
+			continue
+		}
+
+		res.Cases = append(res.Cases, &EvaluationCaseComparison{
+			Id:       baseResult.Id,
+			Question: baseResult.Question,
+			Base:     baseResult,
+			Target:   targetResult,
+		})
+	}
+
+	// The cases only in the target run, e.g. after the dataset was extended.
+	for _, targetResult := range target.Results {
+		if !baseIds[targetResult.Id] {
+			res.Cases = append(res.Cases, &EvaluationCaseComparison{
+				Id:       targetResult.Id,
+				Question: targetResult.Question,
+				Target:   targetResult,
+			})
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,286 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/beego/beego/logs"
+	"github.com/casibase/casibase/embedding"
+	"github.com/casibase/casibase/eval"
+	"github.com/casibase/casibase/i18n"
+	"github.com/casibase/casibase/model"
+	"github.com/casibase/casibase/util"
+	"xorm.io/core"
+)
+
+type evaluationRunner struct {
+	store                *Store
+	modelProvider        *Provider
+	embeddingProvider    *Provider
+	embeddingProviderObj embedding.EmbeddingProvider
+	judgeProvider        string
+	knowledgeCount       int
+	lang                 string
+}
+
+// StartEvaluation resets the evaluation and sets it to running, it returns
+// false when the evaluation is already running. The state is changed with a
+// conditional update, so that two requests cannot start the same evaluation.
+func StartEvaluation(evaluation *Evaluation) (bool, error) {
+	evaluation.State = "Running"
+	evaluation.ErrorText = ""
+	evaluation.StartedTime = util.GetCurrentTime()
+	evaluation.EndedTime = ""
+	evaluation.Metrics = &EvaluationMetrics{}
+	evaluation.Results = []*EvaluationResult{}
+	evaluation.TokenCount = 0
+	evaluation.Price = 0
+	affected, err := adapter.engine.ID(core.PK{evaluation.Owner, evaluation.Name}).Where("state <> ?", "Running").AllCols().Update(evaluation)
+	if err != nil {
+		return false, err
+	}
+
+	return affected != 0, nil
+}
+
+// InitEvaluations fails the evaluations that are still running at startup,
+// their runs were interrupted by a restart and would otherwise never be
+// startable again.
+func InitEvaluations() {
+	evaluation := &Evaluation{
+		State:     "Failed",
+		ErrorText: "The evaluation was interrupted by a restart of the server",
+		EndedTime: util.GetCurrentTime(),
+	}
+	_, err := adapter.engine.Where("state = ?", "Running").Cols("state", "error_text", "ended_time").Update(evaluation)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// RunEvaluation asks every question of the dataset to the store with the
+// providers of the evaluation, and saves the results and metrics after each
+// question so that a running evaluation can be watched. The evaluation is
+// started with StartEvaluation first.
+func RunEvaluation(evaluation *Evaluation, lang string) error {
+	err := runEvaluation(evaluation, lang)
+	evaluation.EndedTime = util.GetCurrentTime()
+	if err != nil {
+		evaluation.State = "Failed"
+		evaluation.ErrorText = err.Error()
+	} else {
+		evaluation.State = "Finished"
+	}
+
+	_, updateErr := UpdateEvaluation(evaluation.GetId(), evaluation)
+	if updateErr != nil {
+		return updateErr
+	}
+	return err
+}
+
+func runEvaluation(evaluation *Evaluation, lang string) error {
+	cases, err := eval.ParseDataset(evaluation.Dataset, lang)
+	if err != nil {
+		return err
+	}
+
+	runner, err := newEvaluationRunner(evaluation, lang)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range cases {
+		result := runner.evaluateCase(c)
+		if result.ErrorText != "" {
+			logs.Warn("evaluation %s: case %s failed: %s", evaluation.GetId(), c.Id, result.ErrorText)
+		}
+
+		evaluation.Results = append(evaluation.Results, result)
+		evaluation.Metrics = getEvaluationMetrics(evaluation.Results)
+		evaluation.TokenCount += result.TokenCount
+		evaluation.Price = model.AddPrices(evaluation.Price, result.Price)
+
+		_, err = UpdateEvaluation(evaluation.GetId(), evaluation)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func newEvaluationRunner(evaluation *Evaluation, lang string) (*evaluationRunner, error) {
+	store, err := getStore(evaluation.Owner, evaluation.Store)
+	if err != nil {
+		return nil, err
+	}
+	if store == nil {
+		return nil, fmt.Errorf(i18n.Translate(lang, "object:The store: %s is not found"), evaluation.Store)
+	}
+
+	// The evaluation may try other providers than the store's, so work on a copy.
+	storeCopy := *store
+	if evaluation.ModelProvider != "" {
+		storeCopy.ModelProvider = evaluation.ModelProvider
+	}
+	if evaluation.EmbeddingProvider != "" {
+		storeCopy.EmbeddingProvider = evaluation.EmbeddingProvider
+	}
+	if evaluation.SearchProvider != "" {
+		storeCopy.SearchProvider = evaluation.SearchProvider
+	}
+
+	modelProvider, _, err := GetModelProviderFromContext(evaluation.Owner, storeCopy.ModelProvider, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	embeddingProvider, embeddingProviderObj, err := GetEmbeddingProviderFromContext(evaluation.Owner, storeCopy.EmbeddingProvider, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	knowledgeCount := evaluation.KnowledgeCount
+	if knowledgeCount <= 0 {
+		knowledgeCount = storeCopy.KnowledgeCount
+	}
+	if knowledgeCount <= 0 {
+		knowledgeCount = 10
+	}
+
+	evaluation.Currency = modelProvider.Currency
+
+	return &evaluationRunner{
+		store:                &storeCopy,
+		modelProvider:        modelProvider,
+		embeddingProvider:    embeddingProvider,
+		embeddingProviderObj: embeddingProviderObj,
+		judgeProvider:        evaluation.JudgeProvider,
+		knowledgeCount:       knowledgeCount,
+		lang:                 lang,
+	}, nil
+}
+
+func (r *evaluationRunner) evaluateCase(c *eval.Case) *EvaluationResult {
+	result := &EvaluationResult{
+		Id:             c.Id,
+		Question:       c.Question,
+		ExpectedAnswer: c.Answer,
+		ExpectedFiles:  c.Files,
+		RetrievedFiles: []string{},
+	}
+
+	knowledge, sources, embeddingResult, err := GetNearestKnowledge(r.store, r.embeddingProvider, r.embeddingProviderObj, r.modelProvider, r.store.Owner, c.Question, r.knowledgeCount, nil, context.Background(), r.lang)
+	if err != nil && !errors.Is(err, ErrNoKnowledgeVectors) {
+		result.ErrorText = err.Error()
+		return result
+	}
+	if embeddingResult != nil {
+		result.TokenCount += embeddingResult.TokenCount
+		result.Price = model.AddPrices(result.Price, embeddingResult.Price)
+	}
+
+	for _, source := range sources {
+		if !util.InSlice(result.RetrievedFiles, source.File) {
+			result.RetrievedFiles = append(result.RetrievedFiles, source.File)
+		}
+	}
+	if len(c.Files) != 0 {
+		result.RecallAtK = eval.RecallAtK(c.Files, result.RetrievedFiles, r.knowledgeCount)
+		result.ReciprocalRank = eval.ReciprocalRank(c.Files, result.RetrievedFiles)
+	}
+
+	prompt := r.store.Prompt
+	if r.store.CrossLingualSearch != "" {
+		prompt = GetPromptWithLanguage(prompt, c.Question)
+	}
+
+	history := []*model.RawMessage{}
+	answer, modelResult, err := GetStoreAnswerWithContext(r.store, r.modelProvider.Name, c.Question, history, knowledge, prompt, context.Background(), r.lang)
+	if err != nil {
+		result.ErrorText = err.Error()
+		return result
+	}
+	result.Answer = answer
+	result.TokenCount += modelResult.TotalTokenCount
+	result.Price = model.AddPrices(result.Price, modelResult.TotalPrice)
+
+	if c.Answer != "" {
+		result.IsExactMatch = eval.IsExactMatch(c.Answer, answer)
+	}
+
+	// Faithfulness is judged against the retrieved knowledge, so it has no
+	// meaning when nothing was retrieved.
+	if r.judgeProvider != "" && len(knowledge) != 0 {
+		texts := []string{}
+		for _, message := range knowledge {
+			texts = append(texts, message.Text)
+		}
+
+		judgeAnswer, judgeResult, err := GetAnswer(r.judgeProvider, eval.GetJudgeQuestion(c.Question, texts, answer), r.lang)
+		if err != nil {
+			result.ErrorText = err.Error()
+			return result
+		}
+		result.TokenCount += judgeResult.TotalTokenCount
+		result.Price = model.AddPrices(result.Price, judgeResult.TotalPrice)
+
+		result.Faithfulness, result.IsJudged = eval.ParseJudgeScore(judgeAnswer)
+	}
+
+	return result
+}
+
+func getEvaluationMetrics(results []*EvaluationResult) *EvaluationMetrics {
+	res := &EvaluationMetrics{CaseCount: len(results)}
+	for _, result := range results {
+		if result.ErrorText != "" {
+			res.ErrorCount++
+			continue
+		}
+
+		if len(result.ExpectedFiles) != 0 {
+			res.RetrievalCaseCount++
+			res.RecallAtK += result.RecallAtK
+			res.Mrr += result.ReciprocalRank
+		}
+		if result.ExpectedAnswer != "" {
+			res.AnswerCaseCount++
+			if result.IsExactMatch {
+				res.ExactMatch++
+			}
+		}
+		if result.IsJudged {
+			res.JudgeCaseCount++
+			res.Faithfulness += result.Faithfulness
+		}
+	}
+
+	if res.RetrievalCaseCount != 0 {
+		res.RecallAtK /= float64(res.RetrievalCaseCount)
+		res.Mrr /= float64(res.RetrievalCaseCount)
+	}
+	if res.AnswerCaseCount != 0 {
+		res.ExactMatch /= float64(res.AnswerCaseCount)
+	}
+	if res.JudgeCaseCount != 0 {
+		res.Faithfulness /= float64(res.JudgeCaseCount)
+	}
+	return res
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package object
+
+import "testing"
+
+func TestCompareEvaluations(t *testing.T) {
+	base := &Evaluation{Owner: "admin", Name: "base", Results: []*EvaluationResult{
+		{Id: "q1", ExpectedFiles: []string{"a.md"}, RecallAtK: 1, ReciprocalRank: 1},
+		{Id: "q2", ExpectedAnswer: "A", IsExactMatch: false},
+		{Id: "q3", ExpectedAnswer: "B", IsExactMatch: true},
+	}}
+	target := &Evaluation{Owner: "admin", Name: "target", Results: []*EvaluationResult{
+		{Id: "q1", ExpectedFiles: []string{"a.md"}, RecallAtK: 1, ReciprocalRank: 0.5},
+		{Id: "q2", ExpectedAnswer: "A", IsExactMatch: true},
+		{Id: "q3", ExpectedAnswer: "B", IsExactMatch: true},
+		{Id: "q4", ErrorText: "timeout"},
+	}}
+	base.Metrics = getEvaluationMetrics(base.Results)
+	target.Metrics = getEvaluationMetrics(target.Results)
+
+	res := CompareEvaluations(base, target)
+
+	deltas := map[string]float64{}
+	for _, metric := range res.Metrics {
+		deltas[metric.Name] = metric.Delta
+	}
+	if deltas["MRR"] != -0.5 || deltas["Exact match"] != 0.5 || deltas["Errors"] != 1 {
+		t.Errorf("unexpected deltas: %v", deltas)
+	}
+
+	ids := []string{}
+	for _, c := range res.Cases {
+		ids = append(ids, c.Id)
+	}
+	if len(ids) != 3 || ids[0] != "q1" || ids[1] != "q2" || ids[2] != "q4" {
+		t.Errorf("unexpected changed cases: %v", ids)
+	}
+	if res.Cases[2].Base != nil {
+		t.Errorf("q4 is not in the base run")
+	}
+}
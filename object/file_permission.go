@@ -0,0 +1,363 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/casdoor/casdoor-go-sdk/casdoorsdk"
+	"github.com/casibase/casibase/i18n"
+	"github.com/casibase/casibase/util"
+)
+
+// filePermissionTtl is how long the tree node permissions fetched from Casdoor
+// are reused, so that a page of files does not query Casdoor for every file.
+const filePermissionTtl = 30 * time.Second
+
+var (
+	filePermissions          []*casdoorsdk.Permission
+	filePermissionsFetchTime time.Time
+	filePermissionsMu        sync.Mutex
+)
+
+// FileAccess tells which files of the stores a user can read. The files are
+// guarded by the Casdoor permissions of the "TreeNode" resource type, whose
+// domain is the store name and whose resources are the keys of the files or
+// folders, a folder permission applies to everything in the folder. A file
+// that no permission covers can be read by everyone, a file that is covered
+// can only be read by the users, groups and roles its permissions allow. A
+// nil access reads everything. Only the downloads of a local file system
+// storage go through the server, so the permissions are only accepted for the
+// stores on such a storage, see CheckFilePermission.
+type FileAccess struct {
+	user *casdoorsdk.User
+
+	permissionMap map[string][]*casdoorsdk.Permission // store name + "/" + file key -> permissions
+}
+
+func getFilePermissions() ([]*casdoorsdk.Permission, error) {
+	filePermissionsMu.Lock()
+	defer filePermissionsMu.Unlock()
+
+	if filePermissions != nil && time.Since(filePermissionsFetchTime) < filePermissionTtl {
+		return filePermissions, nil
+	}
+
+	permissions, err := casdoorsdk.GetPermissions()
+	if err != nil {
+		return nil, err
+	}
+
+	filePermissions = permissions
+	filePermissionsFetchTime = time.Now()
+	return permissions, nil
+}
+
+// GetFileAccess returns the access of the user to the store files, a nil user
+// is an anonymous user.
+func GetFileAccess(user *casdoorsdk.User) (*FileAccess, error) {
+	if isAdmin(user) {
+		return newFileAccess(user, nil), nil
+	}
+
+	permissions, err := getFilePermissions()
+	if err != nil {
+		return nil, err
+	}
+
+	return newFileAccess(user, permissions), nil
+}
+
+func newFileAccess(user *casdoorsdk.User, permissions []*casdoorsdk.Permission) *FileAccess {
+	access := &FileAccess{
+		user:          user,
+		permissionMap: map[string][]*casdoorsdk.Permission{},
+	}
+
+	for _, permission := range permissions {
+		if permission.ResourceType != "TreeNode" || !permission.IsEnabled || (permission.State != "" && permission.State != "Approved") {
+			continue
+		}
+
+		for _, domain := range permission.Domains {
+			for _, resource := range permission.Resources {
+				key := getFilePermissionKey(domain, resource)
+				access.permissionMap[key] = append(access.permissionMap[key], permission)
+			}
+		}
+	}
+	return access
+}
+
+func getFilePermissionKey(storeName string, fileKey string) string {
+	fileKey = strings.Trim(fileKey, "/")
+	if fileKey == "" {
+		return storeName + "/"
+	}
+	return storeName + "/" + fileKey
+}
+
+// getPermissions returns the permissions of the file and of all its folders.
+func (access *FileAccess) getPermissions(storeName string, fileKey string) []*casdoorsdk.Permission {
+	res := []*casdoorsdk.Permission{}
+	fileKey = strings.Trim(fileKey, "/")
+	for {
+		res = append(res, access.permissionMap[getFilePermissionKey(storeName, fileKey)]...)
+		if fileKey == "" {
+			return res
+		}
+
+		fileKey = path.Dir(fileKey)
+		if fileKey == "." {
+			fileKey = ""
+		}
+	}
+}
+
+func (access *FileAccess) isPermissionOfUser(permission *casdoorsdk.Permission) bool {
+	user := access.user
+	if user == nil {
+		return false
+	}
+
+	userId := util.GetIdFromOwnerAndName(user.Owner, user.Name)
+	for _, permissionUser := range permission.Users {
+		if permissionUser == userId || permissionUser == "*" || permissionUser == util.GetIdFromOwnerAndName(user.Owner, "*") {
+			return true
+		}
+	}
+
+	for _, permissionGroup := range permission.Groups {
+		for _, group := range user.Groups {
+			if group == permissionGroup || util.GetIdFromOwnerAndName(user.Owner, group) == permissionGroup {
+				return true
+			}
+		}
+	}
+
+	for _, permissionRole := range permission.Roles {
+		for _, role := range user.Roles {
+			if role != nil && util.GetIdFromOwnerAndName(role.Owner, role.Name) == permissionRole {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CanRead reports whether the user can read the file or folder of the store.
+// A permission that denies the user wins over the ones that allow it.
+func (access *FileAccess) CanRead(storeName string, fileKey string) bool {
+	if access == nil || isAdmin(access.user) {
+		return true
+	}
+
+	permissions := access.getPermissions(storeName, fileKey)
+	isRestricted := false
+	isAllowed := false
+	for _, permission := range permissions {
+		isOfUser := access.isPermissionOfUser(permission)
+		if permission.Effect == "Deny" {
+			if isOfUser {
+				return false
+			}
+			continue
+		}
+
+		isRestricted = true
+		if isOfUser {
+			isAllowed = true
+		}
+	}
+	return !isRestricted || isAllowed
+}
+
+// GetVectorFilter returns the filter of the vectors of the files the user can
+// read.
+func (access *FileAccess) GetVectorFilter() *VectorFilter {
+	return &VectorFilter{access: access}
+}
+
+// HasPermissions reports whether any file of the stores is guarded by a
+// permission, the answers of such stores differ between users.
+func (access *FileAccess) HasPermissions(storeNames []string) bool {
+	if access == nil {
+		return false
+	}
+
+	for key := range access.permissionMap {
+		for _, storeName := range storeNames {
+			if strings.HasPrefix(key, storeName+"/") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// FilterTreeFile removes the files the user cannot read from the tree. A
+// folder the user cannot read is kept when it has a file the user can read,
+// and the root folder is always kept.
+func (access *FileAccess) FilterTreeFile(storeName string, file *TreeFile) *TreeFile {
+	if access == nil || file == nil {
+		return file
+	}
+
+	children := []*TreeFile{}
+	childrenMap := map[string]*TreeFile{}
+	for _, child := range file.Children {
+		child = access.FilterTreeFile(storeName, child)
+		if child != nil {
+			children = append(children, child)
+			childrenMap[child.Title] = child
+		}
+	}
+
+	if len(children) == 0 && file.Key != "/" && !access.CanRead(storeName, file.Key) {
+		return nil
+	}
+
+	res := *file
+	res.Children = children
+	res.ChildrenMap = childrenMap
+	return &res
+}
+
+// getLocalStorageProvider returns the storage provider of the store when it is
+// a local file system, nil otherwise.
+func getLocalStorageProvider(store *Store) (*Provider, error) {
+	var provider *Provider
+	var err error
+	if store.StorageProvider == "" {
+		provider, err = GetDefaultStorageProvider()
+	} else {
+		provider, err = GetProvider(util.GetIdFromOwnerAndName(store.Owner, store.StorageProvider))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil || provider.Type != "Local File System" {
+		return nil, nil
+	}
+
+	return provider, nil
+}
+
+// CheckFilePermission returns an error when the permission guards the files of
+// a store that is not on a local file system storage. The files of the other
+// storage providers are downloaded from the URLs of the provider, which the
+// server cannot check, so such a permission would only hide the files.
+func CheckFilePermission(permission *casdoorsdk.Permission, lang string) error {
+	if permission.ResourceType != "TreeNode" {
+		return nil
+	}
+
+	stores, err := GetGlobalStores()
+	if err != nil {
+		return err
+	}
+
+	for _, store := range stores {
+		if !util.InSlice(permission.Domains, store.Name) {
+			continue
+		}
+
+		provider, err := getLocalStorageProvider(store)
+		if err != nil {
+			return err
+		}
+		if provider == nil {
+			return fmt.Errorf(i18n.Translate(lang, "object:The files of the store: %s are not on a local file system storage and cannot be guarded by permissions"), store.Name)
+		}
+	}
+	return nil
+}
+
+// CheckStoreFilePermissions returns an error when the store has file permissions
+// but is not on a local file system storage, see CheckFilePermission.
+func CheckStoreFilePermissions(store *Store, lang string) error {
+	provider, err := getLocalStorageProvider(store)
+	if err != nil {
+		return err
+	}
+	if provider != nil {
+		return nil
+	}
+
+	permissions, err := getFilePermissions()
+	if err != nil {
+		return err
+	}
+
+	if newFileAccess(nil, permissions).HasPermissions([]string{store.Name}) {
+		return fmt.Errorf(i18n.Translate(lang, "object:The files of the store: %s are guarded by permissions and can only be on a local file system storage"), store.Name)
+	}
+	return nil
+}
+
+// getLocalStorageFile returns the store and the file key of a path of a local
+// file system storage, nil if the path is not in any store.
+func getLocalStorageFile(filePath string) (*Store, string, error) {
+	stores, err := GetGlobalStores()
+	if err != nil {
+		return nil, "", err
+	}
+
+	filePath = strings.TrimPrefix(path.Clean(strings.ReplaceAll(filePath, "\\", "/")), "/")
+	for _, store := range stores {
+		provider, err := getLocalStorageProvider(store)
+		if err != nil {
+			return nil, "", err
+		}
+		if provider == nil {
+			continue
+		}
+
+		root := path.Join(strings.ReplaceAll(provider.ClientId, "\\", "/"), strings.Trim(store.StorageSubpath, "/"))
+		root = strings.TrimPrefix(path.Clean(root), "/")
+		if strings.HasPrefix(filePath, root+"/") {
+			return store, strings.TrimPrefix(filePath, root+"/"), nil
+		}
+	}
+
+	return nil, "", nil
+}
+
+// CanReadStorageFile reports whether the user can download the file of a local
+// file system storage, the files that are in no store are not guarded.
+func CanReadStorageFile(user *casdoorsdk.User, filePath string) (bool, error) {
+	if isAdmin(user) {
+		return true, nil
+	}
+
+	store, fileKey, err := getLocalStorageFile(filePath)
+	if err != nil {
+		return false, err
+	}
+	if store == nil {
+		return true, nil
+	}
+
+	access, err := GetFileAccess(user)
+	if err != nil {
+		return false, err
+	}
+
+	return access.CanRead(store.Name, fileKey), nil
+}
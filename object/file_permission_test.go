@@ -0,0 +1,132 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package object
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/casdoor/casdoor-go-sdk/casdoorsdk"
+)
+
+func getTestFilePermissions() []*casdoorsdk.Permission {
+	newPermission := func(resource string, effect string, users []string, groups []string, roles []string) *casdoorsdk.Permission {
+		return &casdoorsdk.Permission{
+			Users:        users,
+			Groups:       groups,
+			Roles:        roles,
+			Domains:      []string{"store"},
+			ResourceType: "TreeNode",
+			Resources:    []string{resource},
+			Actions:      []string{"Read"},
+			Effect:       effect,
+			IsEnabled:    true,
+			State:        "Approved",
+		}
+	}
+
+	pending := newPermission("public/draft.md", "Allow", []string{"org/bob"}, nil, nil)
+	pending.State = "Pending"
+
+	return []*casdoorsdk.Permission{
+		newPermission("hr", "Allow", []string{"org/alice"}, []string{"org/hr"}, nil),
+		newPermission("hr/salaries.md", "Deny", []string{"org/carol"}, nil, nil),
+		newPermission("finance/report.md", "Allow", nil, nil, []string{"org/auditor"}),
+		pending,
+	}
+}
+
+func TestFileAccess(t *testing.T) {
+	permissions := getTestFilePermissions()
+	users := map[string]*casdoorsdk.User{
+		"alice":   {Owner: "org", Name: "alice"},
+		"carol":   {Owner: "org", Name: "carol", Groups: []string{"org/hr"}},
+		"dave":    {Owner: "org", Name: "dave", Roles: []*casdoorsdk.Role{{Owner: "org", Name: "auditor"}}},
+		"admin":   {Owner: "org", Name: "root", IsAdmin: true},
+		"nobody":  {Owner: "org", Name: "nobody"},
+		"unknown": nil,
+	}
+
+	tests := []struct {
+		user    string
+		file    string
+		canRead bool
+	}{
+		{"unknown", "public/readme.md", true},
+		{"unknown", "public/draft.md", true},
+		{"unknown", "hr/handbook.md", false},
+		{"alice", "hr/handbook.md", true},
+		{"alice", "hr/salaries.md", true},
+		{"carol", "hr/handbook.md", true},
+		{"carol", "hr/salaries.md", false},
+		{"nobody", "hr", false},
+		{"nobody", "finance/report.md", false},
+		{"nobody", "finance/budget.md", true},
+		{"dave", "finance/report.md", true},
+		{"admin", "hr/salaries.md", true},
+	}
+
+	for _, test := range tests {
+		access := newFileAccess(users[test.user], permissions)
+		if res := access.CanRead("store", test.file); res != test.canRead {
+			t.Errorf("%s: CanRead(%s) = %v, want %v", test.user, test.file, res, test.canRead)
+		}
+		if res := access.CanRead("other", test.file); !res {
+			t.Errorf("%s: CanRead(%s) of another store = false, want true", test.user, test.file)
+		}
+	}
+}
+
+func getTreeFileKeys(file *TreeFile) []string {
+	res := []string{file.Key}
+	for _, child := range file.Children {
+		res = append(res, getTreeFileKeys(child)...)
+	}
+	return res
+}
+
+func TestFilterTreeFile(t *testing.T) {
+	store := &Store{FileTree: &TreeFile{Key: "/", Children: []*TreeFile{}, ChildrenMap: map[string]*TreeFile{}}}
+	for _, key := range []string{"public/readme.md", "hr/handbook.md", "hr/salaries.md", "finance/report.md", "finance/budget.md"} {
+		store.createPathIfNotExisted(strings.Split(key, "/"), 1, "", "", true)
+	}
+
+	access := newFileAccess(&casdoorsdk.User{Owner: "org", Name: "dave", Roles: []*casdoorsdk.Role{{Owner: "org", Name: "auditor"}}}, getTestFilePermissions())
+	res := access.FilterTreeFile("store", store.FileTree)
+	if fmt.Sprint(getTreeFileKeys(res)) != "[/ public public/readme.md finance finance/report.md finance/budget.md]" {
+		t.Errorf("FilterTreeFile() = %v", getTreeFileKeys(res))
+	}
+	if len(getTreeFileKeys(store.FileTree)) != 9 {
+		t.Errorf("FilterTreeFile() should not change the tree it filters")
+	}
+
+	filter := access.GetVectorFilter()
+	if filter.Match(&Vector{Store: "store", File: "hr/handbook.md"}) || !filter.Match(&Vector{Store: "store", File: "finance/report.md"}) {
+		t.Errorf("the vector filter should follow the file access")
+	}
+
+	filter, err := ParseVectorFilter(`file ^= "public/"`, "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	filter = filter.WithAccess(access)
+	if !filter.Match(&Vector{Store: "store", File: "public/readme.md"}) || filter.Match(&Vector{Store: "store", File: "finance/report.md"}) {
+		t.Errorf("the vector filter should keep its conditions")
+	}
+}
@@ -37,6 +37,7 @@ type Graph struct {
 
 	DisplayName string `xorm:"varchar(100)" json:"displayName"`
 	Layout      string `xorm:"varchar(100)" json:"layout"`
+	Store       string `xorm:"varchar(100)" json:"store"`
 	Text        string `xorm:"mediumtext" json:"text"`
 	ErrorText   string `xorm:"mediumtext" json:"errorText"`
 }
@@ -0,0 +1,343 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/beego/beego/logs"
+	"github.com/casibase/casibase/util"
+)
+
+// graphSaveInterval is how many chunks are extracted between two saves of the
+// graph, so that a failed refresh keeps most of its work.
+const graphSaveInterval = 20
+
+const graphExtractionPrompt = `Extract the named entities and the relations between them from the text below.
+Reply with only a JSON object in this form, without any explanation:
+{"entities": [{"name": "...", "type": "...", "description": "..."}], "relations": [{"source": "...", "target": "...", "relation": "..."}]}
+The type is a short category such as Person, Organization, Product, Location, Concept or Event. The source and target of a relation must be names from the entities. Keep the names in the language of the text. Reply with empty lists if there is nothing to extract.
+
+Text:
+%s`
+
+// knowledgeGraph is the Text of the graph built for a store. It keeps the
+// nodes/links/categories layout of the graph page, and records for every entity
+// and relation the vectors (chunks) it was extracted from.
+type knowledgeGraph struct {
+	Nodes      []*knowledgeNode     `json:"nodes"`
+	Links      []*knowledgeLink     `json:"links"`
+	Categories []*knowledgeCategory `json:"categories"`
+	Chunks     []string             `json:"chunks"`
+}
+
+type knowledgeNode struct {
+	Id          string   `json:"id"`
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Category    int      `json:"category"`
+	Value       int      `json:"value"`
+	Description string   `json:"description"`
+	Vectors     []string `json:"vectors"`
+}
+
+type knowledgeLink struct {
+	Source   string   `json:"source"`
+	Target   string   `json:"target"`
+	Relation string   `json:"relation"`
+	Vectors  []string `json:"vectors"`
+}
+
+type knowledgeCategory struct {
+	Name string `json:"name"`
+}
+
+type graphExtraction struct {
+	Entities []struct {
+		Name        string `json:"name"`
+		Type        string `json:"type"`
+		Description string `json:"description"`
+	} `json:"entities"`
+	Relations []struct {
+		Source   string `json:"source"`
+		Target   string `json:"target"`
+		Relation string `json:"relation"`
+	} `json:"relations"`
+}
+
+func getStoreGraphName(storeName string) string {
+	return fmt.Sprintf("graph_%s", storeName)
+}
+
+// getEntityId folds the case and the spaces of an entity name, so that the same
+// entity written differently in two chunks becomes one node.
+func getEntityId(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+func parseKnowledgeGraph(text string) (*knowledgeGraph, error) {
+	res := &knowledgeGraph{}
+	if strings.TrimSpace(text) != "" {
+		err := json.Unmarshal([]byte(text), res)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func getStoreKnowledgeGraph(owner string, storeName string) (*knowledgeGraph, error) {
+	graph, err := getGraph(owner, getStoreGraphName(storeName))
+	if err != nil {
+		return nil, err
+	}
+	if graph == nil {
+		return nil, nil
+	}
+
+	return parseKnowledgeGraph(graph.Text)
+}
+
+// parseGraphExtraction reads the JSON object of the model answer, which may be
+// wrapped in a code block or in some text.
+func parseGraphExtraction(answer string) (*graphExtraction, error) {
+	start := strings.Index(answer, "{")
+	end := strings.LastIndex(answer, "}")
+	if start == -1 || end < start {
+		return nil, fmt.Errorf("no JSON object found in the answer: %s", answer)
+	}
+
+	res := &graphExtraction{}
+	err := json.Unmarshal([]byte(answer[start:end+1]), res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func addUniqueString(list []string, s string) []string {
+	if util.InSlice(list, s) {
+		return list
+	}
+	return append(list, s)
+}
+
+// merge adds the entities and relations extracted from a vector to the graph.
+func (g *knowledgeGraph) merge(vectorName string, extraction *graphExtraction) {
+	nodeMap := map[string]*knowledgeNode{}
+	for _, node := range g.Nodes {
+		nodeMap[node.Id] = node
+	}
+
+	for _, entity := range extraction.Entities {
+		id := getEntityId(entity.Name)
+		if id == "" {
+			continue
+		}
+
+		node, ok := nodeMap[id]
+		if !ok {
+			node = &knowledgeNode{Id: id, Name: strings.TrimSpace(entity.Name), Type: strings.TrimSpace(entity.Type)}
+			nodeMap[id] = node
+			g.Nodes = append(g.Nodes, node)
+		}
+		if node.Description == "" {
+			node.Description = strings.TrimSpace(entity.Description)
+		}
+		node.Vectors = addUniqueString(node.Vectors, vectorName)
+	}
+
+	linkMap := map[string]*knowledgeLink{}
+	for _, link := range g.Links {
+		linkMap[link.Source+"\n"+link.Target+"\n"+link.Relation] = link
+	}
+
+	for _, relation := range extraction.Relations {
+		source := getEntityId(relation.Source)
+		target := getEntityId(relation.Target)
+		// A relation to an entity the model did not list is dropped, it would be a
+		// link to nowhere on the graph page.
+		if nodeMap[source] == nil || nodeMap[target] == nil || source == target {
+			continue
+		}
+
+		name := strings.TrimSpace(relation.Relation)
+		key := source + "\n" + target + "\n" + name
+		link, ok := linkMap[key]
+		if !ok {
+			link = &knowledgeLink{Source: source, Target: target, Relation: name}
+			linkMap[key] = link
+			g.Links = append(g.Links, link)
+		}
+		link.Vectors = addUniqueString(link.Vectors, vectorName)
+	}
+
+	g.Chunks = addUniqueString(g.Chunks, vectorName)
+}
+
+// prune drops what was extracted from the vectors that no longer exist, and the
+// entities and relations left without any vector.
+func (g *knowledgeGraph) prune(vectorMap map[string]bool) {
+	filter := func(names []string) []string {
+		res := []string{}
+		for _, name := range names {
+			if vectorMap[name] {
+				res = append(res, name)
+			}
+		}
+		return res
+	}
+
+	g.Chunks = filter(g.Chunks)
+
+	nodes := []*knowledgeNode{}
+	nodeMap := map[string]bool{}
+	for _, node := range g.Nodes {
+		node.Vectors = filter(node.Vectors)
+		if len(node.Vectors) != 0 {
+			nodes = append(nodes, node)
+			nodeMap[node.Id] = true
+		}
+	}
+	g.Nodes = nodes
+
+	links := []*knowledgeLink{}
+	for _, link := range g.Links {
+		link.Vectors = filter(link.Vectors)
+		if len(link.Vectors) != 0 && nodeMap[link.Source] && nodeMap[link.Target] {
+			links = append(links, link)
+		}
+	}
+	g.Links = links
+}
+
+// updateLayout fills the fields only used to draw the graph: one category per
+// entity type and a node size by the number of chunks.
+func (g *knowledgeGraph) updateLayout() {
+	types := []string{}
+	for _, node := range g.Nodes {
+		types = addUniqueString(types, node.Type)
+	}
+	sort.Strings(types)
+
+	g.Categories = []*knowledgeCategory{}
+	for _, typ := range types {
+		g.Categories = append(g.Categories, &knowledgeCategory{Name: typ})
+	}
+
+	for _, node := range g.Nodes {
+		node.Category = sort.SearchStrings(types, node.Type)
+		node.Value = len(node.Vectors)
+	}
+}
+
+func saveStoreKnowledgeGraph(store *Store, g *knowledgeGraph) error {
+	g.updateLayout()
+	text, err := json.Marshal(g)
+	if err != nil {
+		return err
+	}
+
+	name := getStoreGraphName(store.Name)
+	graph, err := getGraph(store.Owner, name)
+	if err != nil {
+		return err
+	}
+
+	if graph == nil {
+		graph = &Graph{
+			Owner:       store.Owner,
+			Name:        name,
+			CreatedTime: util.GetCurrentTime(),
+			DisplayName: fmt.Sprintf("%s - %s", store.DisplayName, "Knowledge Graph"),
+			Layout:      "force",
+			Store:       store.Name,
+			Text:        string(text),
+		}
+		_, err = AddGraph(graph)
+		return err
+	}
+
+	graph.Store = store.Name
+	graph.Text = string(text)
+	graph.ErrorText = ""
+	_, err = UpdateGraph(graph.GetId(), graph)
+	return err
+}
+
+// updateStoreGraph extracts the entities and relations of the store vectors not
+// seen before with the model of the store, and forgets the deleted vectors. A
+// chunk whose extraction fails is retried on the next refresh.
+func updateStoreGraph(store *Store, modelProviderName string, lang string) error {
+	vectors := []*Vector{}
+	err := adapter.engine.Omit("embedding").Asc("file").Asc("index").Find(&vectors, &Vector{Owner: "admin", Store: store.Name})
+	if err != nil {
+		return err
+	}
+
+	g, err := getStoreKnowledgeGraph(store.Owner, store.Name)
+	if err != nil {
+		return err
+	}
+	if g == nil {
+		g = &knowledgeGraph{}
+	}
+
+	vectorMap := map[string]bool{}
+	for _, vector := range vectors {
+		vectorMap[vector.Name] = true
+	}
+	g.prune(vectorMap)
+
+	chunkMap := map[string]bool{}
+	for _, name := range g.Chunks {
+		chunkMap[name] = true
+	}
+
+	count := 0
+	for _, vector := range vectors {
+		if chunkMap[vector.Name] || strings.TrimSpace(vector.Text) == "" {
+			continue
+		}
+
+		answer, _, err := GetAnswer(modelProviderName, fmt.Sprintf(graphExtractionPrompt, vector.Text), lang)
+		if err != nil {
+			logs.Warn("Failed to extract the graph of vector: [%s], store: [%s]: %s", vector.Name, store.Name, err.Error())
+			continue
+		}
+
+		extraction, err := parseGraphExtraction(answer)
+		if err != nil {
+			logs.Warn("Failed to parse the graph of vector: [%s], store: [%s]: %s", vector.Name, store.Name, err.Error())
+			continue
+		}
+
+		g.merge(vector.Name, extraction)
+
+		count++
+		if count%graphSaveInterval == 0 {
+			err = saveStoreKnowledgeGraph(store, g)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	logs.Info("Extracted the graph of %d chunks for store: [%s], %d entities and %d relations in total", count, store.Name, len(g.Nodes), len(g.Links))
+	return saveStoreKnowledgeGraph(store, g)
+}
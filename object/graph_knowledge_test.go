@@ -0,0 +1,95 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package object
+
+import "testing"
+
+func TestKnowledgeGraph(t *testing.T) {
+	g := &knowledgeGraph{}
+
+	answers := map[string]string{
+		"v1": "```json\n{\"entities\": [{\"name\": \"Casibase\", \"type\": \"Product\"}, {\"name\": \"Casdoor\", \"type\": \"Product\"}], \"relations\": [{\"source\": \"Casibase\", \"target\": \"casdoor\", \"relation\": \"signs in with\"}, {\"source\": \"Casibase\", \"target\": \"Nowhere\", \"relation\": \"uses\"}]}\n```",
+		"v2": "{\"entities\": [{\"name\": \"Casdoor\", \"type\": \"Product\"}, {\"name\": \"OAuth\", \"type\": \"Concept\"}], \"relations\": [{\"source\": \"Casdoor\", \"target\": \"OAuth\", \"relation\": \"implements\"}]}",
+		"v3": "{\"entities\": [{\"name\": \"Go\", \"type\": \"Language\"}], \"relations\": []}",
+	}
+	for _, name := range []string{"v1", "v2", "v3"} {
+		extraction, err := parseGraphExtraction(answers[name])
+		if err != nil {
+			t.Fatal(err)
+		}
+		g.merge(name, extraction)
+	}
+
+	if len(g.Nodes) != 4 || len(g.Links) != 2 || len(g.Chunks) != 3 {
+		t.Fatalf("got %d nodes, %d links and %d chunks, want 4, 2 and 3", len(g.Nodes), len(g.Links), len(g.Chunks))
+	}
+
+	seeds := getQuestionEntities(g, "How is Casibase related to OAuth?")
+	if len(seeds) != 2 || !seeds["casibase"] || !seeds["oauth"] {
+		t.Errorf("unexpected question entities: %v", seeds)
+	}
+
+	scores := getGraphVectorScores(g, seeds)
+	if scores["v1"] != 2 || scores["v2"] != 2 || scores["v3"] != 0 {
+		t.Errorf("unexpected scores: %v", scores)
+	}
+
+	g.prune(map[string]bool{"v1": true, "v3": true})
+	g.updateLayout()
+	if len(g.Nodes) != 3 || len(g.Links) != 1 || len(g.Chunks) != 2 {
+		t.Fatalf("after pruning, got %d nodes, %d links and %d chunks, want 3, 1 and 2", len(g.Nodes), len(g.Links), len(g.Chunks))
+	}
+	if len(g.Categories) != 2 || g.Nodes[0].Category != 1 || g.Nodes[2].Category != 0 {
+		t.Errorf("unexpected categories: %v, %v, %v", g.Categories, g.Nodes[0], g.Nodes[2])
+	}
+}
+
+func TestMergeGraphVectors(t *testing.T) {
+	hits := []Vector{{Name: "h1", Score: 0.9}, {Name: "h2", Score: 0.8}, {Name: "h3", Score: 0.7}, {Name: "h4", Score: 0.6}}
+	graphVectors := []Vector{{Name: "g1"}, {Name: "g2"}, {Name: "g3"}}
+
+	tests := []struct {
+		hits   []Vector
+		graph  []Vector
+		count  int
+		expect []string
+	}{
+		{hits, graphVectors, 5, []string{"h1", "h2", "g1", "g2", "g3"}},
+		{hits, graphVectors[:1], 4, []string{"h1", "h2", "h3", "g1"}},
+		{hits[:1], graphVectors, 4, []string{"h1", "g1", "g2", "g3"}},
+		{hits, nil, 3, []string{"h1", "h2", "h3"}},
+	}
+
+	for i, test := range tests {
+		res := mergeGraphVectors(test.hits, test.graph, test.count)
+		names := []string{}
+		for _, vector := range res {
+			names = append(names, vector.Name)
+		}
+		if len(names) != len(test.expect) {
+			t.Errorf("case %d: got %v, want %v", i, names, test.expect)
+			continue
+		}
+		for j := range names {
+			if names[j] != test.expect[j] {
+				t.Errorf("case %d: got %v, want %v", i, names, test.expect)
+				break
+			}
+		}
+	}
+}
@@ -25,6 +25,11 @@ import (
 )
 
 func InitDb() {
+	err := migrateVectorData()
+	if err != nil {
+		panic(err)
+	}
+
 	modelProviderName, embeddingProviderName, ttsProviderName, sttProviderName := initBuiltInProviders()
 	initBuiltInStore(modelProviderName, embeddingProviderName, ttsProviderName, sttProviderName)
 	initTemplates()
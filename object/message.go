@@ -16,6 +16,7 @@ package object
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -31,6 +32,17 @@ type VectorScore struct {
 	Score  float32 `json:"score"`
 }
 
+type MessageSource struct {
+	Index      int     `json:"index"`
+	Vector     string  `json:"vector"`
+	Store      string  `json:"store"`
+	File       string  `json:"file"`
+	ChunkIndex int     `json:"chunkIndex"`
+	Snippet    string  `json:"snippet"`
+	Score      float32 `json:"score"`
+	IsCited    bool    `json:"isCited"`
+}
+
 type Suggestion struct {
 	Text  string `json:"text"`
 	IsHit bool   `json:"isHit"`
@@ -41,32 +53,34 @@ type Message struct {
 	Name        string `xorm:"varchar(100) notnull pk" json:"name"`
 	CreatedTime string `xorm:"varchar(100)" json:"createdTime"`
 
-	Organization      string        `xorm:"varchar(100)" json:"organization"`
-	Store             string        `xorm:"varchar(100)" json:"store"`
-	User              string        `xorm:"varchar(100) index" json:"user"`
-	Chat              string        `xorm:"varchar(100) index" json:"chat"`
-	ReplyTo           string        `xorm:"varchar(100) index" json:"replyTo"`
-	Author            string        `xorm:"varchar(100)" json:"author"`
-	Text              string        `xorm:"mediumtext" json:"text"`
-	ReasonText        string        `xorm:"mediumtext" json:"reasonText"`
-	ErrorText         string        `xorm:"mediumtext" json:"errorText"`
-	FileName          string        `xorm:"varchar(100)" json:"fileName"`
-	Comment           string        `xorm:"mediumtext" json:"comment"`
-	TokenCount        int           `json:"tokenCount"`
-	TextTokenCount    int           `json:"textTokenCount"`
-	Price             float64       `json:"price"`
-	Currency          string        `xorm:"varchar(100)" json:"currency"`
-	IsHidden          bool          `json:"isHidden"`
-	IsDeleted         bool          `json:"isDeleted"`
-	NeedNotify        bool          `json:"needNotify"`
-	IsAlerted         bool          `json:"isAlerted"`
-	IsRegenerated     bool          `json:"isRegenerated"`
-	ModelProvider     string        `xorm:"varchar(100)" json:"modelProvider"`
-	EmbeddingProvider string        `xorm:"varchar(100)" json:"embeddingProvider"`
-	VectorScores      []VectorScore `xorm:"mediumtext" json:"vectorScores"`
-	LikeUsers         []string      `json:"likeUsers"`
-	DisLikeUsers      []string      `json:"dislikeUsers"`
-	Suggestions       []Suggestion  `json:"suggestions"`
+	Organization      string          `xorm:"varchar(100)" json:"organization"`
+	Store             string          `xorm:"varchar(100)" json:"store"`
+	User              string          `xorm:"varchar(100) index" json:"user"`
+	Chat              string          `xorm:"varchar(100) index" json:"chat"`
+	ReplyTo           string          `xorm:"varchar(100) index" json:"replyTo"`
+	Author            string          `xorm:"varchar(100)" json:"author"`
+	Text              string          `xorm:"mediumtext" json:"text"`
+	ReasonText        string          `xorm:"mediumtext" json:"reasonText"`
+	ErrorText         string          `xorm:"mediumtext" json:"errorText"`
+	FileName          string          `xorm:"varchar(100)" json:"fileName"`
+	Comment           string          `xorm:"mediumtext" json:"comment"`
+	TokenCount        int             `json:"tokenCount"`
+	TextTokenCount    int             `json:"textTokenCount"`
+	Price             float64         `json:"price"`
+	Currency          string          `xorm:"varchar(100)" json:"currency"`
+	IsHidden          bool            `json:"isHidden"`
+	IsDeleted         bool            `json:"isDeleted"`
+	NeedNotify        bool            `json:"needNotify"`
+	IsAlerted         bool            `json:"isAlerted"`
+	IsRegenerated     bool            `json:"isRegenerated"`
+	ModelProvider     string          `xorm:"varchar(100)" json:"modelProvider"`
+	EmbeddingProvider string          `xorm:"varchar(100)" json:"embeddingProvider"`
+	VectorScores      []VectorScore   `xorm:"mediumtext" json:"vectorScores"`
+	Sources           []MessageSource `xorm:"mediumtext" json:"sources"`
+	Filter            string          `xorm:"varchar(500)" json:"filter"`
+	LikeUsers         []string        `json:"likeUsers"`
+	DisLikeUsers      []string        `json:"dislikeUsers"`
+	Suggestions       []Suggestion    `json:"suggestions"`
 }
 
 func GetGlobalMessages() ([]*Message, error) {
@@ -329,6 +343,14 @@ type MyWriter struct {
 
 func (w *MyWriter) Flush() {}
 
+func (w *MyWriter) HandleStreamEvent(event *model.StreamEvent) error {
+	if event.Type == model.StreamEventTextDelta {
+		_, err := w.Buffer.WriteString(event.Text)
+		return err
+	}
+	return nil
+}
+
 func (w *MyWriter) Write(p []byte) (n int, err error) {
 	s := string(p)
 	if strings.HasPrefix(s, "event: message\ndata: ") && strings.HasSuffix(s, "\n\n") {
@@ -343,20 +365,37 @@ func (w *MyWriter) Write(p []byte) (n int, err error) {
 func GetAnswer(provider string, question string, lang string) (string, *model.ModelResult, error) {
 	history := []*model.RawMessage{}
 	knowledge := []*model.RawMessage{}
-	return GetAnswerWithContext(provider, question, history, knowledge, "", lang)
+	return GetAnswerWithContext(provider, question, history, knowledge, "", context.Background(), lang)
 }
 
-func GetAnswerWithContext(provider string, question string, history []*model.RawMessage, knowledge []*model.RawMessage, prompt string, lang string) (string, *model.ModelResult, error) {
+func GetAnswerWithContext(provider string, question string, history []*model.RawMessage, knowledge []*model.RawMessage, prompt string, ctx context.Context, lang string) (string, *model.ModelResult, error) {
 	_, modelProviderObj, err := GetModelProviderFromContext("admin", provider, lang)
 	if err != nil {
 		return "", nil, err
 	}
 
+	return getAnswerWithProvider(modelProviderObj, question, history, knowledge, prompt, ctx, lang)
+}
+
+// GetStoreAnswerWithContext answers a question asked to the store with the
+// model provider named provider, or the one of the store, through the routing
+// between the model providers of the store.
+func GetStoreAnswerWithContext(store *Store, provider string, question string, history []*model.RawMessage, knowledge []*model.RawMessage, prompt string, ctx context.Context, lang string) (string, *model.ModelResult, error) {
+	_, modelProviderObj, err := GetRoutedModelProvider(store, provider, question, lang)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return getAnswerWithProvider(modelProviderObj, question, history, knowledge, prompt, ctx, lang)
+}
+
+func getAnswerWithProvider(modelProviderObj model.ModelProvider, question string, history []*model.RawMessage, knowledge []*model.RawMessage, prompt string, ctx context.Context, lang string) (string, *model.ModelResult, error) {
+
 	if prompt == "" {
 		prompt = "You are an expert in your field and you specialize in using your knowledge to answer or solve people's problems."
 	}
 	var writer MyWriter
-	modelResult, err := modelProviderObj.QueryText(question, &writer, history, prompt, knowledge, nil, lang)
+	modelResult, err := modelProviderObj.QueryText(question, &writer, history, prompt, knowledge, nil, ctx, lang)
 	if err != nil {
 		return "", nil, err
 	}
@@ -0,0 +1,105 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/casibase/casibase/model"
+)
+
+const sourceSnippetLength = 200
+
+const citationPrompt = "When you use a piece of the knowledge, cite it right after the sentence with its number in square brackets, e.g. [1] or [2][3]. Only cite the knowledge numbers given to you."
+
+var citationRegex = regexp.MustCompile(`\[(\d+(?:\s*[,，]\s*\d+)*)\]`)
+
+func getSourceSnippet(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+
+	runes := []rune(text)
+	if len(runes) <= sourceSnippetLength {
+		return text
+	}
+	return string(runes[:sourceSnippetLength]) + "..."
+}
+
+// getMessageSources numbers the vectors in the order of the knowledge given to
+// the model, so that the source with index i is "Knowledge i" in the prompt.
+func getMessageSources(vectors []Vector) []MessageSource {
+	res := []MessageSource{}
+	for i, vector := range vectors {
+		res = append(res, MessageSource{
+			Index:      i + 1,
+			Vector:     vector.Name,
+			Store:      vector.Store,
+			File:       vector.File,
+			ChunkIndex: vector.Index,
+			Snippet:    getSourceSnippet(vector.Text),
+			Score:      vector.Score,
+		})
+	}
+	return res
+}
+
+func GetVectorScores(sources []MessageSource) []VectorScore {
+	res := []VectorScore{}
+	for _, source := range sources {
+		res = append(res, VectorScore{
+			Vector: source.Vector,
+			Score:  source.Score,
+		})
+	}
+	return res
+}
+
+// GetPromptWithCitations asks the model to mark the knowledge it uses with
+// citation markers like [1], which GetCitedSources maps back to the sources.
+func GetPromptWithCitations(prompt string) string {
+	if prompt == "" {
+		prompt = model.DefaultPrompt
+	}
+
+	return prompt + "\n\n" + citationPrompt
+}
+
+// GetCitedSources marks the sources cited by the answer. Markers followed by
+// "(" are markdown links and are not citations.
+func GetCitedSources(answer string, sources []MessageSource) []MessageSource {
+	res := make([]MessageSource, len(sources))
+	copy(res, sources)
+
+	for _, match := range citationRegex.FindAllStringSubmatchIndex(answer, -1) {
+		if match[1] < len(answer) && answer[match[1]] == '(' {
+			continue
+		}
+
+		numbers := strings.FieldsFunc(answer[match[2]:match[3]], func(r rune) bool {
+			return r == ',' || r == '，' || r == ' '
+		})
+		for _, number := range numbers {
+			index, err := strconv.Atoi(number)
+			if err != nil || index < 1 || index > len(res) {
+				continue
+			}
+
+			res[index-1].IsCited = true
+		}
+	}
+
+	return res
+}
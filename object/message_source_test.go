@@ -0,0 +1,46 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package object
+
+import (
+	"testing"
+)
+
+func TestGetCitedSources(t *testing.T) {
+	vectors := []Vector{
+		{Name: "vector_1", File: "a.md", Index: 3, Text: "first"},
+		{Name: "vector_2", File: "b.md", Index: 0, Text: "second"},
+		{Name: "vector_3", File: "c.md", Index: 7, Text: "third"},
+		{Name: "vector_4", File: "d.md", Index: 1, Text: "fourth"},
+	}
+	sources := getMessageSources(vectors)
+
+	answer := "The price is 10 [1]. It ships in a week [3, 9]. See [4](https://casibase.org) for details [0]."
+	res := GetCitedSources(answer, sources)
+
+	expected := []bool{true, false, true, false}
+	for i, source := range res {
+		if source.Index != i+1 || source.IsCited != expected[i] {
+			t.Errorf("GetCitedSources()[%d] = %+v, want index %d and isCited %v", i, source, i+1, expected[i])
+		}
+	}
+
+	if sources[0].IsCited {
+		t.Errorf("GetCitedSources() modified the given sources")
+	}
+}
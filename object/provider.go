@@ -17,12 +17,15 @@ package object
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/beego/beego/logs"
 	"github.com/casdoor/casdoor-go-sdk/casdoorsdk"
 	"github.com/casibase/casibase/agent"
 	"github.com/casibase/casibase/embedding"
 	"github.com/casibase/casibase/i18n"
 	"github.com/casibase/casibase/model"
+	"github.com/casibase/casibase/rerank"
 	"github.com/casibase/casibase/scan"
 	"github.com/casibase/casibase/storage"
 	"github.com/casibase/casibase/stt"
@@ -329,6 +332,32 @@ func (p *Provider) GetEmbeddingProvider(lang string) (embedding.EmbeddingProvide
 	return pProvider, nil
 }
 
+func (p *Provider) GetRerankProvider(lang string) (rerank.RerankProvider, error) {
+	pProvider, err := rerank.GetRerankProvider(p.Type, p.SubType, p.ClientSecret, p.ProviderUrl, p.InputPricePerThousandTokens, p.Currency, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	if pProvider == nil {
+		return nil, fmt.Errorf(i18n.Translate(lang, "object:the rerank provider type: %s is not supported"), p.Type)
+	}
+
+	return pProvider, nil
+}
+
+func (p *Provider) GetVectorStore(lang string) (VectorStore, error) {
+	vectorStore, err := GetVectorStore(p.Type, p.ProviderUrl, p.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	if vectorStore == nil {
+		return nil, fmt.Errorf(i18n.Translate(lang, "object:the vector database provider type: %s is not supported"), p.Type)
+	}
+
+	return vectorStore, nil
+}
+
 func (p *Provider) GetAgentProvider(lang string) (agent.AgentProvider, error) {
 	pProvider, err := agent.GetAgentProvider(p.Type, p.SubType, p.Text, p.McpTools, lang)
 	if err != nil {
@@ -399,6 +428,48 @@ func GetModelProviderFromContext(owner string, name string, lang string) (*Provi
 	return getModelProviderFromName(owner, providerName, lang)
 }
 
+// GetRoutedModelProvider returns the model provider named name, or the one of
+// the store. When the store routes between its model providers, the returned
+// provider is a router over it and the child model providers of the store,
+// ordered by the routing policy, and the returned *Provider is the first one.
+func GetRoutedModelProvider(store *Store, name string, question string, lang string) (*Provider, model.ModelProvider, error) {
+	if name == "" {
+		name = store.ModelProvider
+	}
+	if store.ModelRouting == "" || len(store.ChildModelProviders) == 0 {
+		return GetModelProviderFromContext("admin", name, lang)
+	}
+
+	providerMap := map[string]*Provider{}
+	routes := []*model.ModelRoute{}
+	for _, providerName := range append([]string{name}, store.ChildModelProviders...) {
+		if _, ok := providerMap[providerName]; ok {
+			continue
+		}
+
+		provider, providerObj, err := getModelProviderFromName("admin", providerName, lang)
+		if err != nil {
+			if len(routes) == 0 && providerName == name {
+				return nil, nil, err
+			}
+
+			logs.Warn("The model provider: %s is skipped by the routing: %s", providerName, err.Error())
+			continue
+		}
+
+		providerMap[providerName] = provider
+		routes = append(routes, &model.ModelRoute{Name: providerName, Provider: providerObj})
+	}
+
+	routes = model.SortModelRoutes(routes, store.ModelRouting, question, store.RoutingThreshold)
+	router, err := model.NewRouterModelProvider(routes, time.Duration(store.ModelTimeout)*time.Second)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return providerMap[routes[0].Name], router, nil
+}
+
 func GetEmbeddingProviderFromContext(owner string, name string, lang string) (*Provider, embedding.EmbeddingProvider, error) {
 	var providerName string
 	if name != "" {
@@ -505,6 +576,12 @@ func collectProviderNames(store *Store) []string {
 	if store.AgentProvider != "" {
 		providerNames = append(providerNames, store.AgentProvider)
 	}
+	if store.RerankProvider != "" {
+		providerNames = append(providerNames, store.RerankProvider)
+	}
+	if store.VectorDatabaseProvider != "" {
+		providerNames = append(providerNames, store.VectorDatabaseProvider)
+	}
 	if store.ChildModelProviders != nil {
 		providerNames = append(providerNames, store.ChildModelProviders...)
 	}
@@ -77,6 +77,62 @@ func GetModelProviderByProviderKey(providerKey string, lang string) (model.Model
 	return modelProvider, nil
 }
 
+// GetModelProvidersByProviderKey retrieves the model providers that can be used with the Provider key
+func GetModelProvidersByProviderKey(providerKey string, lang string) ([]*Provider, error) {
+	if providerKey == "" {
+		return nil, fmt.Errorf(i18n.Translate(lang, "object:empty provider key"))
+	}
+
+	providers := []*Provider{}
+	err := adapter.engine.Desc("created_time").Find(&providers, &Provider{ProviderKey: providerKey, Category: "Model"})
+	if err != nil {
+		return nil, err
+	}
+
+	if providerAdapter != nil {
+		providers2 := []*Provider{}
+		err = providerAdapter.engine.Desc("created_time").Find(&providers2, &Provider{ProviderKey: providerKey, Category: "Model"})
+		if err != nil {
+			return nil, err
+		}
+
+		providers = append(providers, providers2...)
+	}
+
+	return providers, nil
+}
+
+// GetModelProviderByProviderKeyAndName retrieves the model provider named name among the
+// ones of the Provider key, or the first of them when none is named so
+func GetModelProviderByProviderKeyAndName(providerKey string, name string, lang string) (*Provider, model.ModelProvider, error) {
+	providers, err := GetModelProvidersByProviderKey(providerKey, lang)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(providers) == 0 {
+		return nil, nil, fmt.Errorf(i18n.Translate(lang, "object:The provider is not found"))
+	}
+
+	provider := providers[0]
+	for _, p := range providers {
+		if p.Name == name {
+			provider = p
+			break
+		}
+	}
+
+	modelProvider, err := provider.GetModelProvider(lang)
+	if err != nil {
+		return nil, nil, err
+	}
+	if modelProvider == nil {
+		return nil, nil, fmt.Errorf(i18n.Translate(lang, "object:The model provider: %s is not found"), provider.Name)
+	}
+
+	return provider, modelProvider, nil
+}
+
 func getFilteredProviders(providers []*Provider, needStorage bool) []*Provider {
 	res := []*Provider{}
 	for _, provider := range providers {
@@ -21,6 +21,7 @@ import (
 	"github.com/casibase/casibase/embedding"
 	"github.com/casibase/casibase/i18n"
 	"github.com/casibase/casibase/model"
+	"github.com/casibase/casibase/rerank"
 	"github.com/casibase/casibase/util"
 	"github.com/casibase/casibase/video"
 )
@@ -95,6 +96,54 @@ func getEmbeddingProviderFromName(owner string, providerName string, lang string
 	return provider, providerObj, err
 }
 
+func getRerankProviderFromName(owner string, providerName string, lang string) (*Provider, rerank.RerankProvider, error) {
+	if providerName == "" {
+		return nil, nil, nil
+	}
+
+	providerId := util.GetIdFromOwnerAndName(owner, providerName)
+	provider, err := GetProvider(providerId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if provider == nil {
+		return nil, nil, fmt.Errorf(i18n.Translate(lang, "object:The rerank provider: %s is not found"), providerName)
+	}
+
+	if provider.Category != "Rerank" {
+		return nil, nil, fmt.Errorf(i18n.Translate(lang, "object:The rerank provider: %s is expected to be \"Rerank\" category, got: \"%s\""), provider.GetId(), provider.Category)
+	}
+
+	providerObj, err := provider.GetRerankProvider(lang)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return provider, providerObj, err
+}
+
+func getVectorDatabaseProviderFromName(owner string, providerName string, lang string) (*Provider, VectorStore, error) {
+	providerId := util.GetIdFromOwnerAndName(owner, providerName)
+	provider, err := GetProvider(providerId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if provider == nil {
+		return nil, nil, fmt.Errorf(i18n.Translate(lang, "object:The vector database provider: %s is not found"), providerName)
+	}
+
+	if provider.Category != "Vector Database" {
+		return nil, nil, fmt.Errorf(i18n.Translate(lang, "object:The vector database provider: %s is expected to be \"Vector Database\" category, got: \"%s\""), provider.GetId(), provider.Category)
+	}
+
+	providerObj, err := provider.GetVectorStore(lang)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return provider, providerObj, err
+}
+
 func getAgentProviderFromName(owner string, providerName string, lang string) (*Provider, agent.AgentProvider, error) {
 	var provider *Provider
 	var err error
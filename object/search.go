@@ -15,20 +15,35 @@
 package object
 
 import (
+	"context"
+	"errors"
+
 	"github.com/casibase/casibase/embedding"
 )
 
+// ErrNoKnowledgeVectors is returned by the searches when the stores have no
+// vectors, it is translated where it is shown to the user.
+var ErrNoKnowledgeVectors = errors.New("no knowledge vectors found")
+
 type SearchProvider interface {
-	Search(relatedStores []string, embeddingProviderName string, embeddingProviderObj embedding.EmbeddingProvider, modelProviderName string, text string, knowledgeCount int, lang string) ([]Vector, *embedding.EmbeddingResult, error)
+	Search(relatedStores []*Store, embeddingProviderName string, embeddingProviderObj embedding.EmbeddingProvider, modelProviderName string, text string, knowledgeCount int, filter *VectorFilter, ctx context.Context, lang string) ([]Vector, *embedding.EmbeddingResult, error)
 }
 
-func GetSearchProvider(typ string, owner string) (SearchProvider, error) {
+func GetSearchProvider(typ string, owner string, queryCount int) (SearchProvider, error) {
 	var p SearchProvider
 	var err error
 	if typ == "Default" {
 		p, err = NewDefaultSearchProvider(owner)
 	} else if typ == "Hierarchy" {
 		p, err = NewHierarchySearchProvider(owner)
+	} else if typ == "Hybrid" {
+		p, err = NewHybridSearchProvider(owner)
+	} else if typ == "Multi-Query" {
+		p, err = NewMultiQuerySearchProvider(owner, queryCount)
+	} else if typ == "HyDE" {
+		p, err = NewHydeSearchProvider(owner)
+	} else if typ == "Graph" {
+		p, err = NewGraphSearchProvider(owner)
 	} else {
 		p, err = NewDefaultSearchProvider(owner)
 	}
@@ -0,0 +1,257 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/beego/beego/logs"
+	"github.com/casibase/casibase/embedding"
+	"github.com/casibase/casibase/model"
+	"github.com/casibase/casibase/util"
+)
+
+// documentLanguageMinShare is the share of the chunks of the related stores a
+// language needs to be taken as a document language, so that a few quotes in
+// another language do not cost a translation.
+const documentLanguageMinShare = 0.1
+
+// CrossLingualSearchProvider searches the knowledge in the languages of the
+// documents instead of the language of the question. With "Translate", the
+// question is translated by the model into the main document language and
+// searched with the translation. With "Multi-Query", the question is searched
+// as is and in every document language, and the result lists are fused with
+// reciprocal rank fusion. The search itself is done by the store's search
+// provider.
+type CrossLingualSearchProvider struct {
+	provider SearchProvider
+	mode     string
+}
+
+func NewCrossLingualSearchProvider(provider SearchProvider, mode string) (*CrossLingualSearchProvider, error) {
+	return &CrossLingualSearchProvider{provider: provider, mode: mode}, nil
+}
+
+type languageCount struct {
+	Language string
+	Count    int
+}
+
+// storeLanguageCounts is the chunk count of each language of a store for one
+// knowledge version of it.
+type storeLanguageCounts struct {
+	knowledgeVersion string
+	counts           []languageCount
+}
+
+var (
+	storeLanguageCountsMap = map[string]*storeLanguageCounts{} // store id/provider -> counts
+	storeLanguageCountsMu  sync.Mutex
+)
+
+// getStoreLanguageCounts returns the chunk count of each language of the store.
+// The chunks are counted once per knowledge version, which changes whenever
+// a refresh changes the vectors of the store.
+func getStoreLanguageCounts(store *Store, embeddingProviderName string) ([]languageCount, error) {
+	key := fmt.Sprintf("%s/%s", store.GetId(), embeddingProviderName)
+
+	storeLanguageCountsMu.Lock()
+	cached := storeLanguageCountsMap[key]
+	storeLanguageCountsMu.Unlock()
+	if cached != nil && cached.knowledgeVersion == store.KnowledgeVersion {
+		return cached.counts, nil
+	}
+
+	counts := []languageCount{}
+	err := adapter.engine.Table(&Vector{}).Select("language, count(*) as count").
+		Where("owner = ?", store.Owner).And("store = ?", store.Name).And("provider = ?", embeddingProviderName).
+		GroupBy("language").Find(&counts)
+	if err != nil {
+		return nil, err
+	}
+
+	storeLanguageCountsMu.Lock()
+	storeLanguageCountsMap[key] = &storeLanguageCounts{knowledgeVersion: store.KnowledgeVersion, counts: counts}
+	storeLanguageCountsMu.Unlock()
+	return counts, nil
+}
+
+// getDocumentLanguages returns the languages of the chunks of the stores, the
+// most frequent first.
+func getDocumentLanguages(relatedStores []*Store, embeddingProviderName string) ([]string, error) {
+	countMap := map[string]int{}
+	for _, store := range getUniqueStores(relatedStores) {
+		storeCounts, err := getStoreLanguageCounts(store, embeddingProviderName)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, count := range storeCounts {
+			countMap[count.Language] += count.Count
+		}
+	}
+
+	counts := []languageCount{}
+	for language, count := range countMap {
+		counts = append(counts, languageCount{Language: language, Count: count})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		return counts[i].Language < counts[j].Language
+	})
+
+	return getMainLanguages(counts), nil
+}
+
+func getMainLanguages(counts []languageCount) []string {
+	total := 0
+	for _, count := range counts {
+		if count.Language != "" {
+			total += count.Count
+		}
+	}
+
+	sort.SliceStable(counts, func(i, j int) bool {
+		return counts[i].Count > counts[j].Count
+	})
+
+	res := []string{}
+	for _, count := range counts {
+		if count.Language != "" && float64(count.Count) >= float64(total)*documentLanguageMinShare {
+			res = append(res, count.Language)
+		}
+	}
+	return res
+}
+
+// getTargetLanguages returns the document languages the question should be
+// translated into, none when the documents are in the question language.
+func getTargetLanguages(questionLanguage string, documentLanguages []string, mode string) []string {
+	if questionLanguage == "" || len(documentLanguages) == 0 {
+		return []string{}
+	}
+
+	if mode == "Translate" {
+		if documentLanguages[0] == questionLanguage {
+			return []string{}
+		}
+		return documentLanguages[:1]
+	}
+
+	res := []string{}
+	for _, language := range documentLanguages {
+		if language != questionLanguage {
+			res = append(res, language)
+		}
+	}
+	return res
+}
+
+func (p *CrossLingualSearchProvider) Search(relatedStores []*Store, embeddingProviderName string, embeddingProviderObj embedding.EmbeddingProvider, modelProviderName string, text string, knowledgeCount int, filter *VectorFilter, ctx context.Context, lang string) ([]Vector, *embedding.EmbeddingResult, error) {
+	documentLanguages, err := getDocumentLanguages(relatedStores, embeddingProviderName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	targetLanguages := getTargetLanguages(util.DetectLanguage(text), documentLanguages, p.mode)
+	if len(targetLanguages) == 0 {
+		return p.provider.Search(relatedStores, embeddingProviderName, embeddingProviderObj, modelProviderName, text, knowledgeCount, filter, ctx, lang)
+	}
+
+	// Without a translation, the search still goes on with the question.
+	var searchCost *embedding.EmbeddingResult
+	queries := []string{}
+	if p.mode != "Translate" {
+		queries = append(queries, text)
+	}
+	for _, language := range targetLanguages {
+		translation, modelResult, err := getTranslatedQueryByModel(modelProviderName, text, language, ctx, lang)
+		searchCost = addModelSearchCost(searchCost, modelResult)
+		if err != nil {
+			logs.Warn("Failed to translate the question into %s: %s", util.GetLanguageName(language), err.Error())
+			continue
+		}
+		queries = append(queries, translation)
+	}
+	if len(queries) == 0 {
+		queries = append(queries, text)
+	}
+
+	if len(queries) == 1 {
+		vectors, embeddingResult, err := p.provider.Search(relatedStores, embeddingProviderName, embeddingProviderObj, modelProviderName, queries[0], knowledgeCount, filter, ctx, lang)
+		return vectors, addEmbeddingSearchCost(searchCost, embeddingResult), err
+	}
+
+	vectorMap := map[string]Vector{}
+	indexMap := map[string]int{}
+	ids := []string{}
+	rankings := [][]SimilarityIndex{}
+	for _, query := range queries {
+		vectors, embeddingResult, err := p.provider.Search(relatedStores, embeddingProviderName, embeddingProviderObj, modelProviderName, query, knowledgeCount, filter, ctx, lang)
+		searchCost = addEmbeddingSearchCost(searchCost, embeddingResult)
+		if err != nil {
+			return nil, searchCost, err
+		}
+
+		ranking := []SimilarityIndex{}
+		for _, vector := range vectors {
+			id := vector.GetId()
+			index, ok := indexMap[id]
+			if !ok {
+				index = len(ids)
+				indexMap[id] = index
+				ids = append(ids, id)
+				vectorMap[id] = vector
+			}
+
+			ranking = append(ranking, SimilarityIndex{Similarity: vector.Score, Index: index})
+		}
+		rankings = append(rankings, ranking)
+	}
+
+	res := []Vector{}
+	for _, fusedScore := range getReciprocalRankFusion(rankings, knowledgeCount) {
+		vector := vectorMap[ids[fusedScore.Index]]
+		vector.Score = fusedScore.Similarity
+		res = append(res, vector)
+	}
+
+	return res, searchCost, nil
+}
+
+func addEmbeddingSearchCost(searchCost *embedding.EmbeddingResult, embeddingResult *embedding.EmbeddingResult) *embedding.EmbeddingResult {
+	if embeddingResult == nil {
+		return searchCost
+	}
+
+	return addSearchCost(searchCost, embeddingResult.TokenCount, embeddingResult.Price, embeddingResult.Currency)
+}
+
+// GetPromptWithLanguage asks the model to answer in the language of the
+// question, the knowledge found across languages would lead it to answer in
+// the language of the documents.
+func GetPromptWithLanguage(prompt string, question string) string {
+	language := util.DetectLanguage(question)
+	if language == "" {
+		return prompt
+	}
+
+	if prompt == "" {
+		prompt = model.DefaultPrompt
+	}
+	return fmt.Sprintf("%s\n\nAlways answer in %s, the language of the question, even when the knowledge is written in other languages.", prompt, util.GetLanguageName(language))
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package object
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestGetTargetLanguages(t *testing.T) {
+	documentLanguages := getMainLanguages([]languageCount{
+		{Language: "zh", Count: 300},
+		{Language: "en", Count: 500},
+		{Language: "", Count: 400},
+		{Language: "de", Count: 20},
+	})
+	if fmt.Sprint(documentLanguages) != "[en zh]" {
+		t.Fatalf("getMainLanguages() = %v, want [en zh]", documentLanguages)
+	}
+
+	tests := []struct {
+		questionLanguage string
+		mode             string
+		expected         string
+	}{
+		{"de", "Translate", "[en]"},
+		{"en", "Translate", "[]"},
+		{"zh", "Translate", "[en]"},
+		{"de", "Multi-Query", "[en zh]"},
+		{"zh", "Multi-Query", "[en]"},
+		{"", "Multi-Query", "[]"},
+	}
+
+	for _, test := range tests {
+		res := getTargetLanguages(test.questionLanguage, documentLanguages, test.mode)
+		if fmt.Sprint(res) != test.expected {
+			t.Errorf("getTargetLanguages(%q, %s) = %v, want %s", test.questionLanguage, test.mode, res, test.expected)
+		}
+	}
+}
+
+func TestGetPromptWithLanguage(t *testing.T) {
+	prompt := GetPromptWithLanguage("You are a helpful assistant.", "Wie kann ich die Rechnung für das Abonnement ändern?")
+	if !strings.HasPrefix(prompt, "You are a helpful assistant.") || !strings.Contains(prompt, "German") {
+		t.Errorf("GetPromptWithLanguage() = %q, want the prompt asking for German", prompt)
+	}
+
+	if prompt = GetPromptWithLanguage("You are a helpful assistant.", "123"); prompt != "You are a helpful assistant." {
+		t.Errorf("GetPromptWithLanguage() = %q, want the prompt unchanged", prompt)
+	}
+}
@@ -15,6 +15,7 @@
 package object
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/casibase/casibase/embedding"
@@ -29,13 +30,16 @@ func NewDefaultSearchProvider(owner string) (*DefaultSearchProvider, error) {
 	return &DefaultSearchProvider{owner: owner}, nil
 }
 
-func (p *DefaultSearchProvider) Search(relatedStores []string, embeddingProviderName string, embeddingProviderObj embedding.EmbeddingProvider, modelProviderName string, text string, knowledgeCount int, lang string) ([]Vector, *embedding.EmbeddingResult, error) {
-	vectors, err := getRelatedVectors(relatedStores, embeddingProviderName)
+func (p *DefaultSearchProvider) Search(relatedStores []*Store, embeddingProviderName string, embeddingProviderObj embedding.EmbeddingProvider, modelProviderName string, text string, knowledgeCount int, filter *VectorFilter, ctx context.Context, lang string) ([]Vector, *embedding.EmbeddingResult, error) {
+	vectorCount, err := getRelatedVectorCount(relatedStores, embeddingProviderName)
 	if err != nil {
 		return nil, nil, err
 	}
+	if vectorCount == 0 {
+		return nil, nil, ErrNoKnowledgeVectors
+	}
 
-	qVector, embeddingResult, err := queryVectorSafe(embeddingProviderObj, text, lang)
+	qVector, embeddingResult, err := queryVectorSafe(embeddingProviderObj, text, ctx, lang)
 	if err != nil {
 		return nil, embeddingResult, err
 	}
@@ -43,22 +47,10 @@ func (p *DefaultSearchProvider) Search(relatedStores []string, embeddingProvider
 		return nil, embeddingResult, fmt.Errorf(i18n.Translate(lang, "object:no qVector found"))
 	}
 
-	var vectorData [][]float32
-	for _, candidate := range vectors {
-		vectorData = append(vectorData, candidate.Data)
-	}
-
-	similarities, err := getNearestVectors(qVector, vectorData, knowledgeCount)
+	res, err := searchRelatedVectors(relatedStores, embeddingProviderName, qVector, knowledgeCount, filter.Match, lang)
 	if err != nil {
 		return nil, embeddingResult, err
 	}
 
-	res := []Vector{}
-	for _, similarity := range similarities {
-		vector := vectors[similarity.Index]
-		vector.Score = similarity.Similarity
-		res = append(res, *vector)
-	}
-
 	return res, embeddingResult, nil
 }
@@ -0,0 +1,202 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/casibase/casibase/model"
+)
+
+const (
+	defaultContextWindow     = 1
+	defaultContextTokenLimit = 2000
+)
+
+// contextExpansion grows the vectors found by a search with the other chunks of
+// their files, either the neighbouring chunks ("Neighbors") or the chunks under
+// the same heading ("Section").
+type contextExpansion struct {
+	mode       string
+	window     int
+	tokenLimit int
+
+	getFileVectors func(vector *Vector) ([]*Vector, error)
+}
+
+func newContextExpansion(store *Store) *contextExpansion {
+	if store.ContextExpansion != "Neighbors" && store.ContextExpansion != "Section" {
+		return nil
+	}
+
+	window := store.ContextWindow
+	if window <= 0 {
+		window = defaultContextWindow
+	}
+
+	tokenLimit := store.ContextTokenLimit
+	if tokenLimit <= 0 {
+		tokenLimit = defaultContextTokenLimit
+	}
+
+	return &contextExpansion{
+		mode:       store.ContextExpansion,
+		window:     window,
+		tokenLimit: tokenLimit,
+		getFileVectors: func(vector *Vector) ([]*Vector, error) {
+			return getVectorsByFile(vector.Owner, vector.Store, vector.Provider, vector.File)
+		},
+	}
+}
+
+func getContextTokenSize(text string) int {
+	tokenSize, err := model.GetTokenSize("gpt-3.5-turbo", text)
+	if err != nil {
+		return len([]rune(text)) / 4
+	}
+	return tokenSize
+}
+
+// getChunkHeader returns the first line of a chunk when it is separated from the
+// rest by a blank line, which is how the splitters put the heading path or other
+// context in front of every chunk of a section.
+func getChunkHeader(text string) string {
+	header, _, found := strings.Cut(text, "\n\n")
+	if !found || strings.Contains(header, "\n") {
+		return ""
+	}
+	return header
+}
+
+// getCandidates returns the chunks that may be added around the vector, the
+// closest ones first. Chunks without a header fall back to their neighbours.
+func (e *contextExpansion) getCandidates(vector *Vector, fileVectors []*Vector) []*Vector {
+	header := getChunkHeader(vector.Text)
+
+	res := []*Vector{}
+	for _, fileVector := range fileVectors {
+		if fileVector.Index == vector.Index {
+			continue
+		}
+
+		if e.mode == "Section" && header != "" {
+			if getChunkHeader(fileVector.Text) == header {
+				res = append(res, fileVector)
+			}
+		} else if abs(fileVector.Index-vector.Index) <= e.window {
+			res = append(res, fileVector)
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return abs(res[i].Index-vector.Index) < abs(res[j].Index-vector.Index)
+	})
+	return res
+}
+
+// expand replaces the text of every vector with its chunk plus the surrounding
+// ones in file order. A chunk is only used once: a vector already included in the
+// context of a better vector is dropped. The hits themselves are always kept,
+// the surrounding chunks are added while the token limit allows.
+func (e *contextExpansion) expand(vectors []Vector) ([]Vector, error) {
+	fileVectorsMap := map[string][]*Vector{}
+	usedMap := map[string]bool{}
+	getChunkKey := func(vector *Vector, index int) string {
+		return fmt.Sprintf("%s|%s|%s|%d", vector.Store, vector.Provider, vector.File, index)
+	}
+
+	leftTokens := e.tokenLimit
+	for i := range vectors {
+		leftTokens -= getContextTokenSize(vectors[i].Text)
+	}
+
+	res := []Vector{}
+	for i := range vectors {
+		vector := vectors[i]
+		if vector.File == "" {
+			res = append(res, vector)
+			continue
+		}
+
+		if usedMap[getChunkKey(&vector, vector.Index)] {
+			leftTokens += getContextTokenSize(vector.Text)
+			continue
+		}
+		usedMap[getChunkKey(&vector, vector.Index)] = true
+
+		fileKey := getChunkKey(&vector, -1)
+		fileVectors, ok := fileVectorsMap[fileKey]
+		if !ok {
+			var err error
+			fileVectors, err = e.getFileVectors(&vector)
+			if err != nil {
+				return nil, err
+			}
+			fileVectorsMap[fileKey] = fileVectors
+		}
+
+		chunks := []*Vector{&vector}
+		for _, candidate := range e.getCandidates(&vector, fileVectors) {
+			if usedMap[getChunkKey(candidate, candidate.Index)] {
+				continue
+			}
+
+			tokenSize := getContextTokenSize(candidate.Text)
+			if tokenSize > leftTokens {
+				continue
+			}
+
+			leftTokens -= tokenSize
+			usedMap[getChunkKey(candidate, candidate.Index)] = true
+			chunks = append(chunks, candidate)
+		}
+
+		if len(chunks) > 1 {
+			vector.Text = joinChunks(chunks)
+			vector.TokenCount = getContextTokenSize(vector.Text)
+		}
+		res = append(res, vector)
+	}
+
+	return res, nil
+}
+
+// joinChunks joins the chunks in file order and keeps a header shared by them
+// only once.
+func joinChunks(chunks []*Vector) string {
+	sort.SliceStable(chunks, func(i, j int) bool {
+		return chunks[i].Index < chunks[j].Index
+	})
+
+	header := getChunkHeader(chunks[0].Text)
+	texts := []string{}
+	for i, chunk := range chunks {
+		text := chunk.Text
+		if i != 0 && header != "" && getChunkHeader(text) == header {
+			text = strings.TrimPrefix(text, header+"\n\n")
+		}
+		texts = append(texts, text)
+	}
+	return strings.Join(texts, "\n\n")
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package object
+
+import (
+	"testing"
+)
+
+func testContextExpansion(mode string, tokenLimit int, fileVectors []*Vector) *contextExpansion {
+	return &contextExpansion{
+		mode:       mode,
+		window:     1,
+		tokenLimit: tokenLimit,
+		getFileVectors: func(vector *Vector) ([]*Vector, error) {
+			return fileVectors, nil
+		},
+	}
+}
+
+func TestContextExpansion(t *testing.T) {
+	fileVectors := []*Vector{
+		{Name: "v0", File: "a.md", Index: 0, Text: "# A\n\nalpha"},
+		{Name: "v1", File: "a.md", Index: 1, Text: "# B\n\nbravo"},
+		{Name: "v2", File: "a.md", Index: 2, Text: "# B\n\ncharlie"},
+		{Name: "v3", File: "a.md", Index: 3, Text: "# B\n\ndelta"},
+		{Name: "v4", File: "a.md", Index: 4, Text: "# C\n\necho"},
+	}
+	hits := func(indexes ...int) []Vector {
+		res := []Vector{}
+		for _, index := range indexes {
+			res = append(res, *fileVectors[index])
+		}
+		return res
+	}
+
+	tests := []struct {
+		name       string
+		mode       string
+		tokenLimit int
+		hits       []Vector
+		expected   []string
+	}{
+		{"neighbors", "Neighbors", 1000, hits(2, 0), []string{"# B\n\nbravo\n\ncharlie\n\ndelta", "# A\n\nalpha"}},
+		{"dedup", "Neighbors", 1000, hits(2, 3), []string{"# B\n\nbravo\n\ncharlie\n\ndelta"}},
+		{"section", "Section", 1000, hits(1), []string{"# B\n\nbravo\n\ncharlie\n\ndelta"}},
+		{"token limit", "Neighbors", 0, hits(2), []string{"# B\n\ncharlie"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := testContextExpansion(test.mode, test.tokenLimit, fileVectors).expand(test.hits)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(res) != len(test.expected) {
+				t.Fatalf("expand() returned %d vectors, want %d", len(res), len(test.expected))
+			}
+			for i, vector := range res {
+				if vector.Text != test.expected[i] {
+					t.Errorf("expand()[%d] = %q, want %q", i, vector.Text, test.expected[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/casibase/casibase/embedding"
+)
+
+// GraphSearchProvider adds to the similarity search the chunks connected to the
+// entities of the question in the knowledge graph of the stores: the chunks that
+// state a relation between two entities of the question first, then the chunks
+// relating them to their neighbours. It answers "how is X related to Y" when no
+// single chunk is similar to the question.
+type GraphSearchProvider struct {
+	owner string
+}
+
+func NewGraphSearchProvider(owner string) (*GraphSearchProvider, error) {
+	return &GraphSearchProvider{owner: owner}, nil
+}
+
+// getQuestionEntities returns the ids of the entities named in the question,
+// the names of a single rune are too ambiguous to match.
+func getQuestionEntities(g *knowledgeGraph, text string) map[string]bool {
+	text = getEntityId(text)
+
+	res := map[string]bool{}
+	for _, node := range g.Nodes {
+		if utf8.RuneCountInString(node.Id) > 1 && strings.Contains(text, node.Id) {
+			res[node.Id] = true
+		}
+	}
+	return res
+}
+
+// getVectorEntities returns the ids of the entities extracted from the vectors.
+func getVectorEntities(g *knowledgeGraph, vectors []Vector) map[string]bool {
+	vectorMap := map[string]bool{}
+	for _, vector := range vectors {
+		vectorMap[vector.Name] = true
+	}
+
+	res := map[string]bool{}
+	for _, node := range g.Nodes {
+		for _, name := range node.Vectors {
+			if vectorMap[name] {
+				res[node.Id] = true
+				break
+			}
+		}
+	}
+	return res
+}
+
+// getGraphVectorScores scores the vectors around the seed entities: a relation
+// between two seeds counts 2, a relation from a seed to a neighbour and the
+// mention of a seed count 1.
+func getGraphVectorScores(g *knowledgeGraph, seeds map[string]bool) map[string]int {
+	res := map[string]int{}
+	for _, link := range g.Links {
+		score := 0
+		if seeds[link.Source] {
+			score++
+		}
+		if seeds[link.Target] {
+			score++
+		}
+		if score == 0 {
+			continue
+		}
+
+		for _, name := range link.Vectors {
+			res[name] += score
+		}
+	}
+
+	for _, node := range g.Nodes {
+		if !seeds[node.Id] {
+			continue
+		}
+
+		for _, name := range node.Vectors {
+			res[name]++
+		}
+	}
+	return res
+}
+
+func getVectorsByNames(owner string, names []string) ([]*Vector, error) {
+	vectors := []*Vector{}
+	if len(names) == 0 {
+		return vectors, nil
+	}
+
+	err := adapter.engine.Omit("embedding").In("name", names).Find(&vectors, &Vector{Owner: owner})
+	if err != nil {
+		return vectors, err
+	}
+
+	return vectors, nil
+}
+
+func (p *GraphSearchProvider) Search(relatedStores []*Store, embeddingProviderName string, embeddingProviderObj embedding.EmbeddingProvider, modelProviderName string, text string, knowledgeCount int, filter *VectorFilter, ctx context.Context, lang string) ([]Vector, *embedding.EmbeddingResult, error) {
+	defaultSearchProvider, err := NewDefaultSearchProvider(p.owner)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hits, embeddingResult, err := defaultSearchProvider.Search(relatedStores, embeddingProviderName, embeddingProviderObj, modelProviderName, text, knowledgeCount, filter, ctx, lang)
+	if err != nil {
+		return nil, embeddingResult, err
+	}
+
+	scoreMap := map[string]int{}
+	for _, store := range getUniqueStores(relatedStores) {
+		g, err := getStoreKnowledgeGraph(p.owner, store.Name)
+		if err != nil {
+			return nil, embeddingResult, err
+		}
+		if g == nil {
+			continue
+		}
+
+		// Without an entity named in the question, start from the entities of
+		// the most similar chunks.
+		seeds := getQuestionEntities(g, text)
+		if len(seeds) == 0 {
+			seeds = getVectorEntities(g, hits)
+		}
+
+		for name, score := range getGraphVectorScores(g, seeds) {
+			scoreMap[name] += score
+		}
+	}
+
+	hitMap := map[string]bool{}
+	for _, hit := range hits {
+		hitMap[hit.Name] = true
+	}
+
+	names := []string{}
+	for name := range scoreMap {
+		if !hitMap[name] {
+			names = append(names, name)
+		}
+	}
+
+	vectors, err := getVectorsByNames("admin", names)
+	if err != nil {
+		return nil, embeddingResult, err
+	}
+
+	graphVectors := []Vector{}
+	for _, vector := range vectors {
+		if vector.Provider == embeddingProviderName && filter.Match(vector) {
+			graphVectors = append(graphVectors, *vector)
+		}
+	}
+	sort.SliceStable(graphVectors, func(i, j int) bool {
+		if scoreMap[graphVectors[i].Name] != scoreMap[graphVectors[j].Name] {
+			return scoreMap[graphVectors[i].Name] > scoreMap[graphVectors[j].Name]
+		}
+		return graphVectors[i].Name < graphVectors[j].Name
+	})
+
+	return mergeGraphVectors(hits, graphVectors, knowledgeCount), embeddingResult, nil
+}
+
+// mergeGraphVectors gives up to half of the knowledge count to the graph chunks,
+// and the rest to the most similar chunks. The graph chunks take the lowest
+// similarity score of the hits, they were not found by similarity.
+func mergeGraphVectors(hits []Vector, graphVectors []Vector, knowledgeCount int) []Vector {
+	graphCount := min(len(graphVectors), (knowledgeCount+1)/2)
+	hitCount := min(len(hits), knowledgeCount-graphCount)
+	graphCount = min(len(graphVectors), knowledgeCount-hitCount)
+
+	var score float32
+	if hitCount > 0 {
+		score = hits[hitCount-1].Score
+	}
+
+	res := append([]Vector{}, hits[:hitCount]...)
+	for _, vector := range graphVectors[:graphCount] {
+		vector.Score = score
+		res = append(res, vector)
+	}
+	return res
+}
@@ -15,6 +15,7 @@
 package object
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -31,23 +32,19 @@ func NewHierarchySearchProvider(owner string) (*HierarchySearchProvider, error)
 	return &HierarchySearchProvider{owner: owner}, nil
 }
 
-func (p *HierarchySearchProvider) Search(relatedStores []string, embeddingProviderName string, embeddingProviderObj embedding.EmbeddingProvider, modelProviderName string, text string, knowledgeCount int, lang string) ([]Vector, *embedding.EmbeddingResult, error) {
+func (p *HierarchySearchProvider) Search(relatedStores []*Store, embeddingProviderName string, embeddingProviderObj embedding.EmbeddingProvider, modelProviderName string, text string, knowledgeCount int, filter *VectorFilter, ctx context.Context, lang string) ([]Vector, *embedding.EmbeddingResult, error) {
 	vectors, err := getRelatedVectors(relatedStores, embeddingProviderName)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	var (
-		vectorData [][]float32
-		titleMap   = make(map[string]bool)
-	)
+	titleMap := make(map[string]bool)
 	for _, candidate := range vectors {
-		if candidate.File != "" && strings.HasSuffix(candidate.File, ".md") {
+		if isMarkdownVector(candidate) && filter.Match(candidate) {
 			parts := strings.SplitN(candidate.Text, "\n\n", 2)
 			if len(parts) > 0 {
 				titleMap[parts[0]] = true
 			}
-			vectorData = append(vectorData, candidate.Data)
 		}
 	}
 	titleCandidates := make([]string, 0, len(titleMap))
@@ -55,12 +52,13 @@ func (p *HierarchySearchProvider) Search(relatedStores []string, embeddingProvid
 		titleCandidates = append(titleCandidates, title)
 	}
 
-	question, _, err := getEnhancedQuestionByModel(modelProviderName, text, titleCandidates, knowledgeCount, lang)
+	question, modelResult, err := getEnhancedQuestionByModel(modelProviderName, text, titleCandidates, knowledgeCount, ctx, lang)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	qVector, embeddingResult, err := queryVectorSafe(embeddingProviderObj, question, lang)
+	qVector, embeddingResult, err := queryVectorSafe(embeddingProviderObj, question, ctx, lang)
+	embeddingResult = addModelSearchCost(embeddingResult, modelResult)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -68,29 +66,28 @@ func (p *HierarchySearchProvider) Search(relatedStores []string, embeddingProvid
 		return nil, embeddingResult, fmt.Errorf(i18n.Translate(lang, "object:no qVector found"))
 	}
 
-	similarities, err := getNearestVectors(qVector, vectorData, knowledgeCount)
+	res, err := searchRelatedVectors(relatedStores, embeddingProviderName, qVector, knowledgeCount, func(vector *Vector) bool {
+		return isMarkdownVector(vector) && filter.Match(vector)
+	}, lang)
 	if err != nil {
 		return nil, embeddingResult, err
 	}
 
-	res := []Vector{}
-	for _, similarity := range similarities {
-		vector := vectors[similarity.Index]
-		vector.Score = similarity.Similarity
-		res = append(res, *vector)
-	}
-
 	return res, embeddingResult, nil
 }
 
-func getEnhancedQuestionByModel(modelProviderName string, text string, titleCandidates []string, candidateTitlesNum int, lang string) (string, *model.ModelResult, error) {
+func isMarkdownVector(vector *Vector) bool {
+	return vector.File != "" && strings.HasSuffix(vector.File, ".md")
+}
+
+func getEnhancedQuestionByModel(modelProviderName string, text string, titleCandidates []string, candidateTitlesNum int, ctx context.Context, lang string) (string, *model.ModelResult, error) {
 	prompt := fmt.Sprintf("Please help me select the top %d titles that are most likely to contain the answer. Just return the title list. No other content.", candidateTitlesNum)
 
 	question := fmt.Sprintf("Please select the titles most relevant to the following question and choose the %v most relevant items. Just return the title list. No other content.\nquestion:\n %s \n\nTitles: \n%s", candidateTitlesNum, text, "• "+strings.Join(titleCandidates, "\n• "))
 
 	history := []*model.RawMessage{}
 	knowledge := []*model.RawMessage{}
-	res, modelResult, err := GetAnswerWithContext(modelProviderName, question, history, knowledge, prompt, lang)
+	res, modelResult, err := GetAnswerWithContext(modelProviderName, question, history, knowledge, prompt, ctx, lang)
 	if err != nil {
 		return "", nil, err
 	}
@@ -0,0 +1,148 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/casibase/casibase/embedding"
+	"github.com/casibase/casibase/i18n"
+)
+
+// HybridSearchProvider ranks the candidates both by embedding similarity and by
+// BM25 keyword relevance, then fuses the two rankings with reciprocal rank fusion.
+// The keyword side catches product codes, error numbers and acronyms that
+// embeddings tend to miss.
+type HybridSearchProvider struct {
+	owner string
+}
+
+func NewHybridSearchProvider(owner string) (*HybridSearchProvider, error) {
+	return &HybridSearchProvider{owner: owner}, nil
+}
+
+func (p *HybridSearchProvider) Search(relatedStores []*Store, embeddingProviderName string, embeddingProviderObj embedding.EmbeddingProvider, modelProviderName string, text string, knowledgeCount int, filter *VectorFilter, ctx context.Context, lang string) ([]Vector, *embedding.EmbeddingResult, error) {
+	vectorCount, err := getRelatedVectorCount(relatedStores, embeddingProviderName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if vectorCount == 0 {
+		return nil, nil, ErrNoKnowledgeVectors
+	}
+
+	qVector, embeddingResult, err := queryVectorSafe(embeddingProviderObj, text, ctx, lang)
+	if err != nil {
+		return nil, embeddingResult, err
+	}
+	if qVector == nil || len(qVector) == 0 {
+		return nil, embeddingResult, fmt.Errorf(i18n.Translate(lang, "object:no qVector found"))
+	}
+
+	// Both rankings are taken deeper than knowledgeCount so that a chunk ranked
+	// moderately on both sides can still win after fusion.
+	candidateCount := knowledgeCount * hybridCandidateFactor
+	nearestVectors, err := searchRelatedVectors(relatedStores, embeddingProviderName, qVector, candidateCount, filter.Match, lang)
+	if err != nil {
+		return nil, embeddingResult, err
+	}
+
+	keywordVectors, err := searchRelatedKeywords(relatedStores, embeddingProviderName, text, candidateCount, filter.Match)
+	if err != nil {
+		return nil, embeddingResult, err
+	}
+
+	candidates := []Vector{}
+	candidateIndexMap := map[string]int{}
+	similarities := []SimilarityIndex{}
+	for _, vector := range nearestVectors {
+		candidateIndexMap[vector.GetId()] = len(candidates)
+		similarities = append(similarities, SimilarityIndex{Similarity: vector.Score, Index: len(candidates)})
+		candidates = append(candidates, vector)
+	}
+
+	// The vectors only found by their keywords get their similarity as well,
+	// the fused score only decides the order and is not shown.
+	qVectorNorm := norm(qVector)
+	keywordScores := []SimilarityIndex{}
+	for _, vector := range keywordVectors {
+		index, ok := candidateIndexMap[vector.GetId()]
+		if !ok {
+			index = len(candidates)
+			candidateIndexMap[vector.GetId()] = index
+
+			keywordScore := vector.Score
+			vector.Score = 0
+			if len(vector.Data) == len(qVector) && qVectorNorm != 0 {
+				vector.Score = cosineSimilarity(qVector, vector.Data, qVectorNorm)
+			}
+			candidates = append(candidates, vector)
+			keywordScores = append(keywordScores, SimilarityIndex{Similarity: keywordScore, Index: index})
+			continue
+		}
+
+		keywordScores = append(keywordScores, SimilarityIndex{Similarity: vector.Score, Index: index})
+	}
+
+	fusedScores := getReciprocalRankFusion([][]SimilarityIndex{similarities, keywordScores}, len(candidates))
+
+	res := []Vector{}
+	for _, fusedScore := range fusedScores {
+		res = append(res, candidates[fusedScore.Index])
+	}
+
+	res = collapseDuplicateVectors(res)
+	if knowledgeCount < len(res) {
+		res = res[:knowledgeCount]
+	}
+	return res, embeddingResult, nil
+}
+
+// searchRelatedKeywords returns the n vectors of the related stores with the
+// highest BM25 scores for the query, the stores are scored as one corpus.
+func searchRelatedKeywords(relatedStores []*Store, provider string, query string, n int, filter func(*Vector) bool) ([]Vector, error) {
+	queryTerms := getQueryTerms(query)
+	if len(queryTerms) == 0 {
+		return []Vector{}, nil
+	}
+
+	indexes := []*VectorIndex{}
+	corpus := &bm25Corpus{documentFrequencies: map[string]int{}}
+	for _, store := range getUniqueStores(relatedStores) {
+		index, err := vectorIndexManager.getIndex(store.Name, provider)
+		if err != nil {
+			return nil, err
+		}
+
+		index.addKeywordStats(corpus, queryTerms)
+		indexes = append(indexes, index)
+	}
+
+	res := []Vector{}
+	for _, index := range indexes {
+		res = append(res, index.searchKeywords(corpus, queryTerms, filter)...)
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Score > res[j].Score
+	})
+
+	res = collapseDuplicateVectors(res)
+	if n < len(res) {
+		res = res[:n]
+	}
+	return res, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package object
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetKeywordTokens(t *testing.T) {
+	tokens := getKeywordTokens("Error ERR-1042 in v2.3, 数据库连接")
+	expected := []string{"error", "err-1042", "err", "1042", "in", "v2.3", "v2", "3", "数据", "据库", "库连", "连接"}
+	if !reflect.DeepEqual(tokens, expected) {
+		t.Errorf("getKeywordTokens() = %v, want %v", tokens, expected)
+	}
+}
+
+func TestKeywordIndex(t *testing.T) {
+	index := newKeywordIndex()
+	index.add("0", "How to reset your password")
+	index.add("1", "Error ERR-1042 means the license has expired")
+	index.add("2", "The license page lists all products")
+
+	search := func() []keywordScore {
+		queryTerms := getQueryTerms("what is ERR-1042")
+		corpus := &bm25Corpus{documentFrequencies: map[string]int{}}
+		index.addStats(corpus, queryTerms)
+		return index.search(corpus, queryTerms, nil)
+	}
+
+	scores := search()
+	if len(scores) != 1 || scores[0].id != "1" {
+		t.Errorf("search() = %v, want only document 1", scores)
+	}
+
+	index.remove("1")
+	index.add("2", "The license page explains ERR-1042")
+	scores = search()
+	if len(scores) != 1 || scores[0].id != "2" {
+		t.Errorf("search() after the changes = %v, want only document 2", scores)
+	}
+	if index.totalLength != index.lengths["0"]+index.lengths["2"] {
+		t.Errorf("totalLength = %d, want the token count of documents 0 and 2", index.totalLength)
+	}
+}
+
+func TestGetReciprocalRankFusion(t *testing.T) {
+	vectorRanking := []SimilarityIndex{{Similarity: 0.9, Index: 0}, {Similarity: 0.8, Index: 1}, {Similarity: 0.7, Index: 2}}
+	keywordRanking := []SimilarityIndex{{Similarity: 5.0, Index: 2}, {Similarity: 3.0, Index: 1}}
+
+	fused := getReciprocalRankFusion([][]SimilarityIndex{vectorRanking, keywordRanking}, 2)
+	if len(fused) != 2 || fused[0].Index != 2 || fused[1].Index != 1 {
+		t.Errorf("getReciprocalRankFusion() = %v, want indexes [2 1]", fused)
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+
+	rrfK = 60
+
+	hybridCandidateFactor = 4
+)
+
+func isKeywordRune(r rune) bool {
+	return (unicode.IsLetter(r) || unicode.IsDigit(r)) && !unicode.Is(unicode.Han, r)
+}
+
+func isKeywordJoiner(r rune) bool {
+	return r == '-' || r == '_' || r == '.' || r == '/'
+}
+
+// getKeywordTokens splits text into lowercase terms for keyword ranking.
+// Latin words keep inner joiners so "ERR-1042" and "v2.3" stay whole, and their
+// parts are emitted as well. Han text has no spaces, so it is indexed as bigrams.
+func getKeywordTokens(text string) []string {
+	res := []string{}
+	runes := []rune(strings.ToLower(text))
+
+	i := 0
+	for i < len(runes) {
+		r := runes[i]
+		if unicode.Is(unicode.Han, r) {
+			j := i
+			for j < len(runes) && unicode.Is(unicode.Han, runes[j]) {
+				j++
+			}
+			if j-i == 1 {
+				res = append(res, string(runes[i]))
+			} else {
+				for k := i; k+1 < j; k++ {
+					res = append(res, string(runes[k:k+2]))
+				}
+			}
+			i = j
+			continue
+		}
+
+		if isKeywordRune(r) {
+			j := i
+			for j < len(runes) && (isKeywordRune(runes[j]) || (isKeywordJoiner(runes[j]) && j+1 < len(runes) && isKeywordRune(runes[j+1]))) {
+				j++
+			}
+
+			token := string(runes[i:j])
+			res = append(res, token)
+			if strings.IndexFunc(token, isKeywordJoiner) >= 0 {
+				for _, part := range strings.FieldsFunc(token, isKeywordJoiner) {
+					res = append(res, part)
+				}
+			}
+			i = j
+			continue
+		}
+
+		i++
+	}
+
+	return res
+}
+
+// keywordIndex keeps the keyword terms of the vectors of a VectorIndex, so that
+// a query is scored with BM25 from the postings of its own terms instead of
+// tokenizing the whole store again. It is guarded by the mutex of the index.
+type keywordIndex struct {
+	postings    map[string]map[string]int // term -> vector id -> term frequency
+	terms       map[string][]string       // vector id -> distinct terms
+	lengths     map[string]int            // vector id -> token count
+	totalLength int
+}
+
+// bm25Corpus holds the statistics of the searched indexes that BM25 needs, the
+// indexes of several related stores are scored as one corpus.
+type bm25Corpus struct {
+	documentCount       int
+	totalLength         int
+	documentFrequencies map[string]int
+}
+
+type keywordScore struct {
+	id    string
+	score float32
+}
+
+func newKeywordIndex() *keywordIndex {
+	return &keywordIndex{
+		postings: map[string]map[string]int{},
+		terms:    map[string][]string{},
+		lengths:  map[string]int{},
+	}
+}
+
+func getQueryTerms(query string) []string {
+	res := []string{}
+	termMap := map[string]bool{}
+	for _, token := range getKeywordTokens(query) {
+		if !termMap[token] {
+			termMap[token] = true
+			res = append(res, token)
+		}
+	}
+	return res
+}
+
+func (k *keywordIndex) add(id string, text string) {
+	k.remove(id)
+
+	tokens := getKeywordTokens(text)
+	termFrequency := map[string]int{}
+	for _, token := range tokens {
+		termFrequency[token]++
+	}
+
+	terms := make([]string, 0, len(termFrequency))
+	for term, frequency := range termFrequency {
+		posting, ok := k.postings[term]
+		if !ok {
+			posting = map[string]int{}
+			k.postings[term] = posting
+		}
+		posting[id] = frequency
+		terms = append(terms, term)
+	}
+
+	k.terms[id] = terms
+	k.lengths[id] = len(tokens)
+	k.totalLength += len(tokens)
+}
+
+func (k *keywordIndex) remove(id string) {
+	length, ok := k.lengths[id]
+	if !ok {
+		return
+	}
+
+	for _, term := range k.terms[id] {
+		posting := k.postings[term]
+		delete(posting, id)
+		if len(posting) == 0 {
+			delete(k.postings, term)
+		}
+	}
+
+	delete(k.terms, id)
+	delete(k.lengths, id)
+	k.totalLength -= length
+}
+
+func (k *keywordIndex) addStats(corpus *bm25Corpus, queryTerms []string) {
+	corpus.documentCount += len(k.lengths)
+	corpus.totalLength += k.totalLength
+	for _, term := range queryTerms {
+		corpus.documentFrequencies[term] += len(k.postings[term])
+	}
+}
+
+// search returns the Okapi BM25 scores of the documents that share at least
+// one term with the query and are accepted by filter, in no particular order.
+func (k *keywordIndex) search(corpus *bm25Corpus, queryTerms []string, filter func(id string) bool) []keywordScore {
+	if corpus.documentCount == 0 {
+		return []keywordScore{}
+	}
+
+	documentCount := float64(corpus.documentCount)
+	averageLength := float64(corpus.totalLength) / documentCount
+	if averageLength == 0 {
+		averageLength = 1
+	}
+
+	scoreMap := map[string]float64{}
+	for _, term := range queryTerms {
+		documentFrequency := float64(corpus.documentFrequencies[term])
+		idf := math.Log(1 + (documentCount-documentFrequency+0.5)/(documentFrequency+0.5))
+		for id, termFrequency := range k.postings[term] {
+			frequency := float64(termFrequency)
+			lengthNorm := 1 - bm25B + bm25B*float64(k.lengths[id])/averageLength
+			scoreMap[id] += idf * frequency * (bm25K1 + 1) / (frequency + bm25K1*lengthNorm)
+		}
+	}
+
+	res := []keywordScore{}
+	for id, score := range scoreMap {
+		if score > 0 && (filter == nil || filter(id)) {
+			res = append(res, keywordScore{id: id, score: float32(score)})
+		}
+	}
+	return res
+}
+
+// getReciprocalRankFusion merges several rankings of the same candidates by
+// summing 1 / (rrfK + rank) for every ranking a candidate appears in.
+func getReciprocalRankFusion(rankings [][]SimilarityIndex, n int) []SimilarityIndex {
+	scoreMap := map[int]float32{}
+	order := []int{}
+	for _, ranking := range rankings {
+		for rank, item := range ranking {
+			if _, ok := scoreMap[item.Index]; !ok {
+				order = append(order, item.Index)
+			}
+			scoreMap[item.Index] += 1.0 / float32(rrfK+rank+1)
+		}
+	}
+
+	res := []SimilarityIndex{}
+	for _, index := range order {
+		res = append(res, SimilarityIndex{Similarity: scoreMap[index], Index: index})
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Similarity > res[j].Similarity
+	})
+
+	if n < len(res) {
+		res = res[:n]
+	}
+	return res
+}
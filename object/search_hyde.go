@@ -0,0 +1,71 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/beego/beego/logs"
+	"github.com/casibase/casibase/embedding"
+	"github.com/casibase/casibase/i18n"
+)
+
+// HydeSearchProvider implements Hypothetical Document Embeddings: the model
+// first writes an answer to the question, which is then embedded and searched
+// for instead of the question. A made-up answer is usually closer to the real
+// passages than a short question is.
+type HydeSearchProvider struct {
+	owner string
+}
+
+func NewHydeSearchProvider(owner string) (*HydeSearchProvider, error) {
+	return &HydeSearchProvider{owner: owner}, nil
+}
+
+func (p *HydeSearchProvider) Search(relatedStores []*Store, embeddingProviderName string, embeddingProviderObj embedding.EmbeddingProvider, modelProviderName string, text string, knowledgeCount int, filter *VectorFilter, ctx context.Context, lang string) ([]Vector, *embedding.EmbeddingResult, error) {
+	vectorCount, err := getRelatedVectorCount(relatedStores, embeddingProviderName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if vectorCount == 0 {
+		return nil, nil, ErrNoKnowledgeVectors
+	}
+
+	// Without the hypothetical answer, the search still goes on with the question.
+	query := text
+	answer, modelResult, err := getHypotheticalAnswerByModel(modelProviderName, text, ctx, lang)
+	if err != nil {
+		logs.Warn("Failed to generate the hypothetical answer, searching with the question only: %s", err.Error())
+	} else if answer != "" {
+		query = fmt.Sprintf("%s\n\n%s", text, answer)
+	}
+
+	qVector, embeddingResult, err := queryVectorSafe(embeddingProviderObj, query, ctx, lang)
+	embeddingResult = addModelSearchCost(embeddingResult, modelResult)
+	if err != nil {
+		return nil, embeddingResult, err
+	}
+	if qVector == nil || len(qVector) == 0 {
+		return nil, embeddingResult, fmt.Errorf(i18n.Translate(lang, "object:no qVector found"))
+	}
+
+	res, err := searchRelatedVectors(relatedStores, embeddingProviderName, qVector, knowledgeCount, filter.Match, lang)
+	if err != nil {
+		return nil, embeddingResult, err
+	}
+
+	return res, embeddingResult, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/beego/beego/logs"
+	"github.com/casibase/casibase/embedding"
+	"github.com/casibase/casibase/i18n"
+)
+
+// MultiQuerySearchProvider asks the model for several rewrites of the question,
+// searches with the question and every rewrite, and fuses the result lists with
+// reciprocal rank fusion. It finds knowledge worded differently from the question.
+type MultiQuerySearchProvider struct {
+	owner      string
+	queryCount int
+}
+
+func NewMultiQuerySearchProvider(owner string, queryCount int) (*MultiQuerySearchProvider, error) {
+	if queryCount <= 0 {
+		queryCount = defaultQueryCount
+	}
+
+	return &MultiQuerySearchProvider{owner: owner, queryCount: queryCount}, nil
+}
+
+func (p *MultiQuerySearchProvider) Search(relatedStores []*Store, embeddingProviderName string, embeddingProviderObj embedding.EmbeddingProvider, modelProviderName string, text string, knowledgeCount int, filter *VectorFilter, ctx context.Context, lang string) ([]Vector, *embedding.EmbeddingResult, error) {
+	vectorCount, err := getRelatedVectorCount(relatedStores, embeddingProviderName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if vectorCount == 0 {
+		return nil, nil, ErrNoKnowledgeVectors
+	}
+
+	// Without the rewrites, the search still goes on with the question alone.
+	queries := []string{text}
+	subQueries, modelResult, err := getSubQueriesByModel(modelProviderName, text, p.queryCount, ctx, lang)
+	if err != nil {
+		logs.Warn("Failed to rewrite the question, searching with the question only: %s", err.Error())
+	} else {
+		queries = append(queries, subQueries...)
+	}
+
+	qVectors, embeddingResult, err := queryVectorsSafe(embeddingProviderObj, queries, ctx, lang)
+	embeddingResult = addModelSearchCost(embeddingResult, modelResult)
+	if err != nil {
+		return nil, embeddingResult, err
+	}
+
+	vectorMap := map[string]Vector{}
+	indexMap := map[string]int{}
+	ids := []string{}
+	rankings := [][]SimilarityIndex{}
+	for _, qVector := range qVectors {
+		if len(qVector) == 0 {
+			return nil, embeddingResult, fmt.Errorf(i18n.Translate(lang, "object:no qVector found"))
+		}
+
+		var vectors []Vector
+		vectors, err = searchRelatedVectors(relatedStores, embeddingProviderName, qVector, knowledgeCount, filter.Match, lang)
+		if err != nil {
+			return nil, embeddingResult, err
+		}
+
+		ranking := []SimilarityIndex{}
+		for _, vector := range vectors {
+			id := vector.GetId()
+			index, ok := indexMap[id]
+			if !ok {
+				index = len(ids)
+				indexMap[id] = index
+				ids = append(ids, id)
+				vectorMap[id] = vector
+			}
+
+			ranking = append(ranking, SimilarityIndex{Similarity: vector.Score, Index: index})
+		}
+		rankings = append(rankings, ranking)
+	}
+
+	res := []Vector{}
+	for _, fusedScore := range getReciprocalRankFusion(rankings, knowledgeCount) {
+		vector := vectorMap[ids[fusedScore.Index]]
+		vector.Score = fusedScore.Similarity
+		res = append(res, vector)
+	}
+
+	return res, embeddingResult, nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/casibase/casibase/embedding"
+	"github.com/casibase/casibase/model"
+	"github.com/casibase/casibase/util"
+)
+
+const defaultQueryCount = 3
+
+var reQueryPrefix = regexp.MustCompile(`^\s*(?:[-*•]|\d+[.)、:]|[Qq]uery\s*\d*\s*:)\s*`)
+
+// addSearchCost charges a cost spent during the search, like a rerank or a
+// question rewrite, to the embedding result so that it is recorded on the
+// question message. A cost in another currency only adds its tokens.
+func addSearchCost(embeddingResult *embedding.EmbeddingResult, tokenCount int, price float64, currency string) *embedding.EmbeddingResult {
+	if embeddingResult == nil {
+		embeddingResult = &embedding.EmbeddingResult{}
+	}
+
+	embeddingResult.TokenCount += tokenCount
+	if embeddingResult.Currency == "" || embeddingResult.Currency == currency {
+		embeddingResult.Price += price
+		embeddingResult.Currency = currency
+	}
+	return embeddingResult
+}
+
+func addModelSearchCost(embeddingResult *embedding.EmbeddingResult, modelResult *model.ModelResult) *embedding.EmbeddingResult {
+	if modelResult == nil {
+		return embeddingResult
+	}
+
+	return addSearchCost(embeddingResult, modelResult.TotalTokenCount, modelResult.TotalPrice, modelResult.Currency)
+}
+
+// parseSubQueries reads one query per line from a model answer, dropping list
+// markers, blank lines, duplicates and the original question.
+func parseSubQueries(answer string, question string, queryCount int) []string {
+	res := []string{}
+	queryMap := map[string]bool{strings.ToLower(strings.TrimSpace(question)): true}
+	for _, line := range strings.Split(answer, "\n") {
+		query := strings.TrimSpace(reQueryPrefix.ReplaceAllString(line, ""))
+		query = strings.Trim(query, "\"")
+		if query == "" || queryMap[strings.ToLower(query)] {
+			continue
+		}
+
+		queryMap[strings.ToLower(query)] = true
+		res = append(res, query)
+		if len(res) == queryCount {
+			break
+		}
+	}
+	return res
+}
+
+func getSubQueriesByModel(modelProviderName string, text string, queryCount int, ctx context.Context, lang string) ([]string, *model.ModelResult, error) {
+	prompt := "You rewrite questions into search queries for a knowledge base. Just return the queries, one per line. No other content."
+
+	question := fmt.Sprintf("Please write %d different search queries that together cover the following question. Use other wordings, synonyms and the more specific or more general sides of the question, and keep the language of the question.\nquestion:\n%s", queryCount, text)
+
+	history := []*model.RawMessage{}
+	knowledge := []*model.RawMessage{}
+	res, modelResult, err := GetAnswerWithContext(modelProviderName, question, history, knowledge, prompt, ctx, lang)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return parseSubQueries(res, text, queryCount), modelResult, nil
+}
+
+func getHypotheticalAnswerByModel(modelProviderName string, text string, ctx context.Context, lang string) (string, *model.ModelResult, error) {
+	prompt := "You write passages of documentation. Just return the passage. No other content."
+
+	question := fmt.Sprintf("Please write a short passage, like one from a document, that answers the following question. It is fine to guess the details, and keep the language of the question.\nquestion:\n%s", text)
+
+	history := []*model.RawMessage{}
+	knowledge := []*model.RawMessage{}
+	res, modelResult, err := GetAnswerWithContext(modelProviderName, question, history, knowledge, prompt, ctx, lang)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return strings.TrimSpace(res), modelResult, nil
+}
+
+func getTranslatedQueryByModel(modelProviderName string, text string, language string, ctx context.Context, lang string) (string, *model.ModelResult, error) {
+	prompt := "You translate questions for searching a knowledge base. Just return the translation. No other content."
+
+	question := fmt.Sprintf("Please translate the following question into %s. Keep the names, product terms and code as they are.\nquestion:\n%s", util.GetLanguageName(language), text)
+
+	history := []*model.RawMessage{}
+	knowledge := []*model.RawMessage{}
+	res, modelResult, err := GetAnswerWithContext(modelProviderName, question, history, knowledge, prompt, ctx, lang)
+	if err != nil {
+		return "", modelResult, err
+	}
+
+	res = strings.Trim(strings.TrimSpace(res), "\"")
+	if res == "" {
+		return "", modelResult, fmt.Errorf("the translation is empty")
+	}
+	return res, modelResult, nil
+}
@@ -0,0 +1,46 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package object
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/casibase/casibase/embedding"
+)
+
+func TestParseSubQueries(t *testing.T) {
+	answer := "1. How to reset a password\n\n- Forgot password recovery\n* \"How do I reset my password?\"\nQuery 4: account locked after reset\n2) Forgot password recovery"
+
+	queries := parseSubQueries(answer, "How do I reset my password?", 3)
+	expected := []string{"How to reset a password", "Forgot password recovery", "account locked after reset"}
+	if !reflect.DeepEqual(queries, expected) {
+		t.Errorf("parseSubQueries() = %v, want %v", queries, expected)
+	}
+}
+
+func TestAddSearchCost(t *testing.T) {
+	embeddingResult := addSearchCost(nil, 10, 0.01, "USD")
+	embeddingResult = addSearchCost(embeddingResult, 20, 0.02, "USD")
+	embeddingResult = addSearchCost(embeddingResult, 30, 0.5, "CNY")
+
+	expected := &embedding.EmbeddingResult{TokenCount: 60, Price: 0.03, Currency: "USD"}
+	if embeddingResult.TokenCount != expected.TokenCount || embeddingResult.Currency != expected.Currency || embeddingResult.Price < 0.0299 || embeddingResult.Price > 0.0301 {
+		t.Errorf("addSearchCost() = %+v, want %+v", embeddingResult, expected)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"context"
+	"time"
+
+	"github.com/beego/beego/logs"
+	"github.com/casibase/casibase/embedding"
+	"github.com/casibase/casibase/rerank"
+)
+
+const rerankMinCandidateCount = 50
+
+func getRerankCandidateCount(knowledgeCount int) int {
+	res := knowledgeCount * 5
+	if res < rerankMinCandidateCount {
+		res = rerankMinCandidateCount
+	}
+	return res
+}
+
+// rerankVectors reorders the retrieved candidates with the rerank provider and
+// keeps the top knowledgeCount of them. The rerank cost is added to the
+// embedding result so that it is charged to the question message. When the
+// rerank provider fails, the original retrieval order is kept.
+func rerankVectors(rerankProviderObj rerank.RerankProvider, vectors []Vector, text string, knowledgeCount int, embeddingResult *embedding.EmbeddingResult, ctx context.Context, lang string) ([]Vector, *embedding.EmbeddingResult) {
+	if len(vectors) == 0 {
+		return vectors, embeddingResult
+	}
+
+	documents := []string{}
+	for _, vector := range vectors {
+		documents = append(documents, vector.Text)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
+	defer cancel()
+
+	scores, rerankResult, err := rerankProviderObj.Rerank(text, documents, knowledgeCount, ctx, lang)
+	if err != nil {
+		logs.Warn("Failed to rerank %d candidates, keeping the retrieval order: %s", len(vectors), err.Error())
+		if knowledgeCount < len(vectors) {
+			vectors = vectors[:knowledgeCount]
+		}
+		return vectors, embeddingResult
+	}
+
+	res := []Vector{}
+	for _, score := range scores {
+		vector := vectors[score.Index]
+		vector.Score = score.Score
+		res = append(res, vector)
+	}
+
+	if rerankResult != nil {
+		embeddingResult = addSearchCost(embeddingResult, rerankResult.TokenCount, rerankResult.Price, rerankResult.Currency)
+	}
+
+	return res, embeddingResult
+}
@@ -15,6 +15,7 @@
 package object
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -38,8 +39,9 @@ type TreeFile struct {
 }
 
 type Properties struct {
-	CollectedTime string `xorm:"varchar(100)" json:"collectedTime"`
-	Subject       string `xorm:"varchar(100)" json:"subject"`
+	CollectedTime string            `xorm:"varchar(100)" json:"collectedTime"`
+	Subject       string            `xorm:"varchar(100)" json:"subject"`
+	Metadata      map[string]string `xorm:"mediumtext" json:"metadata"`
 }
 
 type UsageInfo struct {
@@ -60,24 +62,39 @@ type Store struct {
 	CreatedTime string `xorm:"varchar(100)" json:"createdTime"`
 	DisplayName string `xorm:"varchar(100)" json:"displayName"`
 
-	StorageProvider      string   `xorm:"varchar(100)" json:"storageProvider"`
-	StorageSubpath       string   `xorm:"varchar(100)" json:"storageSubpath"`
-	ImageProvider        string   `xorm:"varchar(100)" json:"imageProvider"`
-	SplitProvider        string   `xorm:"varchar(100)" json:"splitProvider"`
-	SearchProvider       string   `xorm:"varchar(100)" json:"searchProvider"`
-	ModelProvider        string   `xorm:"varchar(100)" json:"modelProvider"`
-	EmbeddingProvider    string   `xorm:"varchar(100)" json:"embeddingProvider"`
-	TextToSpeechProvider string   `xorm:"varchar(100)" json:"textToSpeechProvider"`
-	EnableTtsStreaming   bool     `xorm:"bool" json:"enableTtsStreaming"`
-	SpeechToTextProvider string   `xorm:"varchar(100)" json:"speechToTextProvider"`
-	AgentProvider        string   `xorm:"varchar(100)" json:"agentProvider"`
-	VectorStoreId        string   `xorm:"varchar(100)" json:"vectorStoreId"`
-	BuiltinTools         []string `xorm:"varchar(500)" json:"builtinTools"`
-
+	StorageProvider        string   `xorm:"varchar(100)" json:"storageProvider"`
+	StorageSubpath         string   `xorm:"varchar(100)" json:"storageSubpath"`
+	ImageProvider          string   `xorm:"varchar(100)" json:"imageProvider"`
+	SplitProvider          string   `xorm:"varchar(100)" json:"splitProvider"`
+	SearchProvider         string   `xorm:"varchar(100)" json:"searchProvider"`
+	CrossLingualSearch     string   `xorm:"varchar(100)" json:"crossLingualSearch"`
+	ModelProvider          string   `xorm:"varchar(100)" json:"modelProvider"`
+	EmbeddingProvider      string   `xorm:"varchar(100)" json:"embeddingProvider"`
+	TextToSpeechProvider   string   `xorm:"varchar(100)" json:"textToSpeechProvider"`
+	EnableTtsStreaming     bool     `xorm:"bool" json:"enableTtsStreaming"`
+	SpeechToTextProvider   string   `xorm:"varchar(100)" json:"speechToTextProvider"`
+	AgentProvider          string   `xorm:"varchar(100)" json:"agentProvider"`
+	RerankProvider         string   `xorm:"varchar(100)" json:"rerankProvider"`
+	VectorDatabaseProvider string   `xorm:"varchar(100)" json:"vectorDatabaseProvider"`
+	VectorStoreId          string   `xorm:"varchar(100)" json:"vectorStoreId"`
+	VectorQuantization     string   `xorm:"varchar(100)" json:"vectorQuantization"`
+	BuiltinTools           []string `xorm:"varchar(500)" json:"builtinTools"`
+
+	ChunkSize           int      `json:"chunkSize"`
+	ChunkOverlap        int      `json:"chunkOverlap"`
 	MemoryLimit         int      `json:"memoryLimit"`
 	Frequency           int      `json:"frequency"`
 	LimitMinutes        int      `json:"limitMinutes"`
 	KnowledgeCount      int      `json:"knowledgeCount"`
+	QueryCount          int      `json:"queryCount"`
+	ContextExpansion    string   `xorm:"varchar(100)" json:"contextExpansion"`
+	ContextWindow       int      `json:"contextWindow"`
+	ContextTokenLimit   int      `json:"contextTokenLimit"`
+	EnableGraph         bool     `json:"enableGraph"`
+	DuplicateThreshold  float64  `json:"duplicateThreshold"`
+	EnableAnswerCache   bool     `json:"enableAnswerCache"`
+	CacheThreshold      float64  `json:"cacheThreshold"`
+	KnowledgeVersion    string   `xorm:"varchar(100)" json:"knowledgeVersion"`
 	SuggestionCount     int      `json:"suggestionCount"`
 	Welcome             string   `xorm:"varchar(100)" json:"welcome"`
 	WelcomeTitle        string   `xorm:"varchar(100)" json:"welcomeTitle"`
@@ -95,6 +112,9 @@ type Store struct {
 	VectorStores        []string `xorm:"varchar(500)" json:"vectorStores"`
 	ChildStores         []string `xorm:"varchar(500)" json:"childStores"`
 	ChildModelProviders []string `xorm:"varchar(500)" json:"childModelProviders"`
+	ModelRouting        string   `xorm:"varchar(100)" json:"modelRouting"`
+	RoutingThreshold    int      `json:"routingThreshold"`
+	ModelTimeout        int      `json:"modelTimeout"`
 	ForbiddenWords      []string `xorm:"text" json:"forbiddenWords"`
 	ShowAutoRead        bool     `json:"showAutoRead"`
 	DisableFileUpload   bool     `json:"disableFileUpload"`
@@ -175,7 +195,7 @@ func GetStore(id string) (*Store, error) {
 
 func UpdateStore(id string, store *Store) (bool, error) {
 	owner, name := util.GetOwnerAndNameFromId(id)
-	_, err := getStore(owner, name)
+	oldStore, err := getStore(owner, name)
 	if err != nil {
 		return false, err
 	}
@@ -183,6 +203,11 @@ func UpdateStore(id string, store *Store) (bool, error) {
 		return false, nil
 	}
 
+	// The knowledge version only changes when the vectors are refreshed.
+	if oldStore != nil {
+		store.KnowledgeVersion = oldStore.KnowledgeVersion
+	}
+
 	_, err = adapter.engine.ID(core.PK{owner, name}).AllCols().Update(store)
 	if err != nil {
 		return false, err
@@ -260,6 +285,16 @@ func (store *Store) GetModelProvider() (*Provider, error) {
 	return GetProvider(providerId)
 }
 
+// HasModelProvider reports whether the store answers with the model provider,
+// either as its own model provider or as one of its child model providers
+func (store *Store) HasModelProvider(provider *Provider) bool {
+	if provider == nil || provider.Owner != store.Owner {
+		return false
+	}
+
+	return provider.Name == store.ModelProvider || util.InSlice(store.ChildModelProviders, provider.Name)
+}
+
 func (store *Store) GetTextToSpeechProvider() (*Provider, error) {
 	if store.TextToSpeechProvider == "" {
 		return GetDefaultTextToSpeechProvider()
@@ -287,35 +322,51 @@ func (store *Store) GetEmbeddingProvider() (*Provider, error) {
 	return GetProvider(providerId)
 }
 
-func RefreshStoreVectors(store *Store, lang string) (bool, error) {
+func RefreshStoreVectors(store *Store, lang string) (*VectorRefreshResult, error) {
 	storageProviderObj, err := store.GetStorageProviderObj(lang)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
 	modelProvider, err := store.GetModelProvider()
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	if modelProvider == nil {
-		return false, fmt.Errorf(i18n.Translate(lang, "object:The model provider for store: %s is not found"), store.GetId())
+		return nil, fmt.Errorf(i18n.Translate(lang, "object:The model provider for store: %s is not found"), store.GetId())
 	}
 
 	embeddingProvider, err := store.GetEmbeddingProvider()
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	if embeddingProvider == nil {
-		return false, fmt.Errorf(i18n.Translate(lang, "object:The embedding provider for store: %s is not found"), store.GetId())
+		return nil, fmt.Errorf(i18n.Translate(lang, "object:The embedding provider for store: %s is not found"), store.GetId())
 	}
 
 	embeddingProviderObj, err := embeddingProvider.GetEmbeddingProvider(lang)
 	if err != nil {
-		return false, err
+		return nil, err
+	}
+
+	err = migrateStoreVectors(store, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := addVectorsForStore(storageProviderObj, embeddingProviderObj, "", store, embeddingProvider, modelProvider.SubType, context.Background(), lang)
+	if err != nil {
+		return nil, err
+	}
+
+	if store.EnableGraph {
+		err = updateStoreGraph(store, modelProvider.Name, lang)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	ok, err := addVectorsForStore(storageProviderObj, embeddingProviderObj, "", store.Name, store.SplitProvider, embeddingProvider.Name, modelProvider.SubType, lang)
-	return ok, err
+	return res, nil
 }
 
 func refreshVector(vector *Vector, lang string) (bool, error) {
@@ -324,12 +375,13 @@ func refreshVector(vector *Vector, lang string) (bool, error) {
 		return false, err
 	}
 
-	data, _, err := queryVectorSafe(embeddingProviderObj, vector.Text, lang)
+	data, _, err := queryVectorSafe(embeddingProviderObj, vector.Text, context.Background(), lang)
 	if err != nil {
 		return false, err
 	}
 
 	vector.Data = data
+	vector.Dimension = len(data)
 
 	return true, nil
 }
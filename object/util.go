@@ -76,6 +76,8 @@ func isRetryableError(err error) bool {
 
 	retryableErrors := []string{
 		string(openai.RunErrorRateLimitExceeded),
+		"status code: 429",
+		"Too Many Requests",
 	}
 
 	for _, retryableErr := range retryableErrors {
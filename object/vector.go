@@ -37,8 +37,17 @@ type Vector struct {
 	Currency    string  `xorm:"varchar(100)" json:"currency"`
 	Score       float32 `json:"score"`
 
-	Data      []float32 `xorm:"mediumtext" json:"data"`
-	Dimension int       `json:"dimension"`
+	Language string            `xorm:"varchar(100)" json:"language"`
+	Date     string            `xorm:"varchar(100)" json:"date"`
+	Tags     []string          `xorm:"varchar(500)" json:"tags"`
+	Metadata map[string]string `xorm:"mediumtext" json:"metadata"`
+
+	FileHash  string `xorm:"varchar(100)" json:"fileHash"`
+	TextHash  string `xorm:"varchar(100)" json:"textHash"`
+	Canonical string `xorm:"varchar(100)" json:"canonical"`
+
+	Data      VectorData `xorm:"mediumblob 'embedding'" json:"data"`
+	Dimension int        `json:"dimension"`
 }
 
 func GetGlobalVectors() ([]*Vector, error) {
@@ -85,18 +94,24 @@ func getVector(owner string, name string) (*Vector, error) {
 	}
 }
 
-func getVectorByIndex(owner string, store string, file string, index int) (*Vector, error) {
-	vector := Vector{Owner: owner, Store: store, File: file, Index: index}
-	existed, err := adapter.engine.Get(&vector)
+func getVectorsByStore(owner string, store string) ([]*Vector, error) {
+	vectors := []*Vector{}
+	err := adapter.engine.Asc("file").Asc("index").Find(&vectors, &Vector{Owner: owner, Store: store})
 	if err != nil {
-		return &vector, err
+		return vectors, err
 	}
 
-	if existed {
-		return &vector, nil
-	} else {
-		return nil, nil
+	return vectors, nil
+}
+
+func getVectorsByFile(owner string, store string, provider string, file string) ([]*Vector, error) {
+	vectors := []*Vector{}
+	err := adapter.engine.Omit("embedding").Asc("index").Find(&vectors, &Vector{Owner: owner, Store: store, Provider: provider, File: file})
+	if err != nil {
+		return vectors, err
 	}
+
+	return vectors, nil
 }
 
 func GetVector(id string) (*Vector, error) {
@@ -117,6 +132,7 @@ func UpdateVector(id string, vector *Vector, lang string) (bool, error) {
 	if oldVector.Text != vector.Text {
 		if vector.Text == "" {
 			vector.Data = []float32{}
+			vector.Dimension = 0
 		} else {
 			_, err = refreshVector(vector, lang)
 			if err != nil {
@@ -125,38 +141,59 @@ func UpdateVector(id string, vector *Vector, lang string) (bool, error) {
 		}
 	}
 
-	_, err = adapter.engine.ID(core.PK{owner, name}).AllCols().Update(vector)
+	store, vectorStore, err := getVectorStoreByVector(vector, lang)
 	if err != nil {
 		return false, err
 	}
 
-	// return affected != 0
-	return true, nil
+	affected, err := vectorStore.UpdateVector(id, vector)
+	if err != nil {
+		return false, err
+	}
+
+	return affected, updateKnowledgeVersion(store, affected)
 }
 
-func AddVector(vector *Vector) (bool, error) {
-	//err := Index.Add(util.GetId(vector.Owner, vector.Name), vector.Data)
-	//if err != nil {
-	//	return false, err
-	//}
+func AddVector(vector *Vector, lang string) (bool, error) {
+	store, vectorStore, err := getVectorStoreByVector(vector, lang)
+	if err != nil {
+		return false, err
+	}
 
-	affected, err := adapter.engine.Insert(vector)
+	affected, err := vectorStore.AddVector(vector)
 	if err != nil {
 		return false, err
 	}
 
-	return affected != 0, nil
+	return affected, updateKnowledgeVersion(store, affected)
 }
 
-func DeleteVector(vector *Vector) (bool, error) {
-	affected, err := adapter.engine.ID(core.PK{vector.Owner, vector.Name}).Delete(&Vector{})
+func updateVectorCols(vector *Vector, cols ...string) (bool, error) {
+	affected, err := adapter.engine.ID(core.PK{vector.Owner, vector.Name}).Cols(cols...).Update(vector)
 	if err != nil {
 		return false, err
 	}
 
+	vectorIndexManager.removeVector(vector.GetId())
+	vectorIndexManager.addVector(vector)
+
 	return affected != 0, nil
 }
 
+func DeleteVector(vector *Vector, lang string) (bool, error) {
+	store, vectorStore, err := getVectorStoreByVector(vector, lang)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := vectorStore.DeleteVector(vector)
+	if err != nil {
+		return false, err
+	}
+
+	return affected, updateKnowledgeVersion(store, affected)
+}
+
 func (vector *Vector) GetId() string {
 	return fmt.Sprintf("%s/%s", vector.Owner, vector.Name)
 }
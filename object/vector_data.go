@@ -0,0 +1,127 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/beego/beego/logs"
+)
+
+// vectorDataMigrationBatchSize is how many legacy rows are converted per query.
+const vectorDataMigrationBatchSize = 100
+
+// VectorData is the embedding of a vector. It is stored in the database as a
+// blob of little-endian float32 values, 4 bytes per dimension, instead of the
+// JSON text of the numbers, and stays a JSON array in the API.
+type VectorData []float32
+
+func (data VectorData) ToDB() ([]byte, error) {
+	res := make([]byte, len(data)*4)
+	for i, f := range data {
+		binary.LittleEndian.PutUint32(res[i*4:], math.Float32bits(f))
+	}
+	return res, nil
+}
+
+func (data *VectorData) FromDB(b []byte) error {
+	if len(b)%4 != 0 {
+		return fmt.Errorf("the vector data length: [%d] should be a multiple of 4", len(b))
+	}
+
+	res := make(VectorData, len(b)/4)
+	for i := range res {
+		res[i] = math.Float32frombits(binary.LittleEndian.Uint32(b[i*4:]))
+	}
+	*data = res
+	return nil
+}
+
+// parseLegacyVectorData reads the JSON array that was stored in the former
+// "data" column.
+func parseLegacyVectorData(text string) (VectorData, error) {
+	res := VectorData{}
+	if strings.TrimSpace(text) == "" {
+		return res, nil
+	}
+
+	err := json.Unmarshal([]byte(text), &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type legacyVectorData struct {
+	Owner string
+	Name  string
+	Data  string
+}
+
+// migrateVectorData converts the vectors still kept as JSON text in the former
+// "data" column to the "embedding" blob column, and empties the text so that a
+// converted row is never read again. It runs at startup and can be interrupted,
+// the rows left are converted on the next start.
+func migrateVectorData() error {
+	exists, err := adapter.engine.Dialect().IsColumnExist(adapter.engine.DB(), context.Background(), "vector", "data")
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	count := 0
+	for {
+		rows := []*legacyVectorData{}
+		err = adapter.engine.Table("vector").Cols("owner", "name", "data").Where("data is not null and data != ''").Limit(vectorDataMigrationBatchSize).Find(&rows)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			break
+		}
+
+		for _, row := range rows {
+			data, err := parseLegacyVectorData(row.Data)
+			if err != nil {
+				logs.Warn("Failed to parse the legacy data of vector: [%s/%s]: %s", row.Owner, row.Name, err.Error())
+				data = VectorData{}
+			}
+
+			blob, err := data.ToDB()
+			if err != nil {
+				return err
+			}
+
+			_, err = adapter.engine.Table("vector").Where("owner = ? and name = ?", row.Owner, row.Name).Update(map[string]interface{}{"embedding": blob, "data": nil})
+			if err != nil {
+				return err
+			}
+		}
+
+		count += len(rows)
+	}
+
+	if count != 0 {
+		logs.Info("Migrated the data of %d vectors to the binary format", count)
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"sync"
+
+	"github.com/beego/beego/logs"
+)
+
+// vectorDeduplicator links each new vector of a store that repeats an existing
+// one to the canonical vector of its group, the first vector with that content.
+// A vector repeats another when their texts are the same, or when the
+// similarity of their embeddings reaches the duplicate threshold of the store.
+// The duplicates are kept, so that every file still has all its chunks, and
+// are collapsed in the search results.
+type vectorDeduplicator struct {
+	store       *Store
+	vectorStore VectorStore
+	provider    string
+	threshold   float32
+
+	hashMap map[string]*Vector // text hash -> canonical vector
+
+	mu sync.Mutex
+}
+
+func newVectorDeduplicator(store *Store, vectorStore VectorStore, provider string, vectors []*Vector) *vectorDeduplicator {
+	d := &vectorDeduplicator{
+		store:       store,
+		vectorStore: vectorStore,
+		provider:    provider,
+		threshold:   float32(store.DuplicateThreshold),
+		hashMap:     map[string]*Vector{},
+	}
+
+	for _, vector := range vectors {
+		if vector.Provider == provider && vector.Canonical == "" && vector.TextHash != "" && d.hashMap[vector.TextHash] == nil {
+			d.hashMap[vector.TextHash] = vector
+		}
+	}
+	return d
+}
+
+// getCanonicalName returns the name shared by a vector and its duplicates.
+func getCanonicalName(vector *Vector) string {
+	if vector.Canonical != "" {
+		return vector.Canonical
+	}
+	return vector.Name
+}
+
+// getExactCanonical returns the canonical vector with the same text, if its
+// embedding can be copied instead of being requested again.
+func (d *vectorDeduplicator) getExactCanonical(textHash string) *Vector {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	canonical := d.hashMap[textHash]
+	if canonical == nil || len(canonical.Data) == 0 {
+		return nil
+	}
+	return canonical
+}
+
+// getNearCanonical returns the name of the canonical vector of the most similar
+// vector of the store, when it is similar enough to be a duplicate.
+func (d *vectorDeduplicator) getNearCanonical(vector *Vector, oldVector *Vector) (string, error) {
+	if d.threshold <= 0 || len(vector.Data) == 0 {
+		return "", nil
+	}
+
+	nearestVectors, err := searchVectorStores([]*Store{d.store}, []VectorStore{d.vectorStore}, d.provider, vector.Data, 1, func(v *Vector) bool {
+		return oldVector == nil || v.Name != oldVector.Name
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(nearestVectors) == 0 || nearestVectors[0].Score < d.threshold {
+		return "", nil
+	}
+
+	return getCanonicalName(&nearestVectors[0]), nil
+}
+
+// getHashCanonical returns the canonical vector with the same text, other
+// than the old vector that the vector replaces. The lock must be held.
+func (d *vectorDeduplicator) getHashCanonical(vector *Vector, oldVector *Vector) *Vector {
+	canonical := d.hashMap[vector.TextHash]
+	if canonical == nil || (oldVector != nil && canonical.Name == oldVector.Name) {
+		return nil
+	}
+	return canonical
+}
+
+// add links the vector to its canonical vector and adds it in place of the old
+// vector of the same file index. The lock is only held for the text hashes, a
+// vector without a canonical vector claims its hash before it is written, so
+// that two copies embedded at the same time are still linked.
+func (d *vectorDeduplicator) add(vector *Vector, oldVector *Vector) error {
+	d.mu.Lock()
+	canonical := d.getHashCanonical(vector, oldVector)
+	d.mu.Unlock()
+
+	if canonical != nil {
+		vector.Canonical = canonical.Name
+	} else {
+		canonicalName, err := d.getNearCanonical(vector, oldVector)
+		if err != nil {
+			return err
+		}
+		vector.Canonical = canonicalName
+	}
+
+	d.mu.Lock()
+	if vector.Canonical == "" {
+		// Another copy may have claimed the hash during the search
+		if canonical = d.getHashCanonical(vector, oldVector); canonical != nil {
+			vector.Canonical = canonical.Name
+		} else {
+			d.hashMap[vector.TextHash] = vector
+		}
+	}
+	d.mu.Unlock()
+
+	_, err := d.vectorStore.AddVector(vector)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if err != nil {
+		if d.hashMap[vector.TextHash] == vector {
+			delete(d.hashMap, vector.TextHash)
+		}
+		return err
+	}
+
+	if oldVector != nil && d.hashMap[oldVector.TextHash] == oldVector {
+		delete(d.hashMap, oldVector.TextHash)
+	}
+	return nil
+}
+
+// getOrphanVectors relinks the duplicates whose canonical vector was deleted:
+// the first of them becomes the canonical vector of the others. It returns the
+// vectors that changed.
+func getOrphanVectors(vectors []*Vector) []*Vector {
+	nameMap := map[string]bool{}
+	for _, vector := range vectors {
+		nameMap[vector.Name] = true
+	}
+
+	res := []*Vector{}
+	canonicalMap := map[string]string{} // deleted canonical name -> new canonical name
+	for _, vector := range vectors {
+		if vector.Canonical == "" || nameMap[vector.Canonical] {
+			continue
+		}
+
+		if name, ok := canonicalMap[vector.Canonical]; ok {
+			vector.Canonical = name
+		} else {
+			canonicalMap[vector.Canonical] = vector.Name
+			vector.Canonical = ""
+		}
+		res = append(res, vector)
+	}
+	return res
+}
+
+func relinkOrphanVectors(storeName string) error {
+	vectors, err := getVectorsByStore("admin", storeName)
+	if err != nil {
+		return err
+	}
+
+	orphanVectors := getOrphanVectors(vectors)
+	for _, vector := range orphanVectors {
+		_, err = updateVectorCols(vector, "canonical")
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(orphanVectors) != 0 {
+		logs.Info("Relinked %d duplicate vectors for store: [%s]", len(orphanVectors), storeName)
+	}
+	return nil
+}
+
+// collapseDuplicateVectors keeps the first vector of each group of duplicates:
+// the vectors linked to the same canonical vector or with the same text. The
+// vectors are expected to be sorted by relevance.
+func collapseDuplicateVectors(vectors []Vector) []Vector {
+	res := []Vector{}
+	keyMap := map[string]bool{}
+	for _, vector := range vectors {
+		textHash := vector.TextHash
+		if textHash == "" {
+			textHash = getTextHash(vector.Text)
+		}
+
+		nameKey := "name:" + getCanonicalName(&vector)
+		textKey := "text:" + textHash
+		if keyMap[nameKey] || keyMap[textKey] {
+			continue
+		}
+
+		keyMap[nameKey] = true
+		keyMap[textKey] = true
+		res = append(res, vector)
+	}
+	return res
+}
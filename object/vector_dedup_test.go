@@ -0,0 +1,67 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package object
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCollapseDuplicateVectors(t *testing.T) {
+	vectors := []Vector{
+		{Name: "v1", Text: "Refunds are accepted within 30 days.", TextHash: getTextHash("Refunds are accepted within 30 days.")},
+		{Name: "v2", Text: "Refunds are accepted within 30 days.", TextHash: getTextHash("Refunds are accepted within 30 days."), Canonical: "v1"},
+		{Name: "v3", Text: "Refunds are accepted within thirty days.", Canonical: "v1"},
+		{Name: "v4", Text: "Shipping is free above $50."},
+		{Name: "v5", Text: "Shipping is free above $50."},
+		{Name: "v6", Text: "Returns need a receipt.", Canonical: "v0"},
+		{Name: "v7", Text: "Returns need the receipt.", Canonical: "v0"},
+	}
+
+	res := collapseDuplicateVectors(vectors)
+	names := []string{}
+	for _, vector := range res {
+		names = append(names, vector.Name)
+	}
+	if fmt.Sprint(names) != "[v1 v4 v6]" {
+		t.Errorf("collapseDuplicateVectors() = %v, want [v1 v4 v6]", names)
+	}
+}
+
+func TestGetOrphanVectors(t *testing.T) {
+	vectors := []*Vector{
+		{Name: "v1"},
+		{Name: "v2", Canonical: "v1"},
+		{Name: "v3", Canonical: "v0"},
+		{Name: "v4", Canonical: "v0"},
+		{Name: "v5", Canonical: "v0"},
+		{Name: "v6", Canonical: "v9"},
+	}
+
+	res := getOrphanVectors(vectors)
+	if len(res) != 4 {
+		t.Fatalf("getOrphanVectors() returned %d vectors, want 4", len(res))
+	}
+
+	expected := []string{"", "v1", "", "v3", "v3", ""}
+	for i, vector := range vectors {
+		if vector.Canonical != expected[i] {
+			t.Errorf("the canonical vector of %s is %q, want %q", vector.Name, vector.Canonical, expected[i])
+		}
+	}
+}
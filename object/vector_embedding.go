@@ -16,20 +16,19 @@ package object
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
-	"strings"
+	"sort"
 	"time"
 
 	"github.com/beego/beego/logs"
 	"github.com/casibase/casibase/embedding"
 	"github.com/casibase/casibase/i18n"
 	"github.com/casibase/casibase/model"
-	"github.com/casibase/casibase/split"
 	"github.com/casibase/casibase/storage"
 	"github.com/casibase/casibase/txt"
 	"github.com/casibase/casibase/util"
-	"github.com/cenkalti/backoff/v4"
 )
 
 func filterTextFiles(files []*storage.Object) []*storage.Object {
@@ -49,12 +48,7 @@ func filterTextFiles(files []*storage.Object) []*storage.Object {
 	return res
 }
 
-func addEmbeddedVector(embeddingProviderObj embedding.EmbeddingProvider, text string, storeName string, fileName string, index int, embeddingProviderName string, modelSubType string, lang string) (bool, error) {
-	data, embeddingResult, err := queryVectorSafe(embeddingProviderObj, text, lang)
-	if err != nil {
-		return false, err
-	}
-
+func newEmbeddedVector(text string, data []float32, embeddingResult *embedding.EmbeddingResult, storeName string, fileName string, index int, metadata *VectorMetadata, embeddingProviderName string, modelSubType string) (*Vector, error) {
 	displayName := text
 	if len(text) > 25 {
 		displayName = string([]rune(text)[:25])
@@ -71,7 +65,7 @@ func addEmbeddedVector(embeddingProviderObj embedding.EmbeddingProvider, text st
 
 	defaultEmbeddingResult, err := embedding.GetDefaultEmbeddingResult(modelSubType, text)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
 	if tokenCount == 0 {
@@ -97,115 +91,277 @@ func addEmbeddedVector(embeddingProviderObj embedding.EmbeddingProvider, text st
 		TokenCount:  tokenCount,
 		Price:       price,
 		Currency:    currency,
+		Language:    util.DetectLanguage(text),
+		Date:        metadata.Date,
+		Tags:        metadata.Tags,
+		Metadata:    metadata.Metadata,
+		FileHash:    metadata.FileHash,
+		TextHash:    getTextHash(text),
 		Data:        data,
 		Dimension:   len(data),
 	}
-	return AddVector(vector)
+	return vector, nil
 }
 
-func addVectorsForStore(storageProviderObj storage.StorageProvider, embeddingProviderObj embedding.EmbeddingProvider, prefix string, storeName string, splitProviderName string, embeddingProviderName string, modelSubType string, lang string) (bool, error) {
-	var affected bool
+func addVectorsForStore(storageProviderObj storage.StorageProvider, embeddingProviderObj embedding.EmbeddingProvider, prefix string, store *Store, embeddingProvider *Provider, modelSubType string, ctx context.Context, lang string) (*VectorRefreshResult, error) {
+	res := &VectorRefreshResult{}
+	storeName := store.Name
 
 	files, err := storageProviderObj.ListObjects(prefix)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
 	files = filterTextFiles(files)
 
-	for _, file := range files {
-		var text string
-		fileExt := filepath.Ext(file.Key)
-		text, err = txt.GetParsedTextFromUrl(file.Url, fileExt, lang)
+	vectors, err := getVectorsByStore("admin", storeName)
+	if err != nil {
+		return nil, err
+	}
+
+	fileVectorsMap := groupVectorsByFile(vectors)
+
+	vectorStore, err := getVectorStoreByStore(store, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ingestion := &vectorIngestion{
+		store:                 store,
+		vectorStore:           vectorStore,
+		embeddingProviderObj:  embeddingProviderObj,
+		embeddingProviderName: embeddingProvider.Name,
+		modelSubType:          modelSubType,
+		limiter:               embedding.GetRateLimiter(embeddingProvider.GetId(), embeddingProvider.Type),
+		dedup:                 newVectorDeduplicator(store, vectorStore, embeddingProvider.Name, vectors),
+		ctx:                   ctx,
+		cancel:                cancel,
+		lang:                  lang,
+	}
+
+	err = ingestion.refreshFiles(files, fileVectorsMap, res)
+	if err != nil {
+		return nil, err
+	}
+
+	// The vectors of files removed from the storage are only known when the
+	// whole store was listed.
+	if prefix == "" {
+		fileMap := map[string]bool{}
+		for _, file := range files {
+			fileMap[file.Key] = true
+		}
+
+		for file, fileVectors := range fileVectorsMap {
+			if fileMap[file] {
+				continue
+			}
+
+			logs.Info("Deleting embeddings for store: [%s], file: [%s]: %s\n", storeName, file, "Removed from storage")
+			for _, vector := range fileVectors {
+				_, err = vectorStore.DeleteVector(vector)
+				if err != nil {
+					return nil, err
+				}
+				res.Deleted++
+			}
+		}
+	}
+
+	err = relinkOrphanVectors(storeName)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Added != 0 || res.Updated != 0 || res.Deleted != 0 {
+		err = invalidateAnswerCache(store)
 		if err != nil {
-			return false, err
+			return nil, err
 		}
+	}
 
-		splitProviderType := splitProviderName
-		if splitProviderType == "" {
-			splitProviderType = "Default"
+	return res, nil
+}
+
+func getUniqueStores(relatedStores []*Store) []*Store {
+	res := []*Store{}
+	storeMap := map[string]bool{}
+	for _, store := range relatedStores {
+		if storeMap[store.Name] {
+			continue
 		}
 
-		if strings.HasPrefix(file.Key, "QA") && fileExt == ".docx" {
-			splitProviderType = "QA"
+		storeMap[store.Name] = true
+		res = append(res, store)
+	}
+	return res
+}
+
+// getRelatedStores returns the store and the stores whose vectors it searches
+// too, the vector stores that are not found are skipped.
+func getRelatedStores(store *Store) ([]*Store, error) {
+	res := []*Store{store}
+	for _, name := range store.VectorStores {
+		if name == store.Name {
+			continue
 		}
 
-		if fileExt == ".md" {
-			splitProviderType = "Markdown"
+		vectorStore, err := getStore(store.Owner, name)
+		if err != nil {
+			return nil, err
+		}
+		if vectorStore != nil {
+			res = append(res, vectorStore)
 		}
-		var splitProvider split.SplitProvider
-		splitProvider, err = split.GetSplitProvider(splitProviderType)
+	}
+	return res, nil
+}
+
+// getRelatedVectors returns the vectors shared with the in-memory vector indexes,
+// callers must copy a vector before modifying it.
+func getRelatedVectors(relatedStores []*Store, provider string) ([]*Vector, error) {
+	vectors := []*Vector{}
+	for _, store := range getUniqueStores(relatedStores) {
+		index, err := vectorIndexManager.getIndex(store.Name, provider)
 		if err != nil {
-			return false, err
+			return nil, err
 		}
 
-		var textSections []string
-		textSections, err = splitProvider.SplitText(text)
+		vectors = append(vectors, index.getVectors()...)
+	}
+	if len(vectors) == 0 {
+		return nil, ErrNoKnowledgeVectors
+	}
+
+	return vectors, nil
+}
+
+func getRelatedVectorCount(relatedStores []*Store, provider string) (int, error) {
+	res := 0
+	for _, store := range getUniqueStores(relatedStores) {
+		index, err := vectorIndexManager.getIndex(store.Name, provider)
 		if err != nil {
-			return false, err
+			return 0, err
 		}
 
-		for i, textSection := range textSections {
-			var vector *Vector
-			vector, err = getVectorByIndex("admin", storeName, file.Key, i)
-			if err != nil {
-				return false, err
-			}
+		res += index.count()
+	}
+	return res, nil
+}
 
-			if vector != nil {
-				logs.Info("[%d/%d] Generating embedding for store: [%s], file: [%s], index: [%d]: %s\n", i+1, len(textSections), storeName, file.Key, i, "Skipped due to already exists")
-				continue
-			}
+// searchRelatedVectors returns the n vectors of the related stores that are most
+// similar to the target, using the approximate index of each store when it has one.
+// Duplicates are collapsed, so the search is repeated with a larger limit until
+// n distinct vectors are found or the stores are exhausted.
+func searchRelatedVectors(relatedStores []*Store, provider string, target []float32, n int, filter func(*Vector) bool, lang string) ([]Vector, error) {
+	stores := getUniqueStores(relatedStores)
+	vectorStores := []VectorStore{}
+	for _, store := range stores {
+		vectorStore, err := getVectorStoreByStore(store, lang)
+		if err != nil {
+			return nil, err
+		}
 
-			logs.Info("[%d/%d] Generating embedding for store: [%s], file: [%s], index: [%d]: %s\n", i+1, len(textSections), storeName, file.Key, i, textSection)
+		vectorStores = append(vectorStores, vectorStore)
+	}
 
-			operation := func() error {
-				affected, err = addEmbeddedVector(embeddingProviderObj, textSection, storeName, file.Key, i, embeddingProviderName, modelSubType, lang)
-				if err != nil {
-					if isRetryableError(err) {
-						return err
-					}
-					return backoff.Permanent(err)
-				}
-				return nil
-			}
-			err = backoff.Retry(operation, backoff.NewExponentialBackOff())
+	return searchVectorStores(stores, vectorStores, provider, target, n, filter)
+}
+
+// searchVectorStores is searchRelatedVectors with the vector store of each
+// store already resolved.
+func searchVectorStores(stores []*Store, vectorStores []VectorStore, provider string, target []float32, n int, filter func(*Vector) bool) ([]Vector, error) {
+	limit := n
+	for {
+		res := []Vector{}
+		exhausted := true
+		for i, store := range stores {
+			vectors, err := vectorStores[i].SearchVectors(store, provider, target, limit, filter)
 			if err != nil {
-				logs.Error("Failed to generate embedding after retries: %v\n", err)
-				return false, err
+				return nil, err
+			}
+
+			if len(vectors) >= limit {
+				exhausted = false
 			}
+			res = append(res, vectors...)
 		}
-	}
 
-	return affected, err
-}
+		sort.SliceStable(res, func(i, j int) bool {
+			return res[i].Score > res[j].Score
+		})
 
-func getRelatedVectors(relatedStores []string, provider string) ([]*Vector, error) {
-	vectors, err := getVectorsByProvider(relatedStores, provider)
-	if err != nil {
-		return nil, err
-	}
-	if len(vectors) == 0 {
-		return nil, fmt.Errorf("no knowledge vectors found")
+		res = collapseDuplicateVectors(res)
+		if len(res) >= n || exhausted || limit <= 0 {
+			if n < len(res) {
+				res = res[:n]
+			}
+			return res, nil
+		}
+		limit *= 4
 	}
-
-	return vectors, nil
 }
 
-func queryVectorWithContext(embeddingProvider embedding.EmbeddingProvider, text string, timeout int, lang string) ([]float32, *embedding.EmbeddingResult, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(30+timeout*2)*time.Second)
+func queryVectorWithContext(embeddingProvider embedding.EmbeddingProvider, text string, timeout int, ctx context.Context, lang string) ([]float32, *embedding.EmbeddingResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(30+timeout*2)*time.Second)
 	defer cancel()
 	vector, embeddingResult, err := embeddingProvider.QueryVector(text, ctx, lang)
 	return vector, embeddingResult, err
 }
 
-func queryVectorSafe(embeddingProvider embedding.EmbeddingProvider, text string, lang string) ([]float32, *embedding.EmbeddingResult, error) {
+// queryVectorsSafe is the batch version of queryVectorSafe, the timeout also
+// grows with the number of texts.
+func queryVectorsSafe(embeddingProvider embedding.EmbeddingProvider, texts []string, ctx context.Context, lang string) ([][]float32, *embedding.EmbeddingResult, error) {
+	var res [][]float32
+	var embeddingResult *embedding.EmbeddingResult
+	var err error
+	for i := 0; i < 10; i++ {
+		timeoutCtx, cancel := context.WithTimeout(ctx, time.Duration(30+i*2+len(texts)/10)*time.Second)
+		res, embeddingResult, err = embedding.QueryVectors(embeddingProvider, texts, timeoutCtx, lang)
+		timedOut := timeoutCtx.Err() != nil
+		cancel()
+		if err != nil {
+			// No retry once the answer is stopped.
+			if ctx.Err() != nil {
+				return nil, nil, err
+			}
+
+			err = fmt.Errorf(i18n.Translate(lang, "object:queryVectorSafe() error, %s"), err.Error())
+			if i > 0 {
+				logs.Error("\tFailed (%d): %s\n", i+1, err.Error())
+			}
+			// Only a timeout is tried again here with a longer timeout, a rate limit
+			// is left to the backoff of the caller and any other error is final.
+			if !timedOut {
+				return nil, nil, err
+			}
+		} else {
+			break
+		}
+	}
+
+	if err != nil {
+		return nil, nil, err
+	} else {
+		return res, embeddingResult, nil
+	}
+}
+
+func queryVectorSafe(embeddingProvider embedding.EmbeddingProvider, text string, ctx context.Context, lang string) ([]float32, *embedding.EmbeddingResult, error) {
 	var res []float32
 	var embeddingResult *embedding.EmbeddingResult
 	var err error
 	for i := 0; i < 10; i++ {
-		res, embeddingResult, err = queryVectorWithContext(embeddingProvider, text, i, lang)
+		res, embeddingResult, err = queryVectorWithContext(embeddingProvider, text, i, ctx, lang)
 		if err != nil {
+			// No retry once the answer is stopped.
+			if ctx.Err() != nil {
+				return nil, nil, err
+			}
+
 			err = fmt.Errorf(i18n.Translate(lang, "object:queryVectorSafe() error, %s"), err.Error())
 			if i > 0 {
 				logs.Error("\tFailed (%d): %s\n", i+1, err.Error())
@@ -222,33 +378,79 @@ func queryVectorSafe(embeddingProvider embedding.EmbeddingProvider, text string,
 	}
 }
 
-func GetNearestKnowledge(storeName string, vectorStores []string, searchProviderType string, embeddingProvider *Provider, embeddingProviderObj embedding.EmbeddingProvider, modelProvider *Provider, owner string, text string, knowledgeCount int, lang string) ([]*model.RawMessage, []VectorScore, *embedding.EmbeddingResult, error) {
-	searchProvider, err := GetSearchProvider(searchProviderType, owner)
+func GetNearestKnowledge(store *Store, embeddingProvider *Provider, embeddingProviderObj embedding.EmbeddingProvider, modelProvider *Provider, owner string, text string, knowledgeCount int, filter *VectorFilter, ctx context.Context, lang string) ([]*model.RawMessage, []MessageSource, *embedding.EmbeddingResult, error) {
+	searchProvider, err := GetSearchProvider(store.SearchProvider, owner, store.QueryCount)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if store.CrossLingualSearch != "" {
+		searchProvider, err = NewCrossLingualSearchProvider(searchProvider, store.CrossLingualSearch)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	_, rerankProviderObj, err := getRerankProviderFromName(owner, store.RerankProvider, lang)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	candidateCount := knowledgeCount
+	if rerankProviderObj != nil {
+		candidateCount = getRerankCandidateCount(knowledgeCount)
+	}
+
+	relatedStores, err := getRelatedStores(store)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
-	relatedStores := append(vectorStores, storeName)
-	vectors, embeddingResult, err := searchProvider.Search(relatedStores, embeddingProvider.Name, embeddingProviderObj, modelProvider.Name, text, knowledgeCount, lang)
+	vectors, embeddingResult, err := searchProvider.Search(relatedStores, embeddingProvider.Name, embeddingProviderObj, modelProvider.Name, text, candidateCount, filter, ctx, lang)
 	if err != nil {
-		if err.Error() == "no knowledge vectors found" {
+		if errors.Is(err, ErrNoKnowledgeVectors) {
 			return nil, nil, embeddingResult, err
 		} else {
 			return nil, nil, nil, err
 		}
 	}
 
-	vectorScores := []VectorScore{}
+	// The search providers that merge several result lists may return the same
+	// text more than once.
+	vectors = collapseDuplicateVectors(vectors)
+
+	if rerankProviderObj != nil {
+		vectors, embeddingResult = rerankVectors(rerankProviderObj, vectors, text, knowledgeCount, embeddingResult, ctx, lang)
+	}
+
+	sources := getMessageSources(vectors)
+	expansion := newContextExpansion(store)
+	if expansion != nil {
+		vectors, err = expansion.expand(vectors)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		// The sources keep the snippets of the matched chunks, but follow the
+		// vectors left after the expansion.
+		sourceMap := map[string]MessageSource{}
+		for _, source := range sources {
+			sourceMap[source.Vector] = source
+		}
+
+		sources = []MessageSource{}
+		for i, vector := range vectors {
+			source := sourceMap[vector.Name]
+			source.Index = i + 1
+			sources = append(sources, source)
+		}
+	}
+
 	knowledge := []*model.RawMessage{}
 	for _, vector := range vectors {
 		// if embeddingProvider.Name != vector.Provider {
 		//	return "", nil, fmt.Errorf(i18n.Translate(lang, "object:The store's embedding provider: [%s] should equal to vector's embedding provider: [%s], vector = %v"), embeddingProvider.Name, vector.Provider, vector)
 		// }
 
-		vectorScores = append(vectorScores, VectorScore{
-			Vector: vector.Name,
-			Score:  vector.Score,
-		})
 		knowledge = append(knowledge, &model.RawMessage{
 			Text:           vector.Text,
 			Author:         "System",
@@ -256,5 +458,5 @@ func GetNearestKnowledge(storeName string, vectorStores []string, searchProvider
 		})
 	}
 
-	return knowledge, vectorScores, embeddingResult, nil
+	return knowledge, sources, embeddingResult, nil
 }
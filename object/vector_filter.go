@@ -0,0 +1,328 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/casibase/casibase/i18n"
+)
+
+// VectorFilter is a parsed metadata filter expression, for example:
+//
+//	file ^= "product-a/" AND (tags = "billing" OR language = "zh") AND date >= "2025"
+//
+// Fields are "file", "store", "language", "date", "tags" and "index", any other
+// field (optionally written as "metadata.key") is looked up in Vector.Metadata.
+// Operators are =, !=, >, >=, <, <=, ^= (prefix) and ~= (substring). For "tags",
+// = and != test whether the tag list contains the value. Values are compared
+// as strings, so dates must be written as "2006-01-02" prefixes.
+type VectorFilter struct {
+	Text string
+
+	access   *FileAccess // the files the asking user can read, nil for all
+	op       string      // "AND", "OR", "NOT" or a comparison operator
+	children []*VectorFilter
+	field    string
+	value    string
+}
+
+type vectorFilterParser struct {
+	tokens []string
+	pos    int
+	lang   string
+}
+
+var vectorFilterOperators = []string{">=", "<=", "!=", "^=", "~=", "=", ">", "<"}
+
+func tokenizeVectorFilter(text string, lang string) ([]string, error) {
+	tokens := []string{}
+	runes := []rune(text)
+	i := 0
+	for i < len(runes) {
+		r := runes[i]
+		if unicode.IsSpace(r) {
+			i++
+			continue
+		}
+
+		if r == '(' || r == ')' {
+			tokens = append(tokens, string(r))
+			i++
+			continue
+		}
+
+		if r == '"' || r == '\'' {
+			j := i + 1
+			for j < len(runes) && runes[j] != r {
+				j++
+			}
+			if j == len(runes) {
+				return nil, fmt.Errorf(i18n.Translate(lang, "object:the filter has an unterminated string: %s"), string(runes[i:]))
+			}
+			// Quoted values keep their leading quote so that the parser can tell them from keywords.
+			tokens = append(tokens, "\""+string(runes[i+1:j]))
+			i = j + 1
+			continue
+		}
+
+		operator := ""
+		for _, op := range vectorFilterOperators {
+			if strings.HasPrefix(string(runes[i:]), op) {
+				operator = op
+				break
+			}
+		}
+		if operator != "" {
+			tokens = append(tokens, operator)
+			i += len([]rune(operator))
+			continue
+		}
+
+		j := i
+		for j < len(runes) && !unicode.IsSpace(runes[j]) && !strings.ContainsRune("()\"'=!<>^~", runes[j]) {
+			j++
+		}
+		if j == i {
+			return nil, fmt.Errorf(i18n.Translate(lang, "object:the filter has an unexpected character: %s"), string(r))
+		}
+		tokens = append(tokens, string(runes[i:j]))
+		i = j
+	}
+	return tokens, nil
+}
+
+// ParseVectorFilter parses a filter expression, an empty expression gives a nil filter that matches everything.
+func ParseVectorFilter(text string, lang string) (*VectorFilter, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, nil
+	}
+
+	tokens, err := tokenizeVectorFilter(text, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &vectorFilterParser{tokens: tokens, lang: lang}
+	res, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if parser.pos != len(parser.tokens) {
+		return nil, fmt.Errorf(i18n.Translate(lang, "object:the filter has an unexpected token: %s"), parser.tokens[parser.pos])
+	}
+
+	res.Text = text
+	return res, nil
+}
+
+func (p *vectorFilterParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *vectorFilterParser) isKeyword(keyword string) bool {
+	return strings.EqualFold(p.peek(), keyword)
+}
+
+func (p *vectorFilterParser) parseOr() (*VectorFilter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isKeyword("OR") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &VectorFilter{op: "OR", children: []*VectorFilter{left, right}}
+	}
+	return left, nil
+}
+
+func (p *vectorFilterParser) parseAnd() (*VectorFilter, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isKeyword("AND") {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &VectorFilter{op: "AND", children: []*VectorFilter{left, right}}
+	}
+	return left, nil
+}
+
+func (p *vectorFilterParser) parseUnary() (*VectorFilter, error) {
+	if p.isKeyword("NOT") {
+		p.pos++
+		child, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &VectorFilter{op: "NOT", children: []*VectorFilter{child}}, nil
+	}
+
+	if p.peek() == "(" {
+		p.pos++
+		res, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, fmt.Errorf(i18n.Translate(p.lang, "object:the filter is missing a closing parenthesis"))
+		}
+		p.pos++
+		return res, nil
+	}
+
+	return p.parseCondition()
+}
+
+func (p *vectorFilterParser) parseCondition() (*VectorFilter, error) {
+	if p.pos+3 > len(p.tokens) {
+		return nil, fmt.Errorf(i18n.Translate(p.lang, "object:the filter has an incomplete condition at: %s"), strings.Join(p.tokens[p.pos:], " "))
+	}
+
+	field, op, value := p.tokens[p.pos], p.tokens[p.pos+1], p.tokens[p.pos+2]
+	if strings.HasPrefix(field, "\"") || field == "(" || field == ")" {
+		return nil, fmt.Errorf(i18n.Translate(p.lang, "object:the filter has an unexpected token: %s"), field)
+	}
+
+	isOperator := false
+	for _, operator := range vectorFilterOperators {
+		if op == operator {
+			isOperator = true
+			break
+		}
+	}
+	if !isOperator {
+		return nil, fmt.Errorf(i18n.Translate(p.lang, "object:the filter has an unknown operator: %s"), op)
+	}
+
+	if value == "(" || value == ")" {
+		return nil, fmt.Errorf(i18n.Translate(p.lang, "object:the filter has an unexpected token: %s"), value)
+	}
+
+	p.pos += 3
+	return &VectorFilter{op: op, field: strings.ToLower(field), value: strings.TrimPrefix(value, "\"")}, nil
+}
+
+func (vector *Vector) getFilterFieldValues(field string) []string {
+	switch field {
+	case "file":
+		return []string{vector.File}
+	case "store":
+		return []string{vector.Store}
+	case "language":
+		return []string{vector.Language}
+	case "date":
+		return []string{vector.Date}
+	case "index":
+		return []string{fmt.Sprintf("%d", vector.Index)}
+	case "tags", "tag":
+		return vector.Tags
+	}
+
+	key := strings.TrimPrefix(field, "metadata.")
+	for k, v := range vector.Metadata {
+		if strings.EqualFold(k, key) {
+			return []string{v}
+		}
+	}
+	return []string{""}
+}
+
+func compareFilterValue(op string, actual string, expected string) bool {
+	switch op {
+	case "=":
+		return strings.EqualFold(actual, expected)
+	case "!=":
+		return !strings.EqualFold(actual, expected)
+	case ">":
+		return actual > expected
+	case ">=":
+		return actual >= expected
+	case "<":
+		return actual < expected
+	case "<=":
+		return actual <= expected
+	case "^=":
+		return strings.HasPrefix(actual, expected)
+	case "~=":
+		return strings.Contains(strings.ToLower(actual), strings.ToLower(expected))
+	}
+	return false
+}
+
+// WithAccess returns the filter that also drops the vectors of the files the
+// access cannot read, the search providers then never return them.
+func (filter *VectorFilter) WithAccess(access *FileAccess) *VectorFilter {
+	res := &VectorFilter{}
+	if filter != nil {
+		*res = *filter
+	}
+	res.access = access
+	return res
+}
+
+// Match reports whether the vector satisfies the filter, a nil filter matches every vector.
+func (filter *VectorFilter) Match(vector *Vector) bool {
+	if filter == nil {
+		return true
+	}
+
+	if !filter.access.CanRead(vector.Store, vector.File) {
+		return false
+	}
+
+	switch filter.op {
+	case "":
+		return true
+	case "AND":
+		return filter.children[0].Match(vector) && filter.children[1].Match(vector)
+	case "OR":
+		return filter.children[0].Match(vector) || filter.children[1].Match(vector)
+	case "NOT":
+		return !filter.children[0].Match(vector)
+	}
+
+	values := vector.getFilterFieldValues(filter.field)
+	if filter.op == "!=" {
+		for _, value := range values {
+			if strings.EqualFold(value, filter.value) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, value := range values {
+		if compareFilterValue(filter.op, value, filter.value) {
+			return true
+		}
+	}
+	return false
+}
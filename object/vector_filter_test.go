@@ -0,0 +1,63 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package object
+
+import "testing"
+
+func TestVectorFilter(t *testing.T) {
+	vector := &Vector{
+		Store:    "docs",
+		File:     "product-a/manuals/setup.md",
+		Language: "zh",
+		Date:     "2025-03-01",
+		Tags:     []string{"product-a", "manuals"},
+		Metadata: map[string]string{"subject": "Billing"},
+	}
+
+	cases := []struct {
+		text     string
+		expected bool
+	}{
+		{``, true},
+		{`file ^= "product-a/"`, true},
+		{`file ^= "product-b/"`, false},
+		{`tags = manuals AND language = zh`, true},
+		{`tags != manuals`, false},
+		{`date >= "2025" AND date < "2026"`, true},
+		{`date > "2025-06-01" OR metadata.subject = billing`, true},
+		{`NOT (language = "en" OR subject ~= "bill")`, false},
+		{`file ~= "SETUP"`, true},
+	}
+
+	for _, c := range cases {
+		filter, err := ParseVectorFilter(c.text, "en")
+		if err != nil {
+			t.Fatalf("ParseVectorFilter(%q) error: %v", c.text, err)
+		}
+		if filter.Match(vector) != c.expected {
+			t.Errorf("ParseVectorFilter(%q).Match() = %v, want %v", c.text, !c.expected, c.expected)
+		}
+	}
+
+	for _, text := range []string{`file ^=`, `(language = zh`, `language zh`, `file = "abc`, `language = zh extra`} {
+		_, err := ParseVectorFilter(text, "en")
+		if err == nil {
+			t.Errorf("ParseVectorFilter(%q) should fail", text)
+		}
+	}
+}
@@ -0,0 +1,365 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/beego/beego/logs"
+)
+
+const (
+	// Stores with fewer vectors than this are searched exactly, which is both
+	// fast enough and free of recall loss.
+	vectorIndexMinCount = 1000
+)
+
+// VectorIndex keeps the vectors of one store and one embedding provider in
+// memory, together with an HNSW graph once the store is large enough and a
+// keyword index for the hybrid search.
+// It is loaded from the database on first use and kept in sync by AddVector,
+// UpdateVector and DeleteVector. The graph is built with the quantization of
+// the store, which is set by the searches.
+type VectorIndex struct {
+	store        string
+	provider     string
+	quantization string
+
+	vectors  map[string]*Vector // vector id -> vector
+	graph    *hnswGraph
+	keywords *keywordIndex
+	loaded   bool
+
+	mu sync.RWMutex
+}
+
+type VectorIndexManager struct {
+	indexes map[string]*VectorIndex // store/provider -> index
+
+	mu sync.Mutex
+}
+
+var vectorIndexManager = &VectorIndexManager{
+	indexes: map[string]*VectorIndex{},
+}
+
+func getVectorIndexKey(store string, provider string) string {
+	return fmt.Sprintf("%s/%s", store, provider)
+}
+
+func (m *VectorIndexManager) getIndex(store string, provider string) (*VectorIndex, error) {
+	key := getVectorIndexKey(store, provider)
+
+	m.mu.Lock()
+	index, ok := m.indexes[key]
+	if !ok {
+		index = &VectorIndex{store: store, provider: provider}
+		m.indexes[key] = index
+	}
+	m.mu.Unlock()
+
+	err := index.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
+func (m *VectorIndexManager) getIndexes() []*VectorIndex {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	res := []*VectorIndex{}
+	for _, index := range m.indexes {
+		res = append(res, index)
+	}
+	return res
+}
+
+func (m *VectorIndexManager) addVector(vector *Vector) {
+	m.mu.Lock()
+	index, ok := m.indexes[getVectorIndexKey(vector.Store, vector.Provider)]
+	m.mu.Unlock()
+
+	// An index that was never loaded will read the vector from the database
+	// when it is first searched.
+	if ok {
+		index.add(vector)
+	}
+}
+
+func (m *VectorIndexManager) removeVector(id string) {
+	for _, index := range m.getIndexes() {
+		index.remove(id)
+	}
+}
+
+func (index *VectorIndex) load() error {
+	index.mu.Lock()
+	defer index.mu.Unlock()
+
+	if index.loaded {
+		return nil
+	}
+
+	vectors, err := getVectorsByProvider([]string{index.store}, index.provider)
+	if err != nil {
+		return err
+	}
+
+	index.vectors = map[string]*Vector{}
+	index.keywords = newKeywordIndex()
+	for _, vector := range vectors {
+		index.vectors[vector.GetId()] = vector
+		index.keywords.add(vector.GetId(), vector.Text)
+	}
+	index.loaded = true
+
+	logs.Info("Loaded vector index for store: [%s], provider: [%s], vectors: [%d]", index.store, index.provider, len(index.vectors))
+	return nil
+}
+
+// setQuantization builds the graph with the quantization of the store, when it
+// is not built yet or was built with another one.
+func (index *VectorIndex) setQuantization(quantization string) {
+	if getRescoreFactor(quantization) == 0 {
+		quantization = ""
+	}
+
+	index.mu.RLock()
+	isBuilt := index.quantization == quantization && (index.graph != nil || len(index.vectors) < vectorIndexMinCount)
+	index.mu.RUnlock()
+	if isBuilt {
+		return
+	}
+
+	index.mu.Lock()
+	defer index.mu.Unlock()
+
+	if index.quantization == quantization && (index.graph != nil || len(index.vectors) < vectorIndexMinCount) {
+		return
+	}
+
+	index.quantization = quantization
+	index.rebuildGraph()
+}
+
+// rebuildGraph must be called with index.mu held.
+func (index *VectorIndex) rebuildGraph() {
+	index.graph = nil
+	if len(index.vectors) < vectorIndexMinCount {
+		return
+	}
+
+	dimension := 0
+	for _, vector := range index.vectors {
+		if len(vector.Data) != 0 {
+			dimension = len(vector.Data)
+			break
+		}
+	}
+	if dimension == 0 {
+		return
+	}
+
+	ids := make([]string, 0, len(index.vectors))
+	for id := range index.vectors {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	graph := newHnswGraph(dimension, index.quantization)
+	for _, id := range ids {
+		vector := index.vectors[id]
+		if !graph.insert(id, vector.Data) && len(vector.Data) != 0 {
+			logs.Warn("The vector: [%s]'s length: [%d] should equal to the index dimension: [%d]", id, len(vector.Data), dimension)
+		}
+	}
+	index.graph = graph
+}
+
+func (index *VectorIndex) add(vector *Vector) {
+	index.mu.Lock()
+	defer index.mu.Unlock()
+
+	if !index.loaded {
+		return
+	}
+
+	v := *vector
+	id := v.GetId()
+	index.vectors[id] = &v
+	index.keywords.add(id, v.Text)
+
+	if index.graph == nil {
+		if len(index.vectors) >= vectorIndexMinCount {
+			index.rebuildGraph()
+		}
+		return
+	}
+
+	if !index.graph.insert(id, v.Data) {
+		index.graph.remove(id)
+	}
+}
+
+func (index *VectorIndex) remove(id string) {
+	index.mu.Lock()
+	defer index.mu.Unlock()
+
+	if _, ok := index.vectors[id]; !ok {
+		return
+	}
+	delete(index.vectors, id)
+	index.keywords.remove(id)
+
+	if index.graph == nil {
+		return
+	}
+
+	index.graph.remove(id)
+	if len(index.vectors) < vectorIndexMinCount || index.graph.deletedCount > index.graph.liveCount() {
+		index.rebuildGraph()
+	}
+}
+
+func (index *VectorIndex) count() int {
+	index.mu.RLock()
+	defer index.mu.RUnlock()
+
+	return len(index.vectors)
+}
+
+func (index *VectorIndex) getVector(id string) *Vector {
+	index.mu.RLock()
+	defer index.mu.RUnlock()
+
+	return index.vectors[id]
+}
+
+func (index *VectorIndex) getVectors() []*Vector {
+	index.mu.RLock()
+	defer index.mu.RUnlock()
+
+	res := make([]*Vector, 0, len(index.vectors))
+	for _, vector := range index.vectors {
+		res = append(res, vector)
+	}
+	return res
+}
+
+func (index *VectorIndex) addKeywordStats(corpus *bm25Corpus, queryTerms []string) {
+	index.mu.RLock()
+	defer index.mu.RUnlock()
+
+	index.keywords.addStats(corpus, queryTerms)
+}
+
+// searchKeywords returns copies of the vectors that share a term with the query
+// and are accepted by filter, with their BM25 score within corpus set.
+func (index *VectorIndex) searchKeywords(corpus *bm25Corpus, queryTerms []string, filter func(*Vector) bool) []Vector {
+	index.mu.RLock()
+	defer index.mu.RUnlock()
+
+	res := []Vector{}
+	scores := index.keywords.search(corpus, queryTerms, func(id string) bool {
+		return filter == nil || filter(index.vectors[id])
+	})
+	for _, score := range scores {
+		v := *index.vectors[score.id]
+		v.Score = score.score
+		res = append(res, v)
+	}
+	return res
+}
+
+// search returns copies of the n vectors most similar to the target with their
+// Score set. Vectors rejected by filter are never returned; when the graph
+// cannot produce n accepted vectors, the exact scan is used instead. With a
+// quantized graph, the graph returns rescoreFactor times n candidates by their
+// codes, and they are rescored with the full-precision vectors.
+func (index *VectorIndex) search(target []float32, n int, filter func(*Vector) bool) ([]Vector, error) {
+	index.mu.RLock()
+	defer index.mu.RUnlock()
+
+	if index.graph != nil && len(target) == index.graph.dimension {
+		rescoreFactor := getRescoreFactor(index.graph.quantization)
+		if rescoreFactor == 0 {
+			ids, similarities := index.graph.search(target, max(hnswEfSearch, n*4))
+
+			res := []Vector{}
+			for i, id := range ids {
+				vector := index.vectors[id]
+				if vector == nil || (filter != nil && !filter(vector)) {
+					continue
+				}
+
+				v := *vector
+				v.Score = similarities[i]
+				res = append(res, v)
+				if len(res) == n {
+					return res, nil
+				}
+			}
+		} else {
+			ids, _ := index.graph.search(target, max(hnswEfSearch, n*rescoreFactor))
+
+			candidates := []*Vector{}
+			for _, id := range ids {
+				vector := index.vectors[id]
+				if vector != nil && (filter == nil || filter(vector)) {
+					candidates = append(candidates, vector)
+				}
+			}
+			if len(candidates) >= n {
+				return getScoredVectors(target, candidates, n)
+			}
+		}
+	}
+
+	candidates := []*Vector{}
+	for _, vector := range index.vectors {
+		if filter == nil || filter(vector) {
+			candidates = append(candidates, vector)
+		}
+	}
+
+	return getScoredVectors(target, candidates, n)
+}
+
+// getScoredVectors returns copies of the n candidates most similar to the
+// target, with their Score set from the full-precision vectors.
+func getScoredVectors(target []float32, candidates []*Vector, n int) ([]Vector, error) {
+	vectorData := make([][]float32, 0, len(candidates))
+	for _, vector := range candidates {
+		vectorData = append(vectorData, vector.Data)
+	}
+
+	similarities, err := getNearestVectors(target, vectorData, n)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []Vector{}
+	for _, similarity := range similarities {
+		v := *candidates[similarity.Index]
+		v.Score = similarity.Similarity
+		res = append(res, v)
+	}
+	return res, nil
+}
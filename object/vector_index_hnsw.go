@@ -0,0 +1,298 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"container/heap"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+const (
+	hnswM              = 16
+	hnswEfConstruction = 100
+	hnswEfSearch       = 64
+)
+
+type hnswNode struct {
+	id        string
+	point     *hnswPoint
+	level     int
+	neighbors [][]int
+	deleted   bool
+}
+
+type hnswCandidate struct {
+	node       int
+	similarity float32
+}
+
+// hnswMaxHeap pops the most similar candidate first.
+type hnswMaxHeap []hnswCandidate
+
+func (h hnswMaxHeap) Len() int            { return len(h) }
+func (h hnswMaxHeap) Less(i, j int) bool  { return h[i].similarity > h[j].similarity }
+func (h hnswMaxHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *hnswMaxHeap) Push(x interface{}) { *h = append(*h, x.(hnswCandidate)) }
+func (h *hnswMaxHeap) Pop() interface{} {
+	old := *h
+	item := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return item
+}
+
+// hnswMinHeap pops the least similar candidate first.
+type hnswMinHeap []hnswCandidate
+
+func (h hnswMinHeap) Len() int            { return len(h) }
+func (h hnswMinHeap) Less(i, j int) bool  { return h[i].similarity < h[j].similarity }
+func (h hnswMinHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *hnswMinHeap) Push(x interface{}) { *h = append(*h, x.(hnswCandidate)) }
+func (h *hnswMinHeap) Pop() interface{} {
+	old := *h
+	item := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return item
+}
+
+// hnswPoint is a vector as the graph compares it: normalized, so that the
+// inner product of two points is their cosine similarity, or quantized when
+// the graph has a quantization.
+type hnswPoint struct {
+	vector []float32
+	code   *vectorCode
+}
+
+// hnswGraph is a hierarchical navigable small world graph over the points of
+// the vectors. With a quantization ("Int8" or "Binary") the nodes only keep the
+// codes, and the similarities of a search approximate the cosine similarity.
+// Deleted nodes are only marked and keep routing searches until the graph
+// is rebuilt by its owner.
+type hnswGraph struct {
+	dimension    int
+	quantization string
+	nodes        []*hnswNode
+	nodeMap      map[string]int
+	entryPoint   int
+	maxLevel     int
+	deletedCount int
+	levelMult    float64
+	random       *rand.Rand
+}
+
+func newHnswGraph(dimension int, quantization string) *hnswGraph {
+	if getRescoreFactor(quantization) == 0 {
+		quantization = ""
+	}
+
+	return &hnswGraph{
+		dimension:    dimension,
+		quantization: quantization,
+		nodes:        []*hnswNode{},
+		nodeMap:      map[string]int{},
+		entryPoint:   -1,
+		levelMult:    1 / math.Log(float64(hnswM)),
+		random:       rand.New(rand.NewSource(1)),
+	}
+}
+
+func normalizeVector(vec []float32) []float32 {
+	res := make([]float32, len(vec))
+	vecNorm := norm(vec)
+	if vecNorm == 0 {
+		return res
+	}
+
+	for i, val := range vec {
+		res[i] = val / vecNorm
+	}
+	return res
+}
+
+func (g *hnswGraph) liveCount() int {
+	return len(g.nodes) - g.deletedCount
+}
+
+func (g *hnswGraph) getPoint(vec []float32) *hnswPoint {
+	if g.quantization != "" {
+		return &hnswPoint{code: quantizeVector(vec, g.quantization)}
+	}
+	return &hnswPoint{vector: normalizeVector(vec)}
+}
+
+func (g *hnswGraph) similarity(q *hnswPoint, node int) float32 {
+	point := g.nodes[node].point
+	if q.code != nil {
+		return q.code.similarity(point.code)
+	}
+	return dot(q.vector, point.vector)
+}
+
+func (g *hnswGraph) maxNeighbors(level int) int {
+	if level == 0 {
+		return hnswM * 2
+	}
+	return hnswM
+}
+
+func (g *hnswGraph) searchLayer(q *hnswPoint, entryPoints []hnswCandidate, ef int, level int) []hnswCandidate {
+	visited := map[int]bool{}
+	candidates := &hnswMaxHeap{}
+	results := &hnswMinHeap{}
+	for _, entryPoint := range entryPoints {
+		visited[entryPoint.node] = true
+		heap.Push(candidates, entryPoint)
+		heap.Push(results, entryPoint)
+	}
+
+	for candidates.Len() > 0 {
+		current := heap.Pop(candidates).(hnswCandidate)
+		if results.Len() >= ef && current.similarity < (*results)[0].similarity {
+			break
+		}
+
+		for _, neighbor := range g.nodes[current.node].neighbors[level] {
+			if visited[neighbor] {
+				continue
+			}
+			visited[neighbor] = true
+
+			similarity := g.similarity(q, neighbor)
+			if results.Len() < ef || similarity > (*results)[0].similarity {
+				heap.Push(candidates, hnswCandidate{node: neighbor, similarity: similarity})
+				heap.Push(results, hnswCandidate{node: neighbor, similarity: similarity})
+				if results.Len() > ef {
+					heap.Pop(results)
+				}
+			}
+		}
+	}
+
+	res := []hnswCandidate(*results)
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].similarity > res[j].similarity
+	})
+	return res
+}
+
+func (g *hnswGraph) greedySearch(q *hnswPoint, fromLevel int, toLevel int) []hnswCandidate {
+	entryPoints := []hnswCandidate{{node: g.entryPoint, similarity: g.similarity(q, g.entryPoint)}}
+	for level := fromLevel; level > toLevel; level-- {
+		entryPoints = g.searchLayer(q, entryPoints, 1, level)
+	}
+	return entryPoints
+}
+
+func (g *hnswGraph) pruneNeighbors(node int, level int) {
+	neighbors := g.nodes[node].neighbors[level]
+	maxNeighbors := g.maxNeighbors(level)
+	if len(neighbors) <= maxNeighbors {
+		return
+	}
+
+	candidates := make([]hnswCandidate, 0, len(neighbors))
+	for _, neighbor := range neighbors {
+		candidates = append(candidates, hnswCandidate{node: neighbor, similarity: g.similarity(g.nodes[node].point, neighbor)})
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].similarity > candidates[j].similarity
+	})
+
+	res := make([]int, 0, maxNeighbors)
+	for _, candidate := range candidates[:maxNeighbors] {
+		res = append(res, candidate.node)
+	}
+	g.nodes[node].neighbors[level] = res
+}
+
+func (g *hnswGraph) insert(id string, vec []float32) bool {
+	if len(vec) != g.dimension {
+		return false
+	}
+
+	g.remove(id)
+
+	level := int(math.Floor(-math.Log(1-g.random.Float64()) * g.levelMult))
+	node := &hnswNode{
+		id:        id,
+		point:     g.getPoint(vec),
+		level:     level,
+		neighbors: make([][]int, level+1),
+	}
+	nodeIndex := len(g.nodes)
+	g.nodes = append(g.nodes, node)
+	g.nodeMap[id] = nodeIndex
+
+	if g.entryPoint == -1 {
+		g.entryPoint = nodeIndex
+		g.maxLevel = level
+		return true
+	}
+
+	entryPoints := g.greedySearch(node.point, g.maxLevel, level)
+	for l := min(level, g.maxLevel); l >= 0; l-- {
+		entryPoints = g.searchLayer(node.point, entryPoints, hnswEfConstruction, l)
+
+		neighborCount := min(hnswM, len(entryPoints))
+		for _, candidate := range entryPoints[:neighborCount] {
+			node.neighbors[l] = append(node.neighbors[l], candidate.node)
+			g.nodes[candidate.node].neighbors[l] = append(g.nodes[candidate.node].neighbors[l], nodeIndex)
+			g.pruneNeighbors(candidate.node, l)
+		}
+	}
+
+	if level > g.maxLevel {
+		g.entryPoint = nodeIndex
+		g.maxLevel = level
+	}
+	return true
+}
+
+func (g *hnswGraph) remove(id string) {
+	nodeIndex, ok := g.nodeMap[id]
+	if !ok {
+		return
+	}
+
+	g.nodes[nodeIndex].deleted = true
+	g.deletedCount++
+	delete(g.nodeMap, id)
+}
+
+// search returns the ids of up to ef live nodes closest to the query, most
+// similar first.
+func (g *hnswGraph) search(q []float32, ef int) ([]string, []float32) {
+	if g.entryPoint == -1 || len(q) != g.dimension {
+		return nil, nil
+	}
+
+	point := g.getPoint(q)
+	entryPoints := g.greedySearch(point, g.maxLevel, 0)
+	candidates := g.searchLayer(point, entryPoints, ef, 0)
+
+	ids := []string{}
+	similarities := []float32{}
+	for _, candidate := range candidates {
+		node := g.nodes[candidate.node]
+		if node.deleted {
+			continue
+		}
+
+		ids = append(ids, node.id)
+		similarities = append(similarities, candidate.similarity)
+	}
+	return ids, similarities
+}
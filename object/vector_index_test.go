@@ -0,0 +1,93 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package object
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func getRandomTestVectors(count int, dimension int) [][]float32 {
+	random := rand.New(rand.NewSource(42))
+	res := [][]float32{}
+	for i := 0; i < count; i++ {
+		vec := make([]float32, dimension)
+		for j := range vec {
+			vec[j] = random.Float32()*2 - 1
+		}
+		res = append(res, vec)
+	}
+	return res
+}
+
+func TestVectorIndexRecall(t *testing.T) {
+	vectors := getRandomTestVectors(3000, 32)
+
+	index := &VectorIndex{store: "test", provider: "test", vectors: map[string]*Vector{}, keywords: newKeywordIndex(), loaded: true}
+	for i, data := range vectors {
+		index.vectors[fmt.Sprintf("admin/vector_%d", i)] = &Vector{Owner: "admin", Name: fmt.Sprintf("vector_%d", i), Data: data}
+	}
+	index.rebuildGraph()
+	if index.graph == nil {
+		t.Fatalf("rebuildGraph() should build a graph for %d vectors", len(vectors))
+	}
+
+	queries := getRandomTestVectors(50, 32)
+	hits := 0
+	for _, query := range queries {
+		exact, err := getNearestVectors(query, vectors, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := map[string]bool{}
+		for _, similarity := range exact {
+			expected[fmt.Sprintf("vector_%d", similarity.Index)] = true
+		}
+
+		res, err := index.search(query, 10, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, vector := range res {
+			if expected[vector.Name] {
+				hits++
+			}
+		}
+	}
+
+	recall := float64(hits) / float64(len(queries)*10)
+	if recall < 0.9 {
+		t.Errorf("recall@10 = %.3f, want >= 0.9", recall)
+	}
+
+	for i := 0; i < 2000; i++ {
+		index.remove(fmt.Sprintf("admin/vector_%d", i))
+	}
+	res, err := index.search(queries[0], 10, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, vector := range res {
+		var i int
+		fmt.Sscanf(vector.Name, "vector_%d", &i)
+		if i < 2000 {
+			t.Errorf("search() returned removed vector: %s", vector.Name)
+		}
+	}
+}
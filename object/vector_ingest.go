@@ -0,0 +1,362 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/beego/beego/logs"
+	"github.com/casibase/casibase/embedding"
+	"github.com/casibase/casibase/split"
+	"github.com/casibase/casibase/storage"
+	"github.com/casibase/casibase/txt"
+	"github.com/cenkalti/backoff/v4"
+	"golang.org/x/time/rate"
+)
+
+// vectorIngestWorkerCount is how many files of a store are parsed and embedded
+// at the same time, the embedding requests are still paced by the rate limiter.
+const vectorIngestWorkerCount = 8
+
+type vectorIngestion struct {
+	store                 *Store
+	vectorStore           VectorStore
+	embeddingProviderObj  embedding.EmbeddingProvider
+	embeddingProviderName string
+	modelSubType          string
+	limiter               *rate.Limiter
+	dedup                 *vectorDeduplicator
+	ctx                   context.Context
+	cancel                context.CancelFunc
+	lang                  string
+}
+
+func (r *VectorRefreshResult) add(result *VectorRefreshResult) {
+	r.Added += result.Added
+	r.Updated += result.Updated
+	r.Deleted += result.Deleted
+	r.Skipped += result.Skipped
+}
+
+// refreshFiles refreshes the files with a bounded pool of workers. No new file is
+// started after the first error, and the embedding requests of the running files
+// are cancelled, the error is returned once they finish.
+func (ing *vectorIngestion) refreshFiles(files []*storage.Object, fileVectorsMap map[string][]*Vector, res *VectorRefreshResult) error {
+	jobs := make(chan *storage.Object)
+	var firstErr error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for i := 0; i < min(vectorIngestWorkerCount, len(files)); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for file := range jobs {
+				fileRes, err := ing.refreshFile(file, fileVectorsMap[file.Key])
+
+				mu.Lock()
+				if err != nil {
+					if firstErr == nil {
+						firstErr = err
+						ing.cancel()
+					}
+				} else {
+					res.add(fileRes)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, file := range files {
+		mu.Lock()
+		failed := firstErr != nil
+		mu.Unlock()
+		if failed {
+			break
+		}
+
+		jobs <- file
+	}
+	close(jobs)
+	wg.Wait()
+
+	return firstErr
+}
+
+func (ing *vectorIngestion) refreshFile(file *storage.Object, fileVectors []*Vector) (*VectorRefreshResult, error) {
+	res := &VectorRefreshResult{}
+	storeName := ing.store.Name
+
+	fileExt := filepath.Ext(file.Key)
+	text, err := txt.GetParsedTextFromUrl(file.Url, fileExt, ing.lang)
+	if err != nil {
+		return nil, err
+	}
+
+	splitProviderType := ing.store.SplitProvider
+	if splitProviderType == "" {
+		splitProviderType = "Default"
+	}
+
+	if strings.HasPrefix(file.Key, "QA") && fileExt == ".docx" {
+		splitProviderType = "QA"
+	}
+
+	if fileExt == ".md" {
+		splitProviderType = "Markdown"
+	}
+
+	if fileExt == ".xlsx" || fileExt == ".csv" {
+		splitProviderType = "Table"
+	}
+
+	codeLanguage := split.GetCodeLanguage(fileExt)
+	if codeLanguage != "" {
+		splitProviderType = "Code"
+	}
+
+	metadata := getVectorMetadata(ing.store, file)
+	metadata.FileHash = getFileHash(getSplitProviderKey(ing.store, splitProviderType), text)
+
+	if isFileUnchanged(fileVectors, metadata.FileHash, ing.embeddingProviderName) {
+		for _, vector := range fileVectors {
+			err = syncVectorFields(vector, vector.TextHash, metadata)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		logs.Info("Generating embedding for store: [%s], file: [%s]: %s\n", storeName, file.Key, "Skipped due to unchanged")
+		res.Skipped += len(fileVectors)
+		return res, nil
+	}
+
+	var splitProvider split.SplitProvider
+	if splitProviderType == "Code" {
+		splitProvider, err = split.NewCodeSplitProvider(file.Key, codeLanguage, ing.store.ChunkSize)
+	} else {
+		splitProvider, err = split.GetSplitProvider(splitProviderType, ing.store.ChunkSize, ing.store.ChunkOverlap)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	textSections, err := splitProvider.SplitText(text)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the first vector of an index is reused, any duplicates left by an
+	// interrupted refresh are deleted with the stale indexes below.
+	indexVectorMap := map[int]*Vector{}
+	staleVectors := []*Vector{}
+	for _, vector := range fileVectors {
+		if _, ok := indexVectorMap[vector.Index]; ok || vector.Index >= len(textSections) {
+			staleVectors = append(staleVectors, vector)
+			continue
+		}
+
+		indexVectorMap[vector.Index] = vector
+	}
+
+	indexes := []int{}
+	texts := []string{}
+	for i, textSection := range textSections {
+		textHash := getTextHash(textSection)
+		vector := indexVectorMap[i]
+
+		if vector != nil && isVectorUnchanged(vector, textSection, textHash, ing.embeddingProviderName) {
+			err = syncVectorFields(vector, textHash, metadata)
+			if err != nil {
+				return nil, err
+			}
+
+			res.Skipped++
+			continue
+		}
+
+		// A copy of a text already embedded in the store takes the embedding of
+		// its canonical vector, without any request or cost.
+		canonical := ing.dedup.getExactCanonical(textHash)
+		if canonical != nil {
+			var duplicate *Vector
+			duplicate, err = newEmbeddedVector(textSection, canonical.Data, nil, storeName, file.Key, i, metadata, ing.embeddingProviderName, ing.modelSubType)
+			if err != nil {
+				return nil, err
+			}
+			duplicate.Price = 0
+
+			err = ing.addVector(duplicate, vector, res)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		indexes = append(indexes, i)
+		texts = append(texts, textSection)
+	}
+
+	logs.Info("Generating embedding for store: [%s], file: [%s], sections: [%d], changed: [%d]\n", storeName, file.Key, len(textSections), len(texts))
+
+	for start, end := 0, 0; start < len(texts); start = end {
+		end = embedding.GetBatchEnd(ing.embeddingProviderObj, texts, start)
+
+		data, embeddingResults, err := ing.embedTexts(texts[start:end])
+		if err != nil {
+			logs.Error("Failed to generate embedding after retries: %v\n", err)
+			return nil, err
+		}
+
+		for j := start; j < end; j++ {
+			i := indexes[j]
+
+			var vector *Vector
+			vector, err = newEmbeddedVector(texts[j], data[j-start], embeddingResults[j-start], storeName, file.Key, i, metadata, ing.embeddingProviderName, ing.modelSubType)
+			if err != nil {
+				return nil, err
+			}
+
+			err = ing.addVector(vector, indexVectorMap[i], res)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, vector := range staleVectors {
+		_, err = ing.vectorStore.DeleteVector(vector)
+		if err != nil {
+			return nil, err
+		}
+		res.Deleted++
+	}
+
+	return res, nil
+}
+
+// addVector adds the vector in place of the old vector of the same file index.
+// The old vector is only deleted once its replacement exists, so a failed
+// refresh never leaves a hole in the knowledge.
+func (ing *vectorIngestion) addVector(vector *Vector, oldVector *Vector, res *VectorRefreshResult) error {
+	err := ing.dedup.add(vector, oldVector)
+	if err != nil {
+		return err
+	}
+
+	if oldVector == nil {
+		res.Added++
+		return nil
+	}
+
+	_, err = ing.vectorStore.DeleteVector(oldVector)
+	if err != nil {
+		return err
+	}
+	res.Updated++
+	return nil
+}
+
+// getSplitProviderKey adds the chunk settings to the split provider type for the
+// file hash, so that a file is split again when they change.
+func getSplitProviderKey(store *Store, splitProviderType string) string {
+	if splitProviderType == "Recursive" {
+		return fmt.Sprintf("%s|%d|%d", splitProviderType, store.ChunkSize, store.ChunkOverlap)
+	} else if splitProviderType == "Code" || splitProviderType == "Table" {
+		return fmt.Sprintf("%s|%d", splitProviderType, store.ChunkSize)
+	}
+
+	return splitProviderType
+}
+
+// embedTexts sends one embedding request for the texts, waiting for the rate
+// limiter first and backing off when the provider still reports a rate limit.
+func (ing *vectorIngestion) embedTexts(texts []string) ([][]float32, []*embedding.EmbeddingResult, error) {
+	var data [][]float32
+	var embeddingResult *embedding.EmbeddingResult
+
+	operation := func() error {
+		if ing.limiter != nil {
+			err := ing.limiter.Wait(ing.ctx)
+			if err != nil {
+				return backoff.Permanent(err)
+			}
+		}
+
+		var err error
+		data, embeddingResult, err = queryVectorsSafe(ing.embeddingProviderObj, texts, ing.ctx, ing.lang)
+		if err != nil {
+			if isRetryableError(err) {
+				return err
+			}
+			return backoff.Permanent(err)
+		}
+		return nil
+	}
+	err := backoff.Retry(operation, backoff.WithContext(backoff.NewExponentialBackOff(), ing.ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, splitEmbeddingResult(embeddingResult, texts, ing.modelSubType), nil
+}
+
+// splitEmbeddingResult shares the cost of a batch request among its texts in
+// proportion to their estimated token counts, so that every vector keeps its
+// own token count and price. A nil entry falls back to the estimate.
+func splitEmbeddingResult(embeddingResult *embedding.EmbeddingResult, texts []string, modelSubType string) []*embedding.EmbeddingResult {
+	res := make([]*embedding.EmbeddingResult, len(texts))
+	if embeddingResult == nil || embeddingResult.TokenCount == 0 {
+		return res
+	}
+	if len(texts) == 1 {
+		res[0] = embeddingResult
+		return res
+	}
+
+	weights := make([]int, len(texts))
+	totalWeight := 0
+	for i, text := range texts {
+		weights[i] = 1
+		defaultEmbeddingResult, err := embedding.GetDefaultEmbeddingResult(modelSubType, text)
+		if err == nil && defaultEmbeddingResult.TokenCount > 0 {
+			weights[i] = defaultEmbeddingResult.TokenCount
+		}
+		totalWeight += weights[i]
+	}
+
+	tokenCount := 0
+	price := 0.0
+	for i := range texts {
+		res[i] = &embedding.EmbeddingResult{
+			TokenCount: embeddingResult.TokenCount * weights[i] / totalWeight,
+			Price:      embeddingResult.Price * float64(weights[i]) / float64(totalWeight),
+			Currency:   embeddingResult.Currency,
+		}
+		tokenCount += res[i].TokenCount
+		price += res[i].Price
+	}
+
+	// The rounding remainder goes to the last text so that the sums stay exact.
+	last := res[len(res)-1]
+	last.TokenCount += embeddingResult.TokenCount - tokenCount
+	last.Price += embeddingResult.Price - price
+	return res
+}
@@ -0,0 +1,49 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package object
+
+import (
+	"math"
+	"testing"
+
+	"github.com/casibase/casibase/embedding"
+)
+
+func TestSplitEmbeddingResult(t *testing.T) {
+	texts := []string{"short", "a much longer text that should carry most of the cost of the request"}
+	embeddingResult := &embedding.EmbeddingResult{TokenCount: 101, Price: 0.0101, Currency: "USD"}
+
+	results := splitEmbeddingResult(embeddingResult, texts, "text-embedding-ada-002")
+	if len(results) != 2 {
+		t.Fatalf("splitEmbeddingResult() returned %d results, want 2", len(results))
+	}
+	if results[0].TokenCount >= results[1].TokenCount {
+		t.Errorf("splitEmbeddingResult() gave %d tokens to the short text and %d to the long one", results[0].TokenCount, results[1].TokenCount)
+	}
+	if results[0].TokenCount+results[1].TokenCount != 101 {
+		t.Errorf("splitEmbeddingResult() token counts sum to %d, want 101", results[0].TokenCount+results[1].TokenCount)
+	}
+	if math.Abs(results[0].Price+results[1].Price-0.0101) > 1e-12 {
+		t.Errorf("splitEmbeddingResult() prices sum to %f, want 0.0101", results[0].Price+results[1].Price)
+	}
+
+	results = splitEmbeddingResult(nil, texts, "text-embedding-ada-002")
+	if results[0] != nil || results[1] != nil {
+		t.Errorf("splitEmbeddingResult(nil) = %v, want nil results", results)
+	}
+}
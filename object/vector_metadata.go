@@ -0,0 +1,90 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/casibase/casibase/storage"
+)
+
+// VectorMetadata is the per-file metadata copied to every vector of a file so
+// that searches can be filtered by it, and refreshes can tell whether the file changed.
+type VectorMetadata struct {
+	Date     string
+	Tags     []string
+	Metadata map[string]string
+	FileHash string
+}
+
+var vectorDateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// normalizeVectorDate turns the known time layouts into "2006-01-02" so that
+// dates compare correctly as strings in filters.
+func normalizeVectorDate(date string) string {
+	for _, layout := range vectorDateLayouts {
+		t, err := time.Parse(layout, date)
+		if err == nil {
+			return t.Format("2006-01-02")
+		}
+	}
+	return date
+}
+
+func getVectorMetadata(store *Store, file *storage.Object) *VectorMetadata {
+	res := &VectorMetadata{
+		Date:     normalizeVectorDate(file.LastModified),
+		Tags:     []string{},
+		Metadata: map[string]string{},
+	}
+
+	// Every folder on the file path is a tag, e.g. "product-a/manuals/setup.md" is tagged "product-a" and "manuals".
+	dir := path.Dir(filepath.ToSlash(file.Key))
+	if dir != "." && dir != "/" {
+		for _, folder := range strings.Split(strings.Trim(dir, "/"), "/") {
+			if folder != "" {
+				res.Tags = append(res.Tags, folder)
+			}
+		}
+	}
+
+	res.Metadata["ext"] = strings.ToLower(filepath.Ext(file.Key))
+
+	if store != nil && store.PropertiesMap != nil {
+		properties := store.PropertiesMap[file.Key]
+		if properties != nil {
+			if properties.CollectedTime != "" {
+				res.Date = normalizeVectorDate(properties.CollectedTime)
+				res.Metadata["collectedTime"] = properties.CollectedTime
+			}
+			if properties.Subject != "" {
+				res.Metadata["subject"] = properties.Subject
+			}
+			for key, value := range properties.Metadata {
+				res.Metadata[key] = value
+			}
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"math"
+	"math/bits"
+)
+
+// The candidates returned by the quantized graph for each result, before they
+// are rescored with the full-precision vectors. Binary codes lose much more than
+// int8 codes, so they need more candidates for the same recall.
+const (
+	int8RescoreFactor   = 4
+	binaryRescoreFactor = 10
+)
+
+// vectorCode is the quantized form of a vector, of the kind of quantization it
+// was built for: int8 values of the normalized vector with their scale, or one
+// sign bit per dimension.
+type vectorCode struct {
+	dimension int
+	values    []int8
+	scale     float32
+	bits      []uint64
+}
+
+func getRescoreFactor(quantization string) int {
+	if quantization == "Int8" {
+		return int8RescoreFactor
+	} else if quantization == "Binary" {
+		return binaryRescoreFactor
+	}
+	return 0
+}
+
+// quantizeInt8 maps the largest value of the normalized vector to 127, so the
+// dot product of two codes times their scales approximates the cosine similarity.
+func quantizeInt8(vec []float32) *vectorCode {
+	res := &vectorCode{dimension: len(vec), values: make([]int8, len(vec))}
+
+	vecNorm := norm(vec)
+	if vecNorm == 0 {
+		return res
+	}
+
+	var maxAbs float32
+	for _, val := range vec {
+		maxAbs = max(maxAbs, float32(math.Abs(float64(val/vecNorm))))
+	}
+	if maxAbs == 0 {
+		return res
+	}
+
+	res.scale = maxAbs / 127
+	for i, val := range vec {
+		res.values[i] = int8(math.Round(float64(val / vecNorm / res.scale)))
+	}
+	return res
+}
+
+func quantizeBinary(vec []float32) *vectorCode {
+	res := &vectorCode{dimension: len(vec), bits: make([]uint64, (len(vec)+63)/64)}
+	for i, val := range vec {
+		if val > 0 {
+			res.bits[i/64] |= 1 << (i % 64)
+		}
+	}
+	return res
+}
+
+func quantizeVector(vec []float32, quantization string) *vectorCode {
+	if quantization == "Binary" {
+		return quantizeBinary(vec)
+	}
+	return quantizeInt8(vec)
+}
+
+// similarity approximates the cosine similarity of the two vectors, for binary
+// codes it is the share of dimensions with the same sign mapped to [-1, 1].
+func (code *vectorCode) similarity(other *vectorCode) float32 {
+	if code.bits != nil {
+		distance := 0
+		for i := range code.bits {
+			distance += bits.OnesCount64(code.bits[i] ^ other.bits[i])
+		}
+		return 1 - 2*float32(distance)/float32(code.dimension)
+	}
+
+	var dotProduct int32
+	for i := range code.values {
+		dotProduct += int32(code.values[i]) * int32(other.values[i])
+	}
+	return float32(dotProduct) * code.scale * other.scale
+}
@@ -0,0 +1,131 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package object
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestVectorData(t *testing.T) {
+	data := VectorData{0, 1.5, -0.25, 3.4028235e38}
+	b, err := data.ToDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) != len(data)*4 {
+		t.Fatalf("ToDB() returned %d bytes, want %d", len(b), len(data)*4)
+	}
+
+	res := VectorData{}
+	err = res.FromDB(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(res) != fmt.Sprint(data) {
+		t.Errorf("FromDB() = %v, want %v", res, data)
+	}
+
+	if err = res.FromDB([]byte{1, 2, 3}); err == nil {
+		t.Errorf("FromDB() should fail on a truncated value")
+	}
+
+	legacy, err := parseLegacyVectorData("[0,1.5,-0.25,3.4028235e+38]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(legacy) != fmt.Sprint(data) {
+		t.Errorf("parseLegacyVectorData() = %v, want %v", legacy, data)
+	}
+}
+
+func TestQuantizedSearch(t *testing.T) {
+	vectors := getRandomTestVectors(2000, 64)
+
+	index := &VectorIndex{store: "test", provider: "test", vectors: map[string]*Vector{}, keywords: newKeywordIndex(), loaded: true}
+	candidates := []*Vector{}
+	for i, data := range vectors {
+		vector := &Vector{Owner: "admin", Name: fmt.Sprintf("vector_%d", i), Index: i, Data: data}
+		index.vectors[vector.GetId()] = vector
+		candidates = append(candidates, vector)
+	}
+
+	queries := getRandomTestVectors(30, 64)
+	tests := []struct {
+		quantization string
+		minRecall    float64
+	}{
+		{"Int8", 0.95},
+		{"Binary", 0.8},
+	}
+
+	for _, test := range tests {
+		index.setQuantization(test.quantization)
+		if index.graph == nil || index.graph.quantization != test.quantization {
+			t.Fatalf("setQuantization() should build a %s graph", test.quantization)
+		}
+		if index.graph.nodes[0].point.vector != nil {
+			t.Errorf("%s: the graph should only keep the codes of the vectors", test.quantization)
+		}
+
+		hits := 0
+		for _, query := range queries {
+			exact, err := getScoredVectors(query, candidates, 10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected := map[string]float32{}
+			for _, vector := range exact {
+				expected[vector.Name] = vector.Score
+			}
+
+			res, err := index.search(query, 10, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, vector := range res {
+				if score, ok := expected[vector.Name]; ok {
+					hits++
+					// The results are rescored, so their scores are the exact ones.
+					if score != vector.Score {
+						t.Errorf("%s: the score of %s is %f, want %f", test.quantization, vector.Name, vector.Score, score)
+					}
+				}
+			}
+		}
+
+		recall := float64(hits) / float64(len(queries)*10)
+		if recall < test.minRecall {
+			t.Errorf("%s: recall@10 = %.3f, want >= %.2f", test.quantization, recall, test.minRecall)
+		}
+	}
+
+	even := func(vector *Vector) bool { return vector.Index%2 == 0 }
+	res, err := index.search(queries[0], 10, even)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 10 {
+		t.Fatalf("search() returned %d vectors, want 10", len(res))
+	}
+	for _, vector := range res {
+		if vector.Index%2 != 0 {
+			t.Errorf("search() returned filtered vector: %s", vector.Name)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"reflect"
+)
+
+// VectorRefreshResult counts what a store refresh did to its vectors. Skipped
+// vectors were unchanged and kept without calling the embedding provider.
+type VectorRefreshResult struct {
+	Added   int `json:"added"`
+	Updated int `json:"updated"`
+	Deleted int `json:"deleted"`
+	Skipped int `json:"skipped"`
+}
+
+func getTextHash(text string) string {
+	hash := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(hash[:])
+}
+
+// getFileHash fingerprints the parsed text of a file together with the way it
+// is split, so that changing the split provider re-indexes the file as well.
+func getFileHash(splitProviderType string, text string) string {
+	return getTextHash(splitProviderType + "\n" + text)
+}
+
+func groupVectorsByFile(vectors []*Vector) map[string][]*Vector {
+	res := map[string][]*Vector{}
+	for _, vector := range vectors {
+		// Vectors added by hand have no file and are never touched by a refresh.
+		if vector.File == "" {
+			continue
+		}
+
+		res[vector.File] = append(res[vector.File], vector)
+	}
+	return res
+}
+
+func isFileUnchanged(vectors []*Vector, fileHash string, embeddingProviderName string) bool {
+	if len(vectors) == 0 {
+		return false
+	}
+
+	for _, vector := range vectors {
+		if vector.FileHash != fileHash || vector.Provider != embeddingProviderName {
+			return false
+		}
+	}
+	return true
+}
+
+// isVectorUnchanged reports whether an existing vector can be kept for the given
+// section. Vectors created before hashes were stored are compared by text.
+func isVectorUnchanged(vector *Vector, text string, textHash string, embeddingProviderName string) bool {
+	if vector.Provider != embeddingProviderName || vector.Dimension == 0 {
+		return false
+	}
+
+	if vector.TextHash != "" {
+		return vector.TextHash == textHash
+	}
+	return vector.Text == text
+}
+
+// syncVectorFields updates the hashes and the file metadata of a kept vector
+// in place, which is much cheaper than embedding it again.
+func syncVectorFields(vector *Vector, textHash string, metadata *VectorMetadata) error {
+	if vector.FileHash == metadata.FileHash && vector.TextHash == textHash && vector.Date == metadata.Date &&
+		reflect.DeepEqual(vector.Tags, metadata.Tags) && reflect.DeepEqual(vector.Metadata, metadata.Metadata) {
+		return nil
+	}
+
+	vector.FileHash = metadata.FileHash
+	vector.TextHash = textHash
+	vector.Date = metadata.Date
+	vector.Tags = metadata.Tags
+	vector.Metadata = metadata.Metadata
+
+	_, err := updateVectorCols(vector, "file_hash", "text_hash", "date", "tags", "metadata")
+	return err
+}
@@ -0,0 +1,59 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package object
+
+import "testing"
+
+func TestIsVectorUnchanged(t *testing.T) {
+	text := "The license has expired"
+	textHash := getTextHash(text)
+
+	tests := []struct {
+		name     string
+		vector   *Vector
+		expected bool
+	}{
+		{"same hash", &Vector{Provider: "p", TextHash: textHash, Dimension: 2}, true},
+		{"changed hash", &Vector{Provider: "p", TextHash: getTextHash("old text"), Text: text, Dimension: 2}, false},
+		{"legacy same text", &Vector{Provider: "p", Text: text, Dimension: 2}, true},
+		{"legacy changed text", &Vector{Provider: "p", Text: "old text", Dimension: 2}, false},
+		{"other provider", &Vector{Provider: "q", TextHash: textHash, Dimension: 2}, false},
+		{"no embedding", &Vector{Provider: "p", TextHash: textHash}, false},
+	}
+
+	for _, test := range tests {
+		if actual := isVectorUnchanged(test.vector, text, textHash, "p"); actual != test.expected {
+			t.Errorf("%s: isVectorUnchanged() = %t, want %t", test.name, actual, test.expected)
+		}
+	}
+}
+
+func TestIsFileUnchanged(t *testing.T) {
+	fileHash := getFileHash("Default", "text")
+	vectors := []*Vector{{Provider: "p", FileHash: fileHash}, {Provider: "p", FileHash: fileHash}}
+
+	if !isFileUnchanged(vectors, fileHash, "p") {
+		t.Errorf("isFileUnchanged() = false, want true")
+	}
+	if isFileUnchanged(vectors, getFileHash("Markdown", "text"), "p") {
+		t.Errorf("isFileUnchanged() = true for another split provider, want false")
+	}
+	if isFileUnchanged(nil, fileHash, "p") {
+		t.Errorf("isFileUnchanged() = true for a new file, want false")
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/beego/beego/logs"
+	"github.com/casibase/casibase/i18n"
+	"github.com/casibase/casibase/util"
+	"xorm.io/core"
+)
+
+// VectorStore keeps the embeddings of the vectors of a store and finds the
+// nearest ones. The vector rows always stay in the database so that they can be
+// listed and edited, an external vector store only takes over their data.
+type VectorStore interface {
+	AddVector(vector *Vector) (bool, error)
+	UpdateVector(id string, vector *Vector) (bool, error)
+	DeleteVector(vector *Vector) (bool, error)
+	SearchVectors(store *Store, provider string, target []float32, n int, filter func(*Vector) bool) ([]Vector, error)
+}
+
+// DatabaseVectorStore is the default vector store, it keeps the embeddings in
+// the vector table and searches them with the in-memory vector indexes.
+type DatabaseVectorStore struct{}
+
+var databaseVectorStore = &DatabaseVectorStore{}
+
+var (
+	vectorStoreMap = map[string]VectorStore{}
+	vectorStoreMu  sync.Mutex
+)
+
+// GetVectorStore returns the vector store of a "Vector Database" provider, the
+// stores are cached because each of them holds a connection pool.
+func GetVectorStore(typ string, providerUrl string, clientSecret string) (VectorStore, error) {
+	key := fmt.Sprintf("%s|%s|%s", typ, providerUrl, clientSecret)
+
+	vectorStoreMu.Lock()
+	defer vectorStoreMu.Unlock()
+
+	if vectorStore, ok := vectorStoreMap[key]; ok {
+		return vectorStore, nil
+	}
+
+	var vectorStore VectorStore
+	var err error
+	if typ == "pgvector" {
+		vectorStore, err = NewPgvectorVectorStore(providerUrl)
+	} else if typ == "Qdrant" {
+		vectorStore, err = NewQdrantVectorStore(providerUrl, clientSecret)
+	} else {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	vectorStoreMap[key] = vectorStore
+	return vectorStore, nil
+}
+
+func getVectorStoreByStore(store *Store, lang string) (VectorStore, error) {
+	if store == nil || store.VectorDatabaseProvider == "" {
+		return databaseVectorStore, nil
+	}
+
+	_, vectorStore, err := getVectorDatabaseProviderFromName(store.Owner, store.VectorDatabaseProvider, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	return vectorStore, nil
+}
+
+// getVectorStoreByVector returns the store of the vector and its vector store,
+// for the single vectors changed outside of a refresh of the store.
+func getVectorStoreByVector(vector *Vector, lang string) (*Store, VectorStore, error) {
+	store, err := getStore(vector.Owner, vector.Store)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vectorStore, err := getVectorStoreByStore(store, lang)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return store, vectorStore, nil
+}
+
+// updateKnowledgeVersion invalidates the answers cached for the knowledge of
+// the store after one of its vectors changed.
+func updateKnowledgeVersion(store *Store, affected bool) error {
+	if store == nil || !affected {
+		return nil
+	}
+
+	return invalidateAnswerCache(store)
+}
+
+func (s *DatabaseVectorStore) AddVector(vector *Vector) (bool, error) {
+	affected, err := adapter.engine.Insert(vector)
+	if err != nil {
+		return false, err
+	}
+
+	if affected != 0 {
+		vectorIndexManager.addVector(vector)
+	}
+
+	return affected != 0, nil
+}
+
+func (s *DatabaseVectorStore) UpdateVector(id string, vector *Vector) (bool, error) {
+	owner, name := util.GetOwnerAndNameFromId(id)
+	_, err := adapter.engine.ID(core.PK{owner, name}).AllCols().Update(vector)
+	if err != nil {
+		return false, err
+	}
+
+	vectorIndexManager.removeVector(id)
+	vectorIndexManager.addVector(vector)
+
+	// return affected != 0
+	return true, nil
+}
+
+func (s *DatabaseVectorStore) DeleteVector(vector *Vector) (bool, error) {
+	affected, err := adapter.engine.ID(core.PK{vector.Owner, vector.Name}).Delete(&Vector{})
+	if err != nil {
+		return false, err
+	}
+
+	vectorIndexManager.removeVector(vector.GetId())
+
+	return affected != 0, nil
+}
+
+func (s *DatabaseVectorStore) SearchVectors(store *Store, provider string, target []float32, n int, filter func(*Vector) bool) ([]Vector, error) {
+	index, err := vectorIndexManager.getIndex(store.Name, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	index.setQuantization(store.VectorQuantization)
+	return index.search(target, n, filter)
+}
+
+// addExternalVector saves the data of the vector with save and inserts the
+// vector row without it.
+func addExternalVector(vector *Vector, save func(*Vector) error) (bool, error) {
+	if len(vector.Data) != 0 {
+		err := save(vector)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	row := *vector
+	row.Data = []float32{}
+	return databaseVectorStore.AddVector(&row)
+}
+
+func updateExternalVector(id string, vector *Vector, save func(*Vector) error) (bool, error) {
+	if len(vector.Data) != 0 {
+		err := save(vector)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	row := *vector
+	row.Data = []float32{}
+	return databaseVectorStore.UpdateVector(id, &row)
+}
+
+func deleteExternalVector(vector *Vector, remove func(*Vector) error) (bool, error) {
+	affected, err := databaseVectorStore.DeleteVector(vector)
+	if err != nil {
+		return false, err
+	}
+
+	if vector.Dimension != 0 {
+		err = remove(vector)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return affected, nil
+}
+
+// searchExternalVectors resolves the vector ids found by search to the vector
+// rows. Filters are applied to the rows, so the search is repeated with a larger
+// limit until n vectors pass the filter or the store is exhausted.
+func searchExternalVectors(store string, provider string, n int, filter func(*Vector) bool, search func(limit int) ([]string, []float32, error)) ([]Vector, error) {
+	if n <= 0 {
+		return []Vector{}, nil
+	}
+
+	index, err := vectorIndexManager.getIndex(store, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := n * 4
+	for {
+		ids, similarities, err := search(limit)
+		if err != nil {
+			return nil, err
+		}
+
+		res := []Vector{}
+		for i, id := range ids {
+			vector := index.getVector(id)
+			if vector == nil || (filter != nil && !filter(vector)) {
+				continue
+			}
+
+			v := *vector
+			v.Score = similarities[i]
+			res = append(res, v)
+			if len(res) == n {
+				return res, nil
+			}
+		}
+
+		if len(ids) < limit || limit >= index.count() {
+			return res, nil
+		}
+		limit *= 4
+	}
+}
+
+// migrateStoreVectors moves the data kept in the vector rows into the external
+// vector store of the store. With the default vector store, the rows whose data
+// only lived in an external vector store are deleted so that the refresh embeds
+// them again.
+func migrateStoreVectors(store *Store, lang string) error {
+	vectorStore, err := getVectorStoreByStore(store, lang)
+	if err != nil {
+		return err
+	}
+
+	vectors, err := getVectorsByStore(store.Owner, store.Name)
+	if err != nil {
+		return err
+	}
+
+	_, isDatabase := vectorStore.(*DatabaseVectorStore)
+
+	count := 0
+	for _, vector := range vectors {
+		if isDatabase && len(vector.Data) == 0 && vector.Dimension != 0 {
+			_, err = databaseVectorStore.DeleteVector(vector)
+		} else if !isDatabase && len(vector.Data) != 0 {
+			_, err = vectorStore.UpdateVector(vector.GetId(), vector)
+		} else {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf(i18n.Translate(lang, "object:Failed to migrate the vector: %s, %s"), vector.GetId(), err.Error())
+		}
+
+		count++
+	}
+
+	if count != 0 {
+		logs.Info("Migrated vectors for store: [%s], vector database provider: [%s], vectors: [%d]", store.Name, store.VectorDatabaseProvider, count)
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// QdrantVectorStore keeps the embeddings in Qdrant collections through its
+// REST API, using one collection per dimension.
+type QdrantVectorStore struct {
+	url    string
+	apiKey string
+	client *http.Client
+
+	collections map[int]bool
+	mu          sync.Mutex
+}
+
+type qdrantPoint struct {
+	Id      string            `json:"id"`
+	Vector  []float32         `json:"vector"`
+	Payload map[string]string `json:"payload"`
+}
+
+type qdrantScoredPoint struct {
+	Id      string            `json:"id"`
+	Score   float32           `json:"score"`
+	Payload map[string]string `json:"payload"`
+}
+
+func NewQdrantVectorStore(url string, apiKey string) (*QdrantVectorStore, error) {
+	if url == "" {
+		url = "http://localhost:6333"
+	}
+
+	return &QdrantVectorStore{
+		url:         strings.TrimSuffix(url, "/"),
+		apiKey:      apiKey,
+		client:      &http.Client{Timeout: 30 * time.Second},
+		collections: map[int]bool{},
+	}, nil
+}
+
+func getQdrantCollectionName(dimension int) string {
+	return fmt.Sprintf("casibase_vector_%d", dimension)
+}
+
+// getQdrantPointId maps a vector id to a UUID because Qdrant only accepts
+// unsigned integers and UUIDs as point ids.
+func getQdrantPointId(id string) string {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(id)).String()
+}
+
+func (s *QdrantVectorStore) doRequest(method string, path string, body interface{}, result interface{}) (int, error) {
+	var reader io.Reader
+	if body != nil {
+		reqBody, err := json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+		reader = bytes.NewReader(reqBody)
+	}
+
+	req, err := http.NewRequest(method, s.url+path, reader)
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if s.apiKey != "" {
+		req.Header.Set("api-key", s.apiKey)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return resp.StatusCode, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode, fmt.Errorf("qdrant request: %s %s failed with status: %d, body: %s", method, path, resp.StatusCode, string(respBody))
+	}
+
+	if result != nil {
+		response := struct {
+			Result json.RawMessage `json:"result"`
+		}{}
+		err = json.Unmarshal(respBody, &response)
+		if err != nil {
+			return resp.StatusCode, err
+		}
+
+		err = json.Unmarshal(response.Result, result)
+		if err != nil {
+			return resp.StatusCode, err
+		}
+	}
+
+	return resp.StatusCode, nil
+}
+
+func (s *QdrantVectorStore) ensureCollection(dimension int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.collections[dimension] {
+		return nil
+	}
+
+	collection := getQdrantCollectionName(dimension)
+	status, err := s.doRequest("GET", "/collections/"+collection, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if status == http.StatusNotFound {
+		body := map[string]interface{}{
+			"vectors": map[string]interface{}{
+				"size":     dimension,
+				"distance": "Cosine",
+			},
+		}
+		_, err = s.doRequest("PUT", "/collections/"+collection, body, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, field := range []string{"store", "provider"} {
+			index := map[string]interface{}{
+				"field_name":   field,
+				"field_schema": "keyword",
+			}
+			_, err = s.doRequest("PUT", fmt.Sprintf("/collections/%s/index?wait=true", collection), index, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	s.collections[dimension] = true
+	return nil
+}
+
+func (s *QdrantVectorStore) saveVector(vector *Vector) error {
+	err := s.ensureCollection(len(vector.Data))
+	if err != nil {
+		return err
+	}
+
+	point := qdrantPoint{
+		Id:     getQdrantPointId(vector.GetId()),
+		Vector: vector.Data,
+		Payload: map[string]string{
+			"id":       vector.GetId(),
+			"store":    vector.Store,
+			"provider": vector.Provider,
+		},
+	}
+	body := map[string]interface{}{
+		"points": []qdrantPoint{point},
+	}
+	_, err = s.doRequest("PUT", fmt.Sprintf("/collections/%s/points?wait=true", getQdrantCollectionName(len(vector.Data))), body, nil)
+	return err
+}
+
+func (s *QdrantVectorStore) removeVector(vector *Vector) error {
+	body := map[string]interface{}{
+		"points": []string{getQdrantPointId(vector.GetId())},
+	}
+	_, err := s.doRequest("POST", fmt.Sprintf("/collections/%s/points/delete?wait=true", getQdrantCollectionName(vector.Dimension)), body, nil)
+	return err
+}
+
+func (s *QdrantVectorStore) searchIds(store string, provider string, target []float32, limit int) ([]string, []float32, error) {
+	body := map[string]interface{}{
+		"vector": target,
+		"limit":  limit,
+		"filter": map[string]interface{}{
+			"must": []map[string]interface{}{
+				{"key": "store", "match": map[string]string{"value": store}},
+				{"key": "provider", "match": map[string]string{"value": provider}},
+			},
+		},
+		"with_payload": true,
+	}
+
+	// A missing collection means that nothing of this dimension was saved yet.
+	points := []qdrantScoredPoint{}
+	_, err := s.doRequest("POST", fmt.Sprintf("/collections/%s/points/search", getQdrantCollectionName(len(target))), body, &points)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids := []string{}
+	similarities := []float32{}
+	for _, point := range points {
+		ids = append(ids, point.Payload["id"])
+		similarities = append(similarities, point.Score)
+	}
+	return ids, similarities, nil
+}
+
+func (s *QdrantVectorStore) AddVector(vector *Vector) (bool, error) {
+	return addExternalVector(vector, s.saveVector)
+}
+
+func (s *QdrantVectorStore) UpdateVector(id string, vector *Vector) (bool, error) {
+	return updateExternalVector(id, vector, s.saveVector)
+}
+
+func (s *QdrantVectorStore) DeleteVector(vector *Vector) (bool, error) {
+	return deleteExternalVector(vector, s.removeVector)
+}
+
+func (s *QdrantVectorStore) SearchVectors(store *Store, provider string, target []float32, n int, filter func(*Vector) bool) ([]Vector, error) {
+	return searchExternalVectors(store.Name, provider, n, filter, func(limit int) ([]string, []float32, error) {
+		return s.searchIds(store.Name, provider, target, limit)
+	})
+}
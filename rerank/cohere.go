@@ -0,0 +1,59 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rerank
+
+import "context"
+
+type CohereRerankProvider struct {
+	subType string
+	apiKey  string
+}
+
+func NewCohereRerankProvider(subType string, apiKey string) (*CohereRerankProvider, error) {
+	return &CohereRerankProvider{
+		subType: subType,
+		apiKey:  apiKey,
+	}, nil
+}
+
+func (p *CohereRerankProvider) GetPricing() string {
+	return `URL:
+https://cohere.com/pricing
+
+Rerank models:
+
+| Models    | Per 1,000 searches |
+|-----------|--------------------|
+| rerank-v3 | $2                 |
+`
+}
+
+func (p *CohereRerankProvider) calculatePrice(res *RerankResult, searchUnits int) {
+	pricePerSearch := 0.002
+	res.Price = float64(searchUnits) * pricePerSearch
+	res.Currency = "USD"
+}
+
+func (p *CohereRerankProvider) Rerank(query string, documents []string, topN int, ctx context.Context, lang string) ([]RerankScore, *RerankResult, error) {
+	resp, err := postRerankRequest(ctx, "https://api.cohere.com/v2/rerank", p.apiKey, p.subType, query, documents, topN, lang)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rerankResult := &RerankResult{}
+	p.calculatePrice(rerankResult, resp.Meta.BilledUnits.SearchUnits)
+
+	return getRerankScores(resp, len(documents), topN), rerankResult, nil
+}
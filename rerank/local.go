@@ -0,0 +1,70 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rerank
+
+import (
+	"context"
+	"strings"
+)
+
+// LocalRerankProvider talks to a self-hosted rerank server that exposes the
+// Jina/Cohere compatible "/rerank" endpoint next to its OpenAI-compatible API,
+// such as vLLM or Xinference.
+type LocalRerankProvider struct {
+	subType                string
+	apiKey                 string
+	providerUrl            string
+	pricePerThousandTokens float64
+	currency               string
+}
+
+func NewLocalRerankProvider(subType string, apiKey string, providerUrl string, pricePerThousandTokens float64, currency string) (*LocalRerankProvider, error) {
+	return &LocalRerankProvider{
+		subType:                subType,
+		apiKey:                 apiKey,
+		providerUrl:            providerUrl,
+		pricePerThousandTokens: pricePerThousandTokens,
+		currency:               currency,
+	}, nil
+}
+
+func (p *LocalRerankProvider) GetPricing() string {
+	return `URL:
+The price is configured by the provider's "Input price / 1k tokens".
+`
+}
+
+func (p *LocalRerankProvider) getUrl() string {
+	url := strings.TrimSuffix(p.providerUrl, "/")
+	if strings.HasSuffix(url, "/rerank") {
+		return url
+	}
+	return url + "/rerank"
+}
+
+func (p *LocalRerankProvider) Rerank(query string, documents []string, topN int, ctx context.Context, lang string) ([]RerankScore, *RerankResult, error) {
+	resp, err := postRerankRequest(ctx, p.getUrl(), p.apiKey, p.subType, query, documents, topN, lang)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rerankResult := &RerankResult{
+		TokenCount: resp.Usage.TotalTokens,
+		Price:      getPrice(resp.Usage.TotalTokens, p.pricePerThousandTokens),
+		Currency:   p.currency,
+	}
+
+	return getRerankScores(resp, len(documents), topN), rerankResult, nil
+}
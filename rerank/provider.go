@@ -0,0 +1,52 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rerank
+
+import "context"
+
+type RerankResult struct {
+	TokenCount int
+	Price      float64
+	Currency   string
+}
+
+type RerankScore struct {
+	Index int
+	Score float32
+}
+
+type RerankProvider interface {
+	GetPricing() string
+	Rerank(query string, documents []string, topN int, ctx context.Context, lang string) ([]RerankScore, *RerankResult, error)
+}
+
+func GetRerankProvider(typ string, subType string, clientSecret string, providerUrl string, pricePerThousandTokens float64, currency string, lang string) (RerankProvider, error) {
+	var p RerankProvider
+	var err error
+	if typ == "Cohere" {
+		p, err = NewCohereRerankProvider(subType, clientSecret)
+	} else if typ == "Jina" {
+		p, err = NewJinaRerankProvider(subType, clientSecret)
+	} else if typ == "Local" {
+		p, err = NewLocalRerankProvider(subType, clientSecret, providerUrl, pricePerThousandTokens, currency)
+	} else {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
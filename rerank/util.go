@@ -0,0 +1,123 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rerank
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+
+	"github.com/casibase/casibase/i18n"
+	"github.com/casibase/casibase/proxy"
+)
+
+// rerankResponse is the response body shared by the Cohere, Jina and most
+// self-hosted (vLLM, Xinference, TEI-compatible) rerank APIs.
+type rerankResponse struct {
+	Results []struct {
+		Index          int     `json:"index"`
+		RelevanceScore float32 `json:"relevance_score"`
+	} `json:"results"`
+	Usage struct {
+		TotalTokens int `json:"total_tokens"`
+	} `json:"usage"`
+	Meta struct {
+		BilledUnits struct {
+			SearchUnits int `json:"search_units"`
+		} `json:"billed_units"`
+	} `json:"meta"`
+}
+
+func getPrice(tokenCount int, pricePerThousandTokens float64) float64 {
+	res := (float64(tokenCount) / 1000.0) * pricePerThousandTokens
+	res = math.Round(res*1e8) / 1e8
+	return res
+}
+
+func postRerankRequest(ctx context.Context, url string, apiKey string, model string, query string, documents []string, topN int, lang string) (*rerankResponse, error) {
+	if query == "" {
+		return nil, fmt.Errorf(i18n.Translate(lang, "rerank:query cannot be empty"))
+	}
+
+	payload := map[string]interface{}{
+		"model":     model,
+		"query":     query,
+		"documents": documents,
+		"top_n":     topN,
+	}
+
+	reqBody, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.Translate(lang, "rerank:failed to marshal payload: %v"), err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf(i18n.Translate(lang, "rerank:failed to create request: %v"), err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+apiKey)
+	}
+
+	resp, err := proxy.ProxyHttpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.Translate(lang, "rerank:failed to read response body: %v"), err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(i18n.Translate(lang, "rerank:failed to get valid response, status code: %d, body: %s"), resp.StatusCode, string(body))
+	}
+
+	var res rerankResponse
+	err = json.Unmarshal(body, &res)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.Translate(lang, "rerank:failed to unmarshal response: %v"), err)
+	}
+
+	return &res, nil
+}
+
+func getRerankScores(resp *rerankResponse, documentCount int, topN int) []RerankScore {
+	res := []RerankScore{}
+	for _, result := range resp.Results {
+		if result.Index < 0 || result.Index >= documentCount {
+			continue
+		}
+
+		res = append(res, RerankScore{Index: result.Index, Score: result.RelevanceScore})
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Score > res[j].Score
+	})
+
+	if topN > 0 && topN < len(res) {
+		res = res[:topN]
+	}
+	return res
+}
@@ -21,7 +21,7 @@ import (
 )
 
 func AutoSigninFilter(ctx *context.Context) {
-	if strings.HasSuffix(ctx.Request.URL.Path, "/chat/completions") {
+	if strings.HasSuffix(ctx.Request.URL.Path, "/chat/completions") || ctx.Request.URL.Path == "/api/models" || ctx.Request.URL.Path == "/v1/models" {
 		return
 	}
 	// HTTP Bearer token like "Authorization: Bearer 123"
@@ -102,6 +102,7 @@ func initAPI() {
 	beego.Router("/api/get-messages", &controllers.ApiController{}, "GET:GetMessages")
 	beego.Router("/api/get-message", &controllers.ApiController{}, "GET:GetMessage")
 	beego.Router("/api/get-message-answer", &controllers.ApiController{}, "GET:GetMessageAnswer")
+	beego.Router("/api/stop-message-answer", &controllers.ApiController{}, "POST:StopMessageAnswer")
 	beego.Router("/api/get-answer", &controllers.ApiController{}, "GET:GetAnswer")
 	beego.Router("/api/update-message", &controllers.ApiController{}, "POST:UpdateMessage")
 	beego.Router("/api/add-message", &controllers.ApiController{}, "POST:AddMessage")
@@ -146,6 +147,15 @@ func initAPI() {
 	beego.Router("/api/add-workflow", &controllers.ApiController{}, "POST:AddWorkflow")
 	beego.Router("/api/delete-workflow", &controllers.ApiController{}, "POST:DeleteWorkflow")
 
+	beego.Router("/api/get-global-evaluations", &controllers.ApiController{}, "GET:GetGlobalEvaluations")
+	beego.Router("/api/get-evaluations", &controllers.ApiController{}, "GET:GetEvaluations")
+	beego.Router("/api/get-evaluation", &controllers.ApiController{}, "GET:GetEvaluation")
+	beego.Router("/api/update-evaluation", &controllers.ApiController{}, "POST:UpdateEvaluation")
+	beego.Router("/api/add-evaluation", &controllers.ApiController{}, "POST:AddEvaluation")
+	beego.Router("/api/delete-evaluation", &controllers.ApiController{}, "POST:DeleteEvaluation")
+	beego.Router("/api/run-evaluation", &controllers.ApiController{}, "POST:RunEvaluation")
+	beego.Router("/api/compare-evaluations", &controllers.ApiController{}, "GET:CompareEvaluations")
+
 	beego.Router("/api/get-global-tasks", &controllers.ApiController{}, "GET:GetGlobalTasks")
 	beego.Router("/api/get-tasks", &controllers.ApiController{}, "GET:GetTasks")
 	beego.Router("/api/get-task", &controllers.ApiController{}, "GET:GetTask")
@@ -287,6 +297,9 @@ func initAPI() {
 	beego.Handler("/api/metrics", promhttp.Handler())
 
 	beego.Router("/api/chat/completions", &controllers.ApiController{}, "POST:ChatCompletions")
+	beego.Router("/api/models", &controllers.ApiController{}, "GET:ListModels")
+	beego.Router("/v1/chat/completions", &controllers.ApiController{}, "POST:ChatCompletions")
+	beego.Router("/v1/models", &controllers.ApiController{}, "GET:ListModels")
 
 	beego.Router("/api/wecom-bot/callback/:botId", &controllers.ApiController{}, "GET:WecomBotVerifyUrl;POST:WecomBotHandleMessage")
 }
@@ -26,6 +26,7 @@ import (
 
 	"github.com/beego/beego/context"
 	"github.com/casibase/casibase/conf"
+	"github.com/casibase/casibase/object"
 	"github.com/casibase/casibase/util"
 )
 
@@ -47,7 +48,7 @@ func getWebBuildFolder() string {
 
 func StaticFilter(ctx *context.Context) {
 	urlPath := ctx.Request.URL.Path
-	if strings.HasPrefix(urlPath, "/api/") {
+	if strings.HasPrefix(urlPath, "/api/") || strings.HasPrefix(urlPath, "/v1/") {
 		return
 	}
 
@@ -72,6 +73,17 @@ func StaticFilter(ctx *context.Context) {
 
 		urlPath = strings.TrimPrefix(urlPath, "/storage/")
 		urlPath = strings.Replace(urlPath, "|", ":", 1)
+
+		ok, err := object.CanReadStorageFile(GetSessionUser(ctx), urlPath)
+		if err != nil {
+			responseError(ctx, err.Error())
+			return
+		}
+		if !ok {
+			responseError(ctx, "you are not allowed to download this file")
+			return
+		}
+
 		makeGzipResponse(ctx.ResponseWriter, ctx.Request, urlPath)
 		return
 	}
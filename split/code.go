@@ -0,0 +1,268 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package split
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const defaultCodeChunkSize = 512
+
+var codeLanguageMap = map[string]string{
+	".go":   "Go",
+	".py":   "Python",
+	".ts":   "TypeScript",
+	".tsx":  "TypeScript",
+	".js":   "JavaScript",
+	".jsx":  "JavaScript",
+	".java": "Java",
+}
+
+var goMethodPattern = regexp.MustCompile(`^func\s+\(\s*\w*\s*\*?(\w+)[^)]*\)\s*(\w+)`)
+
+// codeSymbolPatterns match the lines that start a function, class or type, the
+// first group is the symbol name.
+var codeSymbolPatterns = map[string][]*regexp.Regexp{
+	"Go": {
+		regexp.MustCompile(`^func\s+(\w+)`),
+		regexp.MustCompile(`^type\s+(\w+)`),
+	},
+	"Python": {
+		regexp.MustCompile(`^(?:async\s+)?def\s+(\w+)`),
+		regexp.MustCompile(`^class\s+(\w+)`),
+	},
+	"TypeScript": {
+		regexp.MustCompile(`^(?:export\s+)?(?:default\s+)?(?:async\s+)?function\*?\s+(\w+)`),
+		regexp.MustCompile(`^(?:export\s+)?(?:default\s+)?(?:abstract\s+)?class\s+(\w+)`),
+		regexp.MustCompile(`^(?:export\s+)?(?:declare\s+)?(?:interface|type|enum)\s+(\w+)`),
+		regexp.MustCompile(`^(?:export\s+)?(?:const|let|var)\s+(\w+)\s*(?::[^=]+)?=\s*(?:async\s+)?(?:\([^)]*\)|\w+)\s*(?::[^=]+)?=>`),
+	},
+	"Java": {
+		regexp.MustCompile(`^\s*(?:(?:public|protected|private|static|final|abstract|sealed)\s+)*(?:class|interface|enum|record)\s+(\w+)`),
+		regexp.MustCompile(`^\s+(?:(?:public|protected|private|static|final|abstract|synchronized|native|default)\s+)+(?:<[^>]+>\s+)?[\w<>\[\],.? ]+\s+(\w+)\s*\(`),
+	},
+}
+
+func init() {
+	codeSymbolPatterns["JavaScript"] = codeSymbolPatterns["TypeScript"]
+}
+
+// GetCodeLanguage returns the language of a source file by its extension, or ""
+// when the file is not source code.
+func GetCodeLanguage(ext string) string {
+	return codeLanguageMap[strings.ToLower(ext)]
+}
+
+// CodeSplitProvider cuts source files at the top-level functions, classes and
+// types (and the methods of Java classes). Every chunk starts with a header line
+// holding the file path and the symbol names, small neighbouring symbols share a
+// chunk and symbols longer than the chunk size are cut between lines.
+type CodeSplitProvider struct {
+	FilePath  string
+	Language  string
+	ChunkSize int
+
+	getTokenSize func(text string) (int, error)
+}
+
+type codeSegment struct {
+	symbol    string
+	text      string
+	tokenSize int
+}
+
+func NewCodeSplitProvider(filePath string, language string, chunkSize int) (*CodeSplitProvider, error) {
+	if chunkSize <= 0 {
+		chunkSize = defaultCodeChunkSize
+	}
+
+	return &CodeSplitProvider{
+		FilePath:     filePath,
+		Language:     language,
+		ChunkSize:    chunkSize,
+		getTokenSize: getTokenSize,
+	}, nil
+}
+
+func (p *CodeSplitProvider) getSymbol(line string, class string) string {
+	if p.Language == "Go" {
+		if m := goMethodPattern.FindStringSubmatch(line); m != nil {
+			return m[1] + "." + m[2]
+		}
+	}
+
+	for i, pattern := range codeSymbolPatterns[p.Language] {
+		if m := pattern.FindStringSubmatch(line); m != nil {
+			// Java methods are named after their class.
+			if p.Language == "Java" && i == 1 && class != "" {
+				return class + "." + m[1]
+			}
+			return m[1]
+		}
+	}
+	return ""
+}
+
+func isCodeCommentLine(line string) bool {
+	line = strings.TrimSpace(line)
+	for _, prefix := range []string{"//", "/*", "*", "#", "@"} {
+		if strings.HasPrefix(line, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// getSegments cuts the lines before every symbol, the comments, annotations and
+// decorators right above a symbol belong to it.
+func (p *CodeSplitProvider) getSegments(lines []string) []*codeSegment {
+	starts := []int{}
+	symbols := []string{}
+	class := ""
+	for i, line := range lines {
+		symbol := p.getSymbol(line, class)
+		if symbol == "" {
+			continue
+		}
+		if p.Language == "Java" && !strings.Contains(symbol, ".") {
+			class = symbol
+		}
+
+		start := i
+		for start > 0 && isCodeCommentLine(lines[start-1]) && (len(starts) == 0 || start-1 > starts[len(starts)-1]) {
+			start--
+		}
+		starts = append(starts, start)
+		symbols = append(symbols, symbol)
+	}
+
+	res := []*codeSegment{}
+	if len(starts) == 0 || starts[0] > 0 {
+		end := len(lines)
+		if len(starts) != 0 {
+			end = starts[0]
+		}
+		res = append(res, &codeSegment{text: strings.Join(lines[:end], "\n")})
+	}
+
+	for i, start := range starts {
+		end := len(lines)
+		if i+1 < len(starts) {
+			end = starts[i+1]
+		}
+		res = append(res, &codeSegment{symbol: symbols[i], text: strings.Join(lines[start:end], "\n")})
+	}
+	return res
+}
+
+func (p *CodeSplitProvider) getHeader(symbols []string) string {
+	if len(symbols) == 0 {
+		return p.FilePath
+	}
+	return fmt.Sprintf("%s > %s", p.FilePath, strings.Join(symbols, ", "))
+}
+
+func (p *CodeSplitProvider) SplitText(text string) ([]string, error) {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	segments := p.getSegments(strings.Split(text, "\n"))
+
+	for _, segment := range segments {
+		segment.text = strings.Trim(segment.text, "\n")
+
+		var err error
+		segment.tokenSize, err = p.getTokenSize(segment.text)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := []string{}
+	symbols := []string{}
+	texts := []string{}
+	size := 0
+	flush := func() {
+		if len(texts) != 0 {
+			res = append(res, p.getHeader(symbols)+"\n\n"+strings.Join(texts, "\n\n"))
+		}
+		symbols = []string{}
+		texts = []string{}
+		size = 0
+	}
+
+	for _, segment := range segments {
+		if strings.TrimSpace(segment.text) == "" {
+			continue
+		}
+
+		if segment.tokenSize > p.ChunkSize {
+			flush()
+
+			parts, err := p.splitLines(segment.text)
+			if err != nil {
+				return nil, err
+			}
+
+			header := p.getHeader(nil)
+			if segment.symbol != "" {
+				header = p.getHeader([]string{segment.symbol})
+			}
+			for _, part := range parts {
+				res = append(res, header+"\n\n"+part)
+			}
+			continue
+		}
+
+		if size+segment.tokenSize > p.ChunkSize {
+			flush()
+		}
+		if segment.symbol != "" {
+			symbols = append(symbols, segment.symbol)
+		}
+		texts = append(texts, segment.text)
+		size += segment.tokenSize
+	}
+	flush()
+
+	return res, nil
+}
+
+// splitLines packs the lines of a long symbol into parts of up to ChunkSize
+// tokens, a single line longer than that becomes a part of its own.
+func (p *CodeSplitProvider) splitLines(text string) ([]string, error) {
+	res := []string{}
+	current := []string{}
+	size := 0
+	for _, line := range strings.Split(text, "\n") {
+		lineSize, err := p.getTokenSize(line + "\n")
+		if err != nil {
+			return nil, err
+		}
+
+		if size+lineSize > p.ChunkSize && len(current) != 0 {
+			res = append(res, strings.Join(current, "\n"))
+			current = []string{}
+			size = 0
+		}
+		current = append(current, line)
+		size += lineSize
+	}
+
+	if len(current) != 0 {
+		res = append(res, strings.Join(current, "\n"))
+	}
+	return res, nil
+}
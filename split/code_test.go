@@ -0,0 +1,75 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package split
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCodeSplit(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		text     string
+		expected []string
+	}{
+		{
+			"go",
+			"object/store.go",
+			"package object\n\nimport \"fmt\"\n\n// Store is a store.\ntype Store struct {\n\tName string\n}\n\nfunc (s *Store) GetId() string {\n\treturn s.Name\n}\n\nfunc getStore() {\n\t" + strings.Repeat("fmt.Println(1)\n\t", 30) + "\n}\n",
+			[]string{"object/store.go > Store, Store.GetId\n\npackage object", "object/store.go > getStore\n\nfunc getStore() {", "object/store.go > getStore\n\n\tfmt.Println(1)"},
+		},
+		{
+			"python",
+			"app/main.py",
+			"import os\n\n@app.route(\"/\")\ndef index():\n    return os.name\n\nclass Handler:\n    def get(self):\n        pass\n",
+			[]string{"app/main.py > index, Handler\n\nimport os"},
+		},
+		{
+			"java",
+			"src/Main.java",
+			"package main;\n\npublic class Main {\n    /** Runs. */\n    public static void main(String[] args) {\n    }\n}\n",
+			[]string{"src/Main.java > Main, Main.main\n\npackage main;"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := NewCodeSplitProvider(test.path, GetCodeLanguage(test.path[strings.LastIndex(test.path, "."):]), 40)
+			if err != nil {
+				t.Fatal(err)
+			}
+			p.getTokenSize = testTokenSize
+
+			chunks, err := p.SplitText(test.text)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(chunks) < len(test.expected) {
+				t.Fatalf("SplitText() = %q, want at least %d chunks", chunks, len(test.expected))
+			}
+			for i, prefix := range test.expected {
+				if !strings.HasPrefix(chunks[i], prefix) {
+					t.Errorf("SplitText()[%d] = %q, want prefix %q", i, chunks[i], prefix)
+				}
+			}
+		})
+	}
+}
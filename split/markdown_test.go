@@ -24,7 +24,7 @@ import (
 )
 
 func TestSplit(t *testing.T) {
-	p, err := GetSplitProvider("Markdown")
+	p, err := GetSplitProvider("Markdown", 0, 0)
 	if err != nil {
 		panic(err)
 	}
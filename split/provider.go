@@ -18,7 +18,7 @@ type SplitProvider interface {
 	SplitText(text string) ([]string, error)
 }
 
-func GetSplitProvider(typ string) (SplitProvider, error) {
+func GetSplitProvider(typ string, chunkSize int, chunkOverlap int) (SplitProvider, error) {
 	var p SplitProvider
 	var err error
 	if typ == "Default" {
@@ -29,6 +29,10 @@ func GetSplitProvider(typ string) (SplitProvider, error) {
 		p, err = NewBasicSplitProvider()
 	} else if typ == "Markdown" {
 		p, err = NewMarkdownSplitProvider()
+	} else if typ == "Recursive" {
+		p, err = NewRecursiveSplitProvider(chunkSize, chunkOverlap)
+	} else if typ == "Table" {
+		p, err = NewTableSplitProvider(chunkSize)
 	} else {
 		p, err = NewDefaultSplitProvider("default")
 	}
@@ -0,0 +1,227 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package split
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/casibase/casibase/model"
+)
+
+const (
+	defaultChunkSize = 256
+	tokenSizeModel   = "gpt-3.5-turbo"
+)
+
+var (
+	headingPattern  = regexp.MustCompile(`(?m)^#{1,6}\s`)
+	sentencePattern = regexp.MustCompile(`[.!?;。！？；]+["'”’)\]）」]*\s*`)
+)
+
+// RecursiveSplitProvider splits the text by headings, then paragraphs, lines,
+// sentences and words, going one level deeper only for the pieces that are still
+// larger than the chunk size. The pieces are then merged back into chunks of up
+// to ChunkSize tokens, each chunk repeating the last ChunkOverlap tokens of the
+// previous one.
+type RecursiveSplitProvider struct {
+	ChunkSize    int
+	ChunkOverlap int
+
+	getTokenSize func(text string) (int, error)
+}
+
+type textPiece struct {
+	text      string
+	tokenSize int
+}
+
+func NewRecursiveSplitProvider(chunkSize int, chunkOverlap int) (*RecursiveSplitProvider, error) {
+	if chunkSize <= 0 {
+		chunkSize = defaultChunkSize
+	}
+	if chunkOverlap < 0 {
+		chunkOverlap = 0
+	}
+	if chunkOverlap > chunkSize/2 {
+		chunkOverlap = chunkSize / 2
+	}
+
+	return &RecursiveSplitProvider{
+		ChunkSize:    chunkSize,
+		ChunkOverlap: chunkOverlap,
+		getTokenSize: getTokenSize,
+	}, nil
+}
+
+var recursiveSeparators = []func(text string) []string{
+	splitBeforeHeadings,
+	func(text string) []string { return strings.SplitAfter(text, "\n\n") },
+	func(text string) []string { return strings.SplitAfter(text, "\n") },
+	splitAfterSentences,
+	func(text string) []string { return strings.SplitAfter(text, " ") },
+}
+
+func splitBeforeHeadings(text string) []string {
+	res := []string{}
+	start := 0
+	for _, match := range headingPattern.FindAllStringIndex(text, -1) {
+		if match[0] > start {
+			res = append(res, text[start:match[0]])
+			start = match[0]
+		}
+	}
+	return append(res, text[start:])
+}
+
+func splitAfterSentences(text string) []string {
+	res := []string{}
+	start := 0
+	for _, match := range sentencePattern.FindAllStringIndex(text, -1) {
+		res = append(res, text[start:match[1]])
+		start = match[1]
+	}
+	if start < len(text) {
+		res = append(res, text[start:])
+	}
+	return res
+}
+
+func getTokenSize(text string) (int, error) {
+	return model.GetTokenSize(tokenSizeModel, text)
+}
+
+func (p *RecursiveSplitProvider) SplitText(text string) ([]string, error) {
+	pieces, err := p.splitPieces(text, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.mergePieces(pieces), nil
+}
+
+// splitPieces cuts the text into pieces of at most ChunkSize tokens with the
+// separator of the level, the separators stay at the end of the pieces.
+func (p *RecursiveSplitProvider) splitPieces(text string, level int) ([]textPiece, error) {
+	tokenSize, err := p.getTokenSize(text)
+	if err != nil {
+		return nil, err
+	}
+
+	if tokenSize <= p.ChunkSize {
+		return []textPiece{{text: text, tokenSize: tokenSize}}, nil
+	}
+
+	if level == len(recursiveSeparators) {
+		return p.splitRunes(text, tokenSize)
+	}
+
+	res := []textPiece{}
+	for _, part := range recursiveSeparators[level](text) {
+		if part == "" {
+			continue
+		}
+
+		pieces, err := p.splitPieces(part, level+1)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, pieces...)
+	}
+	return res, nil
+}
+
+// splitRunes is the last resort for text without any separator, e.g. a long
+// URL or CJK text without punctuation.
+func (p *RecursiveSplitProvider) splitRunes(text string, tokenSize int) ([]textPiece, error) {
+	runes := []rune(text)
+	step := max(1, len(runes)*p.ChunkSize/tokenSize)
+
+	res := []textPiece{}
+	for start := 0; start < len(runes); {
+		end := min(start+step, len(runes))
+		piece := string(runes[start:end])
+
+		pieceSize, err := p.getTokenSize(piece)
+		if err != nil {
+			return nil, err
+		}
+
+		// The token count is not proportional to the rune count, so shrink the
+		// piece until it fits.
+		for pieceSize > p.ChunkSize && end-start > 1 {
+			end = start + (end-start)*p.ChunkSize/pieceSize
+			if end <= start {
+				end = start + 1
+			}
+
+			piece = string(runes[start:end])
+			pieceSize, err = p.getTokenSize(piece)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		res = append(res, textPiece{text: piece, tokenSize: pieceSize})
+		start = end
+	}
+	return res, nil
+}
+
+// mergePieces packs consecutive pieces into chunks. The token size of a chunk is
+// taken as the sum of its pieces, which is close enough for the budget.
+func (p *RecursiveSplitProvider) mergePieces(pieces []textPiece) []string {
+	res := []string{}
+	current := []textPiece{}
+	currentSize := 0
+	hasNewPiece := false
+
+	flush := func() {
+		if !hasNewPiece {
+			return
+		}
+
+		var builder strings.Builder
+		for _, piece := range current {
+			builder.WriteString(piece.text)
+		}
+
+		chunk := strings.TrimSpace(builder.String())
+		if chunk != "" {
+			res = append(res, chunk)
+		}
+		hasNewPiece = false
+	}
+
+	for _, piece := range pieces {
+		if currentSize+piece.tokenSize > p.ChunkSize && len(current) > 0 {
+			flush()
+
+			// Keep the trailing pieces that fit in the overlap, and drop more if the
+			// new piece would not fit next to them.
+			for len(current) > 0 && (currentSize > p.ChunkOverlap || currentSize+piece.tokenSize > p.ChunkSize) {
+				currentSize -= current[0].tokenSize
+				current = current[1:]
+			}
+		}
+
+		current = append(current, piece)
+		currentSize += piece.tokenSize
+		hasNewPiece = true
+	}
+	flush()
+
+	return res
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package split
+
+import (
+	"strings"
+	"testing"
+)
+
+// testTokenSize counts words and every 10 letters of a long word as tokens, so
+// that the test does not need to download the tiktoken encoding.
+func testTokenSize(text string) (int, error) {
+	res := 0
+	for _, word := range strings.Fields(text) {
+		res += (len(word) + 9) / 10
+	}
+	return res, nil
+}
+
+func TestRecursiveSplit(t *testing.T) {
+	sentences := []string{}
+	for i := 0; i < 40; i++ {
+		sentences = append(sentences, "The quick brown fox jumps over the lazy dog.")
+	}
+	text := "# Title\n\nA short intro.\n\n## Details\n\n" + strings.Join(sentences, " ") + "\n\n" + strings.Repeat("x", 2000)
+
+	p, err := NewRecursiveSplitProvider(50, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.getTokenSize = testTokenSize
+
+	chunks, err := p.SplitText(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(chunks) < 2 || !strings.HasPrefix(chunks[0], "# Title") {
+		t.Fatalf("SplitText() = %q, want several chunks starting with the title", chunks)
+	}
+
+	for i, chunk := range chunks {
+		tokenSize, _ := testTokenSize(chunk)
+		if tokenSize > p.ChunkSize {
+			t.Errorf("SplitText()[%d] has %d tokens, want at most %d: %q", i, tokenSize, p.ChunkSize, chunk)
+		}
+	}
+
+	overlapped := false
+	for i := 1; i < len(chunks); i++ {
+		previous := chunks[i-1]
+		if strings.Contains(chunks[i], "fox") && strings.HasSuffix(previous, chunks[i][:strings.Index(chunks[i], ".")+1]) {
+			overlapped = true
+		}
+	}
+	if !overlapped {
+		t.Errorf("SplitText() = %q, want consecutive chunks to overlap", chunks)
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package split
+
+import (
+	"regexp"
+	"strings"
+)
+
+var tableSeparatorPattern = regexp.MustCompile(`^\|?\s*:?-{3,}:?\s*(\|\s*:?-{3,}:?\s*)*\|?$`)
+
+// TableSplitProvider cuts markdown tables, as written for .xlsx and .csv files,
+// into groups of rows. Every chunk repeats the heading above the table (the
+// sheet name) and the header row, so that each row can be understood alone.
+// Text outside of tables is split by the recursive split provider.
+type TableSplitProvider struct {
+	ChunkSize int
+
+	getTokenSize func(text string) (int, error)
+}
+
+func NewTableSplitProvider(chunkSize int) (*TableSplitProvider, error) {
+	if chunkSize <= 0 {
+		chunkSize = defaultChunkSize
+	}
+
+	return &TableSplitProvider{
+		ChunkSize:    chunkSize,
+		getTokenSize: getTokenSize,
+	}, nil
+}
+
+func isTableRow(line string) bool {
+	return strings.HasPrefix(strings.TrimSpace(line), "|")
+}
+
+func (p *TableSplitProvider) SplitText(text string) ([]string, error) {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+
+	res := []string{}
+	heading := ""
+	plainLines := []string{}
+	flushPlain := func() error {
+		plainText := strings.TrimSpace(strings.Join(plainLines, "\n"))
+		plainLines = []string{}
+		if plainText == "" {
+			return nil
+		}
+
+		splitProvider, err := NewRecursiveSplitProvider(p.ChunkSize, 0)
+		if err != nil {
+			return err
+		}
+		splitProvider.getTokenSize = p.getTokenSize
+
+		chunks, err := splitProvider.SplitText(plainText)
+		if err != nil {
+			return err
+		}
+		res = append(res, chunks...)
+		return nil
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(line, "#") {
+			err := flushPlain()
+			if err != nil {
+				return nil, err
+			}
+
+			heading = line
+			continue
+		}
+
+		if !isTableRow(line) || i+1 >= len(lines) || !tableSeparatorPattern.MatchString(strings.TrimSpace(lines[i+1])) {
+			plainLines = append(plainLines, line)
+			continue
+		}
+
+		err := flushPlain()
+		if err != nil {
+			return nil, err
+		}
+
+		end := i + 2
+		for end < len(lines) && isTableRow(lines[end]) {
+			end++
+		}
+
+		chunks, err := p.splitRows(heading, line+"\n"+strings.TrimSpace(lines[i+1]), lines[i+2:end])
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, chunks...)
+		i = end - 1
+	}
+
+	err := flushPlain()
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// splitRows packs the rows into chunks of up to ChunkSize tokens counting the
+// repeated heading and header, a row is never cut.
+func (p *TableSplitProvider) splitRows(heading string, header string, rows []string) ([]string, error) {
+	prefix := header
+	if heading != "" {
+		prefix = heading + "\n\n" + header
+	}
+
+	prefixSize, err := p.getTokenSize(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []string{}
+	current := []string{}
+	size := prefixSize
+	for _, row := range rows {
+		row = strings.TrimSpace(row)
+
+		rowSize, err := p.getTokenSize(row)
+		if err != nil {
+			return nil, err
+		}
+
+		if size+rowSize > p.ChunkSize && len(current) != 0 {
+			res = append(res, prefix+"\n"+strings.Join(current, "\n"))
+			current = []string{}
+			size = prefixSize
+		}
+		current = append(current, row)
+		size += rowSize
+	}
+
+	if len(current) != 0 {
+		res = append(res, prefix+"\n"+strings.Join(current, "\n"))
+	}
+	return res, nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !skipCi
+// +build !skipCi
+
+package split
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTableSplit(t *testing.T) {
+	rows := []string{}
+	for i := 0; i < 5; i++ {
+		rows = append(rows, fmt.Sprintf("| SKU-%d | Widget %d | %d.99 |", i, i, i))
+	}
+	text := "## Prices\n\n| SKU | Name | Price |\n| --- | --- | --- |\n" + strings.Join(rows, "\n")
+
+	p, err := NewTableSplitProvider(35)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.getTokenSize = testTokenSize
+
+	chunks, err := p.SplitText(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	prefix := "## Prices\n\n| SKU | Name | Price |\n| --- | --- | --- |\n"
+	expected := []string{
+		prefix + strings.Join(rows[:2], "\n"),
+		prefix + strings.Join(rows[2:4], "\n"),
+		prefix + rows[4],
+	}
+	if !reflect.DeepEqual(chunks, expected) {
+		t.Errorf("SplitText() = %q, want %q", chunks, expected)
+	}
+}
@@ -1,3 +1,17 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
 /*! For license information please see swagger-ui-es-bundle-core.js.LICENSE.txt */
 module.exports=function(e){var t={};function n(r){if(t[r])return t[r].exports;var a=t[r]={i:r,l:!1,exports:{}};return e[r].call(a.exports,a,a.exports,n),a.l=!0,a.exports}return n.m=e,n.c=t,n.d=function(e,t,r){n.o(e,t)||Object.defineProperty(e,t,{enumerable:!0,get:r})},n.r=function(e){"undefined"!=typeof Symbol&&Symbol.toStringTag&&Object.defineProperty(e,Symbol.toStringTag,{value:"Module"}),Object.defineProperty(e,"__esModule",{value:!0})},n.t=function(e,t){if(1&t&&(e=n(e)),8&t)return e;if(4&t&&"object"==typeof e&&e&&e.__esModule)return e;var r=Object.create(null);if(n.r(r),Object.defineProperty(r,"default",{enumerable:!0,value:e}),2&t&&"string"!=typeof e)for(var a in e)n.d(r,a,function(t){return e[t]}.bind(null,a));return r},n.n=function(e){var t=e&&e.__esModule?function(){return e.default}:function(){return e};return n.d(t,"a",t),t},n.o=function(e,t){return Object.prototype.hasOwnProperty.call(e,t)},n.p="/dist",n(n.s=414)}([function(e,t){e.exports=require("react")},function(e,t){e.exports=require("immutable")},function(e,t,n){e.exports=n(449)},function(e,t,n){var r=n(199);e.exports=function(e,t,n){return t in e?r(e,t,{value:n,enumerable:!0,configurable:!0,writable:!0}):e[t]=n,e},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){e.exports=n(478)},function(e,t,n){"use strict";(function(e){n.d(t,"z",(function(){return be})),n.d(t,"i",(function(){return Ee})),n.d(t,"v",(function(){return xe})),n.d(t,"r",(function(){return Se})),n.d(t,"t",(function(){return we})),n.d(t,"s",(function(){return je})),n.d(t,"p",(function(){return Oe})),n.d(t,"u",(function(){return Ce})),n.d(t,"x",(function(){return _e})),n.d(t,"y",(function(){return Ae})),n.d(t,"J",(function(){return ke})),n.d(t,"f",(function(){return Ie})),n.d(t,"n",(function(){return Pe})),n.d(t,"h",(function(){return Ne})),n.d(t,"D",(function(){return Te})),n.d(t,"K",(function(){return Me})),n.d(t,"o",(function(){return Ve})),n.d(t,"C",(function(){return ze})),n.d(t,"a",(function(){return Fe})),n.d(t,"H",(function(){return Je})),n.d(t,"b",(function(){return We})),n.d(t,"G",(function(){return He})),n.d(t,"F",(function(){return $e})),n.d(t,"E",(function(){return Ye})),n.d(t,"k",(function(){return Ke})),n.d(t,"d",(function(){return Ge})),n.d(t,"g",(function(){return Ze})),n.d(t,"m",(function(){return Xe})),n.d(t,"l",(function(){return Qe})),n.d(t,"e",(function(){return et})),n.d(t,"I",(function(){return tt})),n.d(t,"w",(function(){return nt})),n.d(t,"A",(function(){return rt})),n.d(t,"B",(function(){return at})),n.d(t,"j",(function(){return ot})),n.d(t,"c",(function(){return it})),n.d(t,"q",(function(){return ct}));var r=n(14),a=n.n(r),o=(n(13),n(18)),i=n.n(o),s=n(45),c=n.n(s),u=n(23),l=n.n(u),p=n(4),f=n.n(p),d=n(72),h=n.n(d),m=n(2),v=n.n(m),g=n(22),y=n.n(g),b=n(12),E=n.n(b),x=n(15),S=n.n(x),w=(n(33),n(28)),j=n.n(w),O=n(20),C=n.n(O),_=n(171),A=n.n(_),k=n(19),I=n.n(k),P=n(64),N=n.n(P),T=(n(29),n(30)),R=n.n(T),M=n(17),q=n.n(M),D=n(52),B=n.n(D),L=n(93),U=n.n(L),V=n(90),z=n.n(V),F=n(1),J=n.n(F),W=n(383),H=n(384),$=n.n(H),Y=n(218),K=n.n(Y),G=n(219),Z=n.n(G),X=n(385),Q=n.n(X),ee=n(279),te=n.n(ee),ne=n(88),re=n.n(ne),ae=n(89),oe=n.n(ae),ie=n(122),se=n(25),ce=n(387),ue=n.n(ce),le=n(125),pe=n(108),fe=n.n(pe),de=n(388),he=n.n(de),me=n(63),ve=n.n(me),ge="default",ye=function(e){return J.a.Iterable.isIterable(e)};function be(e){return we(e)?ye(e)?e.toJS():e:{}}function Ee(e){var t,n;if(ye(e))return e;if(e instanceof se.a.File)return e;if(!we(e))return e;if(l()(e))return f()(n=J.a.Seq(e)).call(n,Ee).toList();if(oe()(h()(e))){var r,a=function(e){if(!oe()(h()(e)))return e;var t,n={},r="_**[]",a={},o=c()(h()(e).call(e));try{for(o.s();!(t=o.n()).done;){var i=t.value;if(n[i[0]]||a[i[0]]&&a[i[0]].containsMultiple){var s,u,l,p;if(!a[i[0]])a[i[0]]={containsMultiple:!0,length:1},n[v()(l=v()(p="".concat(i[0])).call(p,r)).call(l,a[i[0]].length)]=n[i[0]],delete n[i[0]];a[i[0]].length+=1,n[v()(s=v()(u="".concat(i[0])).call(u,r)).call(s,a[i[0]].length)]=i[1]}else n[i[0]]=i[1]}}catch(e){o.e(e)}finally{o.f()}return n}(e);return f()(r=J.a.OrderedMap(a)).call(r,Ee)}return f()(t=J.a.OrderedMap(e)).call(t,Ee)}function xe(e){return l()(e)?e:[e]}function Se(e){return"function"==typeof e}function we(e){return!!e&&"object"===i()(e)}function je(e){return"function"==typeof e}function Oe(e){return l()(e)}var Ce=Z.a;function _e(e,t){var n;return j()(n=S()(e)).call(n,(function(n,r){return n[r]=t(e[r],r),n}),{})}function Ae(e,t){var n;return j()(n=S()(e)).call(n,(function(n,r){var a=t(e[r],r);return a&&"object"===i()(a)&&C()(n,a),n}),{})}function ke(e){return function(t){t.dispatch,t.getState;return function(t){return function(n){return"function"==typeof n?n(e()):t(n)}}}}function Ie(e){var t,n=e.keySeq();return n.contains(ge)?ge:A()(t=E()(n).call(n,(function(e){return"2"===(e+"")[0]}))).call(t).first()}function Pe(e,t){if(!J.a.Iterable.isIterable(e))return J.a.List();var n=e.getIn(l()(t)?t:[t]);return J.a.List.isList(n)?n:J.a.List()}function Ne(e){var t,n=[/filename\*=[^']+'\w*'"([^"]+)";?/i,/filename\*=[^']+'\w*'([^;]+);?/i,/filename="([^;]*);?"/i,/filename=([^;]*);?/i];if(N()(n).call(n,(function(n){return null!==(t=n.exec(e))})),null!==t&&t.length>1)try{return decodeURIComponent(t[1])}catch(e){console.error(e)}return null}function Te(e){return t=e.replace(/\.[^./]*$/,""),K()($()(t));var t}function Re(e,t,n,r,o){if(!t)return[];var s=[],c=t.get("nullable"),u=t.get("required"),p=t.get("maximum"),d=t.get("minimum"),h=t.get("type"),m=t.get("format"),g=t.get("maxLength"),b=t.get("minLength"),x=t.get("uniqueItems"),S=t.get("maxItems"),w=t.get("minItems"),j=t.get("pattern"),O=n||!0===u,C=null!=e;if(c&&null===e||!h||!(O||C&&"array"===h||!(!O&&!C)))return[];var _="string"===h&&e,A="array"===h&&l()(e)&&e.length,k="array"===h&&J.a.List.isList(e)&&e.count(),I=[_,A,k,"array"===h&&"string"==typeof e&&e,"file"===h&&e instanceof se.a.File,"boolean"===h&&(e||!1===e),"number"===h&&(e||0===e),"integer"===h&&(e||0===e),"object"===h&&"object"===i()(e)&&null!==e,"object"===h&&"string"==typeof e&&e],P=N()(I).call(I,(function(e){return!!e}));if(O&&!P&&!r)return s.push("Required field is not provided"),s;if("object"===h&&(null===o||"application/json"===o)){var T,R=e;if("string"==typeof e)try{R=JSON.parse(e)}catch(e){return s.push("Parameter string value must be valid JSON"),s}if(t&&t.has("required")&&je(u.isList)&&u.isList()&&y()(u).call(u,(function(e){void 0===R[e]&&s.push({propKey:e,error:"Required property not found"})})),t&&t.has("properties"))y()(T=t.get("properties")).call(T,(function(e,t){var n=Re(R[t],e,!1,r,o);s.push.apply(s,a()(f()(n).call(n,(function(e){return{propKey:t,error:e}}))))}))}if(j){var M=function(e,t){if(!new RegExp(t).test(e))return"Value must follow pattern "+t}(e,j);M&&s.push(M)}if(w&&"array"===h){var q=function(e,t){var n;if(!e&&t>=1||e&&e.length<t)return v()(n="Array must contain at least ".concat(t," item")).call(n,1===t?"":"s")}(e,w);q&&s.push(q)}if(S&&"array"===h){var D=function(e,t){var n;if(e&&e.length>t)return v()(n="Array must not contain more then ".concat(t," item")).call(n,1===t?"":"s")}(e,S);D&&s.push({needRemove:!0,error:D})}if(x&&"array"===h){var B=function(e,t){if(e&&("true"===t||!0===t)){var n=Object(F.fromJS)(e),r=n.toSet();if(e.length>r.size){var a=Object(F.Set)();if(y()(n).call(n,(function(e,t){E()(n).call(n,(function(t){return je(t.equals)?t.equals(e):t===e})).size>1&&(a=a.add(t))})),0!==a.size)return f()(a).call(a,(function(e){return{index:e,error:"No duplicates allowed."}})).toArray()}}}(e,x);B&&s.push.apply(s,a()(B))}if(g||0===g){var L=function(e,t){var n;if(e.length>t)return v()(n="Value must be no longer than ".concat(t," character")).call(n,1!==t?"s":"")}(e,g);L&&s.push(L)}if(b){var U=function(e,t){var n;if(e.length<t)return v()(n="Value must be at least ".concat(t," character")).call(n,1!==t?"s":"")}(e,b);U&&s.push(U)}if(p||0===p){var V=function(e,t){if(e>t)return"Value must be less than ".concat(t)}(e,p);V&&s.push(V)}if(d||0===d){var z=function(e,t){if(e<t)return"Value must be greater than ".concat(t)}(e,d);z&&s.push(z)}if("string"===h){var W;if(!(W="date-time"===m?function(e){if(isNaN(Date.parse(e)))return"Value must be a DateTime"}(e):"uuid"===m?function(e){if(e=e.toString().toLowerCase(),!/^[{(]?[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}[)}]?$/.test(e))return"Value must be a Guid"}(e):function(e){if(e&&"string"!=typeof e)return"Value must be a string"}(e)))return s;s.push(W)}else if("boolean"===h){var H=function(e){if("true"!==e&&"false"!==e&&!0!==e&&!1!==e)return"Value must be a boolean"}(e);if(!H)return s;s.push(H)}else if("number"===h){var $=function(e){if(!/^-?\d+(\.?\d+)?$/.test(e))return"Value must be a number"}(e);if(!$)return s;s.push($)}else if("integer"===h){var Y=function(e){if(!/^-?\d+$/.test(e))return"Value must be an integer"}(e);if(!Y)return s;s.push(Y)}else if("array"===h){if(!A&&!k)return s;e&&y()(e).call(e,(function(e,n){var i=Re(e,t.get("items"),!1,r,o);s.push.apply(s,a()(f()(i).call(i,(function(e){return{index:n,error:e}}))))}))}else if("file"===h){var K=function(e){if(e&&!(e instanceof se.a.File))return"Value must be a file"}(e);if(!K)return s;s.push(K)}return s}var Me=function(e,t){var n=arguments.length>2&&void 0!==arguments[2]?arguments[2]:{},r=n.isOAS3,a=void 0!==r&&r,o=n.bypassRequiredCheck,i=void 0!==o&&o,s=e.get("required"),c=Object(le.a)(e,{isOAS3:a}),u=c.schema,l=c.parameterContentMediaType;return Re(t,u,s,i,l)},qe=function(e,t,n){if(e&&(!e.xml||!e.xml.name)){if(e.xml=e.xml||{},!e.$$ref)return e.type||e.items||e.properties||e.additionalProperties?'<?xml version="1.0" encoding="UTF-8"?>\n\x3c!-- XML example cannot be generated; root element name is undefined --\x3e':null;var r=e.$$ref.match(/\S*\/(\S+)$/);e.xml.name=r[1]}return Object(ie.memoizedCreateXMLExample)(e,t,n)},De=[{when:/json/,shouldStringifyTypes:["string"]}],Be=["object"],Le=function(e,t,n,r){var o=Object(ie.memoizedSampleFromSchema)(e,t,r),s=i()(o),c=j()(De).call(De,(function(e,t){var r;return t.when.test(n)?v()(r=[]).call(r,a()(e),a()(t.shouldStringifyTypes)):e}),Be);return te()(c,(function(e){return e===s}))?R()(o,null,2):o},Ue=function(e,t,n,r){var a,o=Le(e,t,n,r);try{"\n"===(a=ve.a.dump(ve.a.load(o),{lineWidth:-1}))[a.length-1]&&(a=I()(a).call(a,0,a.length-1))}catch(e){return console.error(e),"error: could not generate yaml example"}return a.replace(/\t/g,"  ")},Ve=function(e){var t=arguments.length>1&&void 0!==arguments[1]?arguments[1]:"",n=arguments.length>2&&void 0!==arguments[2]?arguments[2]:{},r=arguments.length>3&&void 0!==arguments[3]?arguments[3]:void 0;return e&&je(e.toJS)&&(e=e.toJS()),r&&je(r.toJS)&&(r=r.toJS()),/xml/.test(t)?qe(e,n,r):/(yaml|yml)/.test(t)?Ue(e,n,t,r):Le(e,n,t,r)},ze=function(){var e={},t=se.a.location.search;if(!t)return{};if(""!=t){var n=t.substr(1).split("&");for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(r=n[r].split("="),e[decodeURIComponent(r[0])]=r[1]&&decodeURIComponent(r[1])||"")}return e},Fe=function(t){return(t instanceof e?t:e.from(t.toString(),"utf-8")).toString("base64")},Je={operationsSorter:{alpha:function(e,t){return e.get("path").localeCompare(t.get("path"))},method:function(e,t){return e.get("method").localeCompare(t.get("method"))}},tagsSorter:{alpha:function(e,t){return e.localeCompare(t)}}},We=function(e){var t=[];for(var n in e){var r=e[n];void 0!==r&&""!==r&&t.push([n,"=",encodeURIComponent(r).replace(/%20/g,"+")].join(""))}return t.join("&")},He=function(e,t,n){return!!Q()(n,(function(n){return re()(e[n],t[n])}))};function $e(e){return"string"!=typeof e||""===e?"":Object(W.sanitizeUrl)(e)}function Ye(e){return!(!e||q()(e).call(e,"localhost")>=0||q()(e).call(e,"127.0.0.1")>=0||"none"===e)}function Ke(e){if(!J.a.OrderedMap.isOrderedMap(e))return null;if(!e.size)return null;var t=B()(e).call(e,(function(e,t){return U()(t).call(t,"2")&&S()(e.get("content")||{}).length>0})),n=e.get("default")||J.a.OrderedMap(),r=(n.get("content")||J.a.OrderedMap()).keySeq().toJS().length?n:null;return t||r}var Ge=function(e){return"string"==typeof e||e instanceof String?z()(e).call(e).replace(/\s/g,"%20"):""},Ze=function(e){return ue()(Ge(e).replace(/%20/g,"_"))},Xe=function(e){return E()(e).call(e,(function(e,t){return/^x-/.test(t)}))},Qe=function(e){return E()(e).call(e,(function(e,t){return/^pattern|maxLength|minLength|maximum|minimum/.test(t)}))};function et(e,t){var n,r=arguments.length>2&&void 0!==arguments[2]?arguments[2]:function(){return!0};if("object"!==i()(e)||l()(e)||null===e||!t)return e;var a=C()({},e);return y()(n=S()(a)).call(n,(function(e){e===t&&r(a[e],e)?delete a[e]:a[e]=et(a[e],t,r)})),a}function tt(e){if("string"==typeof e)return e;if(e&&e.toJS&&(e=e.toJS()),"object"===i()(e)&&null!==e)try{return R()(e,null,2)}catch(t){return String(e)}return null==e?"":e.toString()}function nt(e){return"number"==typeof e?e.toString():e}function rt(e){var t=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{},n=t.returnAll,r=void 0!==n&&n,a=t.allowHashes,o=void 0===a||a;if(!J.a.Map.isMap(e))throw new Error("paramToIdentifier: received a non-Im.Map parameter as input");var i,s,c,u=e.get("name"),l=e.get("in"),p=[];e&&e.hashCode&&l&&u&&o&&p.push(v()(i=v()(s="".concat(l,".")).call(s,u,".hash-")).call(i,e.hashCode()));l&&u&&p.push(v()(c="".concat(l,".")).call(c,u));return p.push(u),r?p:p[0]||""}function at(e,t){var n,r=rt(e,{returnAll:!0});return E()(n=f()(r).call(r,(function(e){return t[e]}))).call(n,(function(e){return void 0!==e}))[0]}function ot(){return st(fe()(32).toString("base64"))}function it(e){return st(he()("sha256").update(e).digest("base64"))}function st(e){return e.replace(/\+/g,"-").replace(/\//g,"_").replace(/=/g,"")}var ct=function(e){return!e||!(!ye(e)||!e.isEmpty())}}).call(this,n(528).Buffer)},function(e,t){e.exports=function(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){var r=n(199);function a(e,t){for(var n=0;n<t.length;n++){var a=t[n];a.enumerable=a.enumerable||!1,a.configurable=!0,"value"in a&&(a.writable=!0),r(e,a.key,a)}}e.exports=function(e,t,n){return t&&a(e.prototype,t),n&&a(e,n),e},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){var r=n(690),a=n(694);e.exports=function(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function");e.prototype=r(t&&t.prototype,{constructor:{value:e,writable:!0,configurable:!0}}),t&&a(e,t)},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){var r=n(373),a=n(701),o=n(706),i=n(707);e.exports=function(e){var t=o();return function(){var n,o=a(e);if(t){var s=a(this).constructor;n=r(o,arguments,s)}else n=o.apply(this,arguments);return i(this,n)}},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t){e.exports=function(e){if(void 0===e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return e},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t){e.exports=require("prop-types")},function(e,t,n){e.exports=n(452)},function(e,t,n){var r=n(336),a=n(542),o=n(166),i=n(337);e.exports=function(e,t){return r(e)||a(e,t)||o(e,t)||i()},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){var r=n(532),a=n(329),o=n(166),i=n(541);e.exports=function(e){return r(e)||a(e)||o(e)||i()},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){e.exports=n(455)},function(e,t){e.exports=require("reselect")},function(e,t,n){e.exports=n(569)},function(e,t,n){var r=n(147),a=n(445);function o(t){return"function"==typeof r&&"symbol"==typeof a?(e.exports=o=function(e){return typeof e},e.exports.default=e.exports,e.exports.__esModule=!0):(e.exports=o=function(e){return e&&"function"==typeof r&&e.constructor===r&&e!==r.prototype?"symbol":typeof e},e.exports.default=e.exports,e.exports.__esModule=!0),o(t)}e.exports=o,e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){e.exports=n(468)},function(e,t,n){e.exports=n(466)},function(e,t,n){"use strict";var r=n(38),a=n(110).f,o=n(292),i=n(31),s=n(99),c=n(67),u=n(51),l=function(e){var t=function(t,n,r){if(this instanceof e){switch(arguments.length){case 0:return new e;case 1:return new e(t);case 2:return new e(t,n)}return new e(t,n,r)}return e.apply(this,arguments)};return t.prototype=e.prototype,t};e.exports=function(e,t){var n,p,f,d,h,m,v,g,y=e.target,b=e.global,E=e.stat,x=e.proto,S=b?r:E?r[y]:(r[y]||{}).prototype,w=b?i:i[y]||(i[y]={}),j=w.prototype;for(f in t)n=!o(b?f:y+(E?".":"#")+f,e.forced)&&S&&u(S,f),h=w[f],n&&(m=e.noTargetGet?(g=a(S,f))&&g.value:S[f]),d=n&&m?m:t[f],n&&typeof h==typeof d||(v=e.bind&&n?s(d,r):e.wrap&&n?l(d):x&&"function"==typeof d?s(Function.call,d):d,(e.sham||d&&d.sham||h&&h.sham)&&c(v,"sham",!0),w[f]=v,x&&(u(i,p=y+"Prototype")||c(i,p,{}),i[p][f]=d,e.real&&j&&!j[f]&&c(j,f,d)))}},function(e,t,n){e.exports=n(338)},function(e,t,n){e.exports=n(471)},function(e,t,n){var r=n(358),a=n(359),o=n(646),i=n(648),s=n(652),c=n(654),u=n(659),l=n(199),p=n(3);function f(e,t){var n=r(e);if(a){var s=a(e);t&&(s=o(s).call(s,(function(t){return i(e,t).enumerable}))),n.push.apply(n,s)}return n}e.exports=function(e){for(var t=1;t<arguments.length;t++){var n,r=null!=arguments[t]?arguments[t]:{};if(t%2)s(n=f(Object(r),!0)).call(n,(function(t){p(e,t,r[t])}));else if(c)u(e,c(r));else{var a;s(a=f(Object(r))).call(a,(function(t){l(e,t,i(r,t))}))}}return e},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){"use strict";t.a=function(){var e={location:{},history:{},open:function(){},close:function(){},File:function(){}};if("undefined"==typeof window)return e;try{e=window;for(var t=0,n=["File","Blob","FormData"];t<n.length;t++){var r=n[t];r in window&&(e[r]=window[r])}}catch(e){console.error(e)}return e}()},function(e,t){e.exports=require("react-immutable-proptypes")},function(e,t,n){var r=n(688);function a(){return e.exports=a=r||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e},e.exports.default=e.exports,e.exports.__esModule=!0,a.apply(this,arguments)}e.exports=a,e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){e.exports=n(473)},function(e,t,n){e.exports=n(562)},function(e,t,n){e.exports=n(457)},function(e,t){e.exports={}},function(e,t,n){"use strict";n.r(t),n.d(t,"isOAS3",(function(){return u})),n.d(t,"isSwagger2",(function(){return l})),n.d(t,"OAS3ComponentWrapFactory",(function(){return p}));var r=n(27),a=n.n(r),o=n(93),i=n.n(o),s=n(0),c=n.n(s);function u(e){var t=e.get("openapi");return"string"==typeof t&&(i()(t).call(t,"3.0.")&&t.length>4)}function l(e){var t=e.get("swagger");return"string"==typeof t&&i()(t).call(t,"2.0")}function p(e){return function(t,n){return function(r){return n&&n.specSelectors&&n.specSelectors.specJson?u(n.specSelectors.specJson())?c.a.createElement(e,a()({},r,n,{Ori:t})):c.a.createElement(t,r):(console.warn("OAS3 wrapper: couldn't get spec"),null)}}}},function(e,t,n){e.exports=n(462)},function(e,t){e.exports=function(e){try{return!!e()}catch(e){return!0}}},function(e,t,n){var r=n(38),a=n(186),o=n(51),i=n(152),s=n(185),c=n(290),u=a("wks"),l=r.Symbol,p=c?l:l&&l.withoutSetter||i;e.exports=function(e){return o(u,e)&&(s||"string"==typeof u[e])||(s&&o(l,e)?u[e]=l[e]:u[e]=p("Symbol."+e)),u[e]}},function(e,t,n){var r=n(215);e.exports=function(e,t,n){var a=null==e?void 0:r(e,t);return void 0===a?n:a}},function(e,t,n){e.exports=n(677)},function(e,t,n){(function(t){var n=function(e){return e&&e.Math==Math&&e};e.exports=n("object"==typeof globalThis&&globalThis)||n("object"==typeof window&&window)||n("object"==typeof self&&self)||n("object"==typeof t&&t)||function(){return this}()||Function("return this")()}).call(this,n(184))},function(e,t,n){var r=n(31);e.exports=function(e){return r[e+"Prototype"]}},function(e,t,n){var r=n(31),a=n(51),o=n(195),i=n(59).f;e.exports=function(e){var t=r.Symbol||(r.Symbol={});a(t,e)||i(t,e,{value:o.f(e)})}},function(e,t){e.exports=function(e){return"object"==typeof e?null!==e:"function"==typeof e}},function(e,t,n){"use strict";n.r(t),n.d(t,"UPDATE_SPEC",(function(){return te})),n.d(t,"UPDATE_URL",(function(){return ne})),n.d(t,"UPDATE_JSON",(function(){return re})),n.d(t,"UPDATE_PARAM",(function(){return ae})),n.d(t,"UPDATE_EMPTY_PARAM_INCLUSION",(function(){return oe})),n.d(t,"VALIDATE_PARAMS",(function(){return ie})),n.d(t,"SET_RESPONSE",(function(){return se})),n.d(t,"SET_REQUEST",(function(){return ce})),n.d(t,"SET_MUTATED_REQUEST",(function(){return ue})),n.d(t,"LOG_REQUEST",(function(){return le})),n.d(t,"CLEAR_RESPONSE",(function(){return pe})),n.d(t,"CLEAR_REQUEST",(function(){return fe})),n.d(t,"CLEAR_VALIDATE_PARAMS",(function(){return de})),n.d(t,"UPDATE_OPERATION_META_VALUE",(function(){return he})),n.d(t,"UPDATE_RESOLVED",(function(){return me})),n.d(t,"UPDATE_RESOLVED_SUBTREE",(function(){return ve})),n.d(t,"SET_SCHEME",(function(){return ge})),n.d(t,"updateSpec",(function(){return ye})),n.d(t,"updateResolved",(function(){return be})),n.d(t,"updateUrl",(function(){return Ee})),n.d(t,"updateJsonSpec",(function(){return xe})),n.d(t,"parseToJson",(function(){return Se})),n.d(t,"resolveSpec",(function(){return je})),n.d(t,"requestResolvedSubtree",(function(){return _e})),n.d(t,"changeParam",(function(){return Ae})),n.d(t,"changeParamByIdentity",(function(){return ke})),n.d(t,"updateResolvedSubtree",(function(){return Ie})),n.d(t,"invalidateResolvedSubtreeCache",(function(){return Pe})),n.d(t,"validateParams",(function(){return Ne})),n.d(t,"updateEmptyParamInclusion",(function(){return Te})),n.d(t,"clearValidateParams",(function(){return Re})),n.d(t,"changeConsumesValue",(function(){return Me})),n.d(t,"changeProducesValue",(function(){return qe})),n.d(t,"setResponse",(function(){return De})),n.d(t,"setRequest",(function(){return Be})),n.d(t,"setMutatedRequest",(function(){return Le})),n.d(t,"logRequest",(function(){return Ue})),n.d(t,"executeRequest",(function(){return Ve})),n.d(t,"execute",(function(){return ze})),n.d(t,"clearResponse",(function(){return Fe})),n.d(t,"clearRequest",(function(){return Je})),n.d(t,"setScheme",(function(){return We}));var r=n(24),a=n.n(r),o=n(49),i=n.n(o),s=n(65),c=n.n(s),u=n(18),l=n.n(u),p=n(37),f=n.n(p),d=n(23),h=n.n(d),m=n(4),v=n.n(m),g=n(281),y=n.n(g),b=n(28),E=n.n(b),x=n(172),S=n.n(x),w=n(57),j=n.n(w),O=n(12),C=n.n(O),_=n(173),A=n.n(_),k=n(17),I=n.n(k),P=n(22),N=n.n(P),T=n(2),R=n.n(T),M=n(15),q=n.n(M),D=n(20),B=n.n(D),L=n(282),U=n.n(L),V=n(63),z=n.n(V),F=n(1),J=n(79),W=n.n(J),H=n(121),$=n(390),Y=n.n($),K=n(391),G=n.n(K),Z=n(283),X=n.n(Z),Q=n(5),ee=["path","method"],te="spec_update_spec",ne="spec_update_url",re="spec_update_json",ae="spec_update_param",oe="spec_update_empty_param_inclusion",ie="spec_validate_param",se="spec_set_response",ce="spec_set_request",ue="spec_set_mutated_request",le="spec_log_request",pe="spec_clear_response",fe="spec_clear_request",de="spec_clear_validate_param",he="spec_update_operation_meta_value",me="spec_update_resolved",ve="spec_update_resolved_subtree",ge="set_scheme";function ye(e){var t,n=(t=e,Y()(t)?t:"").replace(/\t/g,"  ");if("string"==typeof e)return{type:te,payload:n}}function be(e){return{type:me,payload:e}}function Ee(e){return{type:ne,payload:e}}function xe(e){return{type:re,payload:e}}var Se=function(e){return function(t){var n=t.specActions,r=t.specSelectors,a=t.errActions,o=r.specStr,i=null;try{e=e||o(),a.clear({source:"parser"}),i=z.a.load(e)}catch(e){return console.error(e),a.newSpecErr({source:"parser",level:"error",message:e.reason,line:e.mark&&e.mark.line?e.mark.line+1:void 0})}return i&&"object"===l()(i)?n.updateJsonSpec(i):{}}},we=!1,je=function(e,t){return function(n){var r=n.specActions,a=n.specSelectors,o=n.errActions,i=n.fn,s=i.fetch,c=i.resolve,u=i.AST,l=void 0===u?{}:u,p=n.getConfigs;we||(console.warn("specActions.resolveSpec is deprecated since v3.10.0 and will be removed in v4.0.0; use requestResolvedSubtree instead!"),we=!0);var f=p(),d=f.modelPropertyMacro,m=f.parameterMacro,g=f.requestInterceptor,b=f.responseInterceptor;void 0===e&&(e=a.specJson()),void 0===t&&(t=a.url());var E=l.getLineNumberForPath?l.getLineNumberForPath:function(){},x=a.specStr();return c({fetch:s,spec:e,baseDoc:t,modelPropertyMacro:d,parameterMacro:m,requestInterceptor:g,responseInterceptor:b}).then((function(e){var t=e.spec,n=e.errors;if(o.clear({type:"thrown"}),h()(n)&&n.length>0){var a=v()(n).call(n,(function(e){return console.error(e),e.line=e.fullPath?E(x,e.fullPath):null,e.path=e.fullPath?e.fullPath.join("."):null,e.level="error",e.type="thrown",e.source="resolver",y()(e,"message",{enumerable:!0,value:e.message}),e}));o.newThrownErrBatch(a)}return r.updateResolved(t)}))}},Oe=[],Ce=G()(c()(f.a.mark((function e(){var t,n,r,a,o,i,s,u,l,p,d,m,g,b,x,w,O,_;return f.a.wrap((function(e){for(;;)switch(e.prev=e.next){case 0:if(t=Oe.system){e.next=4;break}return console.error("debResolveSubtrees: don't have a system to operate on, aborting."),e.abrupt("return");case 4:if(n=t.errActions,r=t.errSelectors,a=t.fn,o=a.resolveSubtree,i=a.fetch,s=a.AST,u=void 0===s?{}:s,l=t.specSelectors,p=t.specActions,o){e.next=8;break}return console.error("Error: Swagger-Client did not provide a `resolveSubtree` method, doing nothing."),e.abrupt("return");case 8:return d=u.getLineNumberForPath?u.getLineNumberForPath:function(){},m=l.specStr(),g=t.getConfigs(),b=g.modelPropertyMacro,x=g.parameterMacro,w=g.requestInterceptor,O=g.responseInterceptor,e.prev=11,e.next=14,E()(Oe).call(Oe,function(){var e=c()(f.a.mark((function e(t,a){var s,u,p,g,E,_,k,I,P;return f.a.wrap((function(e){for(;;)switch(e.prev=e.next){case 0:return e.next=2,t;case 2:return s=e.sent,u=s.resultMap,p=s.specWithCurrentSubtrees,e.next=7,o(p,a,{baseDoc:l.url(),modelPropertyMacro:b,parameterMacro:x,requestInterceptor:w,responseInterceptor:O});case 7:if(g=e.sent,E=g.errors,_=g.spec,r.allErrors().size&&n.clearBy((function(e){var t;return"thrown"!==e.get("type")||"resolver"!==e.get("source")||!S()(t=e.get("fullPath")).call(t,(function(e,t){return e===a[t]||void 0===a[t]}))})),h()(E)&&E.length>0&&(k=v()(E).call(E,(function(e){return e.line=e.fullPath?d(m,e.fullPath):null,e.path=e.fullPath?e.fullPath.join("."):null,e.level="error",e.type="thrown",e.source="resolver",y()(e,"message",{enumerable:!0,value:e.message}),e})),n.newThrownErrBatch(k)),!_||!l.isOAS3()||"components"!==a[0]||"securitySchemes"!==a[1]){e.next=15;break}return e.next=15,j.a.all(v()(I=C()(P=A()(_)).call(P,(function(e){return"openIdConnect"===e.type}))).call(I,function(){var e=c()(f.a.mark((function e(t){var n,r;return f.a.wrap((function(e){for(;;)switch(e.prev=e.next){case 0:return n={url:t.openIdConnectUrl,requestInterceptor:w,responseInterceptor:O},e.prev=1,e.next=4,i(n);case 4:(r=e.sent)instanceof Error||r.status>=400?console.error(r.statusText+" "+n.url):t.openIdConnectData=JSON.parse(r.text),e.next=11;break;case 8:e.prev=8,e.t0=e.catch(1),console.error(e.t0);case 11:case"end":return e.stop()}}),e,null,[[1,8]])})));return function(t){return e.apply(this,arguments)}}()));case 15:return X()(u,a,_),X()(p,a,_),e.abrupt("return",{resultMap:u,specWithCurrentSubtrees:p});case 18:case"end":return e.stop()}}),e)})));return function(t,n){return e.apply(this,arguments)}}(),j.a.resolve({resultMap:(l.specResolvedSubtree([])||Object(F.Map)()).toJS(),specWithCurrentSubtrees:l.specJson().toJS()}));case 14:_=e.sent,delete Oe.system,Oe=[],e.next=22;break;case 19:e.prev=19,e.t0=e.catch(11),console.error(e.t0);case 22:p.updateResolvedSubtree([],_.resultMap);case 23:case"end":return e.stop()}}),e,null,[[11,19]])}))),35),_e=function(e){return function(t){var n;I()(n=v()(Oe).call(Oe,(function(e){return e.join("@@")}))).call(n,e.join("@@"))>-1||(Oe.push(e),Oe.system=t,Ce())}};function Ae(e,t,n,r,a){return{type:ae,payload:{path:e,value:r,paramName:t,paramIn:n,isXml:a}}}function ke(e,t,n,r){return{type:ae,payload:{path:e,param:t,value:n,isXml:r}}}var Ie=function(e,t){return{type:ve,payload:{path:e,value:t}}},Pe=function(){return{type:ve,payload:{path:[],value:Object(F.Map)()}}},Ne=function(e,t){return{type:ie,payload:{pathMethod:e,isOAS3:t}}},Te=function(e,t,n,r){return{type:oe,payload:{pathMethod:e,paramName:t,paramIn:n,includeEmptyValue:r}}};function Re(e){return{type:de,payload:{pathMethod:e}}}function Me(e,t){return{type:he,payload:{path:e,value:t,key:"consumes_value"}}}function qe(e,t){return{type:he,payload:{path:e,value:t,key:"produces_value"}}}var De=function(e,t,n){return{payload:{path:e,method:t,res:n},type:se}},Be=function(e,t,n){return{payload:{path:e,method:t,req:n},type:ce}},Le=function(e,t,n){return{payload:{path:e,method:t,req:n},type:ue}},Ue=function(e){return{payload:e,type:le}},Ve=function(e){return function(t){var n,r,a=t.fn,o=t.specActions,i=t.specSelectors,s=t.getConfigs,u=t.oas3Selectors,l=e.pathName,p=e.method,d=e.operation,m=s(),g=m.requestInterceptor,y=m.responseInterceptor,b=d.toJS();d&&d.get("parameters")&&N()(n=C()(r=d.get("parameters")).call(r,(function(e){return e&&!0===e.get("allowEmptyValue")}))).call(n,(function(t){if(i.parameterInclusionSettingFor([l,p],t.get("name"),t.get("in"))){e.parameters=e.parameters||{};var n=Object(Q.B)(t,e.parameters);(!n||n&&0===n.size)&&(e.parameters[t.get("name")]="")}}));if(e.contextUrl=W()(i.url()).toString(),b&&b.operationId?e.operationId=b.operationId:b&&l&&p&&(e.operationId=a.opId(b,l,p)),i.isOAS3()){var E,x=R()(E="".concat(l,":")).call(E,p);e.server=u.selectedServer(x)||u.selectedServer();var S=u.serverVariables({server:e.server,namespace:x}).toJS(),w=u.serverVariables({server:e.server}).toJS();e.serverVariables=q()(S).length?S:w,e.requestContentType=u.requestContentType(l,p),e.responseContentType=u.responseContentType(l,p)||"*/*";var j,O=u.requestBodyValue(l,p),_=u.requestBodyInclusionSetting(l,p);if(O&&O.toJS)e.requestBody=C()(j=v()(O).call(O,(function(e){return F.Map.isMap(e)?e.get("value"):e}))).call(j,(function(e,t){return(h()(e)?0!==e.length:!Object(Q.q)(e))||_.get(t)})).toJS();else e.requestBody=O}var A=B()({},e);A=a.buildRequest(A),o.setRequest(e.pathName,e.method,A);var k=function(){var t=c()(f.a.mark((function t(n){var r,a;return f.a.wrap((function(t){for(;;)switch(t.prev=t.next){case 0:return t.next=2,g.apply(undefined,[n]);case 2:return r=t.sent,a=B()({},r),o.setMutatedRequest(e.pathName,e.method,a),t.abrupt("return",r);case 6:case"end":return t.stop()}}),t)})));return function(e){return t.apply(this,arguments)}}();e.requestInterceptor=k,e.responseInterceptor=y;var I=U()();return a.execute(e).then((function(t){t.duration=U()()-I,o.setResponse(e.pathName,e.method,t)})).catch((function(t){"Failed to fetch"===t.message&&(t.name="",t.message='**Failed to fetch.**  \n**Possible Reasons:** \n  - CORS \n  - Network Failure \n  - URL scheme must be "http" or "https" for CORS request.'),o.setResponse(e.pathName,e.method,{error:!0,err:Object(H.serializeError)(t)})}))}},ze=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{},t=e.path,n=e.method,r=i()(e,ee);return function(e){var o=e.fn.fetch,i=e.specSelectors,s=e.specActions,c=i.specJsonWithResolvedSubtrees().toJS(),u=i.operationScheme(t,n),l=i.contentTypeValues([t,n]).toJS(),p=l.requestContentType,f=l.responseContentType,d=/xml/i.test(p),h=i.parameterValues([t,n],d).toJS();return s.executeRequest(a()(a()({},r),{},{fetch:o,spec:c,pathName:t,method:n,parameters:h,requestContentType:p,scheme:u,responseContentType:f}))}};function Fe(e,t){return{type:pe,payload:{path:e,method:t}}}function Je(e,t){return{type:fe,payload:{path:e,method:t}}}function We(e,t,n){return{type:ge,payload:{scheme:e,path:t,method:n}}}},function(e,t){e.exports=require("classnames")},function(e,t,n){var r=n(34);e.exports=!r((function(){return 7!=Object.defineProperty({},1,{get:function(){return 7}})[1]}))},function(e,t,n){var r=n(147),a=n(212),o=n(211),i=n(166);e.exports=function(e,t){var n=void 0!==r&&a(e)||e["@@iterator"];if(!n){if(o(e)||(n=i(e))||t&&e&&"number"==typeof e.length){n&&(e=n);var s=0,c=function(){};return{s:c,n:function(){return s>=e.length?{done:!0}:{done:!1,value:e[s++]}},e:function(e){throw e},f:c}}throw new TypeError("Invalid attempt to iterate non-iterable instance.\nIn order to be iterable, non-array objects must have a [Symbol.iterator]() method.")}var u,l=!0,p=!1;return{s:function(){n=n.call(e)},n:function(){var e=n.next();return l=e.done,e},e:function(e){p=!0,u=e},f:function(){try{l||null==n.return||n.return()}finally{if(p)throw u}}}},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){var r=n(41);e.exports=function(e){if(!r(e))throw TypeError(String(e)+" is not an object");return e}},function(e,t){var n=Array.isArray;e.exports=n},function(e,t){e.exports=function(e){var t=typeof e;return null!=e&&("object"==t||"function"==t)}},function(e,t,n){var r=n(359),a=n(360),o=n(664);e.exports=function(e,t){if(null==e)return{};var n,i,s=o(e,t);if(r){var c=r(e);for(i=0;i<c.length;i++)n=c[i],a(t).call(t,n)>=0||Object.prototype.propertyIsEnumerable.call(e,n)&&(s[n]=e[n])}return s},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){"use strict";n.r(t),n.d(t,"UPDATE_SELECTED_SERVER",(function(){return r})),n.d(t,"UPDATE_REQUEST_BODY_VALUE",(function(){return a})),n.d(t,"UPDATE_REQUEST_BODY_VALUE_RETAIN_FLAG",(function(){return o})),n.d(t,"UPDATE_REQUEST_BODY_INCLUSION",(function(){return i})),n.d(t,"UPDATE_ACTIVE_EXAMPLES_MEMBER",(function(){return s})),n.d(t,"UPDATE_REQUEST_CONTENT_TYPE",(function(){return c})),n.d(t,"UPDATE_RESPONSE_CONTENT_TYPE",(function(){return u})),n.d(t,"UPDATE_SERVER_VARIABLE_VALUE",(function(){return l})),n.d(t,"SET_REQUEST_BODY_VALIDATE_ERROR",(function(){return p})),n.d(t,"CLEAR_REQUEST_BODY_VALIDATE_ERROR",(function(){return f})),n.d(t,"CLEAR_REQUEST_BODY_VALUE",(function(){return d})),n.d(t,"setSelectedServer",(function(){return h})),n.d(t,"setRequestBodyValue",(function(){return m})),n.d(t,"setRetainRequestBodyValueFlag",(function(){return v})),n.d(t,"setRequestBodyInclusion",(function(){return g})),n.d(t,"setActiveExamplesMember",(function(){return y})),n.d(t,"setRequestContentType",(function(){return b})),n.d(t,"setResponseContentType",(function(){return E})),n.d(t,"setServerVariableValue",(function(){return x})),n.d(t,"setRequestBodyValidateError",(function(){return S})),n.d(t,"clearRequestBodyValidateError",(function(){return w})),n.d(t,"initRequestBodyValidateError",(function(){return j})),n.d(t,"clearRequestBodyValue",(function(){return O}));var r="oas3_set_servers",a="oas3_set_request_body_value",o="oas3_set_request_body_retain_flag",i="oas3_set_request_body_inclusion",s="oas3_set_active_examples_member",c="oas3_set_request_content_type",u="oas3_set_response_content_type",l="oas3_set_server_variable_value",p="oas3_set_request_body_validate_error",f="oas3_clear_request_body_validate_error",d="oas3_clear_request_body_value";function h(e,t){return{type:r,payload:{selectedServerUrl:e,namespace:t}}}function m(e){var t=e.value,n=e.pathMethod;return{type:a,payload:{value:t,pathMethod:n}}}var v=function(e){var t=e.value,n=e.pathMethod;return{type:o,payload:{value:t,pathMethod:n}}};function g(e){var t=e.value,n=e.pathMethod,r=e.name;return{type:i,payload:{value:t,pathMethod:n,name:r}}}function y(e){var t=e.name,n=e.pathMethod,r=e.contextType,a=e.contextName;return{type:s,payload:{name:t,pathMethod:n,contextType:r,contextName:a}}}function b(e){var t=e.value,n=e.pathMethod;return{type:c,payload:{value:t,pathMethod:n}}}function E(e){var t=e.value,n=e.path,r=e.method;return{type:u,payload:{value:t,path:n,method:r}}}function x(e){var t=e.server,n=e.namespace,r=e.key,a=e.val;return{type:l,payload:{server:t,namespace:n,key:r,val:a}}}var S=function(e){var t=e.path,n=e.method,r=e.validationErrors;return{type:p,payload:{path:t,method:n,validationErrors:r}}},w=function(e){var t=e.path,n=e.method;return{type:f,payload:{path:t,method:n}}},j=function(e){var t=e.pathMethod;return{type:f,payload:{path:t[0],method:t[1]}}},O=function(e){var t=e.pathMethod;return{type:d,payload:{pathMethod:t}}}},function(e,t,n){var r=n(56),a={}.hasOwnProperty;e.exports=Object.hasOwn||function(e,t){return a.call(r(e),t)}},function(e,t,n){e.exports=n(572)},function(e,t,n){"use strict";n.d(t,"b",(function(){return m})),n.d(t,"e",(function(){return v})),n.d(t,"c",(function(){return y})),n.d(t,"a",(function(){return b})),n.d(t,"d",(function(){return E}));var r=n(45),a=n.n(r),o=n(18),i=n.n(o),s=n(93),c=n.n(s),u=n(2),l=n.n(u),p=n(48),f=n.n(p),d=function(e){return String.prototype.toLowerCase.call(e)},h=function(e){return e.replace(/[^\w]/gi,"_")};function m(e){var t=e.openapi;return!!t&&c()(t).call(t,"3")}function v(e,t){var n=arguments.length>2&&void 0!==arguments[2]?arguments[2]:"",r=arguments.length>3&&void 0!==arguments[3]?arguments[3]:{},a=r.v2OperationIdCompatibilityMode;if(!e||"object"!==i()(e))return null;var o=(e.operationId||"").replace(/\s/g,"");return o.length?h(e.operationId):g(t,n,{v2OperationIdCompatibilityMode:a})}function g(e,t){var n,r=arguments.length>2&&void 0!==arguments[2]?arguments[2]:{},a=r.v2OperationIdCompatibilityMode;if(a){var o,i,s=l()(o="".concat(t.toLowerCase(),"_")).call(o,e).replace(/[\s!@#$%^&*()_+=[{\]};:<>|./?,\\'""-]/g,"_");return(s=s||l()(i="".concat(e.substring(1),"_")).call(i,t)).replace(/((_){2,})/g,"_").replace(/^(_)*/g,"").replace(/([_])*$/g,"")}return l()(n="".concat(d(t))).call(n,h(e))}function y(e,t){var n;return l()(n="".concat(d(t),"-")).call(n,e)}function b(e,t){return e&&e.paths?function(e,t){return function(e,t,n){if(!e||"object"!==i()(e)||!e.paths||"object"!==i()(e.paths))return null;var r=e.paths;for(var a in r)for(var o in r[a])if("PARAMETERS"!==o.toUpperCase()){var s=r[a][o];if(s&&"object"===i()(s)){var c={spec:e,pathName:a,method:o.toUpperCase(),operation:s},u=t(c);if(n&&u)return c}}return}(e,t,!0)||null}(e,(function(e){var n=e.pathName,r=e.method,a=e.operation;if(!a||"object"!==i()(a))return!1;var o=a.operationId;return[v(a,n,r),y(n,r),o].some((function(e){return e&&e===t}))})):null}function E(e){var t=e.spec,n=t.paths,r={};if(!n||t.$$normalized)return e;for(var o in n){var i=n[o];if(f()(i)){var s=i.parameters,c=function(e){var n=i[e];if(!f()(n))return"continue";var c=v(n,o,e);if(c){r[c]?r[c].push(n):r[c]=[n];var u=r[c];if(u.length>1)u.forEach((function(e,t){var n;e.__originalOperationId=e.__originalOperationId||e.operationId,e.operationId=l()(n="".concat(c)).call(n,t+1)}));else if(void 0!==n.operationId){var p=u[0];p.__originalOperationId=p.__originalOperationId||n.operationId,p.operationId=c}}if("parameters"!==e){var d=[],h={};for(var m in t)"produces"!==m&&"consumes"!==m&&"security"!==m||(h[m]=t[m],d.push(h));if(s&&(h.parameters=s,d.push(h)),d.length){var g,y=a()(d);try{for(y.s();!(g=y.n()).done;){var b=g.value;for(var E in b)if(n[E]){if("parameters"===E){var x,S=a()(b[E]);try{var w=function(){var e=x.value;n[E].some((function(t){return t.name&&t.name===e.name||t.$ref&&t.$ref===e.$ref||t.$$ref&&t.$$ref===e.$$ref||t===e}))||n[E].push(e)};for(S.s();!(x=S.n()).done;)w()}catch(e){S.e(e)}finally{S.f()}}}else n[E]=b[E]}}catch(e){y.e(e)}finally{y.f()}}}};for(var u in i)c(u)}}return t.$$normalized=!0,e}},function(e,t,n){"use strict";n.r(t),n.d(t,"NEW_THROWN_ERR",(function(){return a})),n.d(t,"NEW_THROWN_ERR_BATCH",(function(){return o})),n.d(t,"NEW_SPEC_ERR",(function(){return i})),n.d(t,"NEW_SPEC_ERR_BATCH",(function(){return s})),n.d(t,"NEW_AUTH_ERR",(function(){return c})),n.d(t,"CLEAR",(function(){return u})),n.d(t,"CLEAR_BY",(function(){return l})),n.d(t,"newThrownErr",(function(){return p})),n.d(t,"newThrownErrBatch",(function(){return f})),n.d(t,"newSpecErr",(function(){return d})),n.d(t,"newSpecErrBatch",(function(){return h})),n.d(t,"newAuthErr",(function(){return m})),n.d(t,"clear",(function(){return v})),n.d(t,"clearBy",(function(){return g}));var r=n(121),a="err_new_thrown_err",o="err_new_thrown_err_batch",i="err_new_spec_err",s="err_new_spec_err_batch",c="err_new_auth_err",u="err_clear",l="err_clear_by";function p(e){return{type:a,payload:Object(r.serializeError)(e)}}function f(e){return{type:o,payload:e}}function d(e){return{type:i,payload:e}}function h(e){return{type:s,payload:e}}function m(e){return{type:c,payload:e}}function v(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{};return{type:u,payload:e}}function g(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:function(){return!0};return{type:l,payload:e}}},function(e,t,n){var r=n(31),a=n(38),o=function(e){return"function"==typeof e?e:void 0};e.exports=function(e,t){return arguments.length<2?o(r[e])||o(a[e]):r[e]&&r[e][t]||a[e]&&a[e][t]}},function(e,t,n){var r=n(97);e.exports=function(e){return Object(r(e))}},function(e,t,n){e.exports=n(679)},function(e,t,n){var r=n(149),a=n(97);e.exports=function(e){return r(a(e))}},function(e,t,n){var r=n(44),a=n(291),o=n(46),i=n(150),s=Object.defineProperty;t.f=r?s:function(e,t,n){if(o(e),t=i(t),o(n),a)try{return s(e,t,n)}catch(e){}if("get"in n||"set"in n)throw TypeError("Accessors not supported");return"value"in n&&(e[t]=n.value),e}},function(e,t,n){var r=n(112),a=Math.min;e.exports=function(e){return e>0?a(r(e),9007199254740991):0}},function(e,t,n){var r=n(151);e.exports=function(e){if(r(e))throw TypeError("Cannot convert a Symbol value to a string");return String(e)}},function(e,t,n){var r=n(312),a="object"==typeof self&&self&&self.Object===Object&&self,o=r||a||Function("return this")();e.exports=o},function(e,t){e.exports=require("js-yaml")},function(e,t,n){e.exports=n(558)},function(e,t,n){var r=n(665);function a(e,t,n,a,o,i,s){try{var c=e[i](s),u=c.value}catch(e){return void n(e)}c.done?t(u):r.resolve(u).then(a,o)}e.exports=function(e){return function(){var t=this,n=arguments;return new r((function(r,o){var i=e.apply(t,n);function s(e){a(i,r,o,s,c,"next",e)}function c(e){a(i,r,o,s,c,"throw",e)}s(void 0)}))}},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t){e.exports=function(e){if("function"!=typeof e)throw TypeError(String(e)+" is not a function");return e}},function(e,t,n){var r=n(44),a=n(59),o=n(96);e.exports=r?function(e,t,n){return a.f(e,t,o(1,n))}:function(e,t,n){return e[t]=n,e}},function(e,t,n){var r,a,o,i=n(297),s=n(38),c=n(41),u=n(67),l=n(51),p=n(187),f=n(153),d=n(132),h="Object already initialized",m=s.WeakMap;if(i||p.state){var v=p.state||(p.state=new m),g=v.get,y=v.has,b=v.set;r=function(e,t){if(y.call(v,e))throw new TypeError(h);return t.facade=e,b.call(v,e,t),t},a=function(e){return g.call(v,e)||{}},o=function(e){return y.call(v,e)}}else{var E=f("state");d[E]=!0,r=function(e,t){if(l(e,E))throw new TypeError(h);return t.facade=e,u(e,E,t),t},a=function(e){return l(e,E)?e[E]:{}},o=function(e){return l(e,E)}}e.exports={set:r,get:a,has:o,enforce:function(e){return o(e)?a(e):r(e,{})},getterFor:function(e){return function(t){var n;if(!c(t)||(n=a(t)).type!==e)throw TypeError("Incompatible receiver, "+e+" required");return n}}}},function(e,t,n){"use strict";var r=n(58),a=n(197),o=n(113),i=n(68),s=n(198),c="Array Iterator",u=i.set,l=i.getterFor(c);e.exports=s(Array,"Array",(function(e,t){u(this,{type:c,target:r(e),index:0,kind:t})}),(function(){var e=l(this),t=e.target,n=e.kind,r=e.index++;return!t||r>=t.length?(e.target=void 0,{value:void 0,done:!0}):"keys"==n?{value:r,done:!1}:"values"==n?{value:t[r],done:!1}:{value:[r,t[r]],done:!1}}),"values"),o.Arguments=o.Array,a("keys"),a("values"),a("entries")},function(e,t){e.exports=function(e){return null!=e&&"object"==typeof e}},function(e,t){e.exports=require("deep-extend")},function(e,t,n){e.exports=n(543)},function(e,t){e.exports=require("url")},function(e,t,n){"use strict";n.r(t),n.d(t,"SHOW_AUTH_POPUP",(function(){return d})),n.d(t,"AUTHORIZE",(function(){return h})),n.d(t,"LOGOUT",(function(){return m})),n.d(t,"PRE_AUTHORIZE_OAUTH2",(function(){return v})),n.d(t,"AUTHORIZE_OAUTH2",(function(){return g})),n.d(t,"VALIDATE",(function(){return y})),n.d(t,"CONFIGURE_AUTH",(function(){return b})),n.d(t,"RESTORE_AUTHORIZATION",(function(){return E})),n.d(t,"showDefinitions",(function(){return x})),n.d(t,"authorize",(function(){return S})),n.d(t,"authorizeWithPersistOption",(function(){return w})),n.d(t,"logout",(function(){return j})),n.d(t,"logoutWithPersistOption",(function(){return O})),n.d(t,"preAuthorizeImplicit",(function(){return C})),n.d(t,"authorizeOauth2",(function(){return _})),n.d(t,"authorizeOauth2WithPersistOption",(function(){return A})),n.d(t,"authorizePassword",(function(){return k})),n.d(t,"authorizeApplication",(function(){return I})),n.d(t,"authorizeAccessCodeWithFormParams",(function(){return P})),n.d(t,"authorizeAccessCodeWithBasicAuthentication",(function(){return N})),n.d(t,"authorizeRequest",(function(){return T})),n.d(t,"configureAuth",(function(){return R})),n.d(t,"restoreAuthorization",(function(){return M})),n.d(t,"persistAuthorizationIfNeeded",(function(){return q}));var r=n(18),a=n.n(r),o=n(30),i=n.n(o),s=n(20),c=n.n(s),u=n(79),l=n.n(u),p=n(25),f=n(5),d="show_popup",h="authorize",m="logout",v="pre_authorize_oauth2",g="authorize_oauth2",y="validate",b="configure_auth",E="restore_authorization";function x(e){return{type:d,payload:e}}function S(e){return{type:h,payload:e}}var w=function(e){return function(t){var n=t.authActions;n.authorize(e),n.persistAuthorizationIfNeeded()}};function j(e){return{type:m,payload:e}}var O=function(e){return function(t){var n=t.authActions;n.logout(e),n.persistAuthorizationIfNeeded()}},C=function(e){return function(t){var n=t.authActions,r=t.errActions,a=e.auth,o=e.token,s=e.isValid,c=a.schema,u=a.name,l=c.get("flow");delete p.a.swaggerUIRedirectOauth2,"accessCode"===l||s||r.newAuthErr({authId:u,source:"auth",level:"warning",message:"Authorization may be unsafe, passed state was changed in server Passed state wasn't returned from auth server"}),o.error?r.newAuthErr({authId:u,source:"auth",level:"error",message:i()(o)}):n.authorizeOauth2WithPersistOption({auth:a,token:o})}};function _(e){return{type:g,payload:e}}var A=function(e){return function(t){var n=t.authActions;n.authorizeOauth2(e),n.persistAuthorizationIfNeeded()}},k=function(e){return function(t){var n=t.authActions,r=e.schema,a=e.name,o=e.username,i=e.password,s=e.passwordType,u=e.clientId,l=e.clientSecret,p={grant_type:"password",scope:e.scopes.join(" "),username:o,password:i},d={};switch(s){case"request-body":!function(e,t,n){t&&c()(e,{client_id:t});n&&c()(e,{client_secret:n})}(p,u,l);break;case"basic":d.Authorization="Basic "+Object(f.a)(u+":"+l);break;default:console.warn("Warning: invalid passwordType ".concat(s," was passed, not including client id and secret"))}return n.authorizeRequest({body:Object(f.b)(p),url:r.get("tokenUrl"),name:a,headers:d,query:{},auth:e})}};var I=function(e){return function(t){var n=t.authActions,r=e.schema,a=e.scopes,o=e.name,i=e.clientId,s=e.clientSecret,c={Authorization:"Basic "+Object(f.a)(i+":"+s)},u={grant_type:"client_credentials",scope:a.join(" ")};return n.authorizeRequest({body:Object(f.b)(u),name:o,url:r.get("tokenUrl"),auth:e,headers:c})}},P=function(e){var t=e.auth,n=e.redirectUrl;return function(e){var r=e.authActions,a=t.schema,o=t.name,i=t.clientId,s=t.clientSecret,c=t.codeVerifier,u={grant_type:"authorization_code",code:t.code,client_id:i,client_secret:s,redirect_uri:n,code_verifier:c};return r.authorizeRequest({body:Object(f.b)(u),name:o,url:a.get("tokenUrl"),auth:t})}},N=function(e){var t=e.auth,n=e.redirectUrl;return function(e){var r=e.authActions,a=t.schema,o=t.name,i=t.clientId,s=t.clientSecret,c=t.codeVerifier,u={Authorization:"Basic "+Object(f.a)(i+":"+s)},l={grant_type:"authorization_code",code:t.code,client_id:i,redirect_uri:n,code_verifier:c};return r.authorizeRequest({body:Object(f.b)(l),name:o,url:a.get("tokenUrl"),auth:t,headers:u})}},T=function(e){return function(t){var n,r=t.fn,o=t.getConfigs,s=t.authActions,u=t.errActions,p=t.oas3Selectors,f=t.specSelectors,d=t.authSelectors,h=e.body,m=e.query,v=void 0===m?{}:m,g=e.headers,y=void 0===g?{}:g,b=e.name,E=e.url,x=e.auth,S=(d.getConfigs()||{}).additionalQueryStringParams;if(f.isOAS3()){var w=p.serverEffectiveValue(p.selectedServer());n=l()(E,w,!0)}else n=l()(E,f.url(),!0);"object"===a()(S)&&(n.query=c()({},n.query,S));var j=n.toString(),O=c()({Accept:"application/json, text/plain, */*","Content-Type":"application/x-www-form-urlencoded","X-Requested-With":"XMLHttpRequest"},y);r.fetch({url:j,method:"post",headers:O,query:v,body:h,requestInterceptor:o().requestInterceptor,responseInterceptor:o().responseInterceptor}).then((function(e){var t=JSON.parse(e.data),n=t&&(t.error||""),r=t&&(t.parseError||"");e.ok?n||r?u.newAuthErr({authId:b,level:"error",source:"auth",message:i()(t)}):s.authorizeOauth2WithPersistOption({auth:x,token:t}):u.newAuthErr({authId:b,level:"error",source:"auth",message:e.statusText})})).catch((function(e){var t=new Error(e).message;if(e.response&&e.response.data){var n=e.response.data;try{var r="string"==typeof n?JSON.parse(n):n;r.error&&(t+=", error: ".concat(r.error)),r.error_description&&(t+=", description: ".concat(r.error_description))}catch(e){}}u.newAuthErr({authId:b,level:"error",source:"auth",message:t})}))}};function R(e){return{type:b,payload:e}}function M(e){return{type:E,payload:e}}var q=function(){return function(e){var t=e.authSelectors;if((0,e.getConfigs)().persistAuthorization){var n=t.authorized();localStorage.setItem("authorized",i()(n.toJS()))}}}},function(e,t){e.exports=require("react-syntax-highlighter")},function(e,t,n){var r=n(99),a=n(149),o=n(56),i=n(60),s=n(189),c=[].push,u=function(e){var t=1==e,n=2==e,u=3==e,l=4==e,p=6==e,f=7==e,d=5==e||p;return function(h,m,v,g){for(var y,b,E=o(h),x=a(E),S=r(m,v,3),w=i(x.length),j=0,O=g||s,C=t?O(h,w):n||f?O(h,0):void 0;w>j;j++)if((d||j in x)&&(b=S(y=x[j],j,E),e))if(t)C[j]=b;else if(b)switch(e){case 3:return!0;case 5:return y;case 6:return j;case 2:c.call(C,y)}else switch(e){case 4:return!1;case 7:c.call(C,y)}return p?-1:u||l?l:C}};e.exports={forEach:u(0),map:u(1),filter:u(2),some:u(3),every:u(4),find:u(5),findIndex:u(6),filterReject:u(7)}},function(e,t,n){n(69);var r=n(535),a=n(38),o=n(86),i=n(67),s=n(113),c=n(35)("toStringTag");for(var u in r){var l=a[u],p=l&&l.prototype;p&&o(p)!==c&&i(p,c,u),s[u]=s.Array}},function(e,t,n){"use strict";n.r(t),n.d(t,"lastError",(function(){return R})),n.d(t,"url",(function(){return M})),n.d(t,"specStr",(function(){return q})),n.d(t,"specSource",(function(){return D})),n.d(t,"specJson",(function(){return B})),n.d(t,"specResolved",(function(){return L})),n.d(t,"specResolvedSubtree",(function(){return U})),n.d(t,"specJsonWithResolvedSubtrees",(function(){return z})),n.d(t,"spec",(function(){return F})),n.d(t,"isOAS3",(function(){return J})),n.d(t,"info",(function(){return W})),n.d(t,"externalDocs",(function(){return H})),n.d(t,"version",(function(){return $})),n.d(t,"semver",(function(){return Y})),n.d(t,"paths",(function(){return K})),n.d(t,"operations",(function(){return G})),n.d(t,"consumes",(function(){return Z})),n.d(t,"produces",(function(){return X})),n.d(t,"security",(function(){return Q})),n.d(t,"securityDefinitions",(function(){return ee})),n.d(t,"findDefinition",(function(){return te})),n.d(t,"definitions",(function(){return ne})),n.d(t,"basePath",(function(){return re})),n.d(t,"host",(function(){return ae})),n.d(t,"schemes",(function(){return oe})),n.d(t,"operationsWithRootInherited",(function(){return ie})),n.d(t,"tags",(function(){return se})),n.d(t,"tagDetails",(function(){return ce})),n.d(t,"operationsWithTags",(function(){return ue})),n.d(t,"taggedOperations",(function(){return le})),n.d(t,"responses",(function(){return pe})),n.d(t,"requests",(function(){return fe})),n.d(t,"mutatedRequests",(function(){return de})),n.d(t,"responseFor",(function(){return he})),n.d(t,"requestFor",(function(){return me})),n.d(t,"mutatedRequestFor",(function(){return ve})),n.d(t,"allowTryItOutFor",(function(){return ge})),n.d(t,"parameterWithMetaByIdentity",(function(){return ye})),n.d(t,"parameterInclusionSettingFor",(function(){return be})),n.d(t,"parameterWithMeta",(function(){return Ee})),n.d(t,"operationWithMeta",(function(){return xe})),n.d(t,"getParameter",(function(){return Se})),n.d(t,"hasHost",(function(){return we})),n.d(t,"parameterValues",(function(){return je})),n.d(t,"parametersIncludeIn",(function(){return Oe})),n.d(t,"parametersIncludeType",(function(){return Ce})),n.d(t,"contentTypeValues",(function(){return _e})),n.d(t,"currentProducesFor",(function(){return Ae})),n.d(t,"producesOptionsFor",(function(){return ke})),n.d(t,"consumesOptionsFor",(function(){return Ie})),n.d(t,"operationScheme",(function(){return Pe})),n.d(t,"canExecuteScheme",(function(){return Ne})),n.d(t,"validateBeforeExecute",(function(){return Te})),n.d(t,"getOAS3RequiredRequestBodyContentType",(function(){return Re})),n.d(t,"isMediaTypeSchemaPropertiesEqual",(function(){return Me}));var r=n(13),a=n.n(r),o=n(14),i=n.n(o),s=n(2),c=n.n(s),u=n(19),l=n.n(u),p=n(22),f=n.n(p),d=n(17),h=n.n(d),m=n(4),v=n.n(m),g=n(12),y=n.n(g),b=n(52),E=n.n(b),x=n(28),S=n.n(x),w=n(171),j=n.n(w),O=n(64),C=n.n(O),_=n(23),A=n.n(_),k=n(16),I=n(5),P=n(1),N=["get","put","post","delete","options","head","patch","trace"],T=function(e){return e||Object(P.Map)()},R=Object(k.createSelector)(T,(function(e){return e.get("lastError")})),M=Object(k.createSelector)(T,(function(e){return e.get("url")})),q=Object(k.createSelector)(T,(function(e){return e.get("spec")||""})),D=Object(k.createSelector)(T,(function(e){return e.get("specSource")||"not-editor"})),B=Object(k.createSelector)(T,(function(e){return e.get("json",Object(P.Map)())})),L=Object(k.createSelector)(T,(function(e){return e.get("resolved",Object(P.Map)())})),U=function(e,t){var n;return e.getIn(c()(n=["resolvedSubtrees"]).call(n,i()(t)),void 0)},V=function e(t,n){return P.Map.isMap(t)&&P.Map.isMap(n)?n.get("$$ref")?n:Object(P.OrderedMap)().mergeWith(e,t,n):n},z=Object(k.createSelector)(T,(function(e){return Object(P.OrderedMap)().mergeWith(V,e.get("json"),e.get("resolvedSubtrees"))})),F=function(e){return B(e)},J=Object(k.createSelector)(F,(function(){return!1})),W=Object(k.createSelector)(F,(function(e){return qe(e&&e.get("info"))})),H=Object(k.createSelector)(F,(function(e){return qe(e&&e.get("externalDocs"))})),$=Object(k.createSelector)(W,(function(e){return e&&e.get("version")})),Y=Object(k.createSelector)($,(function(e){var t;return l()(t=/v?([0-9]*)\.([0-9]*)\.([0-9]*)/i.exec(e)).call(t,1)})),K=Object(k.createSelector)(z,(function(e){return e.get("paths")})),G=Object(k.createSelector)(K,(function(e){if(!e||e.size<1)return Object(P.List)();var t=Object(P.List)();return e&&f()(e)?(f()(e).call(e,(function(e,n){if(!e||!f()(e))return{};f()(e).call(e,(function(e,r){var a;h()(N).call(N,r)<0||(t=t.push(Object(P.fromJS)({path:n,method:r,operation:e,id:c()(a="".concat(r,"-")).call(a,n)})))}))})),t):Object(P.List)()})),Z=Object(k.createSelector)(F,(function(e){return Object(P.Set)(e.get("consumes"))})),X=Object(k.createSelector)(F,(function(e){return Object(P.Set)(e.get("produces"))})),Q=Object(k.createSelector)(F,(function(e){return e.get("security",Object(P.List)())})),ee=Object(k.createSelector)(F,(function(e){return e.get("securityDefinitions")})),te=function(e,t){var n=e.getIn(["resolvedSubtrees","definitions",t],null),r=e.getIn(["json","definitions",t],null);return n||r||null},ne=Object(k.createSelector)(F,(function(e){var t=e.get("definitions");return P.Map.isMap(t)?t:Object(P.Map)()})),re=Object(k.createSelector)(F,(function(e){return e.get("basePath")})),ae=Object(k.createSelector)(F,(function(e){return e.get("host")})),oe=Object(k.createSelector)(F,(function(e){return e.get("schemes",Object(P.Map)())})),ie=Object(k.createSelector)(G,Z,X,(function(e,t,n){return v()(e).call(e,(function(e){return e.update("operation",(function(e){if(e){if(!P.Map.isMap(e))return;return e.withMutations((function(e){return e.get("consumes")||e.update("consumes",(function(e){return Object(P.Set)(e).merge(t)})),e.get("produces")||e.update("produces",(function(e){return Object(P.Set)(e).merge(n)})),e}))}return Object(P.Map)()}))}))})),se=Object(k.createSelector)(F,(function(e){var t=e.get("tags",Object(P.List)());return P.List.isList(t)?y()(t).call(t,(function(e){return P.Map.isMap(e)})):Object(P.List)()})),ce=function(e,t){var n,r=se(e)||Object(P.List)();return E()(n=y()(r).call(r,P.Map.isMap)).call(n,(function(e){return e.get("name")===t}),Object(P.Map)())},ue=Object(k.createSelector)(ie,se,(function(e,t){return S()(e).call(e,(function(e,t){var n=Object(P.Set)(t.getIn(["operation","tags"]));return n.count()<1?e.update("default",Object(P.List)(),(function(e){return e.push(t)})):S()(n).call(n,(function(e,n){return e.update(n,Object(P.List)(),(function(e){return e.push(t)}))}),e)}),S()(t).call(t,(function(e,t){return e.set(t.get("name"),Object(P.List)())}),Object(P.OrderedMap)()))})),le=function(e){return function(t){var n,r=(0,t.getConfigs)(),a=r.tagsSorter,o=r.operationsSorter;return v()(n=ue(e).sortBy((function(e,t){return t}),(function(e,t){var n="function"==typeof a?a:I.H.tagsSorter[a];return n?n(e,t):null}))).call(n,(function(t,n){var r="function"==typeof o?o:I.H.operationsSorter[o],a=r?j()(t).call(t,r):t;return Object(P.Map)({tagDetails:ce(e,n),operations:a})}))}},pe=Object(k.createSelector)(T,(function(e){return e.get("responses",Object(P.Map)())})),fe=Object(k.createSelector)(T,(function(e){return e.get("requests",Object(P.Map)())})),de=Object(k.createSelector)(T,(function(e){return e.get("mutatedRequests",Object(P.Map)())})),he=function(e,t,n){return pe(e).getIn([t,n],null)},me=function(e,t,n){return fe(e).getIn([t,n],null)},ve=function(e,t,n){return de(e).getIn([t,n],null)},ge=function(){return!0},ye=function(e,t,n){var r,a,o=z(e).getIn(c()(r=["paths"]).call(r,i()(t),["parameters"]),Object(P.OrderedMap)()),s=e.getIn(c()(a=["meta","paths"]).call(a,i()(t),["parameters"]),Object(P.OrderedMap)()),u=v()(o).call(o,(function(e){var t,r,a,o=s.get(c()(t="".concat(n.get("in"),".")).call(t,n.get("name"))),i=s.get(c()(r=c()(a="".concat(n.get("in"),".")).call(a,n.get("name"),".hash-")).call(r,n.hashCode()));return Object(P.OrderedMap)().merge(e,o,i)}));return E()(u).call(u,(function(e){return e.get("in")===n.get("in")&&e.get("name")===n.get("name")}),Object(P.OrderedMap)())},be=function(e,t,n,r){var a,o,s=c()(a="".concat(r,".")).call(a,n);return e.getIn(c()(o=["meta","paths"]).call(o,i()(t),["parameter_inclusions",s]),!1)},Ee=function(e,t,n,r){var a,o=z(e).getIn(c()(a=["paths"]).call(a,i()(t),["parameters"]),Object(P.OrderedMap)()),s=E()(o).call(o,(function(e){return e.get("in")===r&&e.get("name")===n}),Object(P.OrderedMap)());return ye(e,t,s)},xe=function(e,t,n){var r,a=z(e).getIn(["paths",t,n],Object(P.OrderedMap)()),o=e.getIn(["meta","paths",t,n],Object(P.OrderedMap)()),i=v()(r=a.get("parameters",Object(P.List)())).call(r,(function(r){return ye(e,[t,n],r)}));return Object(P.OrderedMap)().merge(a,o).set("parameters",i)};function Se(e,t,n,r){var a;t=t||[];var o=e.getIn(c()(a=["meta","paths"]).call(a,i()(t),["parameters"]),Object(P.fromJS)([]));return E()(o).call(o,(function(e){return P.Map.isMap(e)&&e.get("name")===n&&e.get("in")===r}))||Object(P.Map)()}var we=Object(k.createSelector)(F,(function(e){var t=e.get("host");return"string"==typeof t&&t.length>0&&"/"!==t[0]}));function je(e,t,n){var r;t=t||[];var a=xe.apply(void 0,c()(r=[e]).call(r,i()(t))).get("parameters",Object(P.List)());return S()(a).call(a,(function(e,t){var r=n&&"body"===t.get("in")?t.get("value_xml"):t.get("value");return e.set(Object(I.A)(t,{allowHashes:!1}),r)}),Object(P.fromJS)({}))}function Oe(e){var t=arguments.length>1&&void 0!==arguments[1]?arguments[1]:"";if(P.List.isList(e))return C()(e).call(e,(function(e){return P.Map.isMap(e)&&e.get("in")===t}))}function Ce(e){var t=arguments.length>1&&void 0!==arguments[1]?arguments[1]:"";if(P.List.isList(e))return C()(e).call(e,(function(e){return P.Map.isMap(e)&&e.get("type")===t}))}function _e(e,t){var n,r;t=t||[];var a=z(e).getIn(c()(n=["paths"]).call(n,i()(t)),Object(P.fromJS)({})),o=e.getIn(c()(r=["meta","paths"]).call(r,i()(t)),Object(P.fromJS)({})),s=Ae(e,t),u=a.get("parameters")||new P.List,l=o.get("consumes_value")?o.get("consumes_value"):Ce(u,"file")?"multipart/form-data":Ce(u,"formData")?"application/x-www-form-urlencoded":void 0;return Object(P.fromJS)({requestContentType:l,responseContentType:s})}function Ae(e,t){var n,r;t=t||[];var a=z(e).getIn(c()(n=["paths"]).call(n,i()(t)),null);if(null!==a){var o=e.getIn(c()(r=["meta","paths"]).call(r,i()(t),["produces_value"]),null),s=a.getIn(["produces",0],null);return o||s||"application/json"}}function ke(e,t){var n;t=t||[];var r=z(e),o=r.getIn(c()(n=["paths"]).call(n,i()(t)),null);if(null!==o){var s=t,u=a()(s,1)[0],l=o.get("produces",null),p=r.getIn(["paths",u,"produces"],null),f=r.getIn(["produces"],null);return l||p||f}}function Ie(e,t){var n;t=t||[];var r=z(e),o=r.getIn(c()(n=["paths"]).call(n,i()(t)),null);if(null!==o){var s=t,u=a()(s,1)[0],l=o.get("consumes",null),p=r.getIn(["paths",u,"consumes"],null),f=r.getIn(["consumes"],null);return l||p||f}}var Pe=function(e,t,n){var r=e.get("url").match(/^([a-z][a-z0-9+\-.]*):/),a=A()(r)?r[1]:null;return e.getIn(["scheme",t,n])||e.getIn(["scheme","_defaultScheme"])||a||""},Ne=function(e,t,n){var r;return h()(r=["http","https"]).call(r,Pe(e,t,n))>-1},Te=function(e,t){var n;t=t||[];var r=e.getIn(c()(n=["meta","paths"]).call(n,i()(t),["parameters"]),Object(P.fromJS)([])),a=!0;return f()(r).call(r,(function(e){var t=e.get("errors");t&&t.count()&&(a=!1)})),a},Re=function(e,t){var n,r,a={requestBody:!1,requestContentType:{}},o=e.getIn(c()(n=["resolvedSubtrees","paths"]).call(n,i()(t),["requestBody"]),Object(P.fromJS)([]));return o.size<1||(o.getIn(["required"])&&(a.requestBody=o.getIn(["required"])),f()(r=o.getIn(["content"]).entrySeq()).call(r,(function(e){var t=e[0];if(e[1].getIn(["schema","required"])){var n=e[1].getIn(["schema","required"]).toJS();a.requestContentType[t]=n}}))),a},Me=function(e,t,n,r){var a;if((n||r)&&n===r)return!0;var o=e.getIn(c()(a=["resolvedSubtrees","paths"]).call(a,i()(t),["requestBody","content"]),Object(P.fromJS)([]));if(o.size<2||!n||!r)return!1;var s=o.getIn([n,"schema","properties"],Object(P.fromJS)([])),u=o.getIn([r,"schema","properties"],Object(P.fromJS)([]));return!!s.equals(u)};function qe(e){return P.Map.isMap(e)?e:new P.Map}},function(e,t){e.exports=require("url-parse")},function(e,t,n){e.exports=n(751)},function(e,t,n){"use strict";n.d(t,"b",(function(){return O})),n.d(t,"a",(function(){return s.Light}));var r=n(15),a=n.n(r),o=n(29),i=n.n(o),s=n(75),c=n(396),u=n.n(c).a,l=n(395),p=n.n(l).a,f=n(397),d=n.n(f).a,h=n(400),m=n.n(h).a,v=n(398),g=n.n(v).a,y=n(399),b=n.n(y).a,E=n(401),x=n.n(E).a,S={hljs:{display:"block",overflowX:"auto",padding:"0.5em",background:"#333",color:"white"},"hljs-name":{fontWeight:"bold"},"hljs-strong":{fontWeight:"bold"},"hljs-code":{fontStyle:"italic",color:"#888"},"hljs-emphasis":{fontStyle:"italic"},"hljs-tag":{color:"#62c8f3"},"hljs-variable":{color:"#ade5fc"},"hljs-template-variable":{color:"#ade5fc"},"hljs-selector-id":{color:"#ade5fc"},"hljs-selector-class":{color:"#ade5fc"},"hljs-string":{color:"#a2fca2"},"hljs-bullet":{color:"#d36363"},"hljs-type":{color:"#ffa"},"hljs-title":{color:"#ffa"},"hljs-section":{color:"#ffa"},"hljs-attribute":{color:"#ffa"},"hljs-quote":{color:"#ffa"},"hljs-built_in":{color:"#ffa"},"hljs-builtin-name":{color:"#ffa"},"hljs-number":{color:"#d36363"},"hljs-symbol":{color:"#d36363"},"hljs-keyword":{color:"#fcc28c"},"hljs-selector-tag":{color:"#fcc28c"},"hljs-literal":{color:"#fcc28c"},"hljs-comment":{color:"#888"},"hljs-deletion":{color:"#333",backgroundColor:"#fc9b9b"},"hljs-regexp":{color:"#c6b4f0"},"hljs-link":{color:"#c6b4f0"},"hljs-meta":{color:"#fc9b9b"},"hljs-addition":{backgroundColor:"#a2fca2",color:"#333"}};s.Light.registerLanguage("json",p),s.Light.registerLanguage("js",u),s.Light.registerLanguage("xml",d),s.Light.registerLanguage("yaml",g),s.Light.registerLanguage("http",b),s.Light.registerLanguage("bash",m),s.Light.registerLanguage("powershell",x),s.Light.registerLanguage("javascript",u);var w={agate:S,arta:{hljs:{display:"block",overflowX:"auto",padding:"0.5em",background:"#222",color:"#aaa"},"hljs-subst":{color:"#aaa"},"hljs-section":{color:"#fff",fontWeight:"bold"},"hljs-comment":{color:"#444"},"hljs-quote":{color:"#444"},"hljs-meta":{color:"#444"},"hljs-string":{color:"#ffcc33"},"hljs-symbol":{color:"#ffcc33"},"hljs-bullet":{color:"#ffcc33"},"hljs-regexp":{color:"#ffcc33"},"hljs-number":{color:"#00cc66"},"hljs-addition":{color:"#00cc66"},"hljs-built_in":{color:"#32aaee"},"hljs-builtin-name":{color:"#32aaee"},"hljs-literal":{color:"#32aaee"},"hljs-type":{color:"#32aaee"},"hljs-template-variable":{color:"#32aaee"},"hljs-attribute":{color:"#32aaee"},"hljs-link":{color:"#32aaee"},"hljs-keyword":{color:"#6644aa"},"hljs-selector-tag":{color:"#6644aa"},"hljs-name":{color:"#6644aa"},"hljs-selector-id":{color:"#6644aa"},"hljs-selector-class":{color:"#6644aa"},"hljs-title":{color:"#bb1166"},"hljs-variable":{color:"#bb1166"},"hljs-deletion":{color:"#bb1166"},"hljs-template-tag":{color:"#bb1166"},"hljs-doctag":{fontWeight:"bold"},"hljs-strong":{fontWeight:"bold"},"hljs-emphasis":{fontStyle:"italic"}},monokai:{hljs:{display:"block",overflowX:"auto",padding:"0.5em",background:"#272822",color:"#ddd"},"hljs-tag":{color:"#f92672"},"hljs-keyword":{color:"#f92672",fontWeight:"bold"},"hljs-selector-tag":{color:"#f92672",fontWeight:"bold"},"hljs-literal":{color:"#f92672",fontWeight:"bold"},"hljs-strong":{color:"#f92672"},"hljs-name":{color:"#f92672"},"hljs-code":{color:"#66d9ef"},"hljs-class .hljs-title":{color:"white"},"hljs-attribute":{color:"#bf79db"},"hljs-symbol":{color:"#bf79db"},"hljs-regexp":{color:"#bf79db"},"hljs-link":{color:"#bf79db"},"hljs-string":{color:"#a6e22e"},"hljs-bullet":{color:"#a6e22e"},"hljs-subst":{color:"#a6e22e"},"hljs-title":{color:"#a6e22e",fontWeight:"bold"},"hljs-section":{color:"#a6e22e",fontWeight:"bold"},"hljs-emphasis":{color:"#a6e22e"},"hljs-type":{color:"#a6e22e",fontWeight:"bold"},"hljs-built_in":{color:"#a6e22e"},"hljs-builtin-name":{color:"#a6e22e"},"hljs-selector-attr":{color:"#a6e22e"},"hljs-selector-pseudo":{color:"#a6e22e"},"hljs-addition":{color:"#a6e22e"},"hljs-variable":{color:"#a6e22e"},"hljs-template-tag":{color:"#a6e22e"},"hljs-template-variable":{color:"#a6e22e"},"hljs-comment":{color:"#75715e"},"hljs-quote":{color:"#75715e"},"hljs-deletion":{color:"#75715e"},"hljs-meta":{color:"#75715e"},"hljs-doctag":{fontWeight:"bold"},"hljs-selector-id":{fontWeight:"bold"}},nord:{hljs:{display:"block",overflowX:"auto",padding:"0.5em",background:"#2E3440",color:"#D8DEE9"},"hljs-subst":{color:"#D8DEE9"},"hljs-selector-tag":{color:"#81A1C1"},"hljs-selector-id":{color:"#8FBCBB",fontWeight:"bold"},"hljs-selector-class":{color:"#8FBCBB"},"hljs-selector-attr":{color:"#8FBCBB"},"hljs-selector-pseudo":{color:"#88C0D0"},"hljs-addition":{backgroundColor:"rgba(163, 190, 140, 0.5)"},"hljs-deletion":{backgroundColor:"rgba(191, 97, 106, 0.5)"},"hljs-built_in":{color:"#8FBCBB"},"hljs-type":{color:"#8FBCBB"},"hljs-class":{color:"#8FBCBB"},"hljs-function":{color:"#88C0D0"},"hljs-function > .hljs-title":{color:"#88C0D0"},"hljs-keyword":{color:"#81A1C1"},"hljs-literal":{color:"#81A1C1"},"hljs-symbol":{color:"#81A1C1"},"hljs-number":{color:"#B48EAD"},"hljs-regexp":{color:"#EBCB8B"},"hljs-string":{color:"#A3BE8C"},"hljs-title":{color:"#8FBCBB"},"hljs-params":{color:"#D8DEE9"},"hljs-bullet":{color:"#81A1C1"},"hljs-code":{color:"#8FBCBB"},"hljs-emphasis":{fontStyle:"italic"},"hljs-formula":{color:"#8FBCBB"},"hljs-strong":{fontWeight:"bold"},"hljs-link:hover":{textDecoration:"underline"},"hljs-quote":{color:"#4C566A"},"hljs-comment":{color:"#4C566A"},"hljs-doctag":{color:"#8FBCBB"},"hljs-meta":{color:"#5E81AC"},"hljs-meta-keyword":{color:"#5E81AC"},"hljs-meta-string":{color:"#A3BE8C"},"hljs-attr":{color:"#8FBCBB"},"hljs-attribute":{color:"#D8DEE9"},"hljs-builtin-name":{color:"#81A1C1"},"hljs-name":{color:"#81A1C1"},"hljs-section":{color:"#88C0D0"},"hljs-tag":{color:"#81A1C1"},"hljs-variable":{color:"#D8DEE9"},"hljs-template-variable":{color:"#D8DEE9"},"hljs-template-tag":{color:"#5E81AC"},"abnf .hljs-attribute":{color:"#88C0D0"},"abnf .hljs-symbol":{color:"#EBCB8B"},"apache .hljs-attribute":{color:"#88C0D0"},"apache .hljs-section":{color:"#81A1C1"},"arduino .hljs-built_in":{color:"#88C0D0"},"aspectj .hljs-meta":{color:"#D08770"},"aspectj > .hljs-title":{color:"#88C0D0"},"bnf .hljs-attribute":{color:"#8FBCBB"},"clojure .hljs-name":{color:"#88C0D0"},"clojure .hljs-symbol":{color:"#EBCB8B"},"coq .hljs-built_in":{color:"#88C0D0"},"cpp .hljs-meta-string":{color:"#8FBCBB"},"css .hljs-built_in":{color:"#88C0D0"},"css .hljs-keyword":{color:"#D08770"},"diff .hljs-meta":{color:"#8FBCBB"},"ebnf .hljs-attribute":{color:"#8FBCBB"},"glsl .hljs-built_in":{color:"#88C0D0"},"groovy .hljs-meta:not(:first-child)":{color:"#D08770"},"haxe .hljs-meta":{color:"#D08770"},"java .hljs-meta":{color:"#D08770"},"ldif .hljs-attribute":{color:"#8FBCBB"},"lisp .hljs-name":{color:"#88C0D0"},"lua .hljs-built_in":{color:"#88C0D0"},"moonscript .hljs-built_in":{color:"#88C0D0"},"nginx .hljs-attribute":{color:"#88C0D0"},"nginx .hljs-section":{color:"#5E81AC"},"pf .hljs-built_in":{color:"#88C0D0"},"processing .hljs-built_in":{color:"#88C0D0"},"scss .hljs-keyword":{color:"#81A1C1"},"stylus .hljs-keyword":{color:"#81A1C1"},"swift .hljs-meta":{color:"#D08770"},"vim .hljs-built_in":{color:"#88C0D0",fontStyle:"italic"},"yaml .hljs-meta":{color:"#D08770"}},obsidian:{hljs:{display:"block",overflowX:"auto",padding:"0.5em",background:"#282b2e",color:"#e0e2e4"},"hljs-keyword":{color:"#93c763",fontWeight:"bold"},"hljs-selector-tag":{color:"#93c763",fontWeight:"bold"},"hljs-literal":{color:"#93c763",fontWeight:"bold"},"hljs-selector-id":{color:"#93c763"},"hljs-number":{color:"#ffcd22"},"hljs-attribute":{color:"#668bb0"},"hljs-code":{color:"white"},"hljs-class .hljs-title":{color:"white"},"hljs-section":{color:"white",fontWeight:"bold"},"hljs-regexp":{color:"#d39745"},"hljs-link":{color:"#d39745"},"hljs-meta":{color:"#557182"},"hljs-tag":{color:"#8cbbad"},"hljs-name":{color:"#8cbbad",fontWeight:"bold"},"hljs-bullet":{color:"#8cbbad"},"hljs-subst":{color:"#8cbbad"},"hljs-emphasis":{color:"#8cbbad"},"hljs-type":{color:"#8cbbad",fontWeight:"bold"},"hljs-built_in":{color:"#8cbbad"},"hljs-selector-attr":{color:"#8cbbad"},"hljs-selector-pseudo":{color:"#8cbbad"},"hljs-addition":{color:"#8cbbad"},"hljs-variable":{color:"#8cbbad"},"hljs-template-tag":{color:"#8cbbad"},"hljs-template-variable":{color:"#8cbbad"},"hljs-string":{color:"#ec7600"},"hljs-symbol":{color:"#ec7600"},"hljs-comment":{color:"#818e96"},"hljs-quote":{color:"#818e96"},"hljs-deletion":{color:"#818e96"},"hljs-selector-class":{color:"#A082BD"},"hljs-doctag":{fontWeight:"bold"},"hljs-title":{fontWeight:"bold"},"hljs-strong":{fontWeight:"bold"}},"tomorrow-night":{"hljs-comment":{color:"#969896"},"hljs-quote":{color:"#969896"},"hljs-variable":{color:"#cc6666"},"hljs-template-variable":{color:"#cc6666"},"hljs-tag":{color:"#cc6666"},"hljs-name":{color:"#cc6666"},"hljs-selector-id":{color:"#cc6666"},"hljs-selector-class":{color:"#cc6666"},"hljs-regexp":{color:"#cc6666"},"hljs-deletion":{color:"#cc6666"},"hljs-number":{color:"#de935f"},"hljs-built_in":{color:"#de935f"},"hljs-builtin-name":{color:"#de935f"},"hljs-literal":{color:"#de935f"},"hljs-type":{color:"#de935f"},"hljs-params":{color:"#de935f"},"hljs-meta":{color:"#de935f"},"hljs-link":{color:"#de935f"},"hljs-attribute":{color:"#f0c674"},"hljs-string":{color:"#b5bd68"},"hljs-symbol":{color:"#b5bd68"},"hljs-bullet":{color:"#b5bd68"},"hljs-addition":{color:"#b5bd68"},"hljs-title":{color:"#81a2be"},"hljs-section":{color:"#81a2be"},"hljs-keyword":{color:"#b294bb"},"hljs-selector-tag":{color:"#b294bb"},hljs:{display:"block",overflowX:"auto",background:"#1d1f21",color:"#c5c8c6",padding:"0.5em"},"hljs-emphasis":{fontStyle:"italic"},"hljs-strong":{fontWeight:"bold"}}},j=a()(w),O=function(e){return i()(j).call(j,e)?w[e]:(console.warn("Request style '".concat(e,"' is not available, returning default instead")),S)}},function(e,t){e.exports=require("formdata-node")},function(e,t){e.exports=!0},function(e,t){},function(e,t,n){var r=n(196),a=n(59).f,o=n(67),i=n(51),s=n(421),c=n(35)("toStringTag");e.exports=function(e,t,n,u){if(e){var l=n?e:e.prototype;i(l,c)||a(l,c,{configurable:!0,value:t}),u&&!r&&o(l,"toString",s)}}},function(e,t,n){var r=n(196),a=n(127),o=n(35)("toStringTag"),i="Arguments"==a(function(){return arguments}());e.exports=r?a:function(e){var t,n,r;return void 0===e?"Undefined":null===e?"Null":"string"==typeof(n=function(e,t){try{return e[t]}catch(e){}}(t=Object(e),o))?n:i?a(t):"Object"==(r=a(t))&&"function"==typeof t.callee?"Arguments":r}},function(e,t,n){var r=n(587);e.exports=function(e){return null==e?"":r(e)}},function(e,t){e.exports=function(e,t){return e===t||e!=e&&t!=t}},function(e,t,n){var r=n(104),a=n(48);e.exports=function(e){if(!a(e))return!1;var t=r(e);return"[object Function]"==t||"[object GeneratorFunction]"==t||"[object AsyncFunction]"==t||"[object Proxy]"==t}},function(e,t,n){e.exports=n(580)},function(e,t,n){e.exports=n(735)},function(e,t,n){"use strict";function r(e){return function(e){try{return!!JSON.parse(e)}catch(e){return null}}(e)?"json":null}n.d(t,"a",(function(){return r}))},function(e,t,n){e.exports=n(576)},function(e,t,n){"use strict";n.r(t),n.d(t,"UPDATE_LAYOUT",(function(){return a})),n.d(t,"UPDATE_FILTER",(function(){return o})),n.d(t,"UPDATE_MODE",(function(){return i})),n.d(t,"SHOW",(function(){return s})),n.d(t,"updateLayout",(function(){return c})),n.d(t,"updateFilter",(function(){return u})),n.d(t,"show",(function(){return l})),n.d(t,"changeMode",(function(){return p}));var r=n(5),a="layout_update_layout",o="layout_update_filter",i="layout_update_mode",s="layout_show";function c(e){return{type:a,payload:e}}function u(e){return{type:o,payload:e}}function l(e){var t=!(arguments.length>1&&void 0!==arguments[1])||arguments[1];return e=Object(r.v)(e),{type:s,payload:{thing:e,shown:t}}}function p(e){var t=arguments.length>1&&void 0!==arguments[1]?arguments[1]:"";return e=Object(r.v)(e),{type:i,payload:{thing:e,mode:t}}}},function(e,t,n){var r=n(353),a=n(137),o=n(133),i=n(47),s=n(105),c=n(134),u=n(163),l=n(164),p=Object.prototype.hasOwnProperty;e.exports=function(e){if(null==e)return!0;if(s(e)&&(i(e)||"string"==typeof e||"function"==typeof e.splice||c(e)||l(e)||o(e)))return!e.length;var t=a(e);if("[object Map]"==t||"[object Set]"==t)return!e.size;if(u(e))return!r(e).length;for(var n in e)if(p.call(e,n))return!1;return!0}},function(e,t){e.exports=function(e,t){return{enumerable:!(1&e),configurable:!(2&e),writable:!(4&e),value:t}}},function(e,t){e.exports=function(e){if(null==e)throw TypeError("Can't call method on "+e);return e}},function(e,t,n){var r=n(55);e.exports=r("navigator","userAgent")||""},function(e,t,n){var r=n(66);e.exports=function(e,t,n){if(r(e),void 0===t)return e;switch(n){case 0:return function(){return e.call(t)};case 1:return function(n){return e.call(t,n)};case 2:return function(n,r){return e.call(t,n,r)};case 3:return function(n,r,a){return e.call(t,n,r,a)}}return function(){return e.apply(t,arguments)}}},function(e,t,n){var r,a=n(46),o=n(190),i=n(193),s=n(132),c=n(295),u=n(188),l=n(153),p=l("IE_PROTO"),f=function(){},d=function(e){return"<script>"+e+"</"+"script>"},h=function(e){e.write(d("")),e.close();var t=e.parentWindow.Object;return e=null,t},m=function(){try{r=new ActiveXObject("htmlfile")}catch(e){}m=document.domain&&r?h(r):function(){var e,t=u("iframe");if(t.style)return t.style.display="none",c.appendChild(t),t.src=String("javascript:"),(e=t.contentWindow.document).open(),e.write(d("document.F=Object")),e.close(),e.F}()||h(r);for(var e=i.length;e--;)delete m.prototype[i[e]];return m()};s[p]=!0,e.exports=Object.create||function(e,t){var n;return null!==e?(f.prototype=a(e),n=new f,f.prototype=null,n[p]=e):n=m(),void 0===t?n:o(n,t)}},function(e,t,n){var r=n(67);e.exports=function(e,t,n,a){a&&a.enumerable?e[t]=n:r(e,t,n)}},function(e,t,n){"use strict";var r=n(34);e.exports=function(e,t){var n=[][e];return!!n&&r((function(){n.call(null,t||function(){throw 1},1)}))}},function(e,t,n){var r=n(493),a=n(498);e.exports=function(e,t){var n=a(e,t);return r(n)?n:void 0}},function(e,t,n){var r=n(115),a=n(494),o=n(495),i=r?r.toStringTag:void 0;e.exports=function(e){return null==e?void 0===e?"[object Undefined]":"[object Null]":i&&i in Object(e)?a(e):o(e)}},function(e,t,n){var r=n(89),a=n(206);e.exports=function(e){return null!=e&&a(e.length)&&!r(e)}},function(e,t,n){var r=n(46),a=n(334),o=n(60),i=n(99),s=n(136),c=n(333),u=function(e,t){this.stopped=e,this.result=t};e.exports=function(e,t,n){var l,p,f,d,h,m,v,g=n&&n.that,y=!(!n||!n.AS_ENTRIES),b=!(!n||!n.IS_ITERATOR),E=!(!n||!n.INTERRUPTED),x=i(t,g,1+y+E),S=function(e){return l&&c(l),new u(!0,e)},w=function(e){return y?(r(e),E?x(e[0],e[1],S):x(e[0],e[1])):E?x(e,S):x(e)};if(b)l=e;else{if("function"!=typeof(p=s(e)))throw TypeError("Target is not iterable");if(a(p)){for(f=0,d=o(e.length);d>f;f++)if((h=w(e[f]))&&h instanceof u)return h;return new u(!1)}l=p.call(e)}for(m=l.next;!(v=m.call(l)).done;){try{h=w(v.value)}catch(e){throw c(l),e}if("object"==typeof h&&h&&h instanceof u)return h}return new u(!1)}},function(e,t,n){e.exports=n(762)},function(e,t){e.exports=require("randombytes")},function(e,t,n){"use strict";n.r(t),n.d(t,"default",(function(){return l}));var r=n(12),a=n.n(r),o=n(4),i=n.n(o),s=n(389),c=n.n(s),u=[n(222),n(223)];function l(e){var t,n={jsSpec:{}},r=c()(u,(function(e,t){try{var r=t.transform(e,n);return a()(r).call(r,(function(e){return!!e}))}catch(t){return console.error("Transformer error:",t),e}}),e);return i()(t=a()(r).call(r,(function(e){return!!e}))).call(t,(function(e){return!e.get("line")&&e.get("path"),e}))}},function(e,t,n){var r=n(44),a=n(148),o=n(96),i=n(58),s=n(150),c=n(51),u=n(291),l=Object.getOwnPropertyDescriptor;t.f=r?l:function(e,t){if(e=i(e),t=s(t),u)try{return l(e,t)}catch(e){}if(c(e,t))return o(!a.f.call(e,t),e[t])}},function(e,t,n){var r,a,o=n(38),i=n(98),s=o.process,c=o.Deno,u=s&&s.versions||c&&c.version,l=u&&u.v8;l?a=(r=l.split("."))[0]<4?1:r[0]+r[1]:i&&(!(r=i.match(/Edge\/(\d+)/))||r[1]>=74)&&(r=i.match(/Chrome\/(\d+)/))&&(a=r[1]),e.exports=a&&+a},function(e,t){var n=Math.ceil,r=Math.floor;e.exports=function(e){return isNaN(e=+e)?0:(e>0?r:n)(e)}},function(e,t){e.exports={}},function(e,t,n){"use strict";var r=n(303).charAt,a=n(61),o=n(68),i=n(198),s="String Iterator",c=o.set,u=o.getterFor(s);i(String,"String",(function(e){c(this,{type:s,string:a(e),index:0})}),(function(){var e,t=u(this),n=t.string,a=t.index;return a>=n.length?{value:void 0,done:!0}:(e=r(n,a),t.index+=e.length,{value:e,done:!1})}))},function(e,t,n){var r=n(62).Symbol;e.exports=r},function(e,t,n){var r=n(209),a=n(202);e.exports=function(e,t,n,o){var i=!n;n||(n={});for(var s=-1,c=t.length;++s<c;){var u=t[s],l=o?o(n[u],e[u],u,n,e):void 0;void 0===l&&(l=e[u]),i?a(n,u,l):r(n,u,l)}return n}},function(e,t,n){var r=n(324),a=n(353),o=n(105);e.exports=function(e){return o(e)?r(e):a(e)}},function(e,t,n){var r=n(167);e.exports=function(e){if("string"==typeof e||r(e))return e;var t=e+"";return"0"==t&&1/e==-Infinity?"-0":t}},function(e,t){e.exports=function(e,t,n){if(!(e instanceof t))throw TypeError("Incorrect "+(n?n+" ":"")+"invocation");return e}},function(e,t,n){var r=n(104),a=n(205),o=n(70),i=Function.prototype,s=Object.prototype,c=i.toString,u=s.hasOwnProperty,l=c.call(Object);e.exports=function(e){if(!o(e)||"[object Object]"!=r(e))return!1;var t=a(e);if(null===t)return!0;var n=u.call(t,"constructor")&&t.constructor;return"function"==typeof n&&n instanceof n&&c.call(n)==l}},function(e,t){e.exports=require("serialize-error")},function(e,t,n){"use strict";n.r(t),n.d(t,"sampleFromSchemaGeneric",(function(){return B})),n.d(t,"inferSchema",(function(){return L})),n.d(t,"createXMLExample",(function(){return U})),n.d(t,"sampleFromSchema",(function(){return V})),n.d(t,"memoizedCreateXMLExample",(function(){return z})),n.d(t,"memoizedSampleFromSchema",(function(){return F}));var r=n(18),a=n.n(r),o=n(2),i=n.n(o),s=n(17),c=n.n(s),u=n(22),l=n.n(u),p=n(23),f=n.n(p),d=n(29),h=n.n(d),m=n(64),v=n.n(m),g=n(19),y=n.n(g),b=n(52),E=n.n(b),x=n(4),S=n.n(x),w=n(5),j=n(386),O=n.n(j),C=n(280),_=n.n(C),A=n(95),k=n.n(A),I={string:function(){return"string"},string_email:function(){return"user@example.com"},"string_date-time":function(){return(new Date).toISOString()},string_date:function(){return(new Date).toISOString().substring(0,10)},string_uuid:function(){return"3fa85f64-5717-4562-b3fc-2c963f66afa6"},string_hostname:function(){return"example.com"},string_ipv4:function(){return"198.51.100.42"},string_ipv6:function(){return"2001:0db8:5b96:0000:0000:426f:8e17:642a"},number:function(){return 0},number_float:function(){return 0},integer:function(){return 0},boolean:function(e){return"boolean"!=typeof e.default||e.default}},P=function(e){var t,n=e=Object(w.z)(e),r=n.type,a=n.format,o=I[i()(t="".concat(r,"_")).call(t,a)]||I[r];return Object(w.s)(o)?o(e):"Unknown Type: "+e.type},N=function(e){return Object(w.e)(e,"$$ref",(function(e){return"string"==typeof e&&c()(e).call(e,"#")>-1}))},T=["maxProperties","minProperties"],R=["minItems","maxItems"],M=["minimum","maximum","exclusiveMinimum","exclusiveMaximum"],q=["minLength","maxLength"],D=function e(t,n){var r,a,o,s=arguments.length>2&&void 0!==arguments[2]?arguments[2]:{},u=function(e){void 0===n[e]&&void 0!==t[e]&&(n[e]=t[e])};(l()(r=i()(a=["example","default","enum","xml","type"]).call(a,T,R,M,q)).call(r,(function(e){return u(e)})),void 0!==t.required&&f()(t.required))&&(void 0!==n.required&&n.required.length||(n.required=[]),l()(o=t.required).call(o,(function(e){var t;h()(t=n.required).call(t,e)||n.required.push(e)})));if(t.properties){n.properties||(n.properties={});var p=Object(w.z)(t.properties);for(var d in p){var m;if(Object.prototype.hasOwnProperty.call(p,d))if(!p[d]||!p[d].deprecated)if(!p[d]||!p[d].readOnly||s.includeReadOnly)if(!p[d]||!p[d].writeOnly||s.includeWriteOnly)if(!n.properties[d])n.properties[d]=p[d],!t.required&&f()(t.required)&&-1!==c()(m=t.required).call(m,d)&&(n.required?n.required.push(d):n.required=[d])}}return t.items&&(n.items||(n.items={}),n.items=e(t.items,n.items,s)),n},B=function e(t){var n=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{},r=arguments.length>2&&void 0!==arguments[2]?arguments[2]:void 0,o=arguments.length>3&&void 0!==arguments[3]&&arguments[3];t&&Object(w.s)(t.toJS)&&(t=t.toJS());var s=void 0!==r||t&&void 0!==t.example||t&&void 0!==t.default,u=!s&&t&&t.oneOf&&t.oneOf.length>0,p=!s&&t&&t.anyOf&&t.anyOf.length>0;if(!s&&(u||p)){var d=Object(w.z)(u?t.oneOf[0]:t.anyOf[0]);if(D(d,t,n),!t.xml&&d.xml&&(t.xml=d.xml),void 0!==t.example&&void 0!==d.example)s=!0;else if(d.properties){t.properties||(t.properties={});var m=Object(w.z)(d.properties);for(var g in m){var b;if(Object.prototype.hasOwnProperty.call(m,g))if(!m[g]||!m[g].deprecated)if(!m[g]||!m[g].readOnly||n.includeReadOnly)if(!m[g]||!m[g].writeOnly||n.includeWriteOnly)if(!t.properties[g])t.properties[g]=m[g],!d.required&&f()(d.required)&&-1!==c()(b=d.required).call(b,g)&&(t.required?t.required.push(g):t.required=[g])}}}var x,j={},O=t||{},C=O.xml,_=O.type,A=O.example,I=O.properties,q=O.additionalProperties,B=O.items,L=n.includeReadOnly,U=n.includeWriteOnly,V=C=C||{},z=V.name,F=V.prefix,J=V.namespace,W={};if(o&&(x=(F?F+":":"")+(z=z||"notagname"),J)){var H=F?"xmlns:"+F:"xmlns";j[H]=J}o&&(W[x]=[]);var $=function(e){return v()(e).call(e,(function(e){return Object.prototype.hasOwnProperty.call(t,e)}))};t&&!_&&(I||q||$(T)?_="object":B||$(R)?_="array":$(M)?(_="number",t.type="number"):s||t.enum||(_="string",t.type="string"));var Y,K,G=function(e){var n,r,a,o,i;null!==(null===(n=t)||void 0===n?void 0:n.maxItems)&&void 0!==(null===(r=t)||void 0===r?void 0:r.maxItems)&&(e=y()(e).call(e,0,null===(i=t)||void 0===i?void 0:i.maxItems));if(null!==(null===(a=t)||void 0===a?void 0:a.minItems)&&void 0!==(null===(o=t)||void 0===o?void 0:o.minItems))for(var s=0;e.length<(null===(c=t)||void 0===c?void 0:c.minItems);){var c;e.push(e[s++%e.length])}return e},Z=Object(w.z)(I),X=0,Q=function(){return t&&null!==t.maxProperties&&void 0!==t.maxProperties&&X>=t.maxProperties},ee=function(){if(!t||!t.required)return 0;var e,n,r=0;o?l()(e=t.required).call(e,(function(e){return r+=void 0===W[e]?0:1})):l()(n=t.required).call(n,(function(e){var t;return r+=void 0===(null===(t=W[x])||void 0===t?void 0:E()(t).call(t,(function(t){return void 0!==t[e]})))?0:1}));return t.required.length-r},te=function(e){var n;return!(t&&t.required&&t.required.length)||!h()(n=t.required).call(n,e)},ne=function(e){return!t||null===t.maxProperties||void 0===t.maxProperties||!Q()&&(!te(e)||t.maxProperties-X-ee()>0)};if(Y=o?function(r){var a=arguments.length>1&&void 0!==arguments[1]?arguments[1]:void 0;if(t&&Z[r]){if(Z[r].xml=Z[r].xml||{},Z[r].xml.attribute){var s=f()(Z[r].enum)?Z[r].enum[0]:void 0,c=Z[r].example,u=Z[r].default;return void(j[Z[r].xml.name||r]=void 0!==c?c:void 0!==u?u:void 0!==s?s:P(Z[r]))}Z[r].xml.name=Z[r].xml.name||r}else Z[r]||!1===q||(Z[r]={xml:{name:r}});var l,p=e(t&&Z[r]||void 0,n,a,o);ne(r)&&(X++,f()(p)?W[x]=i()(l=W[x]).call(l,p):W[x].push(p))}:function(t,r){ne(t)&&(W[t]=e(Z[t],n,r,o),X++)},s){var re;if(re=N(void 0!==r?r:void 0!==A?A:t.default),!o){if("number"==typeof re&&"string"===_)return"".concat(re);if("string"!=typeof re||"string"===_)return re;try{return JSON.parse(re)}catch(e){return re}}if(t||(_=f()(re)?"array":a()(re)),"array"===_){if(!f()(re)){if("string"==typeof re)return re;re=[re]}var ae=t?t.items:void 0;ae&&(ae.xml=ae.xml||C||{},ae.xml.name=ae.xml.name||C.name);var oe=S()(re).call(re,(function(t){return e(ae,n,t,o)}));return oe=G(oe),C.wrapped?(W[x]=oe,k()(j)||W[x].push({_attr:j})):W=oe,W}if("object"===_){if("string"==typeof re)return re;for(var ie in re)Object.prototype.hasOwnProperty.call(re,ie)&&(t&&Z[ie]&&Z[ie].readOnly&&!L||t&&Z[ie]&&Z[ie].writeOnly&&!U||(t&&Z[ie]&&Z[ie].xml&&Z[ie].xml.attribute?j[Z[ie].xml.name||ie]=re[ie]:Y(ie,re[ie])));return k()(j)||W[x].push({_attr:j}),W}return W[x]=k()(j)?re:[{_attr:j},re],W}if("object"===_){for(var se in Z)Object.prototype.hasOwnProperty.call(Z,se)&&(Z[se]&&Z[se].deprecated||Z[se]&&Z[se].readOnly&&!L||Z[se]&&Z[se].writeOnly&&!U||Y(se));if(o&&j&&W[x].push({_attr:j}),Q())return W;if(!0===q)o?W[x].push({additionalProp:"Anything can be here"}):W.additionalProp1={},X++;else if(q){var ce=Object(w.z)(q),ue=e(ce,n,void 0,o);if(o&&ce.xml&&ce.xml.name&&"notagname"!==ce.xml.name)W[x].push(ue);else for(var le=null!==t.minProperties&&void 0!==t.minProperties&&X<t.minProperties?t.minProperties-X:3,pe=1;pe<=le;pe++){if(Q())return W;if(o){var fe={};fe["additionalProp"+pe]=ue.notagname,W[x].push(fe)}else W["additionalProp"+pe]=ue;X++}}return W}if("array"===_){if(!B)return;var de,he,me;if(o)B.xml=B.xml||(null===(he=t)||void 0===he?void 0:he.xml)||{},B.xml.name=B.xml.name||C.name;if(f()(B.anyOf))de=S()(me=B.anyOf).call(me,(function(t){return e(D(B,t,n),n,void 0,o)}));else if(f()(B.oneOf)){var ve;de=S()(ve=B.oneOf).call(ve,(function(t){return e(D(B,t,n),n,void 0,o)}))}else{if(!(!o||o&&C.wrapped))return e(B,n,void 0,o);de=[e(B,n,void 0,o)]}return de=G(de),o&&C.wrapped?(W[x]=de,k()(j)||W[x].push({_attr:j}),W):de}if(t&&f()(t.enum))K=Object(w.v)(t.enum)[0];else{if(!t)return;if("number"==typeof(K=P(t))){var ge=t.minimum;null!=ge&&(t.exclusiveMinimum&&ge++,K=ge);var ye=t.maximum;null!=ye&&(t.exclusiveMaximum&&ye--,K=ye)}if("string"==typeof K&&(null!==t.maxLength&&void 0!==t.maxLength&&(K=y()(K).call(K,0,t.maxLength)),null!==t.minLength&&void 0!==t.minLength))for(var be=0;K.length<t.minLength;)K+=K[be++%K.length]}if("file"!==_)return o?(W[x]=k()(j)?K:[{_attr:j},K],W):K},L=function(e){return e.schema&&(e=e.schema),e.properties&&(e.type="object"),e},U=function(e,t,n){var r=B(e,t,n,!0);if(r)return"string"==typeof r?r:O()(r,{declaration:!0,indent:"\t"})},V=function(e,t,n){return B(e,t,n,!1)},z=_()(U),F=_()(V)},function(e,t){e.exports=require("react-copy-to-clipboard")},function(e,t,n){"use strict";n.r(t),n.d(t,"UPDATE_CONFIGS",(function(){return o})),n.d(t,"TOGGLE_CONFIGS",(function(){return i})),n.d(t,"update",(function(){return s})),n.d(t,"toggle",(function(){return c})),n.d(t,"loaded",(function(){return u}));var r=n(3),a=n.n(r),o="configs_update",i="configs_toggle";function s(e,t){return{type:o,payload:a()({},e,t)}}function c(e){return{type:i,payload:e}}var u=function(){return function(e){var t=e.getConfigs,n=e.authActions;if(t().persistAuthorization){var r=localStorage.getItem("authorized");r&&n.restoreAuthorization({authorized:JSON.parse(r)})}}}},function(e,t,n){"use strict";n.d(t,"a",(function(){return l}));var r=n(12),a=n.n(r),o=n(29),i=n.n(o),s=n(1),c=n.n(s),u=c.a.Set.of("type","format","items","default","maximum","exclusiveMaximum","minimum","exclusiveMinimum","maxLength","minLength","pattern","maxItems","minItems","uniqueItems","enum","multipleOf");function l(e){var t=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{},n=t.isOAS3;if(!c.a.Map.isMap(e))return{schema:c.a.Map(),parameterContentMediaType:null};if(!n)return"body"===e.get("in")?{schema:e.get("schema",c.a.Map()),parameterContentMediaType:null}:{schema:a()(e).call(e,(function(e,t){return i()(u).call(u,t)})),parameterContentMediaType:null};if(e.get("content")){var r=e.get("content",c.a.Map({})).keySeq(),o=r.first();return{schema:e.getIn(["content",o,"schema"],c.a.Map()),parameterContentMediaType:o}}return{schema:e.get("schema",c.a.Map()),parameterContentMediaType:null}}},function(e,t){e.exports=require("fast-json-patch")},function(e,t){var n={}.toString;e.exports=function(e){return n.call(e).slice(8,-1)}},function(e,t,n){var r=n(127);e.exports=Array.isArray||function(e){return"Array"==r(e)}},function(e,t,n){"use strict";var r=n(150),a=n(59),o=n(96);e.exports=function(e,t,n){var i=r(t);i in e?a.f(e,i,o(0,n)):e[i]=n}},function(e,t,n){var r=n(34),a=n(35),o=n(111),i=a("species");e.exports=function(e){return o>=51||!r((function(){var t=[];return(t.constructor={})[i]=function(){return{foo:1}},1!==t[e](Boolean).foo}))}},function(e,t,n){var r=n(294),a=n(193);e.exports=Object.keys||function(e){return r(e,a)}},function(e,t){e.exports={}},function(e,t,n){var r=n(514),a=n(70),o=Object.prototype,i=o.hasOwnProperty,s=o.propertyIsEnumerable,c=r(function(){return arguments}())?r:function(e){return a(e)&&i.call(e,"callee")&&!s.call(e,"callee")};e.exports=c},function(e,t,n){(function(e){var r=n(62),a=n(516),o=t&&!t.nodeType&&t,i=o&&"object"==typeof e&&e&&!e.nodeType&&e,s=i&&i.exports===o?r.Buffer:void 0,c=(s?s.isBuffer:void 0)||a;e.exports=c}).call(this,n(203)(e))},function(e,t,n){var r=n(324),a=n(520),o=n(105);e.exports=function(e){return o(e)?r(e,!0):a(e)}},function(e,t,n){var r=n(86),a=n(113),o=n(35)("iterator");e.exports=function(e){if(null!=e)return e[o]||e["@@iterator"]||a[r(e)]}},function(e,t,n){var r=n(615),a=n(200),o=n(616),i=n(617),s=n(618),c=n(104),u=n(313),l="[object Map]",p="[object Promise]",f="[object Set]",d="[object WeakMap]",h="[object DataView]",m=u(r),v=u(a),g=u(o),y=u(i),b=u(s),E=c;(r&&E(new r(new ArrayBuffer(1)))!=h||a&&E(new a)!=l||o&&E(o.resolve())!=p||i&&E(new i)!=f||s&&E(new s)!=d)&&(E=function(e){var t=c(e),n="[object Object]"==t?e.constructor:void 0,r=n?u(n):"";if(r)switch(r){case m:return h;case v:return l;case g:return p;case y:return f;case b:return d}return t}),e.exports=E},function(e,t,n){var r=n(47),a=n(216),o=n(621),i=n(87);e.exports=function(e,t){return r(e)?e:a(e,t)?[e]:o(i(e))}},function(e,t,n){var r=n(101);e.exports=function(e,t,n){for(var a in t)n&&n.unsafe&&e[a]?e[a]=t[a]:r(e,a,t[a],n);return e}},function(e,t,n){"use strict";var r=n(66),a=function(e){var t,n;this.promise=new e((function(e,r){if(void 0!==t||void 0!==n)throw TypeError("Bad Promise constructor");t=e,n=r})),this.resolve=r(t),this.reject=r(n)};e.exports.f=function(e){return new a(e)}},function(e,t,n){"use strict";n.r(t),n.d(t,"requestSnippetGenerator_curl_powershell",(function(){return T})),n.d(t,"requestSnippetGenerator_curl_bash",(function(){return R})),n.d(t,"requestSnippetGenerator_curl_cmd",(function(){return M}));var r=n(14),a=n.n(r),o=n(13),i=n.n(o),s=n(45),c=n.n(s),u=n(17),l=n.n(u),p=n(90),f=n.n(p),d=n(2),h=n.n(d),m=n(30),v=n.n(m),g=n(4),y=n.n(g),b=n(394),E=n.n(b),x=n(72),S=n.n(x),w=n(29),j=n.n(w),O=n(25),C=n(1),_=function(e){var t,n="_**[]";return l()(e).call(e,n)<0?e:f()(t=e.split(n)[0]).call(t)},A=function(e){return"-d "===e||/^[_\/-]/g.test(e)?e:"'"+e.replace(/'/g,"'\\''")+"'"},k=function(e){return"-d "===(e=e.replace(/\^/g,"^^").replace(/\\"/g,'\\\\"').replace(/"/g,'""').replace(/\n/g,"^\n"))?e.replace(/-d /g,"-d ^\n"):/^[_\/-]/g.test(e)?e:'"'+e+'"'},I=function(e){return"-d "===e?e:/\n/.test(e)?'@"\n'+e.replace(/"/g,'\\"').replace(/`/g,"``").replace(/\$/,"`$")+'\n"@':/^[_\/-]/g.test(e)?e:"'"+e.replace(/"/g,'""').replace(/'/g,"''")+"'"};function P(e){var t,n=[],r=c()(e.get("body").entrySeq());try{for(r.s();!(t=r.n()).done;){var a,o,s,u=i()(t.value,2),l=u[0],p=u[1],f=_(l);if(p instanceof O.a.File)n.push(h()(a=h()(o='  "'.concat(f,'": {\n    "name": "')).call(o,p.name,'"')).call(a,p.type?',\n    "type": "'.concat(p.type,'"'):"","\n  }"));else n.push(h()(s='  "'.concat(f,'": ')).call(s,v()(p,null,2).replace(/(\r\n|\r|\n)/g,"\n  ")))}}catch(e){r.e(e)}finally{r.f()}return"{\n".concat(n.join(",\n"),"\n}")}var N=function(e,t,n){var r,o=arguments.length>3&&void 0!==arguments[3]?arguments[3]:"",s=!1,u="",l=function(){for(var e=arguments.length,n=new Array(e),r=0;r<e;r++)n[r]=arguments[r];return u+=" "+y()(n).call(n,t).join(" ")},p=function(){for(var e=arguments.length,n=new Array(e),r=0;r<e;r++)n[r]=arguments[r];return u+=y()(n).call(n,t).join(" ")},f=function(){return u+=" ".concat(n)},d=function(){var e,t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:1;return u+=E()(e="  ").call(e,t)},m=e.get("headers");if(u+="curl"+o,e.has("curlOptions")&&l.apply(void 0,a()(e.get("curlOptions"))),l("-X",e.get("method")),f(),d(),p("".concat(e.get("url"))),m&&m.size){var g,b,x=c()(S()(g=e.get("headers")).call(g));try{for(x.s();!(b=x.n()).done;){var w,A=b.value;f(),d();var k=i()(A,2),I=k[0],N=k[1];p("-H",h()(w="".concat(I,": ")).call(w,N)),s=s||/^content-type$/i.test(I)&&/^multipart\/form-data$/i.test(N)}}catch(e){x.e(e)}finally{x.f()}}if(e.get("body"))if(s&&j()(r=["POST","PUT","PATCH"]).call(r,e.get("method"))){var T,R=c()(e.get("body").entrySeq());try{for(R.s();!(T=R.n()).done;){var M,q,D,B=i()(T.value,2),L=B[0],U=B[1],V=_(L);if(f(),d(),p("-F"),U instanceof O.a.File)l(h()(M=h()(q="".concat(V,"=@")).call(q,U.name)).call(M,U.type?";type=".concat(U.type):""));else l(h()(D="".concat(V,"=")).call(D,U))}}catch(e){R.e(e)}finally{R.f()}}else{f(),d(),p("-d ");var z=e.get("body");C.Map.isMap(z)?p(P(e)):("string"!=typeof z&&(z=v()(z)),p(z))}else e.get("body")||"POST"!==e.get("method")||(f(),d(),p("-d ''"));return u},T=function(e){return N(e,I,"`\n",".exe")},R=function(e){return N(e,A,"\\\n")},M=function(e){return N(e,k,"^\n")}},function(e,t,n){"use strict";n.r(t),n.d(t,"parseYamlConfig",(function(){return o}));var r=n(63),a=n.n(r),o=function(e,t){try{return a.a.load(e)}catch(e){return t&&t.errActions.newThrownErr(new Error(e)),{}}}},function(e,t,n){"use strict";n.r(t),n.d(t,"getDefaultRequestBodyValue",(function(){return E}));var r=n(13),a=n.n(r),o=n(4),i=n.n(o),s=n(17),c=n.n(s),u=n(29),l=n.n(u),p=n(2),f=n.n(p),d=n(23),h=n.n(d),m=n(0),v=n.n(m),g=(n(11),n(26),n(1)),y=n(5),b=n(92),E=function(e,t,n){var r=e.getIn(["content",t]),a=r.get("schema").toJS(),o=void 0!==r.get("examples"),i=r.get("example"),s=o?r.getIn(["examples",n,"value"]):i,c=Object(y.o)(a,t,{includeWriteOnly:!0},s);return Object(y.I)(c)};t.default=function(e){var t=e.userHasEditedBody,n=e.requestBody,r=e.requestBodyValue,o=e.requestBodyInclusionSetting,s=e.requestBodyErrors,u=e.getComponent,p=e.getConfigs,d=e.specSelectors,m=e.fn,x=e.contentType,S=e.isExecute,w=e.specPath,j=e.onChange,O=e.onChangeIncludeEmpty,C=e.activeExamplesKey,_=e.updateActiveExamplesKey,A=e.setRetainRequestBodyValueFlag,k=function(e){var t={key:e,shouldDispatchInit:!1,defaultValue:!0};return"no value"===o.get(e,"no value")&&(t.shouldDispatchInit=!0),t},I=u("Markdown",!0),P=u("modelExample"),N=u("RequestBodyEditor"),T=u("highlightCode"),R=u("ExamplesSelectValueRetainer"),M=u("Example"),q=u("ParameterIncludeEmpty"),D=p().showCommonExtensions,B=n&&n.get("description")||null,L=n&&n.get("content")||new g.OrderedMap;x=x||L.keySeq().first()||"";var U=L.get(x,Object(g.OrderedMap)()),V=U.get("schema",Object(g.OrderedMap)()),z=U.get("examples",null),F=null==z?void 0:i()(z).call(z,(function(e,t){var r,a=null===(r=e)||void 0===r?void 0:r.get("value",null);return a&&(e=e.set("value",E(n,x,t),a)),e}));if(s=g.List.isList(s)?s:Object(g.List)(),!U.size)return null;var J="object"===U.getIn(["schema","type"]),W="binary"===U.getIn(["schema","format"]),H="base64"===U.getIn(["schema","format"]);if("application/octet-stream"===x||0===c()(x).call(x,"image/")||0===c()(x).call(x,"audio/")||0===c()(x).call(x,"video/")||W||H){var $=u("Input");return S?v.a.createElement($,{type:"file",onChange:function(e){j(e.target.files[0])}}):v.a.createElement("i",null,"Example values are not available for ",v.a.createElement("code",null,x)," media types.")}if(J&&("application/x-www-form-urlencoded"===x||0===c()(x).call(x,"multipart/"))&&V.get("properties",Object(g.OrderedMap)()).size>0){var Y,K=u("JsonSchemaForm"),G=u("ParameterExt"),Z=V.get("properties",Object(g.OrderedMap)());return r=g.Map.isMap(r)?r:Object(g.OrderedMap)(),v.a.createElement("div",{className:"table-container"},B&&v.a.createElement(I,{source:B}),v.a.createElement("table",null,v.a.createElement("tbody",null,g.Map.isMap(Z)&&i()(Y=Z.entrySeq()).call(Y,(function(e){var t,n,c=a()(e,2),p=c[0],d=c[1];if(!d.get("readOnly")){var b=D?Object(y.l)(d):null,E=l()(t=V.get("required",Object(g.List)())).call(t,p),x=d.get("type"),w=d.get("format"),C=d.get("description"),_=r.getIn([p,"value"]),A=r.getIn([p,"errors"])||s,P=o.get(p)||!1,N=d.has("default")||d.has("example")||d.hasIn(["items","example"])||d.hasIn(["items","default"]),T=d.has("enum")&&(1===d.get("enum").size||E),R=N||T,M="";"array"!==x||R||(M=[]),("object"===x||R)&&(M=Object(y.o)(d,!1,{includeWriteOnly:!0})),"string"!=typeof M&&"object"===x&&(M=Object(y.I)(M)),"string"==typeof M&&"array"===x&&(M=JSON.parse(M));var B="string"===x&&("binary"===w||"base64"===w);return v.a.createElement("tr",{key:p,className:"parameters","data-property-name":p},v.a.createElement("td",{className:"parameters-col_name"},v.a.createElement("div",{className:E?"parameter__name required":"parameter__name"},p,E?v.a.createElement("span",null," *"):null),v.a.createElement("div",{className:"parameter__type"},x,w&&v.a.createElement("span",{className:"prop-format"},"($",w,")"),D&&b.size?i()(n=b.entrySeq()).call(n,(function(e){var t,n=a()(e,2),r=n[0],o=n[1];return v.a.createElement(G,{key:f()(t="".concat(r,"-")).call(t,o),xKey:r,xVal:o})})):null),v.a.createElement("div",{className:"parameter__deprecated"},d.get("deprecated")?"deprecated":null)),v.a.createElement("td",{className:"parameters-col_description"},v.a.createElement(I,{source:C}),S?v.a.createElement("div",null,v.a.createElement(K,{fn:m,dispatchInitialValue:!B,schema:d,description:p,getComponent:u,value:void 0===_?M:_,required:E,errors:A,onChange:function(e){j(e,[p])}}),E?null:v.a.createElement(q,{onChange:function(e){return O(p,e)},isIncluded:P,isIncludedOptions:k(p),isDisabled:h()(_)?0!==_.length:!Object(y.q)(_)})):null))}})))))}var X=E(n,x,C),Q=null;return Object(b.a)(X)&&(Q="json"),v.a.createElement("div",null,B&&v.a.createElement(I,{source:B}),F?v.a.createElement(R,{userHasEditedBody:t,examples:F,currentKey:C,currentUserInputValue:r,onSelect:function(e){_(e)},updateValue:j,defaultToFirstExample:!0,getComponent:u,setRetainRequestBodyValueFlag:A}):null,S?v.a.createElement("div",null,v.a.createElement(N,{value:r,errors:s,defaultValue:X,onChange:j,getComponent:u})):v.a.createElement(P,{getComponent:u,getConfigs:p,specSelectors:d,expandDepth:1,isExecute:S,schema:U.get("schema"),specPath:w.push("content",x),example:v.a.createElement(T,{className:"body-param__example",getConfigs:p,language:Q,value:Object(y.I)(r)||X}),includeWriteOnly:!0}),F?v.a.createElement(M,{example:F.get(C),getComponent:u,getConfigs:p}):null)}},function(e,t,n){"use strict";n.r(t),n.d(t,"makeMappedContainer",(function(){return A})),n.d(t,"render",(function(){return k})),n.d(t,"getComponent",(function(){return T}));var r=n(18),a=n.n(r),o=n(27),i=n.n(o),s=n(6),c=n.n(s),u=n(7),l=n.n(u),p=n(8),f=n.n(p),d=n(9),h=n.n(d),m=n(20),v=n.n(m),g=n(15),y=n.n(g),b=n(0),E=n.n(b),x=(n(11),n(392)),S=n.n(x),w=n(284),j=n(393),O=n.n(j),C=function(e,t,n){var r=function(e,t){return function(n){f()(a,n);var r=h()(a);function a(){return c()(this,a),r.apply(this,arguments)}return l()(a,[{key:"render",value:function(){return E.a.createElement(t,i()({},e(),this.props,this.context))}}]),a}(b.Component)}(e,t),a=Object(w.connect)((function(n,r){var a=v()({},r,e());return(t.prototype.mapStateToProps||function(e){return{state:e}})(n,a)}))(r);return n?function(e,t){return function(n){f()(a,n);var r=h()(a);function a(){return c()(this,a),r.apply(this,arguments)}return l()(a,[{key:"render",value:function(){return E.a.createElement(w.Provider,{store:e},E.a.createElement(t,i()({},this.props,this.context)))}}]),a}(b.Component)}(n,a):a},_=function(e,t,n,r){for(var a in t){var o=t[a];"function"==typeof o&&o(n[a],r[a],e())}},A=function(e,t,n,r,a,o){return function(t){f()(i,t);var r=h()(i);function i(t,n){var a;return c()(this,i),a=r.call(this,t,n),_(e,o,t,{}),a}return l()(i,[{key:"UNSAFE_componentWillReceiveProps",value:function(t){_(e,o,t,this.props)}},{key:"render",value:function(){var e=O()(this.props,o?y()(o):[]),t=n(a,"root");return E.a.createElement(t,e)}}]),i}(b.Component)},k=function(e,t,n,r,a){var o=n(e,t,r,"App","root");S.a.render(E.a.createElement(o,null),a)},I=function(e){f()(n,e);var t=h()(n);function n(e){var r;return c()(this,n),(r=t.call(this,e)).state={hasError:!1,error:null},r}return l()(n,[{key:"componentDidCatch",value:function(e,t){console.error(e,t)}},{key:"render",value:function(){return this.state.hasError?E.a.createElement(P,{name:this.props.targetName}):this.props.children}}],[{key:"getDerivedStateFromError",value:function(e){return{hasError:!0,error:e}}}]),n}(b.Component);I.defaultProps={targetName:"this component",children:null};var P=function(e){var t=e.name;return E.a.createElement("div",{className:"fallback"},"😱 ",E.a.createElement("i",null,"Could not render ","t"===t?"this component":t,", see the console."))},N=function(e){var t,n=function(e){return!(e.prototype&&e.prototype.isReactComponent)}(e)?(t=e,function(e){f()(r,e);var n=h()(r);function r(){return c()(this,r),n.apply(this,arguments)}return l()(r,[{key:"render",value:function(){return E.a.createElement(I,{targetName:null==t?void 0:t.name},E.a.createElement(t,this.props))}}]),r}(b.Component)):e,r=n.prototype.render;return n.prototype.render=function(){try{for(var e=arguments.length,t=new Array(e),a=0;a<e;a++)t[a]=arguments[a];return r.apply(this,t)}catch(e){return console.error(e),E.a.createElement(P,{name:n.name})}},n},T=function(e,t,n,r,o){var i=arguments.length>5&&void 0!==arguments[5]?arguments[5]:{};if("string"!=typeof r)throw new TypeError("Need a string, to fetch a component. Was given a "+a()(r));var s=n(r);return s?o?"root"===o?C(e,s,t()):C(e,N(s)):N(s):(i.failSilently||e().log.warn("Could not find component:",r),null)}},function(e,t,n){"use strict";n.r(t),n.d(t,"setHash",(function(){return r}));var r=function(e){return e?history.pushState(null,null,"#".concat(e)):window.location.hash=""}},function(e,t){e.exports=require("redux")},function(e,t,n){e.exports=n(415)},function(e,t,n){"use strict";var r={}.propertyIsEnumerable,a=Object.getOwnPropertyDescriptor,o=a&&!r.call({1:2},1);t.f=o?function(e){var t=a(this,e);return!!t&&t.enumerable}:r},function(e,t,n){var r=n(34),a=n(127),o="".split;e.exports=r((function(){return!Object("z").propertyIsEnumerable(0)}))?function(e){return"String"==a(e)?o.call(e,""):Object(e)}:Object},function(e,t,n){var r=n(417),a=n(151);e.exports=function(e){var t=r(e,"string");return a(t)?t:String(t)}},function(e,t,n){var r=n(55),a=n(290);e.exports=a?function(e){return"symbol"==typeof e}:function(e){var t=r("Symbol");return"function"==typeof t&&Object(e)instanceof t}},function(e,t){var n=0,r=Math.random();e.exports=function(e){return"Symbol("+String(void 0===e?"":e)+")_"+(++n+r).toString(36)}},function(e,t,n){var r=n(186),a=n(152),o=r("keys");e.exports=function(e){return o[e]||(o[e]=a(e))}},function(e,t,n){var r=n(294),a=n(193).concat("length","prototype");t.f=Object.getOwnPropertyNames||function(e){return r(e,a)}},function(e,t,n){var r=n(51),a=n(56),o=n(153),i=n(302),s=o("IE_PROTO"),c=Object.prototype;e.exports=i?Object.getPrototypeOf:function(e){return e=a(e),r(e,s)?e[s]:"function"==typeof e.constructor&&e instanceof e.constructor?e.constructor.prototype:e instanceof Object?c:null}},function(e,t,n){var r=n(46),a=n(448);e.exports=Object.setPrototypeOf||("__proto__"in{}?function(){var e,t=!1,n={};try{(e=Object.getOwnPropertyDescriptor(Object.prototype,"__proto__").set).call(n,[]),t=n instanceof Array}catch(e){}return function(n,o){return r(n),a(o),t?e.call(n,o):n.__proto__=o,n}}():void 0)},function(e,t,n){var r=n(127),a=n(38);e.exports="process"==r(a.process)},function(e,t,n){var r=n(159),a=n(488),o=n(489),i=n(490),s=n(491),c=n(492);function u(e){var t=this.__data__=new r(e);this.size=t.size}u.prototype.clear=a,u.prototype.delete=o,u.prototype.get=i,u.prototype.has=s,u.prototype.set=c,e.exports=u},function(e,t,n){var r=n(483),a=n(484),o=n(485),i=n(486),s=n(487);function c(e){var t=-1,n=null==e?0:e.length;for(this.clear();++t<n;){var r=e[t];this.set(r[0],r[1])}}c.prototype.clear=r,c.prototype.delete=a,c.prototype.get=o,c.prototype.has=i,c.prototype.set=s,e.exports=c},function(e,t,n){var r=n(88);e.exports=function(e,t){for(var n=e.length;n--;)if(r(e[n][0],t))return n;return-1}},function(e,t,n){var r=n(103)(Object,"create");e.exports=r},function(e,t,n){var r=n(507);e.exports=function(e,t){var n=e.__data__;return r(t)?n["string"==typeof t?"string":"hash"]:n.map}},function(e,t){var n=Object.prototype;e.exports=function(e){var t=e&&e.constructor;return e===("function"==typeof t&&t.prototype||n)}},function(e,t,n){var r=n(517),a=n(207),o=n(208),i=o&&o.isTypedArray,s=i?a(i):r;e.exports=s},function(e,t){var n=/^(?:0|[1-9]\d*)$/;e.exports=function(e,t){var r=typeof e;return!!(t=null==t?9007199254740991:t)&&("number"==r||"symbol"!=r&&n.test(e))&&e>-1&&e%1==0&&e<t}},function(e,t,n){var r=n(539),a=n(330),o=n(328);e.exports=function(e,t){var n;if(e){if("string"==typeof e)return o(e,t);var i=r(n=Object.prototype.toString.call(e)).call(n,8,-1);return"Object"===i&&e.constructor&&(i=e.constructor.name),"Map"===i||"Set"===i?a(e):"Arguments"===i||/^(?:Ui|I)nt(?:8|16|32)(?:Clamped)?Array$/.test(i)?o(e,t):void 0}},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){var r=n(104),a=n(70);e.exports=function(e){return"symbol"==typeof e||a(e)&&"[object Symbol]"==r(e)}},function(e,t,n){var r=n(602),a=n(620),o=n(210),i=n(47),s=n(626);e.exports=function(e){return"function"==typeof e?e:null==e?o:"object"==typeof e?i(e)?a(e[0],e[1]):r(e):s(e)}},function(e,t){e.exports=function(e){try{return{error:!1,value:e()}}catch(e){return{error:!0,value:e}}}},function(e,t,n){var r=n(21),a=n(132),o=n(41),i=n(51),s=n(59).f,c=n(154),u=n(296),l=n(152),p=n(746),f=!1,d=l("meta"),h=0,m=Object.isExtensible||function(){return!0},v=function(e){s(e,d,{value:{objectID:"O"+h++,weakData:{}}})},g=e.exports={enable:function(){g.enable=function(){},f=!0;var e=c.f,t=[].splice,n={};n[d]=1,e(n).length&&(c.f=function(n){for(var r=e(n),a=0,o=r.length;a<o;a++)if(r[a]===d){t.call(r,a,1);break}return r},r({target:"Object",stat:!0,forced:!0},{getOwnPropertyNames:u.f}))},fastKey:function(e,t){if(!o(e))return"symbol"==typeof e?e:("string"==typeof e?"S":"P")+e;if(!i(e,d)){if(!m(e))return"F";if(!t)return"E";v(e)}return e[d].objectID},getWeakData:function(e,t){if(!i(e,d)){if(!m(e))return!0;if(!t)return!1;v(e)}return e[d].weakData},onFreeze:function(e){return p&&f&&m(e)&&!i(e,d)&&v(e),e}};a[d]=!0},function(e,t,n){e.exports=n(550)},function(e,t,n){e.exports=n(638)},function(e,t,n){e.exports=n(680)},function(e,t,n){e.exports=n(736)},function(e,t,n){e.exports=n(739)},function(e,t){e.exports=require("btoa")},function(e,t,n){e.exports=n(756)},function(e,t,n){"use strict";n.d(t,"a",(function(){return _}));var r=n(18),a=n.n(r),o=n(6),i=n.n(o),s=n(7),c=n.n(s),u=n(10),l=n.n(u),p=n(8),f=n.n(p),d=n(9),h=n.n(d),m=n(3),v=n.n(m),g=n(15),y=n.n(g),b=n(2),E=n.n(b),x=n(0),S=n.n(x),w=n(79),j=n.n(w),O=(n(11),n(5)),C=n(25),_=function(e){f()(n,e);var t=h()(n);function n(e,r){var a;i()(this,n),a=t.call(this,e,r),v()(l()(a),"getDefinitionUrl",(function(){var e=a.props.specSelectors;return new j.a(e.url(),C.a.location).toString()}));var o=(0,e.getConfigs)().validatorUrl;return a.state={url:a.getDefinitionUrl(),validatorUrl:void 0===o?"https://validator.swagger.io/validator":o},a}return c()(n,[{key:"UNSAFE_componentWillReceiveProps",value:function(e){var t=(0,e.getConfigs)().validatorUrl;this.setState({url:this.getDefinitionUrl(),validatorUrl:void 0===t?"https://validator.swagger.io/validator":t})}},{key:"render",value:function(){var e,t,n=(0,this.props.getConfigs)().spec,r=Object(O.F)(this.state.validatorUrl);return"object"===a()(n)&&y()(n).length?null:this.state.url&&Object(O.E)(this.state.validatorUrl)&&Object(O.E)(this.state.url)?S.a.createElement("span",{className:"float-right"},S.a.createElement("a",{target:"_blank",rel:"noopener noreferrer",href:E()(e="".concat(r,"/debug?url=")).call(e,encodeURIComponent(this.state.url))},S.a.createElement(A,{src:E()(t="".concat(r,"?url=")).call(t,encodeURIComponent(this.state.url)),alt:"Online validator badge"}))):null}}]),n}(S.a.Component),A=function(e){f()(n,e);var t=h()(n);function n(e){var r;return i()(this,n),(r=t.call(this,e)).state={loaded:!1,error:!1},r}return c()(n,[{key:"componentDidMount",value:function(){var e=this,t=new Image;t.onload=function(){e.setState({loaded:!0})},t.onerror=function(){e.setState({error:!0})},t.src=this.props.src}},{key:"UNSAFE_componentWillReceiveProps",value:function(e){var t=this;if(e.src!==this.props.src){var n=new Image;n.onload=function(){t.setState({loaded:!0})},n.onerror=function(){t.setState({error:!0})},n.src=e.src}}},{key:"render",value:function(){return this.state.error?S.a.createElement("img",{alt:"Error"}):this.state.loaded?S.a.createElement("img",{src:this.props.src,alt:this.props.alt}):null}}]),n}(S.a.Component)},function(e,t,n){"use strict";n.d(t,"a",(function(){return P}));var r=n(27),a=n.n(r),o=n(6),i=n.n(o),s=n(7),c=n.n(s),u=n(10),l=n.n(u),p=n(8),f=n.n(p),d=n(9),h=n.n(d),m=n(3),v=n.n(m),g=n(2),y=n.n(g),b=n(17),E=n.n(b),x=n(4),S=n.n(x),w=n(0),j=n.n(w),O=n(412),C=n.n(O),_=n(26),A=n.n(_),k=n(11),I=n.n(k),P=function(e){f()(r,e);var t=h()(r);function r(){var e,n;i()(this,r);for(var a=arguments.length,o=new Array(a),s=0;s<a;s++)o[s]=arguments[s];return n=t.call.apply(t,y()(e=[this]).call(e,o)),v()(l()(n),"getModelName",(function(e){return-1!==E()(e).call(e,"#/definitions/")?e.replace(/^.*#\/definitions\//,""):-1!==E()(e).call(e,"#/components/schemas/")?e.replace(/^.*#\/components\/schemas\//,""):void 0})),v()(l()(n),"getRefSchema",(function(e){return n.props.specSelectors.findDefinition(e)})),n}return c()(r,[{key:"render",value:function(){var e=this.props,t=e.getComponent,r=e.getConfigs,o=e.specSelectors,i=e.schema,s=e.required,c=e.name,u=e.isRef,l=e.specPath,p=e.displayName,f=e.includeReadOnly,d=e.includeWriteOnly,h=t("ObjectModel"),m=t("ArrayModel"),v=t("PrimitiveModel"),g="object",y=i&&i.get("$$ref");if(!c&&y&&(c=this.getModelName(y)),!i&&y&&(i=this.getRefSchema(c)),!i)return j.a.createElement("span",{className:"model model-title"},j.a.createElement("span",{className:"model-title__text"},p||c),j.a.createElement("img",{src:n(380),height:"20px",width:"20px"}));var b=o.isOAS3()&&i.get("deprecated");switch(u=void 0!==u?u:!!y,g=i&&i.get("type")||g){case"object":return j.a.createElement(h,a()({className:"object"},this.props,{specPath:l,getConfigs:r,schema:i,name:c,deprecated:b,isRef:u,includeReadOnly:f,includeWriteOnly:d}));case"array":return j.a.createElement(m,a()({className:"array"},this.props,{getConfigs:r,schema:i,name:c,deprecated:b,required:s,includeReadOnly:f,includeWriteOnly:d}));case"string":case"number":case"integer":case"boolean":default:return j.a.createElement(v,a()({},this.props,{getComponent:t,getConfigs:r,schema:i,name:c,deprecated:b,required:s}))}}}]),r}(C.a);v()(P,"propTypes",{schema:S()(A.a).isRequired,getComponent:I.a.func.isRequired,getConfigs:I.a.func.isRequired,specSelectors:I.a.object.isRequired,name:I.a.string,displayName:I.a.string,isRef:I.a.bool,required:I.a.bool,expandDepth:I.a.number,depth:I.a.number,specPath:A.a.list.isRequired,includeReadOnly:I.a.bool,includeWriteOnly:I.a.bool})},function(e,t){e.exports=require("remarkable")},function(e,t,n){"use strict";n.d(t,"b",(function(){return y}));var r=n(0),a=n.n(r),o=(n(11),n(180)),i=n(413),s=n.n(i),c=/www|@|\:\/\//;function u(e){return/^<\/a\s*>/i.test(e)}function l(){var e=[],t=new s.a({stripPrefix:!1,url:!0,email:!0,replaceFn:function(t){switch(t.getType()){case"url":e.push({text:t.matchedText,url:t.getUrl()});break;case"email":e.push({text:t.matchedText,url:"mailto:"+t.getEmail().replace(/^mailto:/i,"")})}return!1}});return{links:e,autolinker:t}}function p(e){var t,n,r,a,o,i,s,p,f,d,h,m,v,g,y=e.tokens,b=null;for(n=0,r=y.length;n<r;n++)if("inline"===y[n].type)for(h=0,t=(a=y[n].children).length-1;t>=0;t--)if("link_close"!==(o=a[t]).type){if("htmltag"===o.type&&(g=o.content,/^<a[>\s]/i.test(g)&&h>0&&h--,u(o.content)&&h++),!(h>0)&&"text"===o.type&&c.test(o.content)){if(b||(m=(b=l()).links,v=b.autolinker),i=o.content,m.length=0,v.link(i),!m.length)continue;for(s=[],d=o.level,p=0;p<m.length;p++)e.inline.validateLink(m[p].url)&&((f=i.indexOf(m[p].text))&&s.push({type:"text",content:i.slice(0,f),level:d}),s.push({type:"link_open",href:m[p].url,title:"",level:d++}),s.push({type:"text",content:m[p].text,level:d}),s.push({type:"link_close",level:--d}),i=i.slice(f+m[p].text.length));i.length&&s.push({type:"text",content:i,level:d}),y[n].children=a=[].concat(a.slice(0,t),s,a.slice(t+1))}}else for(t--;a[t].level!==o.level&&"link_open"!==a[t].type;)t--}function f(e){e.core.ruler.push("linkify",p)}var d=n(183),h=n.n(d),m=n(43),v=n.n(m);function g(e){var t=e.source,n=e.className,r=void 0===n?"":n,i=e.getConfigs;if("string"!=typeof t)return null;var s=new o.Remarkable({html:!0,typographer:!0,breaks:!0,linkTarget:"_blank"}).use(f);s.core.ruler.disable(["replacements","smartquotes"]);var c=i().useUnsafeMarkdown,u=s.render(t),l=y(u,{useUnsafeMarkdown:c});return t&&u&&l?a.a.createElement("div",{className:v()(r,"markdown"),dangerouslySetInnerHTML:{__html:l}}):null}h.a.addHook&&h.a.addHook("beforeSanitizeElements",(function(e){return e.href&&e.setAttribute("rel","noopener noreferrer"),e})),g.defaultProps={getConfigs:function(){return{useUnsafeMarkdown:!1}}};t.a=g;function y(e){var t=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{},n=t.useUnsafeMarkdown,r=void 0!==n&&n,a=r,o=r?[]:["style","class"];return r&&!y.hasWarnedAboutDeprecation&&(console.warn("useUnsafeMarkdown display configuration parameter is deprecated since >3.26.0 and will be removed in v4.0.0."),y.hasWarnedAboutDeprecation=!0),h.a.sanitize(e,{ADD_ATTR:["target"],FORBID_TAGS:["style","form"],ALLOW_DATA_ATTR:a,FORBID_ATTR:o})}y.hasWarnedAboutDeprecation=!1},function(e,t){e.exports=require("qs")},function(e,t){e.exports=require("dompurify")},function(e,t){var n;n=function(){return this}();try{n=n||new Function("return this")()}catch(e){"object"==typeof window&&(n=window)}e.exports=n},function(e,t,n){var r=n(111),a=n(34);e.exports=!!Object.getOwnPropertySymbols&&!a((function(){var e=Symbol();return!String(e)||!(Object(e)instanceof Symbol)||!Symbol.sham&&r&&r<41}))},function(e,t,n){var r=n(83),a=n(187);(e.exports=function(e,t){return a[e]||(a[e]=void 0!==t?t:{})})("versions",[]).push({version:"3.16.0",mode:r?"pure":"global",copyright:"© 2021 Denis Pushkarev (zloirock.ru)"})},function(e,t,n){var r=n(38),a=n(419),o="__core-js_shared__",i=r[o]||a(o,{});e.exports=i},function(e,t,n){var r=n(38),a=n(41),o=r.document,i=a(o)&&a(o.createElement);e.exports=function(e){return i?o.createElement(e):{}}},function(e,t,n){var r=n(420);e.exports=function(e,t){return new(r(e))(0===t?0:t)}},function(e,t,n){var r=n(44),a=n(59),o=n(46),i=n(131);e.exports=r?Object.defineProperties:function(e,t){o(e);for(var n,r=i(t),s=r.length,c=0;s>c;)a.f(e,n=r[c++],t[n]);return e}},function(e,t,n){var r=n(58),a=n(60),o=n(192),i=function(e){return function(t,n,i){var s,c=r(t),u=a(c.length),l=o(i,u);if(e&&n!=n){for(;u>l;)if((s=c[l++])!=s)return!0}else for(;u>l;l++)if((e||l in c)&&c[l]===n)return e||l||0;return!e&&-1}};e.exports={includes:i(!0),indexOf:i(!1)}},function(e,t,n){var r=n(112),a=Math.max,o=Math.min;e.exports=function(e,t){var n=r(e);return n<0?a(n+t,0):o(n,t)}},function(e,t){e.exports=["constructor","hasOwnProperty","isPrototypeOf","propertyIsEnumerable","toLocaleString","toString","valueOf"]},function(e,t){t.f=Object.getOwnPropertySymbols},function(e,t,n){var r=n(35);t.f=r},function(e,t,n){var r={};r[n(35)("toStringTag")]="z",e.exports="[object z]"===String(r)},function(e,t){e.exports=function(){}},function(e,t,n){"use strict";var r=n(21),a=n(300),o=n(155),i=n(156),s=n(85),c=n(67),u=n(101),l=n(35),p=n(83),f=n(113),d=n(301),h=d.IteratorPrototype,m=d.BUGGY_SAFARI_ITERATORS,v=l("iterator"),g="keys",y="values",b="entries",E=function(){return this};e.exports=function(e,t,n,l,d,x,S){a(n,t,l);var w,j,O,C=function(e){if(e===d&&P)return P;if(!m&&e in k)return k[e];switch(e){case g:case y:case b:return function(){return new n(this,e)}}return function(){return new n(this)}},_=t+" Iterator",A=!1,k=e.prototype,I=k[v]||k["@@iterator"]||d&&k[d],P=!m&&I||C(d),N="Array"==t&&k.entries||I;if(N&&(w=o(N.call(new e)),h!==Object.prototype&&w.next&&(p||o(w)===h||(i?i(w,h):"function"!=typeof w[v]&&c(w,v,E)),s(w,_,!0,!0),p&&(f[_]=E))),d==y&&I&&I.name!==y&&(A=!0,P=function(){return I.call(this)}),p&&!S||k[v]===P||c(k,v,P),f[t]=P,d)if(j={values:C(y),keys:x?P:C(g),entries:C(b)},S)for(O in j)(m||A||!(O in k))&&u(k,O,j[O]);else r({target:t,proto:!0,forced:m||A},j);return j}},function(e,t,n){e.exports=n(460)},function(e,t,n){var r=n(103)(n(62),"Map");e.exports=r},function(e,t,n){var r=n(499),a=n(506),o=n(508),i=n(509),s=n(510);function c(e){var t=-1,n=null==e?0:e.length;for(this.clear();++t<n;){var r=e[t];this.set(r[0],r[1])}}c.prototype.clear=r,c.prototype.delete=a,c.prototype.get=o,c.prototype.has=i,c.prototype.set=s,e.exports=c},function(e,t,n){var r=n(315);e.exports=function(e,t,n){"__proto__"==t&&r?r(e,t,{configurable:!0,enumerable:!0,value:n,writable:!0}):e[t]=n}},function(e,t){e.exports=function(e){return e.webpackPolyfill||(e.deprecate=function(){},e.paths=[],e.children||(e.children=[]),Object.defineProperty(e,"loaded",{enumerable:!0,get:function(){return e.l}}),Object.defineProperty(e,"id",{enumerable:!0,get:function(){return e.i}}),e.webpackPolyfill=1),e}},function(e,t,n){var r=n(319);e.exports=function(e){var t=new e.constructor(e.byteLength);return new r(t).set(new r(e)),t}},function(e,t,n){var r=n(322)(Object.getPrototypeOf,Object);e.exports=r},function(e,t){e.exports=function(e){return"number"==typeof e&&e>-1&&e%1==0&&e<=9007199254740991}},function(e,t){e.exports=function(e){return function(t){return e(t)}}},function(e,t,n){(function(e){var r=n(312),a=t&&!t.nodeType&&t,o=a&&"object"==typeof e&&e&&!e.nodeType&&e,i=o&&o.exports===a&&r.process,s=function(){try{var e=o&&o.require&&o.require("util").types;return e||i&&i.binding&&i.binding("util")}catch(e){}}();e.exports=s}).call(this,n(203)(e))},function(e,t,n){var r=n(202),a=n(88),o=Object.prototype.hasOwnProperty;e.exports=function(e,t,n){var i=e[t];o.call(e,t)&&a(i,n)&&(void 0!==n||t in e)||r(e,t,n)}},function(e,t){e.exports=function(e){return e}},function(e,t,n){e.exports=n(533)},function(e,t,n){e.exports=n(534)},function(e,t){e.exports=function(e,t){for(var n=-1,r=t.length,a=e.length;++n<r;)e[a+n]=t[n];return e}},function(e,t,n){var r=n(613),a=n(352),o=Object.prototype.propertyIsEnumerable,i=Object.getOwnPropertySymbols,s=i?function(e){return null==e?[]:(e=Object(e),r(i(e),(function(t){return o.call(e,t)})))}:a;e.exports=s},function(e,t,n){var r=n(138),a=n(118);e.exports=function(e,t){for(var n=0,o=(t=r(t,e)).length;null!=e&&n<o;)e=e[a(t[n++])];return n&&n==o?e:void 0}},function(e,t,n){var r=n(47),a=n(167),o=/\.|\[(?:[^[\]]*|(["'])(?:(?!\1)[^\\]|\\.)*?\1)\]/,i=/^\w*$/;e.exports=function(e,t){if(r(e))return!1;var n=typeof e;return!("number"!=n&&"symbol"!=n&&"boolean"!=n&&null!=e&&!a(e))||(i.test(e)||!o.test(e)||null!=t&&e in Object(t))}},function(e,t,n){"use strict";n(69);var r=n(21),a=n(55),o=n(379),i=n(101),s=n(139),c=n(85),u=n(300),l=n(68),p=n(119),f=n(51),d=n(99),h=n(86),m=n(46),v=n(41),g=n(61),y=n(100),b=n(96),E=n(750),x=n(136),S=n(35),w=a("fetch"),j=a("Request"),O=j&&j.prototype,C=a("Headers"),_=S("iterator"),A="URLSearchParams",k="URLSearchParamsIterator",I=l.set,P=l.getterFor(A),N=l.getterFor(k),T=/\+/g,R=Array(4),M=function(e){return R[e-1]||(R[e-1]=RegExp("((?:%[\\da-f]{2}){"+e+"})","gi"))},q=function(e){try{return decodeURIComponent(e)}catch(t){return e}},D=function(e){var t=e.replace(T," "),n=4;try{return decodeURIComponent(t)}catch(e){for(;n;)t=t.replace(M(n--),q);return t}},B=/[!'()~]|%20/g,L={"!":"%21","'":"%27","(":"%28",")":"%29","~":"%7E","%20":"+"},U=function(e){return L[e]},V=function(e){return encodeURIComponent(e).replace(B,U)},z=function(e,t){if(t)for(var n,r,a=t.split("&"),o=0;o<a.length;)(n=a[o++]).length&&(r=n.split("="),e.push({key:D(r.shift()),value:D(r.join("="))}))},F=function(e){this.entries.length=0,z(this.entries,e)},J=function(e,t){if(e<t)throw TypeError("Not enough arguments")},W=u((function(e,t){I(this,{type:k,iterator:E(P(e).entries),kind:t})}),"Iterator",(function(){var e=N(this),t=e.kind,n=e.iterator.next(),r=n.value;return n.done||(n.value="keys"===t?r.key:"values"===t?r.value:[r.key,r.value]),n})),H=function(){p(this,H,A);var e,t,n,r,a,o,i,s,c,u=arguments.length>0?arguments[0]:void 0,l=this,d=[];if(I(l,{type:A,entries:d,updateURL:function(){},updateSearchParams:F}),void 0!==u)if(v(u))if("function"==typeof(e=x(u)))for(n=(t=e.call(u)).next;!(r=n.call(t)).done;){if((i=(o=(a=E(m(r.value))).next).call(a)).done||(s=o.call(a)).done||!o.call(a).done)throw TypeError("Expected sequence with length 2");d.push({key:g(i.value),value:g(s.value)})}else for(c in u)f(u,c)&&d.push({key:c,value:g(u[c])});else z(d,"string"==typeof u?"?"===u.charAt(0)?u.slice(1):u:g(u))},$=H.prototype;if(s($,{append:function(e,t){J(arguments.length,2);var n=P(this);n.entries.push({key:g(e),value:g(t)}),n.updateURL()},delete:function(e){J(arguments.length,1);for(var t=P(this),n=t.entries,r=g(e),a=0;a<n.length;)n[a].key===r?n.splice(a,1):a++;t.updateURL()},get:function(e){J(arguments.length,1);for(var t=P(this).entries,n=g(e),r=0;r<t.length;r++)if(t[r].key===n)return t[r].value;return null},getAll:function(e){J(arguments.length,1);for(var t=P(this).entries,n=g(e),r=[],a=0;a<t.length;a++)t[a].key===n&&r.push(t[a].value);return r},has:function(e){J(arguments.length,1);for(var t=P(this).entries,n=g(e),r=0;r<t.length;)if(t[r++].key===n)return!0;return!1},set:function(e,t){J(arguments.length,1);for(var n,r=P(this),a=r.entries,o=!1,i=g(e),s=g(t),c=0;c<a.length;c++)(n=a[c]).key===i&&(o?a.splice(c--,1):(o=!0,n.value=s));o||a.push({key:i,value:s}),r.updateURL()},sort:function(){var e,t,n,r=P(this),a=r.entries,o=a.slice();for(a.length=0,n=0;n<o.length;n++){for(e=o[n],t=0;t<n;t++)if(a[t].key>e.key){a.splice(t,0,e);break}t===n&&a.push(e)}r.updateURL()},forEach:function(e){for(var t,n=P(this).entries,r=d(e,arguments.length>1?arguments[1]:void 0,3),a=0;a<n.length;)r((t=n[a++]).value,t.key,this)},keys:function(){return new W(this,"keys")},values:function(){return new W(this,"values")},entries:function(){return new W(this,"entries")}},{enumerable:!0}),i($,_,$.entries),i($,"toString",(function(){for(var e,t=P(this).entries,n=[],r=0;r<t.length;)e=t[r++],n.push(V(e.key)+"="+V(e.value));return n.join("&")}),{enumerable:!0}),c(H,A),r({global:!0,forced:!o},{URLSearchParams:H}),!o&&"function"==typeof C){var Y=function(e){if(v(e)){var t,n=e.body;if(h(n)===A)return(t=e.headers?new C(e.headers):new C).has("content-type")||t.set("content-type","application/x-www-form-urlencoded;charset=UTF-8"),y(e,{body:b(0,String(n)),headers:b(0,t)})}return e};if("function"==typeof w&&r({global:!0,enumerable:!0,forced:!0},{fetch:function(e){return w(e,arguments.length>1?Y(arguments[1]):{})}}),"function"==typeof j){var K=function(e){return p(this,K,"Request"),new j(e,arguments.length>1?Y(arguments[1]):{})};O.constructor=K,K.prototype=O,r({global:!0,forced:!0},{Request:K})}}e.exports={URLSearchParams:H,getState:P}},function(e,t,n){var r=n(588)("toUpperCase");e.exports=r},function(e,t,n){var r=n(201);function a(e,t){if("function"!=typeof e||null!=t&&"function"!=typeof t)throw new TypeError("Expected a function");var n=function(){var r=arguments,a=t?t.apply(this,r):r[0],o=n.cache;if(o.has(a))return o.get(a);var i=e.apply(this,r);return n.cache=o.set(a,i)||o,i};return n.cache=new(a.Cache||r),n}a.Cache=r,e.exports=a},function(e,t,n){"use strict";n.r(t);var r=n(221),a=n(54),o=n(224);t.default=function(e){return{statePlugins:{err:{reducers:Object(r.default)(e),actions:a,selectors:o}}}}},function(e,t,n){"use strict";n.r(t);var r=n(3),a=n.n(r),o=n(20),i=n.n(o),s=n(4),c=n.n(s),u=n(2),l=n.n(u),p=n(12),f=n.n(p),d=n(172),h=n.n(d),m=n(54),v=n(1),g=n(109),y={line:0,level:"error",message:"Unknown error"};t.default=function(){var e;return e={},a()(e,m.NEW_THROWN_ERR,(function(e,t){var n=t.payload,r=i()(y,n,{type:"thrown"});return e.update("errors",(function(e){return(e||Object(v.List)()).push(Object(v.fromJS)(r))})).update("errors",(function(e){return Object(g.default)(e)}))})),a()(e,m.NEW_THROWN_ERR_BATCH,(function(e,t){var n=t.payload;return n=c()(n).call(n,(function(e){return Object(v.fromJS)(i()(y,e,{type:"thrown"}))})),e.update("errors",(function(e){var t;return l()(t=e||Object(v.List)()).call(t,Object(v.fromJS)(n))})).update("errors",(function(e){return Object(g.default)(e)}))})),a()(e,m.NEW_SPEC_ERR,(function(e,t){var n=t.payload,r=Object(v.fromJS)(n);return r=r.set("type","spec"),e.update("errors",(function(e){return(e||Object(v.List)()).push(Object(v.fromJS)(r)).sortBy((function(e){return e.get("line")}))})).update("errors",(function(e){return Object(g.default)(e)}))})),a()(e,m.NEW_SPEC_ERR_BATCH,(function(e,t){var n=t.payload;return n=c()(n).call(n,(function(e){return Object(v.fromJS)(i()(y,e,{type:"spec"}))})),e.update("errors",(function(e){var t;return l()(t=e||Object(v.List)()).call(t,Object(v.fromJS)(n))})).update("errors",(function(e){return Object(g.default)(e)}))})),a()(e,m.NEW_AUTH_ERR,(function(e,t){var n=t.payload,r=Object(v.fromJS)(i()({},n));return r=r.set("type","auth"),e.update("errors",(function(e){return(e||Object(v.List)()).push(Object(v.fromJS)(r))})).update("errors",(function(e){return Object(g.default)(e)}))})),a()(e,m.CLEAR,(function(e,t){var n,r=t.payload;if(!r||!e.get("errors"))return e;var a=f()(n=e.get("errors")).call(n,(function(e){var t;return h()(t=e.keySeq()).call(t,(function(t){var n=e.get(t),a=r[t];return!a||n!==a}))}));return e.merge({errors:a})})),a()(e,m.CLEAR_BY,(function(e,t){var n,r=t.payload;if(!r||"function"!=typeof r)return e;var a=f()(n=e.get("errors")).call(n,(function(e){return r(e)}));return e.merge({errors:a})})),e}},function(e,t,n){"use strict";n.r(t),n.d(t,"transform",(function(){return p}));var r=n(4),a=n.n(r),o=n(17),i=n.n(o),s=n(19),c=n.n(s),u=n(28),l=n.n(u);function p(e){return a()(e).call(e,(function(e){var t,n="is not of a type(s)",r=i()(t=e.get("message")).call(t,n);if(r>-1){var a,o,s=c()(a=e.get("message")).call(a,r+n.length).split(",");return e.set("message",c()(o=e.get("message")).call(o,0,r)+function(e){return l()(e).call(e,(function(e,t,n,r){return n===r.length-1&&r.length>1?e+"or "+t:r[n+1]&&r.length>2?e+t+", ":r[n+1]?e+t+" ":e+t}),"should be a")}(s))}return e}))}},function(e,t,n){"use strict";n.r(t),n.d(t,"transform",(function(){return r}));n(4),n(17),n(36),n(1);function r(e,t){t.jsSpec;return e}},function(e,t,n){"use strict";n.r(t),n.d(t,"allErrors",(function(){return o})),n.d(t,"lastError",(function(){return i}));var r=n(1),a=n(16),o=Object(a.createSelector)((function(e){return e}),(function(e){return e.get("errors",Object(r.List)())})),i=Object(a.createSelector)(o,(function(e){return e.last()}))},function(e,t,n){"use strict";n.r(t);var r=n(226),a=n(94),o=n(227),i=n(228);t.default=function(){return{statePlugins:{layout:{reducers:r.default,actions:a,selectors:o},spec:{wrapSelectors:i}}}}},function(e,t,n){"use strict";n.r(t);var r,a=n(3),o=n.n(a),i=n(2),s=n.n(i),c=n(1),u=n(94);t.default=(r={},o()(r,u.UPDATE_LAYOUT,(function(e,t){return e.set("layout",t.payload)})),o()(r,u.UPDATE_FILTER,(function(e,t){return e.set("filter",t.payload)})),o()(r,u.SHOW,(function(e,t){var n=t.payload.shown,r=Object(c.fromJS)(t.payload.thing);return e.update("shown",Object(c.fromJS)({}),(function(e){return e.set(r,n)}))})),o()(r,u.UPDATE_MODE,(function(e,t){var n,r=t.payload.thing,a=t.payload.mode;return e.setIn(s()(n=["modes"]).call(n,r),(a||"")+"")})),r)},function(e,t,n){"use strict";n.r(t),n.d(t,"current",(function(){return l})),n.d(t,"currentFilter",(function(){return p})),n.d(t,"isShown",(function(){return f})),n.d(t,"whatMode",(function(){return d})),n.d(t,"showSummary",(function(){return h}));var r=n(14),a=n.n(r),o=n(2),i=n.n(o),s=n(16),c=n(5),u=n(1),l=function(e){return e.get("layout")},p=function(e){return e.get("filter")},f=function(e,t,n){return t=Object(c.v)(t),e.get("shown",Object(u.fromJS)({})).get(Object(u.fromJS)(t),n)},d=function(e,t){var n,r=arguments.length>2&&void 0!==arguments[2]?arguments[2]:"";return t=Object(c.v)(t),e.getIn(i()(n=["modes"]).call(n,a()(t)),r)},h=Object(s.createSelector)((function(e){return e}),(function(e){return!f(e,"editor")}))},function(e,t,n){"use strict";n.r(t),n.d(t,"taggedOperations",(function(){return s}));var r=n(2),a=n.n(r),o=n(19),i=n.n(o),s=function(e,t){return function(n){for(var r,o=arguments.length,s=new Array(o>1?o-1:0),c=1;c<o;c++)s[c-1]=arguments[c];var u=e.apply(void 0,a()(r=[n]).call(r,s)),l=t.getSystem(),p=l.fn,f=l.layoutSelectors,d=l.getConfigs,h=d(),m=h.maxDisplayedTags,v=f.currentFilter();return v&&!0!==v&&"true"!==v&&"false"!==v&&(u=p.opsFilter(u,v)),m&&!isNaN(m)&&m>=0&&(u=i()(u).call(u,0,m)),u}}},function(e,t,n){"use strict";n.r(t);var r=n(230),a=n(42),o=n(78),i=n(231);t.default=function(){return{statePlugins:{spec:{wrapActions:i,reducers:r.default,actions:a,selectors:o}}}}},function(e,t,n){"use strict";n.r(t);var r,a=n(3),o=n.n(a),i=n(14),s=n.n(i),c=n(2),u=n.n(c),l=n(28),p=n.n(l),f=n(4),d=n.n(f),h=n(20),m=n.n(h),v=n(1),g=n(5),y=n(25),b=n(78),E=n(42);t.default=(r={},o()(r,E.UPDATE_SPEC,(function(e,t){return"string"==typeof t.payload?e.set("spec",t.payload):e})),o()(r,E.UPDATE_URL,(function(e,t){return e.set("url",t.payload+"")})),o()(r,E.UPDATE_JSON,(function(e,t){return e.set("json",Object(g.i)(t.payload))})),o()(r,E.UPDATE_RESOLVED,(function(e,t){return e.setIn(["resolved"],Object(g.i)(t.payload))})),o()(r,E.UPDATE_RESOLVED_SUBTREE,(function(e,t){var n,r=t.payload,a=r.value,o=r.path;return e.setIn(u()(n=["resolvedSubtrees"]).call(n,s()(o)),Object(g.i)(a))})),o()(r,E.UPDATE_PARAM,(function(e,t){var n,r,a=t.payload,o=a.path,i=a.paramName,c=a.paramIn,l=a.param,p=a.value,f=a.isXml,d=l?Object(g.A)(l):u()(n="".concat(c,".")).call(n,i),h=f?"value_xml":"value";return e.setIn(u()(r=["meta","paths"]).call(r,s()(o),["parameters",d,h]),p)})),o()(r,E.UPDATE_EMPTY_PARAM_INCLUSION,(function(e,t){var n,r,a=t.payload,o=a.pathMethod,i=a.paramName,c=a.paramIn,l=a.includeEmptyValue;if(!i||!c)return console.warn("Warning: UPDATE_EMPTY_PARAM_INCLUSION could not generate a paramKey."),e;var p=u()(n="".concat(c,".")).call(n,i);return e.setIn(u()(r=["meta","paths"]).call(r,s()(o),["parameter_inclusions",p]),l)})),o()(r,E.VALIDATE_PARAMS,(function(e,t){var n,r,a=t.payload,o=a.pathMethod,i=a.isOAS3,c=Object(b.specJsonWithResolvedSubtrees)(e).getIn(u()(n=["paths"]).call(n,s()(o))),l=Object(b.parameterValues)(e,o).toJS();return e.updateIn(u()(r=["meta","paths"]).call(r,s()(o),["parameters"]),Object(v.fromJS)({}),(function(t){var n;return p()(n=c.get("parameters",Object(v.List)())).call(n,(function(t,n){var r=Object(g.B)(n,l),a=Object(b.parameterInclusionSettingFor)(e,o,n.get("name"),n.get("in")),s=Object(g.K)(n,r,{bypassRequiredCheck:a,isOAS3:i});return t.setIn([Object(g.A)(n),"errors"],Object(v.fromJS)(s))}),t)}))})),o()(r,E.CLEAR_VALIDATE_PARAMS,(function(e,t){var n,r=t.payload.pathMethod;return e.updateIn(u()(n=["meta","paths"]).call(n,s()(r),["parameters"]),Object(v.fromJS)([]),(function(e){return d()(e).call(e,(function(e){return e.set("errors",Object(v.fromJS)([]))}))}))})),o()(r,E.SET_RESPONSE,(function(e,t){var n,r=t.payload,a=r.res,o=r.path,i=r.method;(n=a.error?m()({error:!0,name:a.err.name,message:a.err.message,statusCode:a.err.statusCode},a.err.response):a).headers=n.headers||{};var s=e.setIn(["responses",o,i],Object(g.i)(n));return y.a.Blob&&a.data instanceof y.a.Blob&&(s=s.setIn(["responses",o,i,"text"],a.data)),s})),o()(r,E.SET_REQUEST,(function(e,t){var n=t.payload,r=n.req,a=n.path,o=n.method;return e.setIn(["requests",a,o],Object(g.i)(r))})),o()(r,E.SET_MUTATED_REQUEST,(function(e,t){var n=t.payload,r=n.req,a=n.path,o=n.method;return e.setIn(["mutatedRequests",a,o],Object(g.i)(r))})),o()(r,E.UPDATE_OPERATION_META_VALUE,(function(e,t){var n,r,a,o,i,c,l=t.payload,p=l.path,f=l.value,d=l.key,h=u()(n=["paths"]).call(n,s()(p)),m=u()(r=["meta","paths"]).call(r,s()(p));return e.getIn(u()(a=["json"]).call(a,s()(h)))||e.getIn(u()(o=["resolved"]).call(o,s()(h)))||e.getIn(u()(i=["resolvedSubtrees"]).call(i,s()(h)))?e.setIn(u()(c=[]).call(c,s()(m),[d]),Object(v.fromJS)(f)):e})),o()(r,E.CLEAR_RESPONSE,(function(e,t){var n=t.payload,r=n.path,a=n.method;return e.deleteIn(["responses",r,a])})),o()(r,E.CLEAR_REQUEST,(function(e,t){var n=t.payload,r=n.path,a=n.method;return e.deleteIn(["requests",r,a])})),o()(r,E.SET_SCHEME,(function(e,t){var n=t.payload,r=n.scheme,a=n.path,o=n.method;return a&&o?e.setIn(["scheme",a,o],r):a||o?void 0:e.setIn(["scheme","_defaultScheme"],r)})),r)},function(e,t,n){"use strict";n.r(t),n.d(t,"updateSpec",(function(){return u})),n.d(t,"updateJsonSpec",(function(){return l})),n.d(t,"executeRequest",(function(){return p})),n.d(t,"validateParams",(function(){return f}));var r=n(15),a=n.n(r),o=n(22),i=n.n(o),s=n(36),c=n.n(s),u=function(e,t){var n=t.specActions;return function(){e.apply(void 0,arguments),n.parseToJson.apply(n,arguments)}},l=function(e,t){var n=t.specActions;return function(){for(var t=arguments.length,r=new Array(t),o=0;o<t;o++)r[o]=arguments[o];e.apply(void 0,r),n.invalidateResolvedSubtreeCache();var s=r[0],u=c()(s,["paths"])||{},l=a()(u);i()(l).call(l,(function(e){c()(u,[e]).$ref&&n.requestResolvedSubtree(["paths",e])})),n.requestResolvedSubtree(["components","securitySchemes"])}},p=function(e,t){var n=t.specActions;return function(t){return n.logRequest(t),e(t)}},f=function(e,t){var n=t.specSelectors;return function(t){return e(t,n.isOAS3())}}},function(e,t,n){"use strict";n.r(t);var r=n(33),a=n.n(r),o=n(144),i=n(5);t.default=function(e){var t=e.getComponents,n=e.getStore,r=e.getSystem,s=o.getComponent,c=o.render,u=o.makeMappedContainer,l=Object(i.u)(a()(s).call(s,null,r,n,t));return{rootInjects:{getComponent:l,makeMappedContainer:Object(i.u)(a()(u).call(u,null,r,n,l,t)),render:a()(c).call(c,null,r,n,s,t)}}}},function(e,t,n){"use strict";n.r(t);var r=n(122);t.default=function(){return{fn:r}}},function(e,t,n){"use strict";n.r(t);var r=n(141),a=n(235),o=n(236);t.default=function(){return{components:{RequestSnippets:o.RequestSnippets},fn:r,statePlugins:{requestSnippets:{selectors:a}}}}},function(e,t,n){"use strict";n.r(t),n.d(t,"getGenerators",(function(){return f})),n.d(t,"getSnippetGenerators",(function(){return d})),n.d(t,"getActiveLanguage",(function(){return h})),n.d(t,"getDefaultExpanded",(function(){return m}));var r=n(12),a=n.n(r),o=n(29),i=n.n(o),s=n(4),c=n.n(s),u=n(16),l=n(1),p=function(e){return e||Object(l.Map)()},f=Object(u.createSelector)(p,(function(e){var t=e.get("languages"),n=e.get("generators",Object(l.Map)());return!t||t.isEmpty()?n:a()(n).call(n,(function(e,n){return i()(t).call(t,n)}))})),d=function(e){return function(t){var n,r,o=t.fn;return a()(n=c()(r=f(e)).call(r,(function(e,t){var n=function(e){return o["requestSnippetGenerator_".concat(e)]}(t);return"function"!=typeof n?null:e.set("fn",n)}))).call(n,(function(e){return e}))}},h=Object(u.createSelector)(p,(function(e){return e.get("activeLanguage")})),m=Object(u.createSelector)(p,(function(e){return e.get("defaultExpanded")}))},function(e,t,n){"use strict";n.r(t),n.d(t,"RequestSnippets",(function(){return x}));var r=n(13),a=n.n(r),o=n(6),i=n.n(o),s=n(7),c=n.n(s),u=n(8),l=n.n(u),p=n(9),f=n.n(p),d=n(4),h=n.n(d),m=n(0),v=n.n(m),g=n(123),y=(n(11),n(36)),b=n.n(y),E=n(81),x=function(e){l()(n,e);var t=f()(n);function n(){var e,r,a,o,s,c;return i()(this,n),(c=t.call(this)).state={activeLanguage:null===(e=c.props)||void 0===e||null===(r=e.requestSnippetsSelectors)||void 0===r||null===(a=r.getSnippetGenerators())||void 0===a?void 0:a.keySeq().first(),expanded:null===(o=c.props)||void 0===o||null===(s=o.requestSnippetsSelectors)||void 0===s?void 0:s.getDefaultExpanded()},c}return c()(n,[{key:"render",value:function(){var e,t,n,r,o=this,i=this.props,s=i.request,c=i.getConfigs,u=i.requestSnippetsSelectors.getSnippetGenerators(),l=this.state.activeLanguage||u.keySeq().first(),p=u.get(l),f=p.get("fn")(s),d={cursor:"pointer",lineHeight:1,display:"inline-flex",backgroundColor:"rgb(250, 250, 250)",paddingBottom:"0",paddingTop:"0",border:"1px solid rgb(51, 51, 51)",borderRadius:"4px 4px 0 0",boxShadow:"none",borderBottom:"none"},m={cursor:"pointer",lineHeight:1,display:"inline-flex",backgroundColor:"rgb(51, 51, 51)",boxShadow:"none",border:"1px solid rgb(51, 51, 51)",paddingBottom:"0",paddingTop:"0",borderRadius:"4px 4px 0 0",marginTop:"-5px",marginRight:"-5px",marginLeft:"-5px",zIndex:"9999",borderBottom:"none"},y=function(e){return e===l?m:d},x=c(),S=null!=x&&null!==(e=x.syntaxHighlight)&&void 0!==e&&e.activated?v.a.createElement(E.a,{language:p.get("syntax"),className:"curl microlight",onWheel:function(e){return this.preventYScrollingBeyondElement(e)},style:Object(E.b)(b()(x,"syntaxHighlight.theme"))},f):v.a.createElement("textarea",{readOnly:!0,className:"curl",value:f}),w=void 0===this.state.expanded?null===(t=this.props)||void 0===t||null===(n=t.requestSnippetsSelectors)||void 0===n?void 0:n.getDefaultExpanded():this.state.expanded;return v.a.createElement("div",null,v.a.createElement("div",{style:{width:"100%",display:"flex",justifyContent:"flex-start",alignItems:"center",marginBottom:"15px"}},v.a.createElement("h4",{style:{cursor:"pointer"},onClick:function(){return o.setState({expanded:!w})}},"Snippets"),v.a.createElement("button",{onClick:function(){return o.setState({expanded:!w})},style:{border:"none",background:"none"},title:w?"Collapse operation":"Expand operation"},v.a.createElement("svg",{className:"arrow",width:"10",height:"10"},v.a.createElement("use",{href:w?"#large-arrow-down":"#large-arrow",xlinkHref:w?"#large-arrow-down":"#large-arrow"})))),w&&v.a.createElement("div",{className:"curl-command"},v.a.createElement("div",{style:{paddingLeft:"15px",paddingRight:"10px",width:"100%",display:"flex"}},h()(r=u.entrySeq()).call(r,(function(e){var t=a()(e,2),n=t[0],r=t[1];return v.a.createElement("div",{style:y(n),className:"btn",key:n,onClick:function(){return function(e){l!==e&&o.setState({activeLanguage:e})}(n)}},v.a.createElement("h4",{style:n===l?{color:"white"}:{}},r.get("title")))}))),v.a.createElement("div",{className:"copy-to-clipboard"},v.a.createElement(g.CopyToClipboard,{text:f},v.a.createElement("button",null))),v.a.createElement("div",null,S)))}}]),n}(v.a.Component)},function(e,t,n){"use strict";n.r(t);var r=n(33),a=n.n(r);t.default=function(e){var t=e.configs,n={debug:0,info:1,log:2,warn:3,error:4},r=function(e){return n[e]||-1},o=t.logLevel,i=r(o);function s(e){for(var t,n=arguments.length,a=new Array(n>1?n-1:0),o=1;o<n;o++)a[o-1]=arguments[o];r(e)>=i&&(t=console)[e].apply(t,a)}return s.warn=a()(s).call(s,null,"warn"),s.error=a()(s).call(s,null,"error"),s.info=a()(s).call(s,null,"info"),s.debug=a()(s).call(s,null,"debug"),{rootInjects:{log:s}}}},function(e,t,n){"use strict";n.r(t),n.d(t,"loaded",(function(){return r}));var r=function(e,t){return function(){e.apply(void 0,arguments);var n=t.getConfigs().withCredentials;void 0!==n&&(t.fn.fetch.withCredentials="string"==typeof n?"true"===n:!!n)}}},function(e,t,n){"use strict";n.r(t),n.d(t,"preauthorizeBasic",(function(){return d})),n.d(t,"preauthorizeApiKey",(function(){return h}));var r=n(3),a=n.n(r),o=n(33),i=n.n(o),s=n(2),c=n.n(s),u=n(240),l=n(74),p=n(241),f=n(242);function d(e,t,n,r){var o,i=e.authActions.authorize,s=e.specSelectors,u=s.specJson,l=(0,s.isOAS3)()?["components","securitySchemes"]:["securityDefinitions"],p=u().getIn(c()(o=[]).call(o,l,[t]));return p?i(a()({},t,{value:{username:n,password:r},schema:p.toJS()})):null}function h(e,t,n){var r,o=e.authActions.authorize,i=e.specSelectors,s=i.specJson,u=(0,i.isOAS3)()?["components","securitySchemes"]:["securityDefinitions"],l=s().getIn(c()(r=[]).call(r,u,[t]));return l?o(a()({},t,{value:n,schema:l.toJS()})):null}t.default=function(){return{afterLoad:function(e){this.rootInjects=this.rootInjects||{},this.rootInjects.initOAuth=e.authActions.configureAuth,this.rootInjects.preauthorizeApiKey=i()(h).call(h,null,e),this.rootInjects.preauthorizeBasic=i()(d).call(d,null,e)},statePlugins:{auth:{reducers:u.default,actions:l,selectors:p},spec:{wrapActions:f}}}}},function(e,t,n){"use strict";n.r(t);var r,a=n(3),o=n.n(a),i=n(13),s=n.n(i),c=n(22),u=n.n(c),l=n(20),p=n.n(l),f=n(1),d=n(5),h=n(74);t.default=(r={},o()(r,h.SHOW_AUTH_POPUP,(function(e,t){var n=t.payload;return e.set("showDefinitions",n)})),o()(r,h.AUTHORIZE,(function(e,t){var n,r=t.payload,a=Object(f.fromJS)(r),o=e.get("authorized")||Object(f.Map)();return u()(n=a.entrySeq()).call(n,(function(t){var n=s()(t,2),r=n[0],a=n[1];if(!Object(d.s)(a.getIn))return e.set("authorized",o);var i=a.getIn(["schema","type"]);if("apiKey"===i||"http"===i)o=o.set(r,a);else if("basic"===i){var c=a.getIn(["value","username"]),u=a.getIn(["value","password"]);o=(o=o.setIn([r,"value"],{username:c,header:"Basic "+Object(d.a)(c+":"+u)})).setIn([r,"schema"],a.get("schema"))}})),e.set("authorized",o)})),o()(r,h.AUTHORIZE_OAUTH2,(function(e,t){var n,r=t.payload,a=r.auth,o=r.token;a.token=p()({},o),n=Object(f.fromJS)(a);var i=e.get("authorized")||Object(f.Map)();return i=i.set(n.get("name"),n),e.set("authorized",i)})),o()(r,h.LOGOUT,(function(e,t){var n=t.payload,r=e.get("authorized").withMutations((function(e){u()(n).call(n,(function(t){e.delete(t)}))}));return e.set("authorized",r)})),o()(r,h.CONFIGURE_AUTH,(function(e,t){var n=t.payload;return e.set("configs",n)})),o()(r,h.RESTORE_AUTHORIZATION,(function(e,t){var n=t.payload;return e.set("authorized",Object(f.fromJS)(n.authorized))})),r)},function(e,t,n){"use strict";n.r(t),n.d(t,"shownDefinitions",(function(){return E})),n.d(t,"definitionsToAuthorize",(function(){return x})),n.d(t,"getDefinitionsByNames",(function(){return S})),n.d(t,"definitionsForRequirements",(function(){return w})),n.d(t,"authorized",(function(){return j})),n.d(t,"isAuthorized",(function(){return O})),n.d(t,"getConfigs",(function(){return C}));var r=n(13),a=n.n(r),o=n(22),i=n.n(o),s=n(12),c=n.n(s),u=n(64),l=n.n(u),p=n(17),f=n.n(p),d=n(4),h=n.n(d),m=n(15),v=n.n(m),g=n(16),y=n(1),b=function(e){return e},E=Object(g.createSelector)(b,(function(e){return e.get("showDefinitions")})),x=Object(g.createSelector)(b,(function(){return function(e){var t,n=e.specSelectors.securityDefinitions()||Object(y.Map)({}),r=Object(y.List)();return i()(t=n.entrySeq()).call(t,(function(e){var t=a()(e,2),n=t[0],o=t[1],i=Object(y.Map)();i=i.set(n,o),r=r.push(i)})),r}})),S=function(e,t){return function(e){var n,r=e.specSelectors;console.warn("WARNING: getDefinitionsByNames is deprecated and will be removed in the next major version.");var o=r.securityDefinitions(),s=Object(y.List)();return i()(n=t.valueSeq()).call(n,(function(e){var t,n=Object(y.Map)();i()(t=e.entrySeq()).call(t,(function(e){var t,r,s=a()(e,2),c=s[0],u=s[1],l=o.get(c);"oauth2"===l.get("type")&&u.size&&(t=l.get("scopes"),i()(r=t.keySeq()).call(r,(function(e){u.contains(e)||(t=t.delete(e))})),l=l.set("allowedScopes",t));n=n.set(c,l)})),s=s.push(n)})),s}},w=function(e){var t=arguments.length>1&&void 0!==arguments[1]?arguments[1]:Object(y.List)();return function(e){var n=e.authSelectors.definitionsToAuthorize()||Object(y.List)();return c()(n).call(n,(function(e){return l()(t).call(t,(function(t){return t.get(e.keySeq().first())}))}))}},j=Object(g.createSelector)(b,(function(e){return e.get("authorized")||Object(y.Map)()})),O=function(e,t){return function(e){var n,r=e.authSelectors.authorized();return y.List.isList(t)?!!c()(n=t.toJS()).call(n,(function(e){var t,n;return-1===f()(t=h()(n=v()(e)).call(n,(function(e){return!!r.get(e)}))).call(t,!1)})).length:null}},C=Object(g.createSelector)(b,(function(e){return e.get("configs")}))},function(e,t,n){"use strict";n.r(t),n.d(t,"execute",(function(){return o}));var r=n(24),a=n.n(r),o=function(e,t){var n=t.authSelectors,r=t.specSelectors;return function(t){var o=t.path,i=t.method,s=t.operation,c=t.extras,u={authorized:n.authorized()&&n.authorized().toJS(),definitions:r.securityDefinitions()&&r.securityDefinitions().toJS(),specSecurity:r.security()&&r.security().toJS()};return e(a()({path:o,method:i,operation:s,securities:u},c))}}},function(e,t,n){"use strict";n.r(t);var r=n(5);t.default=function(){return{fn:{shallowEqualKeys:r.G}}}},function(e,t,n){"use strict";n.r(t),n.d(t,"default",(function(){return v}));var r=n(20),a=n.n(r),o=n(80),i=n.n(o),s=n(2),c=n.n(s),u=n(17),l=n.n(u),p=n(30),f=n.n(p),d=n(16),h=n(1),m=n(25);function v(e){var t=e.fn;return{statePlugins:{spec:{actions:{download:function(e){return function(n){var r=n.errActions,o=n.specSelectors,s=n.specActions,u=n.getConfigs,l=t.fetch,p=u();function f(t){if(t instanceof Error||t.status>=400)return s.updateLoadingStatus("failed"),r.newThrownErr(a()(new Error((t.message||t.statusText)+" "+e),{source:"fetch"})),void(!t.status&&t instanceof Error&&function(){try{var t;if("URL"in m.a?t=new i.a(e):(t=document.createElement("a")).href=e,"https:"!==t.protocol&&"https:"===m.a.location.protocol){var n=a()(new Error("Possible mixed-content issue? The page was loaded over https:// but a ".concat(t.protocol,"// URL was specified. Check that you are not attempting to load mixed content.")),{source:"fetch"});return void r.newThrownErr(n)}if(t.origin!==m.a.location.origin){var o,s=a()(new Error(c()(o="Possible cross-origin (CORS) issue? The URL origin (".concat(t.origin,") does not match the page (")).call(o,m.a.location.origin,"). Check the server returns the correct 'Access-Control-Allow-*' headers.")),{source:"fetch"});r.newThrownErr(s)}}catch(e){return}}());s.updateLoadingStatus("success"),s.updateSpec(t.text),o.url()!==e&&s.updateUrl(e)}e=e||o.url(),s.updateLoadingStatus("loading"),r.clear({source:"fetch"}),l({url:e,loadSpec:!0,requestInterceptor:p.requestInterceptor||function(e){return e},responseInterceptor:p.responseInterceptor||function(e){return e},credentials:"same-origin",headers:{Accept:"application/json,*/*"}}).then(f,f)}},updateLoadingStatus:function(e){var t,n=[null,"loading","failed","success","failedConfig"];-1===l()(n).call(n,e)&&console.error(c()(t="Error: ".concat(e," is not one of ")).call(t,f()(n)));return{type:"spec_update_loading_status",payload:e}}},reducers:{spec_update_loading_status:function(e,t){return"string"==typeof t.payload?e.set("loadingStatus",t.payload):e}},selectors:{loadingStatus:Object(d.createSelector)((function(e){return e||Object(h.Map)()}),(function(e){return e.get("loadingStatus")||null}))}}}}}},function(e,t,n){"use strict";n.r(t),n.d(t,"downloadConfig",(function(){return a})),n.d(t,"getConfigByUrl",(function(){return o}));var r=n(142),a=function(e){return function(t){return(0,t.fn.fetch)(e)}},o=function(e,t){return function(n){var a=n.specActions;if(e)return a.downloadConfig(e).then(o,o);function o(n){n instanceof Error||n.status>=400?(a.updateLoadingStatus("failedConfig"),a.updateLoadingStatus("failedConfig"),a.updateUrl(""),console.error(n.statusText+" "+e.url),t(null)):t(Object(r.parseYamlConfig)(n.text))}}}},function(e,t,n){"use strict";n.r(t),n.d(t,"get",(function(){return o}));var r=n(23),a=n.n(r),o=function(e,t){return e.getIn(a()(t)?t:[t])}},function(e,t,n){"use strict";n.r(t);var r,a=n(3),o=n.n(a),i=n(1),s=n(124);t.default=(r={},o()(r,s.UPDATE_CONFIGS,(function(e,t){return e.merge(Object(i.fromJS)(t.payload))})),o()(r,s.TOGGLE_CONFIGS,(function(e,t){var n=t.payload,r=e.get(n);return e.set(n,!r)})),r)},function(e,t,n){"use strict";n.r(t);var r=n(249),a=n(250),o=n(251);t.default=function(){return[r.default,{statePlugins:{configs:{wrapActions:{loaded:function(e,t){return function(){e.apply(void 0,arguments);var n=decodeURIComponent(window.location.hash);t.layoutActions.parseDeepLinkHash(n)}}}}},wrapComponents:{operation:a.default,OperationTag:o.default}}]}},function(e,t,n){"use strict";n.r(t),n.d(t,"show",(function(){return C})),n.d(t,"scrollTo",(function(){return _})),n.d(t,"parseDeepLinkHash",(function(){return A})),n.d(t,"readyToScroll",(function(){return k})),n.d(t,"scrollToElement",(function(){return I})),n.d(t,"clearScrollTo",(function(){return P}));var r,a=n(3),o=n.n(a),i=n(13),s=n.n(i),c=n(23),u=n.n(c),l=n(2),p=n.n(l),f=n(19),d=n.n(f),h=n(4),m=n.n(h),v=n(17),g=n.n(v),y=n(145),b=n(406),E=n.n(b),x=n(5),S=n(1),w=n.n(S),j="layout_scroll_to",O="layout_clear_scroll",C=function(e,t){var n=t.getConfigs,r=t.layoutSelectors;return function(){for(var t=arguments.length,a=new Array(t),o=0;o<t;o++)a[o]=arguments[o];if(e.apply(void 0,a),n().deepLinking)try{var i=a[0],c=a[1];i=u()(i)?i:[i];var l=r.urlHashArrayFromIsShownKey(i);if(!l.length)return;var f,d=s()(l,2),h=d[0],m=d[1];if(!c)return Object(y.setHash)("/");if(2===l.length)Object(y.setHash)(Object(x.d)(p()(f="/".concat(encodeURIComponent(h),"/")).call(f,encodeURIComponent(m))));else 1===l.length&&Object(y.setHash)(Object(x.d)("/".concat(encodeURIComponent(h))))}catch(e){console.error(e)}}},_=function(e){return{type:j,payload:u()(e)?e:[e]}},A=function(e){return function(t){var n=t.layoutActions,r=t.layoutSelectors;if((0,t.getConfigs)().deepLinking&&e){var a,o=d()(e).call(e,1);"!"===o[0]&&(o=d()(o).call(o,1)),"/"===o[0]&&(o=d()(o).call(o,1));var i=m()(a=o.split("/")).call(a,(function(e){return e||""})),c=r.isShownKeyFromUrlHashArray(i),u=s()(c,3),l=u[0],p=u[1],f=void 0===p?"":p,h=u[2],v=void 0===h?"":h;if("operations"===l){var y=r.isShownKeyFromUrlHashArray([f]);g()(f).call(f,"_")>-1&&(console.warn("Warning: escaping deep link whitespace with `_` will be unsupported in v4.0, use `%20` instead."),n.show(m()(y).call(y,(function(e){return e.replace(/_/g," ")})),!0)),n.show(y,!0)}(g()(f).call(f,"_")>-1||g()(v).call(v,"_")>-1)&&(console.warn("Warning: escaping deep link whitespace with `_` will be unsupported in v4.0, use `%20` instead."),n.show(m()(c).call(c,(function(e){return e.replace(/_/g," ")})),!0)),n.show(c,!0),n.scrollTo(c)}}},k=function(e,t){return function(n){var r=n.layoutSelectors.getScrollToKey();w.a.is(r,Object(S.fromJS)(e))&&(n.layoutActions.scrollToElement(t),n.layoutActions.clearScrollTo())}},I=function(e,t){return function(n){try{t=t||n.fn.getScrollParent(e),E.a.createScroller(t).to(e)}catch(e){console.error(e)}}},P=function(){return{type:O}};t.default={fn:{getScrollParent:function(e,t){var n=document.documentElement,r=getComputedStyle(e),a="absolute"===r.position,o=t?/(auto|scroll|hidden)/:/(auto|scroll)/;if("fixed"===r.position)return n;for(var i=e;i=i.parentElement;)if(r=getComputedStyle(i),(!a||"static"!==r.position)&&o.test(r.overflow+r.overflowY+r.overflowX))return i;return n}},statePlugins:{layout:{actions:{scrollToElement:I,scrollTo:_,clearScrollTo:P,readyToScroll:k,parseDeepLinkHash:A},selectors:{getScrollToKey:function(e){return e.get("scrollToKey")},isShownKeyFromUrlHashArray:function(e,t){var n=s()(t,2),r=n[0],a=n[1];return a?["operations",r,a]:r?["operations-tag",r]:[]},urlHashArrayFromIsShownKey:function(e,t){var n=s()(t,3),r=n[0],a=n[1],o=n[2];return"operations"==r?[a,o]:"operations-tag"==r?[a]:[]}},reducers:(r={},o()(r,j,(function(e,t){return e.set("scrollToKey",w.a.fromJS(t.payload))})),o()(r,O,(function(e){return e.delete("scrollToKey")})),r),wrapActions:{show:C}}}}},function(e,t,n){"use strict";n.r(t);var r=n(6),a=n.n(r),o=n(7),i=n.n(o),s=n(10),c=n.n(s),u=n(8),l=n.n(u),p=n(9),f=n.n(p),d=n(3),h=n.n(d),m=n(2),v=n.n(m),g=n(0),y=n.n(g);n(26);t.default=function(e,t){return function(n){l()(o,n);var r=f()(o);function o(){var e,n;a()(this,o);for(var i=arguments.length,s=new Array(i),u=0;u<i;u++)s[u]=arguments[u];return n=r.call.apply(r,v()(e=[this]).call(e,s)),h()(c()(n),"onLoad",(function(e){var r=n.props.operation,a=r.toObject(),o=a.tag,i=a.operationId,s=r.toObject().isShownKey;s=s||["operations",o,i],t.layoutActions.readyToScroll(s,e)})),n}return i()(o,[{key:"render",value:function(){return y.a.createElement("span",{ref:this.onLoad},y.a.createElement(e,this.props))}}]),o}(y.a.Component)}},function(e,t,n){"use strict";n.r(t);var r=n(6),a=n.n(r),o=n(7),i=n.n(o),s=n(10),c=n.n(s),u=n(8),l=n.n(u),p=n(9),f=n.n(p),d=n(3),h=n.n(d),m=n(2),v=n.n(m),g=n(0),y=n.n(g);n(11);t.default=function(e,t){return function(n){l()(o,n);var r=f()(o);function o(){var e,n;a()(this,o);for(var i=arguments.length,s=new Array(i),u=0;u<i;u++)s[u]=arguments[u];return n=r.call.apply(r,v()(e=[this]).call(e,s)),h()(c()(n),"onLoad",(function(e){var r=["operations-tag",n.props.tag];t.layoutActions.readyToScroll(r,e)})),n}return i()(o,[{key:"render",value:function(){return y.a.createElement("span",{ref:this.onLoad},y.a.createElement(e,this.props))}}]),o}(y.a.Component)}},function(e,t,n){"use strict";n.r(t);var r=n(253);t.default=function(){return{fn:{opsFilter:r.default}}}},function(e,t,n){"use strict";n.r(t);var r=n(12),a=n.n(r),o=n(17),i=n.n(o);t.default=function(e,t){return a()(e).call(e,(function(e,n){return-1!==i()(n).call(n,t)}))}},function(e,t,n){"use strict";n.r(t);var r=n(177),a=n.n(r),o=!1;t.default=function(){return{statePlugins:{spec:{wrapActions:{updateSpec:function(e){return function(){return o=!0,e.apply(void 0,arguments)}},updateJsonSpec:function(e,t){return function(){var n=t.getConfigs().onComplete;return o&&"function"==typeof n&&(a()(n,0),o=!1),e.apply(void 0,arguments)}}}}}}}},function(e,t,n){"use strict";n.r(t);var r=n(256),a=n(257),o=n(258),i=n(259),s=n(267),c=n(50),u=n(274),l=n(275);t.default=function(){return{components:i.default,wrapComponents:s.default,statePlugins:{spec:{wrapSelectors:r,selectors:o},auth:{wrapSelectors:a},oas3:{actions:c,reducers:l.default,selectors:u}}}}},function(e,t,n){"use strict";n.r(t),n.d(t,"definitions",(function(){return d})),n.d(t,"hasHost",(function(){return h})),n.d(t,"securityDefinitions",(function(){return m})),n.d(t,"host",(function(){return v})),n.d(t,"basePath",(function(){return g})),n.d(t,"consumes",(function(){return y})),n.d(t,"produces",(function(){return b})),n.d(t,"schemes",(function(){return E})),n.d(t,"servers",(function(){return x})),n.d(t,"isOAS3",(function(){return S})),n.d(t,"isSwagger2",(function(){return w}));var r=n(16),a=n(78),o=n(1),i=n(32);function s(e){return function(t,n){return function(){var r=n.getSystem().specSelectors.specJson();return Object(i.isOAS3)(r)?e.apply(void 0,arguments):t.apply(void 0,arguments)}}}var c=function(e){return e||Object(o.Map)()},u=s(Object(r.createSelector)((function(){return null}))),l=Object(r.createSelector)(c,(function(e){return e.get("json",Object(o.Map)())})),p=Object(r.createSelector)(c,(function(e){return e.get("resolved",Object(o.Map)())})),f=function(e){var t=p(e);return t.count()<1&&(t=l(e)),t},d=s(Object(r.createSelector)(f,(function(e){var t=e.getIn(["components","schemas"]);return o.Map.isMap(t)?t:Object(o.Map)()}))),h=s((function(e){return f(e).hasIn(["servers",0])})),m=s(Object(r.createSelector)(a.specJsonWithResolvedSubtrees,(function(e){return e.getIn(["components","securitySchemes"])||null}))),v=u,g=u,y=u,b=u,E=u,x=s(Object(r.createSelector)(f,(function(e){return e.getIn(["servers"])||Object(o.Map)()}))),S=function(e,t){return function(){var e=t.getSystem().specSelectors.specJson();return Object(i.isOAS3)(o.Map.isMap(e)?e:Object(o.Map)())}},w=function(e,t){return function(){var e=t.getSystem().specSelectors.specJson();return Object(i.isSwagger2)(o.Map.isMap(e)?e:Object(o.Map)())}}},function(e,t,n){"use strict";n.r(t),n.d(t,"definitionsToAuthorize",(function(){return b}));var r=n(3),a=n.n(r),o=n(13),i=n.n(o),s=n(2),c=n.n(s),u=n(22),l=n.n(u),p=n(12),f=n.n(p),d=n(28),h=n.n(d),m=n(16),v=n(1),g=n(32);var y,b=(y=Object(m.createSelector)((function(e){return e}),(function(e){return e.specSelectors.securityDefinitions()}),(function(e,t){var n,r=Object(v.List)();return t?(l()(n=t.entrySeq()).call(n,(function(e){var t,n=i()(e,2),o=n[0],s=n[1],c=s.get("type");if("oauth2"===c&&l()(t=s.get("flows").entrySeq()).call(t,(function(e){var t=i()(e,2),n=t[0],c=t[1],u=Object(v.fromJS)({flow:n,authorizationUrl:c.get("authorizationUrl"),tokenUrl:c.get("tokenUrl"),scopes:c.get("scopes"),type:s.get("type"),description:s.get("description")});r=r.push(new v.Map(a()({},o,f()(u).call(u,(function(e){return void 0!==e})))))})),"http"!==c&&"apiKey"!==c||(r=r.push(new v.Map(a()({},o,s)))),"openIdConnect"===c&&s.get("openIdConnectData")){var u=s.get("openIdConnectData"),p=u.get("grant_types_supported")||["authorization_code","implicit"];l()(p).call(p,(function(e){var t,n=u.get("scopes_supported")&&h()(t=u.get("scopes_supported")).call(t,(function(e,t){return e.set(t,"")}),new v.Map),i=Object(v.fromJS)({flow:e,authorizationUrl:u.get("authorization_endpoint"),tokenUrl:u.get("token_endpoint"),scopes:n,type:"oauth2",openIdConnectUrl:s.get("openIdConnectUrl")});r=r.push(new v.Map(a()({},o,f()(i).call(i,(function(e){return void 0!==e})))))}))}})),r):r})),function(e,t){return function(){for(var n=t.getSystem().specSelectors.specJson(),r=arguments.length,a=new Array(r),o=0;o<r;o++)a[o]=arguments[o];if(Object(g.isOAS3)(n)){var i,s=t.getState().getIn(["spec","resolvedSubtrees","components","securitySchemes"]);return y.apply(void 0,c()(i=[t,s]).call(i,a))}return e.apply(void 0,a)}})},function(e,t,n){"use strict";n.r(t),n.d(t,"servers",(function(){return l})),n.d(t,"isSwagger2",(function(){return p}));var r=n(16),a=n(1),o=n(32);var i,s=function(e){return e||Object(a.Map)()},c=Object(r.createSelector)(s,(function(e){return e.get("json",Object(a.Map)())})),u=Object(r.createSelector)(s,(function(e){return e.get("resolved",Object(a.Map)())})),l=(i=Object(r.createSelector)((function(e){var t=u(e);return t.count()<1&&(t=c(e)),t}),(function(e){return e.getIn(["servers"])||Object(a.Map)()})),function(){return function(e){var t=e.getSystem().specSelectors.specJson();if(Object(o.isOAS3)(t)){for(var n=arguments.length,r=new Array(n>1?n-1:0),a=1;a<n;a++)r[a-1]=arguments[a];return i.apply(void 0,r)}return null}}),p=function(e,t){return function(){var e=t.getSystem().specSelectors.specJson();return Object(o.isSwagger2)(e)}}},function(e,t,n){"use strict";n.r(t);var r=n(260),a=n(143),o=n(261),i=n(262),s=n(263),c=n(264),u=n(265),l=n(266);t.default={Callbacks:r.default,HttpAuth:u.default,RequestBody:a.default,Servers:i.default,ServersContainer:s.default,RequestBodyEditor:c.default,OperationServers:l.default,operationLink:o.default}},function(e,t,n){"use strict";n.r(t);var r=n(27),a=n.n(r),o=n(13),i=n.n(o),s=n(4),c=n.n(s),u=n(0),l=n.n(u),p=(n(11),n(26),n(1));t.default=function(e){var t,n=e.callbacks,r=e.getComponent,o=e.specPath,s=r("OperationContainer",!0);if(!n)return l.a.createElement("span",null,"No callbacks");var u=c()(t=n.entrySeq()).call(t,(function(t){var n,r=i()(t,2),u=r[0],f=r[1];return l.a.createElement("div",{key:u},l.a.createElement("h2",null,u),c()(n=f.entrySeq()).call(n,(function(t){var n,r=i()(t,2),f=r[0],d=r[1];return"$$ref"===f?null:l.a.createElement("div",{key:f},c()(n=d.entrySeq()).call(n,(function(t){var n=i()(t,2),r=n[0],c=n[1];if("$$ref"===r)return null;var d=Object(p.fromJS)({operation:c});return l.a.createElement(s,a()({},e,{op:d,key:r,tag:"",method:r,path:f,specPath:o.push(u,f,r),allowTryItOut:!1}))})))})))}));return l.a.createElement("div",null,u)}},function(e,t,n){"use strict";n.r(t);var r=n(6),a=n.n(r),o=n(7),i=n.n(o),s=n(8),c=n.n(s),u=n(9),l=n.n(u),p=n(30),f=n.n(p),d=n(4),h=n.n(d),m=n(0),v=n.n(m),g=(n(11),n(26),function(e){c()(n,e);var t=l()(n);function n(){return a()(this,n),t.apply(this,arguments)}return i()(n,[{key:"render",value:function(){var e=this.props,t=e.link,n=e.name,r=(0,e.getComponent)("Markdown",!0),a=t.get("operationId")||t.get("operationRef"),o=t.get("parameters")&&t.get("parameters").toJS(),i=t.get("description");return v.a.createElement("div",{className:"operation-link"},v.a.createElement("div",{className:"description"},v.a.createElement("b",null,v.a.createElement("code",null,n)),i?v.a.createElement(r,{source:i}):null),v.a.createElement("pre",null,"Operation `",a,"`",v.a.createElement("br",null),v.a.createElement("br",null),"Parameters ",function(e,t){var n;if("string"!=typeof t)return"";return h()(n=t.split("\n")).call(n,(function(t,n){return n>0?Array(e+1).join(" ")+t:t})).join("\n")}(0,f()(o,null,2))||"{}",v.a.createElement("br",null)))}}]),n}(m.Component));t.default=g},function(e,t,n){"use strict";n.r(t),n.d(t,"default",(function(){return C}));var r=n(13),a=n.n(r),o=n(6),i=n.n(o),s=n(7),c=n.n(s),u=n(10),l=n.n(u),p=n(8),f=n.n(p),d=n(9),h=n.n(d),m=n(3),v=n.n(m),g=n(2),y=n.n(g),b=n(52),E=n.n(b),x=n(4),S=n.n(x),w=n(0),j=n.n(w),O=n(1),C=(n(11),n(26),function(e){f()(n,e);var t=h()(n);function n(){var e,r;i()(this,n);for(var a=arguments.length,o=new Array(a),s=0;s<a;s++)o[s]=arguments[s];return r=t.call.apply(t,y()(e=[this]).call(e,o)),v()(l()(r),"onServerChange",(function(e){r.setServer(e.target.value)})),v()(l()(r),"onServerVariableValueChange",(function(e){var t=r.props,n=t.setServerVariableValue,a=t.currentServer,o=e.target.getAttribute("data-variable"),i=e.target.value;"function"==typeof n&&n({server:a,key:o,val:i})})),v()(l()(r),"setServer",(function(e){(0,r.props.setSelectedServer)(e)})),r}return c()(n,[{key:"componentDidMount",value:function(){var e,t=this.props,n=t.servers;t.currentServer||this.setServer(null===(e=n.first())||void 0===e?void 0:e.get("url"))}},{key:"UNSAFE_componentWillReceiveProps",value:function(e){var t=e.servers,n=e.setServerVariableValue,r=e.getServerVariable;if(this.props.currentServer!==e.currentServer||this.props.servers!==e.servers){var a=E()(t).call(t,(function(t){return t.get("url")===e.currentServer}));if(!a)return this.setServer(t.first().get("url"));var o=a.get("variables")||Object(O.OrderedMap)();S()(o).call(o,(function(t,a){r(e.currentServer,a)||n({server:e.currentServer,key:a,val:t.get("default")||""})}))}}},{key:"render",value:function(){var e,t,n=this,r=this.props,o=r.servers,i=r.currentServer,s=r.getServerVariable,c=r.getEffectiveServerValue,u=(E()(o).call(o,(function(e){return e.get("url")===i}))||Object(O.OrderedMap)()).get("variables")||Object(O.OrderedMap)(),l=0!==u.size;return j.a.createElement("div",{className:"servers"},j.a.createElement("label",{htmlFor:"servers"},j.a.createElement("select",{onChange:this.onServerChange,value:i},S()(e=o.valueSeq()).call(e,(function(e){return j.a.createElement("option",{value:e.get("url"),key:e.get("url")},e.get("url"),e.get("description")&&" - ".concat(e.get("description")))})).toArray())),l?j.a.createElement("div",null,j.a.createElement("div",{className:"computed-url"},"Computed URL:",j.a.createElement("code",null,c(i))),j.a.createElement("h4",null,"Server variables"),j.a.createElement("table",null,j.a.createElement("tbody",null,S()(t=u.entrySeq()).call(t,(function(e){var t,r=a()(e,2),o=r[0],c=r[1];return j.a.createElement("tr",{key:o},j.a.createElement("td",null,o),j.a.createElement("td",null,c.get("enum")?j.a.createElement("select",{"data-variable":o,onChange:n.onServerVariableValueChange},S()(t=c.get("enum")).call(t,(function(e){return j.a.createElement("option",{selected:e===s(i,o),key:e,value:e},e)}))):j.a.createElement("input",{type:"text",value:s(i,o)||"",onChange:n.onServerVariableValueChange,"data-variable":o})))}))))):null)}}]),n}(j.a.Component))},function(e,t,n){"use strict";n.r(t),n.d(t,"default",(function(){return d}));var r=n(6),a=n.n(r),o=n(7),i=n.n(o),s=n(8),c=n.n(s),u=n(9),l=n.n(u),p=n(0),f=n.n(p),d=(n(11),function(e){c()(n,e);var t=l()(n);function n(){return a()(this,n),t.apply(this,arguments)}return i()(n,[{key:"render",value:function(){var e=this.props,t=e.specSelectors,n=e.oas3Selectors,r=e.oas3Actions,a=e.getComponent,o=t.servers(),i=a("Servers");return o&&o.size?f.a.createElement("div",null,f.a.createElement("span",{className:"servers-title"},"Servers"),f.a.createElement(i,{servers:o,currentServer:n.selectedServer(),setSelectedServer:r.setSelectedServer,setServerVariableValue:r.setServerVariableValue,getServerVariable:n.serverVariableValue,getEffectiveServerValue:n.serverEffectiveValue})):null}}]),n}(f.a.Component))},function(e,t,n){"use strict";n.r(t),n.d(t,"default",(function(){return x}));var r=n(6),a=n.n(r),o=n(7),i=n.n(o),s=n(10),c=n.n(s),u=n(8),l=n.n(u),p=n(9),f=n.n(p),d=n(3),h=n.n(d),m=n(0),v=n.n(m),g=(n(11),n(43)),y=n.n(g),b=n(5),E=Function.prototype,x=function(e){l()(n,e);var t=f()(n);function n(e,r){var o;return a()(this,n),o=t.call(this,e,r),h()(c()(o),"applyDefaultValue",(function(e){var t=e||o.props,n=t.onChange,r=t.defaultValue;return o.setState({value:r}),n(r)})),h()(c()(o),"onChange",(function(e){o.props.onChange(Object(b.I)(e))})),h()(c()(o),"onDomChange",(function(e){var t=e.target.value;o.setState({value:t},(function(){return o.onChange(t)}))})),o.state={value:Object(b.I)(e.value)||e.defaultValue},e.onChange(e.value),o}return i()(n,[{key:"UNSAFE_componentWillReceiveProps",value:function(e){this.props.value!==e.value&&e.value!==this.state.value&&this.setState({value:Object(b.I)(e.value)}),!e.value&&e.defaultValue&&this.state.value&&this.applyDefaultValue(e)}},{key:"render",value:function(){var e=this.props,t=e.getComponent,n=e.errors,r=this.state.value,a=n.size>0,o=t("TextArea");return v.a.createElement("div",{className:"body-param"},v.a.createElement(o,{className:y()("body-param__text",{invalid:a}),title:n.size?n.join(", "):"",value:r,onChange:this.onDomChange}))}}]),n}(m.PureComponent);h()(x,"defaultProps",{onChange:E,userHasEditedBody:!1})},function(e,t,n){"use strict";n.r(t),n.d(t,"default",(function(){return w}));var r=n(6),a=n.n(r),o=n(7),i=n.n(o),s=n(10),c=n.n(s),u=n(8),l=n.n(u),p=n(9),f=n.n(p),d=n(3),h=n.n(d),m=n(20),v=n.n(m),g=n(12),y=n.n(g),b=n(4),E=n.n(b),x=n(0),S=n.n(x),w=(n(11),function(e){l()(n,e);var t=f()(n);function n(e,r){var o;a()(this,n),o=t.call(this,e,r),h()(c()(o),"onChange",(function(e){var t=o.props.onChange,n=e.target,r=n.value,a=n.name,i=v()({},o.state.value);a?i[a]=r:i=r,o.setState({value:i},(function(){return t(o.state)}))}));var i=o.props,s=i.name,u=i.schema,l=o.getValue();return o.state={name:s,schema:u,value:l},o}return i()(n,[{key:"getValue",value:function(){var e=this.props,t=e.name,n=e.authorized;return n&&n.getIn([t,"value"])}},{key:"render",value:function(){var e,t,n=this.props,r=n.schema,a=n.getComponent,o=n.errSelectors,i=n.name,s=a("Input"),c=a("Row"),u=a("Col"),l=a("authError"),p=a("Markdown",!0),f=a("JumpToPath",!0),d=(r.get("scheme")||"").toLowerCase(),h=this.getValue(),m=y()(e=o.allErrors()).call(e,(function(e){return e.get("authId")===i}));if("basic"===d){var v,g=h?h.get("username"):null;return S.a.createElement("div",null,S.a.createElement("h4",null,S.a.createElement("code",null,i||r.get("name")),"  (http, Basic)",S.a.createElement(f,{path:["securityDefinitions",i]})),g&&S.a.createElement("h6",null,"Authorized"),S.a.createElement(c,null,S.a.createElement(p,{source:r.get("description")})),S.a.createElement(c,null,S.a.createElement("label",null,"Username:"),g?S.a.createElement("code",null," ",g," "):S.a.createElement(u,null,S.a.createElement(s,{type:"text",required:"required",name:"username",onChange:this.onChange,autoFocus:!0}))),S.a.createElement(c,null,S.a.createElement("label",null,"Password:"),g?S.a.createElement("code",null," ****** "):S.a.createElement(u,null,S.a.createElement(s,{autoComplete:"new-password",name:"password",type:"password",onChange:this.onChange}))),E()(v=m.valueSeq()).call(v,(function(e,t){return S.a.createElement(l,{error:e,key:t})})))}return"bearer"===d?S.a.createElement("div",null,S.a.createElement("h4",null,S.a.createElement("code",null,i||r.get("name")),"  (http, Bearer)",S.a.createElement(f,{path:["securityDefinitions",i]})),h&&S.a.createElement("h6",null,"Authorized"),S.a.createElement(c,null,S.a.createElement(p,{source:r.get("description")})),S.a.createElement(c,null,S.a.createElement("label",null,"Value:"),h?S.a.createElement("code",null," ****** "):S.a.createElement(u,null,S.a.createElement(s,{type:"text",onChange:this.onChange,autoFocus:!0}))),E()(t=m.valueSeq()).call(t,(function(e,t){return S.a.createElement(l,{error:e,key:t})}))):S.a.createElement("div",null,S.a.createElement("em",null,S.a.createElement("b",null,i)," HTTP authentication: unsupported scheme ","'".concat(d,"'")))}}]),n}(S.a.Component))},function(e,t,n){"use strict";n.r(t),n.d(t,"default",(function(){return x}));var r=n(24),a=n.n(r),o=n(6),i=n.n(o),s=n(7),c=n.n(s),u=n(10),l=n.n(u),p=n(8),f=n.n(p),d=n(9),h=n.n(d),m=n(3),v=n.n(m),g=n(2),y=n.n(g),b=n(0),E=n.n(b),x=(n(11),n(26),function(e){f()(n,e);var t=h()(n);function n(){var e,r;i()(this,n);for(var o=arguments.length,s=new Array(o),c=0;c<o;c++)s[c]=arguments[c];return r=t.call.apply(t,y()(e=[this]).call(e,s)),v()(l()(r),"setSelectedServer",(function(e){var t,n=r.props,a=n.path,o=n.method;return r.forceUpdate(),r.props.setSelectedServer(e,y()(t="".concat(a,":")).call(t,o))})),v()(l()(r),"setServerVariableValue",(function(e){var t,n=r.props,o=n.path,i=n.method;return r.forceUpdate(),r.props.setServerVariableValue(a()(a()({},e),{},{namespace:y()(t="".concat(o,":")).call(t,i)}))})),v()(l()(r),"getSelectedServer",(function(){var e,t=r.props,n=t.path,a=t.method;return r.props.getSelectedServer(y()(e="".concat(n,":")).call(e,a))})),v()(l()(r),"getServerVariable",(function(e,t){var n,a=r.props,o=a.path,i=a.method;return r.props.getServerVariable({namespace:y()(n="".concat(o,":")).call(n,i),server:e},t)})),v()(l()(r),"getEffectiveServerValue",(function(e){var t,n=r.props,a=n.path,o=n.method;return r.props.getEffectiveServerValue({server:e,namespace:y()(t="".concat(a,":")).call(t,o)})})),r}return c()(n,[{key:"render",value:function(){var e=this.props,t=e.operationServers,n=e.pathServers,r=e.getComponent;if(!t&&!n)return null;var a=r("Servers"),o=t||n,i=t?"operation":"path";return E.a.createElement("div",{className:"opblock-section operation-servers"},E.a.createElement("div",{className:"opblock-section-header"},E.a.createElement("div",{className:"tab-header"},E.a.createElement("h4",{className:"opblock-title"},"Servers"))),E.a.createElement("div",{className:"opblock-description-wrapper"},E.a.createElement("h4",{className:"message"},"These ",i,"-level options override the global server options."),E.a.createElement(a,{servers:o,currentServer:this.getSelectedServer(),setSelectedServer:this.setSelectedServer,setServerVariableValue:this.setServerVariableValue,getServerVariable:this.getServerVariable,getEffectiveServerValue:this.getEffectiveServerValue})))}}]),n}(E.a.Component))},function(e,t,n){"use strict";n.r(t);var r=n(268),a=n(269),o=n(270),i=n(271),s=n(272),c=n(273);t.default={Markdown:r.default,AuthItem:a.default,JsonSchema_string:c.default,VersionStamp:o.default,model:s.default,onlineValidatorBadge:i.default}},function(e,t,n){"use strict";n.r(t),n.d(t,"Markdown",(function(){return d}));var r=n(90),a=n.n(r),o=n(0),i=n.n(o),s=(n(11),n(43)),c=n.n(s),u=n(180),l=n(32),p=n(181),f=new u.Remarkable("commonmark");f.block.ruler.enable(["table"]),f.set({linkTarget:"_blank"});var d=function(e){var t=e.source,n=e.className,r=void 0===n?"":n,o=e.getConfigs;if("string"!=typeof t)return null;if(t){var s,u=o().useUnsafeMarkdown,l=f.render(t),d=Object(p.b)(l,{useUnsafeMarkdown:u});return"string"==typeof d&&(s=a()(d).call(d)),i.a.createElement("div",{dangerouslySetInnerHTML:{__html:s},className:c()(r,"renderedMarkdown")})}return null};d.defaultProps={getConfigs:function(){return{useUnsafeMarkdown:!1}}},t.default=Object(l.OAS3ComponentWrapFactory)(d)},function(e,t,n){"use strict";n.r(t);var r=n(49),a=n.n(r),o=n(0),i=n.n(o),s=n(32),c=["Ori"];t.default=Object(s.OAS3ComponentWrapFactory)((function(e){var t=e.Ori,n=a()(e,c),r=n.schema,o=n.getComponent,s=n.errSelectors,u=n.authorized,l=n.onAuthChange,p=n.name,f=o("HttpAuth");return"http"===r.get("type")?i.a.createElement(f,{key:p,schema:r,name:p,errSelectors:s,authorized:u,getComponent:o,onChange:l}):i.a.createElement(t,n)}))},function(e,t,n){"use strict";n.r(t);var r=n(0),a=n.n(r),o=n(32);t.default=Object(o.OAS3ComponentWrapFactory)((function(e){var t=e.Ori;return a.a.createElement("span",null,a.a.createElement(t,e),a.a.createElement("small",{className:"version-stamp"},a.a.createElement("pre",{className:"version"},"OAS3")))}))},function(e,t,n){"use strict";n.r(t);var r=n(32),a=n(178);t.default=Object(r.OAS3ComponentWrapFactory)(a.a)},function(e,t,n){"use strict";n.r(t);var r=n(27),a=n.n(r),o=n(6),i=n.n(o),s=n(7),c=n.n(s),u=n(8),l=n.n(u),p=n(9),f=n.n(p),d=n(0),h=n.n(d),m=(n(11),n(32)),v=n(179),g=function(e){l()(n,e);var t=f()(n);function n(){return i()(this,n),t.apply(this,arguments)}return c()(n,[{key:"render",value:function(){var e=this.props,t=e.getConfigs,n=["model-box"],r=null;return!0===e.schema.get("deprecated")&&(n.push("deprecated"),r=h.a.createElement("span",{className:"model-deprecated-warning"},"Deprecated:")),h.a.createElement("div",{className:n.join(" ")},r,h.a.createElement(v.a,a()({},this.props,{getConfigs:t,depth:1,expandDepth:this.props.expandDepth||0})))}}]),n}(d.Component);t.default=Object(m.OAS3ComponentWrapFactory)(g)},function(e,t,n){"use strict";n.r(t);var r=n(49),a=n.n(r),o=n(0),i=n.n(o),s=n(32),c=["Ori"];t.default=Object(s.OAS3ComponentWrapFactory)((function(e){var t=e.Ori,n=a()(e,c),r=n.schema,o=n.getComponent,s=n.errors,u=n.onChange,l=r&&r.get?r.get("format"):null,p=r&&r.get?r.get("type"):null,f=o("Input");return p&&"string"===p&&l&&("binary"===l||"base64"===l)?i.a.createElement(f,{type:"file",className:s.length?"invalid":"",title:s.length?s:"",onChange:function(e){u(e.target.files[0])},disabled:t.isDisabled}):i.a.createElement(t,n)}))},function(e,t,n){"use strict";n.r(t),n.d(t,"selectedServer",(function(){return x})),n.d(t,"requestBodyValue",(function(){return S})),n.d(t,"shouldRetainRequestBodyValue",(function(){return w})),n.d(t,"hasUserEditedBody",(function(){return j})),n.d(t,"requestBodyInclusionSetting",(function(){return O})),n.d(t,"requestBodyErrors",(function(){return C})),n.d(t,"activeExamplesMember",(function(){return _})),n.d(t,"requestContentType",(function(){return A})),n.d(t,"responseContentType",(function(){return k})),n.d(t,"serverVariableValue",(function(){return I})),n.d(t,"serverVariables",(function(){return P})),n.d(t,"serverEffectiveValue",(function(){return N})),n.d(t,"validateBeforeExecute",(function(){return T})),n.d(t,"validateShallowRequired",(function(){return R}));var r=n(14),a=n.n(r),o=n(2),i=n.n(o),s=n(4),c=n.n(s),u=n(22),l=n.n(u),p=n(15),f=n.n(p),d=n(17),h=n.n(d),m=n(1),v=n(32),g=n(143),y=n(5);function b(e){return function(){for(var t=arguments.length,n=new Array(t),r=0;r<t;r++)n[r]=arguments[r];return function(t){var r=t.getSystem().specSelectors.specJson();return Object(v.isOAS3)(r)?e.apply(void 0,n):null}}}var E,x=b((function(e,t){var n=t?[t,"selectedServer"]:["selectedServer"];return e.getIn(n)||""})),S=b((function(e,t,n){return e.getIn(["requestData",t,n,"bodyValue"])||null})),w=b((function(e,t,n){return e.getIn(["requestData",t,n,"retainBodyValue"])||!1})),j=function(e,t,n){return function(e){var r=e.getSystem(),a=r.oas3Selectors,o=r.specSelectors,i=o.specJson();if(Object(v.isOAS3)(i)){var s=!1,c=a.requestContentType(t,n),u=a.requestBodyValue(t,n);if(m.Map.isMap(u)&&(u=Object(y.I)(u.mapEntries((function(e){return m.Map.isMap(e[1])?[e[0],e[1].get("value")]:e})).toJS())),m.List.isList(u)&&(u=Object(y.I)(u)),c){var l=Object(g.getDefaultRequestBodyValue)(o.specResolvedSubtree(["paths",t,n,"requestBody"]),c,a.activeExamplesMember(t,n,"requestBody","requestBody"));s=!!u&&u!==l}return s}return null}},O=b((function(e,t,n){return e.getIn(["requestData",t,n,"bodyInclusion"])||Object(m.Map)()})),C=b((function(e,t,n){return e.getIn(["requestData",t,n,"errors"])||null})),_=b((function(e,t,n,r,a){return e.getIn(["examples",t,n,r,a,"activeExample"])||null})),A=b((function(e,t,n){return e.getIn(["requestData",t,n,"requestContentType"])||null})),k=b((function(e,t,n){return e.getIn(["requestData",t,n,"responseContentType"])||null})),I=b((function(e,t,n){var r;if("string"!=typeof t){var a=t.server,o=t.namespace;r=o?[o,"serverVariableValues",a,n]:["serverVariableValues",a,n]}else{r=["serverVariableValues",t,n]}return e.getIn(r)||null})),P=b((function(e,t){var n;if("string"!=typeof t){var r=t.server,a=t.namespace;n=a?[a,"serverVariableValues",r]:["serverVariableValues",r]}else{n=["serverVariableValues",t]}return e.getIn(n)||Object(m.OrderedMap)()})),N=b((function(e,t){var n,r;if("string"!=typeof t){var a=t.server,o=t.namespace;r=a,n=o?e.getIn([o,"serverVariableValues",r]):e.getIn(["serverVariableValues",r])}else r=t,n=e.getIn(["serverVariableValues",r]);n=n||Object(m.OrderedMap)();var i=r;return c()(n).call(n,(function(e,t){i=i.replace(new RegExp("{".concat(t,"}"),"g"),e)})),i})),T=(E=function(e,t){return function(e,t){var n;return t=t||[],!!e.getIn(i()(n=["requestData"]).call(n,a()(t),["bodyValue"]))}(e,t)},function(){for(var e=arguments.length,t=new Array(e),n=0;n<e;n++)t[n]=arguments[n];return function(e){var n,r,o=e.getSystem().specSelectors.specJson(),s=i()(n=[]).call(n,t)[1]||[];return!o.getIn(i()(r=["paths"]).call(r,a()(s),["requestBody","required"]))||E.apply(void 0,t)}}),R=function(e,t){var n,r=t.oas3RequiredRequestBodyContentType,a=t.oas3RequestContentType,o=t.oas3RequestBodyValue,i=[];if(!m.Map.isMap(o))return i;var s=[];return l()(n=f()(r.requestContentType)).call(n,(function(e){if(e===a){var t=r.requestContentType[e];l()(t).call(t,(function(e){h()(s).call(s,e)<0&&s.push(e)}))}})),l()(s).call(s,(function(e){o.getIn([e,"value"])||i.push(e)})),i}},function(e,t,n){"use strict";n.r(t);var r,a=n(3),o=n.n(a),i=n(288),s=n.n(i),c=n(13),u=n.n(c),l=n(107),p=n.n(l),f=n(19),d=n.n(f),h=n(22),m=n.n(h),v=n(28),g=n.n(v),y=n(1),b=n(50);t.default=(r={},o()(r,b.UPDATE_SELECTED_SERVER,(function(e,t){var n=t.payload,r=n.selectedServerUrl,a=n.namespace,o=a?[a,"selectedServer"]:["selectedServer"];return e.setIn(o,r)})),o()(r,b.UPDATE_REQUEST_BODY_VALUE,(function(e,t){var n=t.payload,r=n.value,a=n.pathMethod,o=u()(a,2),i=o[0],c=o[1];if(!y.Map.isMap(r))return e.setIn(["requestData",i,c,"bodyValue"],r);var l,f=e.getIn(["requestData",i,c,"bodyValue"])||Object(y.Map)();y.Map.isMap(f)||(f=Object(y.Map)());var h=p()(r).call(r),v=s()(h),g=d()(v).call(v,0);return m()(g).call(g,(function(e){var t=r.getIn([e]);f.has(e)&&y.Map.isMap(t)||(l=f.setIn([e,"value"],t))})),e.setIn(["requestData",i,c,"bodyValue"],l)})),o()(r,b.UPDATE_REQUEST_BODY_VALUE_RETAIN_FLAG,(function(e,t){var n=t.payload,r=n.value,a=n.pathMethod,o=u()(a,2),i=o[0],s=o[1];return e.setIn(["requestData",i,s,"retainBodyValue"],r)})),o()(r,b.UPDATE_REQUEST_BODY_INCLUSION,(function(e,t){var n=t.payload,r=n.value,a=n.pathMethod,o=n.name,i=u()(a,2),s=i[0],c=i[1];return e.setIn(["requestData",s,c,"bodyInclusion",o],r)})),o()(r,b.UPDATE_ACTIVE_EXAMPLES_MEMBER,(function(e,t){var n=t.payload,r=n.name,a=n.pathMethod,o=n.contextType,i=n.contextName,s=u()(a,2),c=s[0],l=s[1];return e.setIn(["examples",c,l,o,i,"activeExample"],r)})),o()(r,b.UPDATE_REQUEST_CONTENT_TYPE,(function(e,t){var n=t.payload,r=n.value,a=n.pathMethod,o=u()(a,2),i=o[0],s=o[1];return e.setIn(["requestData",i,s,"requestContentType"],r)})),o()(r,b.UPDATE_RESPONSE_CONTENT_TYPE,(function(e,t){var n=t.payload,r=n.value,a=n.path,o=n.method;return e.setIn(["requestData",a,o,"responseContentType"],r)})),o()(r,b.UPDATE_SERVER_VARIABLE_VALUE,(function(e,t){var n=t.payload,r=n.server,a=n.namespace,o=n.key,i=n.val,s=a?[a,"serverVariableValues",r,o]:["serverVariableValues",r,o];return e.setIn(s,i)})),o()(r,b.SET_REQUEST_BODY_VALIDATE_ERROR,(function(e,t){var n=t.payload,r=n.path,a=n.method,o=n.validationErrors,i=[];if(i.push("Required field is not provided"),o.missingBodyValue)return e.setIn(["requestData",r,a,"errors"],Object(y.fromJS)(i));if(o.missingRequiredKeys&&o.missingRequiredKeys.length>0){var s=o.missingRequiredKeys;return e.updateIn(["requestData",r,a,"bodyValue"],Object(y.fromJS)({}),(function(e){return g()(s).call(s,(function(e,t){return e.setIn([t,"errors"],Object(y.fromJS)(i))}),e)}))}return console.warn("unexpected result: SET_REQUEST_BODY_VALIDATE_ERROR"),e})),o()(r,b.CLEAR_REQUEST_BODY_VALIDATE_ERROR,(function(e,t){var n=t.payload,r=n.path,a=n.method,o=e.getIn(["requestData",r,a,"bodyValue"]);if(!y.Map.isMap(o))return e.setIn(["requestData",r,a,"errors"],Object(y.fromJS)([]));var i=p()(o).call(o),c=s()(i),u=d()(c).call(c,0);return u?e.updateIn(["requestData",r,a,"bodyValue"],Object(y.fromJS)({}),(function(e){return g()(u).call(u,(function(e,t){return e.setIn([t,"errors"],Object(y.fromJS)([]))}),e)})):e})),o()(r,b.CLEAR_REQUEST_BODY_VALUE,(function(e,t){var n=t.payload.pathMethod,r=u()(n,2),a=r[0],o=r[1],i=e.getIn(["requestData",a,o,"bodyValue"]);return i?y.Map.isMap(i)?e.setIn(["requestData",a,o,"bodyValue"],Object(y.Map)()):e.setIn(["requestData",a,o,"bodyValue"],""):e})),r)},function(e,t,n){"use strict";n.r(t);var r,a=n(22),o=n.n(a),i=n(107),s=n.n(i),c=n(5),u=n(773),l={};o()(r=s()(u).call(u)).call(r,(function(e){if("./index.js"!==e){var t=u(e);l[Object(c.D)(e)]=t.default?t.default:t}})),t.default=l},function(e,t,n){"use strict";n.r(t);var r={};n.r(r),n.d(r,"path",(function(){return Gt})),n.d(r,"query",(function(){return Zt})),n.d(r,"header",(function(){return Qt})),n.d(r,"cookie",(function(){return en}));var a=n(2),o=n.n(a),i=n(65),s=n.n(i),c=n(37),u=n.n(c),l=n(45),p=n.n(l),f=n(18),d=n.n(f),h=n(13),m=n.n(h),v=n(29),g=n.n(v),y=n(72),b=n.n(y),E=n(91),x=n.n(E),S=n(30),w=n.n(S),j=n(4),O=n.n(j),C=n(15),_=n.n(C),A=n(174),k=n.n(A),I=n(12),P=n.n(I),N=(n(377),n(182)),T=n.n(N),R=n(63),M=n.n(R),q=n(82),D=n(14),B=n.n(D),L=n(19),U=n.n(L),V=function(e){return":/?#[]@!$&'()*+,;=".indexOf(e)>-1},z=function(e){return/^[a-z0-9\-._~]+$/i.test(e)};function F(e){var t,n=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{},r=n.escape,a=arguments.length>2?arguments[2]:void 0;return"number"==typeof e&&(e=e.toString()),"string"==typeof e&&e.length&&r?a?JSON.parse(e):O()(t=B()(e)).call(t,(function(e){var t,n;if(z(e))return e;if(V(e)&&"unsafe"===r)return e;var a=new TextEncoder;return O()(t=O()(n=x()(a.encode(e))).call(n,(function(e){var t;return U()(t="0".concat(e.toString(16).toUpperCase())).call(t,-2)}))).call(t,(function(e){return"%".concat(e)})).join("")})).join(""):e}function J(e){var t=e.value;return Array.isArray(t)?function(e){var t=e.key,n=e.value,r=e.style,a=e.explode,i=e.escape,s=function(e){return F(e,{escape:i})};if("simple"===r)return O()(n).call(n,(function(e){return s(e)})).join(",");if("label"===r)return".".concat(O()(n).call(n,(function(e){return s(e)})).join("."));if("matrix"===r)return O()(n).call(n,(function(e){return s(e)})).reduce((function(e,n){var r,i,s;return!e||a?o()(i=o()(s="".concat(e||"",";")).call(s,t,"=")).call(i,n):o()(r="".concat(e,",")).call(r,n)}),"");if("form"===r){var c=a?"&".concat(t,"="):",";return O()(n).call(n,(function(e){return s(e)})).join(c)}if("spaceDelimited"===r){var u=a?"".concat(t,"="):"";return O()(n).call(n,(function(e){return s(e)})).join(" ".concat(u))}if("pipeDelimited"===r){var l=a?"".concat(t,"="):"";return O()(n).call(n,(function(e){return s(e)})).join("|".concat(l))}return}(e):"object"===d()(t)?function(e){var t=e.key,n=e.value,r=e.style,a=e.explode,i=e.escape,s=function(e){return F(e,{escape:i})},c=_()(n);if("simple"===r)return c.reduce((function(e,t){var r,i,c,u=s(n[t]),l=a?"=":",",p=e?"".concat(e,","):"";return o()(r=o()(i=o()(c="".concat(p)).call(c,t)).call(i,l)).call(r,u)}),"");if("label"===r)return c.reduce((function(e,t){var r,i,c,u=s(n[t]),l=a?"=":".",p=e?"".concat(e,"."):".";return o()(r=o()(i=o()(c="".concat(p)).call(c,t)).call(i,l)).call(r,u)}),"");if("matrix"===r&&a)return c.reduce((function(e,t){var r,a,i=s(n[t]),c=e?"".concat(e,";"):";";return o()(r=o()(a="".concat(c)).call(a,t,"=")).call(r,i)}),"");if("matrix"===r)return c.reduce((function(e,r){var a,i,c=s(n[r]),u=e?"".concat(e,","):";".concat(t,"=");return o()(a=o()(i="".concat(u)).call(i,r,",")).call(a,c)}),"");if("form"===r)return c.reduce((function(e,t){var r,i,c,u,l=s(n[t]),p=e?o()(r="".concat(e)).call(r,a?"&":","):"",f=a?"=":",";return o()(i=o()(c=o()(u="".concat(p)).call(u,t)).call(c,f)).call(i,l)}),"");return}(e):function(e){var t,n=e.key,r=e.value,a=e.style,i=e.escape,s=function(e){return F(e,{escape:i})};if("simple"===a)return s(r);if("label"===a)return".".concat(s(r));if("matrix"===a)return o()(t=";".concat(n,"=")).call(t,s(r));if("form"===a)return s(r);if("deepObject"===a)return s(r,{},!0);return}(e)}var W=function(e,t){t.body=e},H={serializeRes:Z,mergeInQueryOrForm:ce};function $(e){return Y.apply(this,arguments)}function Y(){return(Y=s()(u.a.mark((function e(t){var n,r,a,o,i,s=arguments;return u.a.wrap((function(e){for(;;)switch(e.prev=e.next){case 0:if(n=s.length>1&&void 0!==s[1]?s[1]:{},"object"===d()(t)&&(t=(n=t).url),n.headers=n.headers||{},H.mergeInQueryOrForm(n),n.headers&&_()(n.headers).forEach((function(e){var t=n.headers[e];"string"==typeof t&&(n.headers[e]=t.replace(/\n+/g," "))})),!n.requestInterceptor){e.next=12;break}return e.next=8,n.requestInterceptor(n);case 8:if(e.t0=e.sent,e.t0){e.next=11;break}e.t0=n;case 11:n=e.t0;case 12:return r=n.headers["content-type"]||n.headers["Content-Type"],/multipart\/form-data/i.test(r)&&n.body instanceof q.FormData&&(delete n.headers["content-type"],delete n.headers["Content-Type"]),e.prev=14,e.next=17,(n.userFetch||fetch)(n.url,n);case 17:return a=e.sent,e.next=20,H.serializeRes(a,t,n);case 20:if(a=e.sent,!n.responseInterceptor){e.next=28;break}return e.next=24,n.responseInterceptor(a);case 24:if(e.t1=e.sent,e.t1){e.next=27;break}e.t1=a;case 27:a=e.t1;case 28:e.next=39;break;case 30:if(e.prev=30,e.t2=e.catch(14),a){e.next=34;break}throw e.t2;case 34:throw(o=new Error(a.statusText||"response status is ".concat(a.status))).status=a.status,o.statusCode=a.status,o.responseError=e.t2,o;case 39:if(a.ok){e.next=45;break}throw(i=new Error(a.statusText||"response status is ".concat(a.status))).status=a.status,i.statusCode=a.status,i.response=a,i;case 45:return e.abrupt("return",a);case 46:case"end":return e.stop()}}),e,null,[[14,30]])})))).apply(this,arguments)}var K=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:"";return/(json|xml|yaml|text)\b/.test(e)};function G(e,t){return t&&(0===t.indexOf("application/json")||t.indexOf("+json")>0)?JSON.parse(e):M.a.load(e)}function Z(e,t){var n=arguments.length>2&&void 0!==arguments[2]?arguments[2]:{},r=n.loadSpec,a=void 0!==r&&r,o={ok:e.ok,url:e.url||t,status:e.status,statusText:e.statusText,headers:Q(e.headers)},i=o.headers["content-type"],s=a||K(i),c=s?e.text:e.blob||e.buffer;return c.call(e).then((function(e){if(o.text=e,o.data=e,s)try{var t=G(e,i);o.body=t,o.obj=t}catch(e){o.parseError=e}return o}))}function X(e){return g()(e).call(e,", ")?e.split(", "):e}function Q(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{};return"function"!=typeof b()(e)?{}:x()(b()(e).call(e)).reduce((function(e,t){var n=m()(t,2),r=n[0],a=n[1];return e[r]=X(a),e}),{})}function ee(e,t){return t||"undefined"==typeof navigator||(t=navigator),t&&"ReactNative"===t.product?!(!e||"object"!==d()(e)||"string"!=typeof e.uri):void 0!==q.File&&e instanceof q.File||(void 0!==q.Blob&&e instanceof q.Blob||(!!ArrayBuffer.isView(e)||null!==e&&"object"===d()(e)&&"function"==typeof e.pipe))}function te(e,t){return Array.isArray(e)&&e.some((function(e){return ee(e,t)}))}var ne={form:",",spaceDelimited:"%20",pipeDelimited:"|"},re={csv:",",ssv:"%20",tsv:"%09",pipes:"|"};function ae(e,t){var n=arguments.length>2&&void 0!==arguments[2]&&arguments[2],r=t.collectionFormat,a=t.allowEmptyValue,o=t.serializationOption,i=t.encoding,s="object"!==d()(t)||Array.isArray(t)?t:t.value,c=n?function(e){return e.toString()}:function(e){return encodeURIComponent(e)},u=c(e);if(void 0===s&&a)return[[u,""]];if(ee(s)||te(s))return[[u,s]];if(o)return oe(e,s,n,o);if(i){if([d()(i.style),d()(i.explode),d()(i.allowReserved)].some((function(e){return"undefined"!==e}))){var l=i.style,p=i.explode,f=i.allowReserved;return oe(e,s,n,{style:l,explode:p,allowReserved:f})}if(i.contentType){if("application/json"===i.contentType){var h="string"==typeof s?s:w()(s);return[[u,c(h)]]}return[[u,c(s.toString())]]}return"object"!==d()(s)?[[u,c(s)]]:Array.isArray(s)&&s.every((function(e){return"object"!==d()(e)}))?[[u,O()(s).call(s,c).join(",")]]:[[u,c(w()(s))]]}return"object"!==d()(s)?[[u,c(s)]]:Array.isArray(s)?"multi"===r?[[u,O()(s).call(s,c)]]:[[u,O()(s).call(s,c).join(re[r||"csv"])]]:[[u,""]]}function oe(e,t,n,r){var a,i,s,c=r.style||"form",u=void 0===r.explode?"form"===c:r.explode,l=!n&&(r&&r.allowReserved?"unsafe":"reserved"),p=function(e){return F(e,{escape:l})},f=n?function(e){return e}:function(e){return F(e,{escape:l})};return"object"!==d()(t)?[[f(e),p(t)]]:Array.isArray(t)?u?[[f(e),O()(t).call(t,p)]]:[[f(e),O()(t).call(t,p).join(ne[c])]]:"deepObject"===c?O()(i=_()(t)).call(i,(function(n){var r;return[f(o()(r="".concat(e,"[")).call(r,n,"]")),p(t[n])]})):u?O()(s=_()(t)).call(s,(function(e){return[f(e),p(t[e])]})):[[f(e),O()(a=_()(t)).call(a,(function(e){var n;return[o()(n="".concat(f(e),",")).call(n,p(t[e]))]})).join(",")]]}function ie(e){return k()(e).reduce((function(e,t){var n,r=m()(t,2),a=r[0],o=r[1],i=p()(ae(a,o,!0));try{for(i.s();!(n=i.n()).done;){var s=m()(n.value,2),c=s[0],u=s[1];if(Array.isArray(u)){var l,f=p()(u);try{for(f.s();!(l=f.n()).done;){var d=l.value;if(ArrayBuffer.isView(d)){var h=new q.Blob([d]);e.append(c,h)}else e.append(c,d)}}catch(e){f.e(e)}finally{f.f()}}else if(ArrayBuffer.isView(u)){var v=new q.Blob([u]);e.append(c,v)}else e.append(c,u)}}catch(e){i.e(e)}finally{i.f()}return e}),new q.FormData)}function se(e){var t=_()(e).reduce((function(t,n){var r,a=p()(ae(n,e[n]));try{for(a.s();!(r=a.n()).done;){var o=m()(r.value,2),i=o[0],s=o[1];t[i]=s}}catch(e){a.e(e)}finally{a.f()}return t}),{});return T.a.stringify(t,{encode:!1,indices:!1})||""}function ce(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{},t=e.url,n=void 0===t?"":t,r=e.query,a=e.form,o=function(){for(var e=arguments.length,t=new Array(e),n=0;n<e;n++)t[n]=arguments[n];var r=P()(t).call(t,(function(e){return e})).join("&");return r?"?".concat(r):""};if(a){var i=_()(a).some((function(e){var t=a[e].value;return ee(t)||te(t)})),s=e.headers["content-type"]||e.headers["Content-Type"];if(i||/multipart\/form-data/i.test(s)){var c=ie(e.form);W(c,e)}else e.body=se(a);delete e.form}if(r){var u=n.split("?"),l=m()(u,2),p=l[0],f=l[1],d="";if(f){var h=T.a.parse(f),v=_()(r);v.forEach((function(e){return delete h[e]})),d=T.a.stringify(h,{encode:!0})}var g=o(d,se(r));e.url=p+g,delete e.query}return e}var ue=n(24),le=n.n(ue),pe=n(6),fe=n.n(pe),de=n(7),he=n.n(de),me=n(20),ve=n.n(me),ge=n(52),ye=n.n(ge),be=n(57),Ee=n.n(be),xe=n(175),Se=n.n(xe),we=n(3),je=n.n(we),Oe=n(126),Ce=n(71),_e=n.n(Ce),Ae=n(402),ke=n.n(Ae),Ie={add:function(e,t){return{op:"add",path:e,value:t}},replace:Ne,remove:function(e){return{op:"remove",path:e}},merge:function(e,t){return{type:"mutation",op:"merge",path:e,value:t}},mergeDeep:function(e,t){return{type:"mutation",op:"mergeDeep",path:e,value:t}},context:function(e,t){return{type:"context",path:e,value:t}},getIn:function(e,t){return t.reduce((function(e,t){return void 0!==t&&e?e[t]:e}),e)},applyPatch:function(e,t,n){if(n=n||{},"merge"===(t=le()(le()({},t),{},{path:t.path&&Pe(t.path)})).op){var r=We(e,t.path);ve()(r,t.value),Oe.applyPatch(e,[Ne(t.path,r)])}else if("mergeDeep"===t.op){var a=We(e,t.path);for(var i in t.value){var s=t.value[i],c=Array.isArray(s);if(c){var u=a[i]||[];a[i]=o()(u).call(u,s)}else if(Le(s)&&!c){var l=le()({},a[i]);for(var p in s){if(Object.prototype.hasOwnProperty.call(l,p)){l=_e()(ke()(l),s);break}ve()(l,je()({},p,s[p]))}a[i]=l}else a[i]=s}}else if("add"===t.op&&""===t.path&&Le(t.value)){var f=_()(t.value).reduce((function(e,n){return e.push({op:"add",path:"/".concat(Pe(n)),value:t.value[n]}),e}),[]);Oe.applyPatch(e,f)}else if("replace"===t.op&&""===t.path){var d=t.value;n.allowMetaPatches&&t.meta&&Fe(t)&&(Array.isArray(t.value)||Le(t.value))&&(d=le()(le()({},d),t.meta)),e=d}else if(Oe.applyPatch(e,[t]),n.allowMetaPatches&&t.meta&&Fe(t)&&(Array.isArray(t.value)||Le(t.value))){var h=We(e,t.path),m=le()(le()({},h),t.meta);Oe.applyPatch(e,[Ne(t.path,m)])}return e},parentPathMatch:function(e,t){if(!Array.isArray(t))return!1;for(var n=0,r=t.length;n<r;n+=1)if(t[n]!==e[n])return!1;return!0},flatten:De,fullyNormalizeArray:function(e){return Be(De(qe(e)))},normalizeArray:qe,isPromise:function(e){return Le(e)&&Ue(e.then)},forEachNew:function(e,t){try{return Te(e,Me,t)}catch(e){return e}},forEachNewPrimitive:function(e,t){try{return Te(e,Re,t)}catch(e){return e}},isJsonPatch:Ve,isContextPatch:function(e){return Je(e)&&"context"===e.type},isPatch:Je,isMutation:ze,isAdditiveMutation:Fe,isGenerator:function(e){return"[object GeneratorFunction]"===Object.prototype.toString.call(e)},isFunction:Ue,isObject:Le,isError:function(e){return e instanceof Error}};function Pe(e){return Array.isArray(e)?e.length<1?"":"/".concat(O()(e).call(e,(function(e){return(e+"").replace(/~/g,"~0").replace(/\//g,"~1")})).join("/")):e}function Ne(e,t,n){return{op:"replace",path:e,value:t,meta:n}}function Te(e,t,n){var r;return Be(De(O()(r=P()(e).call(e,Fe)).call(r,(function(e){return t(e.value,n,e.path)}))||[]))}function Re(e,t,n){return n=n||[],Array.isArray(e)?O()(e).call(e,(function(e,r){return Re(e,t,o()(n).call(n,r))})):Le(e)?O()(r=_()(e)).call(r,(function(r){return Re(e[r],t,o()(n).call(n,r))})):t(e,n[n.length-1],n);var r}function Me(e,t,n){var r=[];if((n=n||[]).length>0){var a=t(e,n[n.length-1],n);a&&(r=o()(r).call(r,a))}if(Array.isArray(e)){var i=O()(e).call(e,(function(e,r){return Me(e,t,o()(n).call(n,r))}));i&&(r=o()(r).call(r,i))}else if(Le(e)){var s,c=O()(s=_()(e)).call(s,(function(r){return Me(e[r],t,o()(n).call(n,r))}));c&&(r=o()(r).call(r,c))}return r=De(r)}function qe(e){return Array.isArray(e)?e:[e]}function De(e){var t;return o()(t=[]).apply(t,B()(O()(e).call(e,(function(e){return Array.isArray(e)?De(e):e}))))}function Be(e){return P()(e).call(e,(function(e){return void 0!==e}))}function Le(e){return e&&"object"===d()(e)}function Ue(e){return e&&"function"==typeof e}function Ve(e){if(Je(e)){var t=e.op;return"add"===t||"remove"===t||"replace"===t}return!1}function ze(e){return Ve(e)||Je(e)&&"mutation"===e.type}function Fe(e){return ze(e)&&("add"===e.op||"replace"===e.op||"merge"===e.op||"mergeDeep"===e.op)}function Je(e){return e&&"object"===d()(e)}function We(e,t){try{return Oe.getValueByPointer(e,t)}catch(e){return console.error(e),{}}}var He=n(403),$e=n.n(He),Ye=n(285),Ke=n.n(Ye),Ge=n(73),Ze=n.n(Ge);function Xe(e,t){function n(){Error.captureStackTrace?Error.captureStackTrace(this,this.constructor):this.stack=(new Error).stack;for(var e=arguments.length,n=new Array(e),r=0;r<e;r++)n[r]=arguments[r];this.message=n[0],t&&t.apply(this,n)}return n.prototype=new Error,n.prototype.name=e,n.prototype.constructor=n,n}var Qe=n(404),et=n.n(Qe),tt=["properties"],nt=["properties"],rt=["definitions","parameters","responses","securityDefinitions","components/schemas","components/responses","components/parameters","components/securitySchemes"],at=["schema/example","items/example"];function ot(e){var t=e[e.length-1],n=e[e.length-2],r=e.join("/");return tt.indexOf(t)>-1&&-1===nt.indexOf(n)||rt.indexOf(r)>-1||at.some((function(e){return r.indexOf(e)>-1}))}function it(e,t){var n,r=e.split("#"),a=m()(r,2),i=a[0],s=a[1],c=Ze.a.resolve(i||"",t||"");return s?o()(n="".concat(c,"#")).call(n,s):c}var st="application/json, application/yaml",ct=new RegExp("^([a-z]+://|//)","i"),ut=Xe("JSONRefError",(function(e,t,n){this.originalError=n,ve()(this,t||{})})),lt={},pt=new $e.a,ft=[function(e){return"paths"===e[0]&&"responses"===e[3]&&"examples"===e[5]},function(e){return"paths"===e[0]&&"responses"===e[3]&&"content"===e[5]&&"example"===e[7]},function(e){return"paths"===e[0]&&"responses"===e[3]&&"content"===e[5]&&"examples"===e[7]&&"value"===e[9]},function(e){return"paths"===e[0]&&"requestBody"===e[3]&&"content"===e[4]&&"example"===e[6]},function(e){return"paths"===e[0]&&"requestBody"===e[3]&&"content"===e[4]&&"examples"===e[6]&&"value"===e[8]},function(e){return"paths"===e[0]&&"parameters"===e[2]&&"example"===e[4]},function(e){return"paths"===e[0]&&"parameters"===e[3]&&"example"===e[5]},function(e){return"paths"===e[0]&&"parameters"===e[2]&&"examples"===e[4]&&"value"===e[6]},function(e){return"paths"===e[0]&&"parameters"===e[3]&&"examples"===e[5]&&"value"===e[7]},function(e){return"paths"===e[0]&&"parameters"===e[2]&&"content"===e[4]&&"example"===e[6]},function(e){return"paths"===e[0]&&"parameters"===e[2]&&"content"===e[4]&&"examples"===e[6]&&"value"===e[8]},function(e){return"paths"===e[0]&&"parameters"===e[3]&&"content"===e[4]&&"example"===e[7]},function(e){return"paths"===e[0]&&"parameters"===e[3]&&"content"===e[5]&&"examples"===e[7]&&"value"===e[9]}],dt={key:"$ref",plugin:function(e,t,n,r){var a=r.getInstance(),i=U()(n).call(n,0,-1);if(!ot(i)&&(s=i,!ft.some((function(e){return e(s)})))){var s,c=r.getContext(n).baseDoc;if("string"!=typeof e)return new ut("$ref: must be a string (JSON-Ref)",{$ref:e,baseDoc:c,fullPath:n});var u,l,p,f=yt(e),d=f[0],h=f[1]||"";try{u=c||d?vt(d,c):null}catch(t){return gt(t,{pointer:h,$ref:e,basePath:u,fullPath:n})}if(function(e,t,n,r){var a,i,s=pt.get(r);s||(s={},pt.set(r,s));var c=function(e){if(0===e.length)return"";return"/".concat(O()(e).call(e,jt).join("/"))}(n),u=o()(a="".concat(t||"<specmap-base>","#")).call(a,e),l=c.replace(/allOf\/\d+\/?/g,""),p=r.contextTree.get([]).baseDoc;if(t===p&&Ot(l,e))return!0;var f="";if(n.some((function(e){var t;return f=o()(t="".concat(f,"/")).call(t,jt(e)),s[f]&&s[f].some((function(e){return Ot(e,u)||Ot(u,e)}))})))return!0;return void(s[l]=o()(i=s[l]||[]).call(i,u))}(h,u,i,r)&&!a.useCircularStructures){var m=it(e,u);return e===m?null:Ie.replace(n,m)}if(null==u?(p=St(h),void 0===(l=r.get(p))&&(l=new ut("Could not resolve reference: ".concat(e),{pointer:h,$ref:e,baseDoc:c,fullPath:n}))):l=null!=(l=bt(u,h)).__value?l.__value:l.catch((function(t){throw gt(t,{pointer:h,$ref:e,baseDoc:c,fullPath:n})})),l instanceof Error)return[Ie.remove(n),l];var v=it(e,u),g=Ie.replace(i,l,{$$ref:v});if(u&&u!==c)return[g,Ie.context(i,{baseDoc:u})];try{if(!function(e,t){var n=[e];return t.path.reduce((function(e,t){return n.push(e[t]),e[t]}),e),r(t.value);function r(e){return Ie.isObject(e)&&(n.indexOf(e)>=0||_()(e).some((function(t){return r(e[t])})))}}(r.state,g)||a.useCircularStructures)return g}catch(e){return null}}}},ht=ve()(dt,{docCache:lt,absoluteify:vt,clearCache:function(e){void 0!==e?delete lt[e]:_()(lt).forEach((function(e){delete lt[e]}))},JSONRefError:ut,wrapError:gt,getDoc:Et,split:yt,extractFromDoc:bt,fetchJSON:function(e){return fetch(e,{headers:{Accept:st},loadSpec:!0}).then((function(e){return e.text()})).then((function(e){return M.a.load(e)}))},extract:xt,jsonPointerToArray:St,unescapeJsonPointerToken:wt}),mt=ht;function vt(e,t){if(!ct.test(e)){var n;if(!t)throw new ut(o()(n="Tried to resolve a relative URL, without having a basePath. path: '".concat(e,"' basePath: '")).call(n,t,"'"));return Ze.a.resolve(t,e)}return e}function gt(e,t){var n,r;e&&e.response&&e.response.body?n=o()(r="".concat(e.response.body.code," ")).call(r,e.response.body.message):n=e.message;return new ut("Could not resolve reference: ".concat(n),t,e)}function yt(e){return(e+"").split("#")}function bt(e,t){var n=lt[e];if(n&&!Ie.isPromise(n))try{var r=xt(t,n);return ve()(Ee.a.resolve(r),{__value:r})}catch(e){return Ee.a.reject(e)}return Et(e).then((function(e){return xt(t,e)}))}function Et(e){var t=lt[e];return t?Ie.isPromise(t)?t:Ee.a.resolve(t):(lt[e]=ht.fetchJSON(e).then((function(t){return lt[e]=t,t})),lt[e])}function xt(e,t){var n=St(e);if(n.length<1)return t;var r=Ie.getIn(t,n);if(void 0===r)throw new ut("Could not resolve pointer: ".concat(e," does not exist in document"),{pointer:e});return r}function St(e){var t;if("string"!=typeof e)throw new TypeError("Expected a string, got a ".concat(d()(e)));return"/"===e[0]&&(e=e.substr(1)),""===e?[]:O()(t=e.split("/")).call(t,wt)}function wt(e){return"string"!=typeof e?e:new Ke.a("=".concat(e.replace(/~1/g,"/").replace(/~0/g,"~"))).get("")}function jt(e){var t,n=new Ke.a([["",e.replace(/~/g,"~0").replace(/\//g,"~1")]]);return U()(t=n.toString()).call(t,1)}function Ot(e,t){if(!(n=t)||"/"===n||"#"===n)return!0;var n,r=e.charAt(t.length),a=U()(t).call(t,-1);return 0===e.indexOf(t)&&(!r||"/"===r||"#"===r)&&"#"!==a}var Ct=n(95),_t=n.n(Ct),At={key:"allOf",plugin:function(e,t,n,r,a){if(!a.meta||!a.meta.$$ref){var i=U()(n).call(n,0,-1);if(!ot(i)){if(!Array.isArray(e)){var s=new TypeError("allOf must be an array");return s.fullPath=n,s}var c=!1,u=a.value;if(i.forEach((function(e){u&&(u=u[e])})),u=le()({},u),!_t()(u)){delete u.allOf;var l,p=[];if(p.push(r.replace(i,{})),e.forEach((function(e,t){if(!r.isObject(e)){if(c)return null;c=!0;var a=new TypeError("Elements in allOf must be objects");return a.fullPath=n,p.push(a)}p.push(r.mergeDeep(i,e));var s=function(e,t){var n=arguments.length>2&&void 0!==arguments[2]?arguments[2]:{},r=n.specmap,a=n.getBaseUrlForNodePath,i=void 0===a?function(e){var n;return r.getContext(o()(n=[]).call(n,B()(t),B()(e))).baseDoc}:a,s=n.targetKeys,c=void 0===s?["$ref","$$ref"]:s,u=[];return et()(e).forEach((function(){if(g()(c).call(c,this.key)&&"string"==typeof this.node){var e=this.path,n=o()(t).call(t,this.path),a=it(this.node,i(e));u.push(r.replace(n,a))}})),u}(e,U()(n).call(n,0,-1),{getBaseUrlForNodePath:function(e){var a;return r.getContext(o()(a=[]).call(a,B()(n),[t],B()(e))).baseDoc},specmap:r});p.push.apply(p,B()(s))})),p.push(r.mergeDeep(i,u)),!u.$$ref)p.push(r.remove(o()(l=[]).call(l,i,"$$ref")));return p}}}}},kt={key:"parameters",plugin:function(e,t,n,r){if(Array.isArray(e)&&e.length){var a=ve()([],e),o=U()(n).call(n,0,-1),i=le()({},Ie.getIn(r.spec,o));return e.forEach((function(e,t){try{a[t].default=r.parameterMacro(i,e)}catch(e){var o=new Error(e);return o.fullPath=n,o}})),Ie.replace(n,a)}return Ie.replace(n,e)}},It={key:"properties",plugin:function(e,t,n,r){var a=le()({},e);for(var o in e)try{a[o].default=r.modelPropertyMacro(a[o])}catch(e){var i=new Error(e);return i.fullPath=n,i}return Ie.replace(n,a)}},Pt=function(){function e(t){fe()(this,e),this.root=Nt(t||{})}return he()(e,[{key:"set",value:function(e,t){var n=this.getParent(e,!0);if(n){var r=e[e.length-1],a=n.children;a[r]?Tt(a[r],t,n):a[r]=Nt(t,n)}else Tt(this.root,t,null)}},{key:"get",value:function(e){if((e=e||[]).length<1)return this.root.value;for(var t,n,r=this.root,a=0;a<e.length&&(n=e[a],(t=r.children)[n]);a+=1)r=t[n];return r&&r.protoValue}},{key:"getParent",value:function(e,t){return!e||e.length<1?null:e.length<2?this.root:U()(e).call(e,0,-1).reduce((function(e,n){if(!e)return e;var r=e.children;return!r[n]&&t&&(r[n]=Nt(null,e)),r[n]}),this.root)}}]),e}();function Nt(e,t){return Tt({children:{}},e,t)}function Tt(e,t,n){return e.value=t||{},e.protoValue=n?le()(le()({},n.protoValue),e.value):e.value,_()(e.children).forEach((function(t){var n=e.children[t];e.children[t]=Tt(n,n.value,e)})),e}var Rt=function(){},Mt=function(){function e(t){var n,r,a=this;fe()(this,e),ve()(this,{spec:"",debugLevel:"info",plugins:[],pluginHistory:{},errors:[],mutations:[],promisedPatches:[],state:{},patches:[],context:{},contextTree:new Pt,showDebug:!1,allPatches:[],pluginProp:"specMap",libMethods:ve()(Object.create(this),Ie,{getInstance:function(){return a}}),allowMetaPatches:!1},t),this.get=this._get.bind(this),this.getContext=this._getContext.bind(this),this.hasRun=this._hasRun.bind(this),this.wrappedPlugins=P()(n=O()(r=this.plugins).call(r,this.wrapPlugin.bind(this))).call(n,Ie.isFunction),this.patches.push(Ie.add([],this.spec)),this.patches.push(Ie.context([],this.context)),this.updatePatches(this.patches)}return he()(e,[{key:"debug",value:function(e){if(this.debugLevel===e){for(var t,n=arguments.length,r=new Array(n>1?n-1:0),a=1;a<n;a++)r[a-1]=arguments[a];(t=console).log.apply(t,r)}}},{key:"verbose",value:function(e){if("verbose"===this.debugLevel){for(var t,n,r=arguments.length,a=new Array(r>1?r-1:0),i=1;i<r;i++)a[i-1]=arguments[i];(t=console).log.apply(t,o()(n=["[".concat(e,"]   ")]).call(n,a))}}},{key:"wrapPlugin",value:function(e,t){var n,r,a,i=this.pathDiscriminator,s=null;return e[this.pluginProp]?(s=e,n=e[this.pluginProp]):Ie.isFunction(e)?n=e:Ie.isObject(e)&&(r=e,a=function(e,t){return!Array.isArray(e)||e.every((function(e,n){return e===t[n]}))},n=u.a.mark((function e(t,n){var s,c,l,f,d,h;return u.a.wrap((function(e){for(;;)switch(e.prev=e.next){case 0:h=function(e,t,l){var p,f,d,m,v,g,y,b,E,x,S,w,j;return u.a.wrap((function(s){for(;;)switch(s.prev=s.next){case 0:if(Ie.isObject(e)){s.next=6;break}if(r.key!==t[t.length-1]){s.next=4;break}return s.next=4,r.plugin(e,r.key,t,n);case 4:s.next=30;break;case 6:p=t.length-1,f=t[p],d=t.indexOf("properties"),m="properties"===f&&p===d,v=n.allowMetaPatches&&c[e.$$ref],g=0,y=_()(e);case 12:if(!(g<y.length)){s.next=30;break}if(b=y[g],E=e[b],x=o()(t).call(t,b),S=Ie.isObject(E),w=e.$$ref,v){s.next=22;break}if(!S){s.next=22;break}return n.allowMetaPatches&&w&&(c[w]=!0),s.delegateYield(h(E,x,l),"t0",22);case 22:if(m||b!==r.key){s.next=27;break}if(j=a(i,t),i&&!j){s.next=27;break}return s.next=27,r.plugin(E,b,x,n,l);case 27:g++,s.next=12;break;case 30:case"end":return s.stop()}}),s)},s=u.a.mark(h),c={},l=p()(P()(t).call(t,Ie.isAdditiveMutation)),e.prev=4,l.s();case 6:if((f=l.n()).done){e.next=11;break}return d=f.value,e.delegateYield(h(d.value,d.path,d),"t0",9);case 9:e.next=6;break;case 11:e.next=16;break;case 13:e.prev=13,e.t1=e.catch(4),l.e(e.t1);case 16:return e.prev=16,l.f(),e.finish(16);case 19:case"end":return e.stop()}}),e,null,[[4,13,16,19]])}))),ve()(n.bind(s),{pluginName:e.name||t,isGenerator:Ie.isGenerator(n)})}},{key:"nextPlugin",value:function(){var e,t=this;return ye()(e=this.wrappedPlugins).call(e,(function(e){return t.getMutationsForPlugin(e).length>0}))}},{key:"nextPromisedPatch",value:function(){var e;if(this.promisedPatches.length>0)return Ee.a.race(O()(e=this.promisedPatches).call(e,(function(e){return e.value})))}},{key:"getPluginHistory",value:function(e){var t=this.constructor.getPluginName(e);return this.pluginHistory[t]||[]}},{key:"getPluginRunCount",value:function(e){return this.getPluginHistory(e).length}},{key:"getPluginHistoryTip",value:function(e){var t=this.getPluginHistory(e);return t&&t[t.length-1]||{}}},{key:"getPluginMutationIndex",value:function(e){var t=this.getPluginHistoryTip(e).mutationIndex;return"number"!=typeof t?-1:t}},{key:"updatePluginHistory",value:function(e,t){var n=this.constructor.getPluginName(e);this.pluginHistory[n]=this.pluginHistory[n]||[],this.pluginHistory[n].push(t)}},{key:"updatePatches",value:function(e){var t=this;Ie.normalizeArray(e).forEach((function(e){if(e instanceof Error)t.errors.push(e);else try{if(!Ie.isObject(e))return void t.debug("updatePatches","Got a non-object patch",e);if(t.showDebug&&t.allPatches.push(e),Ie.isPromise(e.value))return t.promisedPatches.push(e),void t.promisedPatchThen(e);if(Ie.isContextPatch(e))return void t.setContext(e.path,e.value);if(Ie.isMutation(e))return void t.updateMutations(e)}catch(e){console.error(e),t.errors.push(e)}}))}},{key:"updateMutations",value:function(e){"object"===d()(e.value)&&!Array.isArray(e.value)&&this.allowMetaPatches&&(e.value=le()({},e.value));var t=Ie.applyPatch(this.state,e,{allowMetaPatches:this.allowMetaPatches});t&&(this.mutations.push(e),this.state=t)}},{key:"removePromisedPatch",value:function(e){var t,n=this.promisedPatches.indexOf(e);n<0?this.debug("Tried to remove a promisedPatch that isn't there!"):Se()(t=this.promisedPatches).call(t,n,1)}},{key:"promisedPatchThen",value:function(e){var t=this;return e.value=e.value.then((function(n){var r=le()(le()({},e),{},{value:n});t.removePromisedPatch(e),t.updatePatches(r)})).catch((function(n){t.removePromisedPatch(e),t.updatePatches(n)})),e.value}},{key:"getMutations",value:function(e,t){var n;return e=e||0,"number"!=typeof t&&(t=this.mutations.length),U()(n=this.mutations).call(n,e,t)}},{key:"getCurrentMutations",value:function(){return this.getMutationsForPlugin(this.getCurrentPlugin())}},{key:"getMutationsForPlugin",value:function(e){var t=this.getPluginMutationIndex(e);return this.getMutations(t+1)}},{key:"getCurrentPlugin",value:function(){return this.currentPlugin}},{key:"getLib",value:function(){return this.libMethods}},{key:"_get",value:function(e){return Ie.getIn(this.state,e)}},{key:"_getContext",value:function(e){return this.contextTree.get(e)}},{key:"setContext",value:function(e,t){return this.contextTree.set(e,t)}},{key:"_hasRun",value:function(e){return this.getPluginRunCount(this.getCurrentPlugin())>(e||0)}},{key:"dispatch",value:function(){var e,t=this,n=this,r=this.nextPlugin();if(!r){var a=this.nextPromisedPatch();if(a)return a.then((function(){return t.dispatch()})).catch((function(){return t.dispatch()}));var i={spec:this.state,errors:this.errors};return this.showDebug&&(i.patches=this.allPatches),Ee.a.resolve(i)}if(n.pluginCount=n.pluginCount||{},n.pluginCount[r]=(n.pluginCount[r]||0)+1,n.pluginCount[r]>100)return Ee.a.resolve({spec:n.state,errors:o()(e=n.errors).call(e,new Error("We've reached a hard limit of ".concat(100," plugin runs")))});if(r!==this.currentPlugin&&this.promisedPatches.length){var s,c=O()(s=this.promisedPatches).call(s,(function(e){return e.value}));return Ee.a.all(O()(c).call(c,(function(e){return e.then(Rt,Rt)}))).then((function(){return t.dispatch()}))}return function(){n.currentPlugin=r;var e=n.getCurrentMutations(),t=n.mutations.length-1;try{if(r.isGenerator){var a,o=p()(r(e,n.getLib()));try{for(o.s();!(a=o.n()).done;){u(a.value)}}catch(e){o.e(e)}finally{o.f()}}else{u(r(e,n.getLib()))}}catch(e){console.error(e),u([ve()(Object.create(e),{plugin:r})])}finally{n.updatePluginHistory(r,{mutationIndex:t})}return n.dispatch()}();function u(e){e&&(e=Ie.fullyNormalizeArray(e),n.updatePatches(e,r))}}}],[{key:"getPluginName",value:function(e){return e.pluginName}},{key:"getPatchesOfType",value:function(e,t){return P()(e).call(e,t)}}]),e}();var qt={refs:mt,allOf:At,parameters:kt,properties:It},Dt=n(53);function Bt(e){var t=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{},n=t.requestInterceptor,r=t.responseInterceptor,a=e.withCredentials?"include":"same-origin";return function(t){return e({url:t,loadSpec:!0,requestInterceptor:n,responseInterceptor:r,headers:{Accept:st},credentials:a}).then((function(e){return e.body}))}}function Lt(e){var t=e.fetch,n=e.spec,r=e.url,a=e.mode,o=e.allowMetaPatches,i=void 0===o||o,c=e.pathDiscriminator,l=e.modelPropertyMacro,p=e.parameterMacro,f=e.requestInterceptor,d=e.responseInterceptor,h=e.skipNormalization,m=e.useCircularStructures,v=e.http,g=e.baseDoc;return g=g||r,v=t||v||$,n?y(n):Bt(v,{requestInterceptor:f,responseInterceptor:d})(g).then(y);function y(e){g&&(qt.refs.docCache[g]=e),qt.refs.fetchJSON=Bt(v,{requestInterceptor:f,responseInterceptor:d});var t,n=[qt.refs];return"function"==typeof p&&n.push(qt.parameters),"function"==typeof l&&n.push(qt.properties),"strict"!==a&&n.push(qt.allOf),(t={spec:e,context:{baseDoc:g},plugins:n,allowMetaPatches:i,pathDiscriminator:c,parameterMacro:p,modelPropertyMacro:l,useCircularStructures:m},new Mt(t).dispatch()).then(h?function(){var e=s()(u.a.mark((function e(t){return u.a.wrap((function(e){for(;;)switch(e.prev=e.next){case 0:return e.abrupt("return",t);case 1:case"end":return e.stop()}}),e)})));return function(t){return e.apply(this,arguments)}}():Dt.d)}}var Ut=n(49),Vt=n.n(Ut),zt=n(36),Ft=n.n(zt),Jt=n(120),Wt=n.n(Jt),Ht=n(405),$t=n.n(Ht),Yt={body:function(e){var t=e.req,n=e.value;t.body=n},header:function(e){var t=e.req,n=e.parameter,r=e.value;t.headers=t.headers||{},void 0!==r&&(t.headers[n.name]=r)},query:function(e){var t=e.req,n=e.value,r=e.parameter;t.query=t.query||{},!1===n&&"boolean"===r.type&&(n="false");0===n&&["number","integer"].indexOf(r.type)>-1&&(n="0");if(n)t.query[r.name]={collectionFormat:r.collectionFormat,value:n};else if(r.allowEmptyValue&&void 0!==n){var a=r.name;t.query[a]=t.query[a]||{},t.query[a].allowEmptyValue=!0}},path:function(e){var t=e.req,n=e.value,r=e.parameter;t.url=t.url.split("{".concat(r.name,"}")).join(encodeURIComponent(n))},formData:function(e){var t=e.req,n=e.value,r=e.parameter;(n||r.allowEmptyValue)&&(t.form=t.form||{},t.form[r.name]={value:n,allowEmptyValue:r.allowEmptyValue,collectionFormat:r.collectionFormat})}};function Kt(e,t){return g()(t).call(t,"application/json")?"string"==typeof e?e:w()(e):e.toString()}function Gt(e){var t=e.req,n=e.value,r=e.parameter,a=r.name,o=r.style,i=r.explode,s=r.content;if(s){var c=_()(s)[0];t.url=t.url.split("{".concat(a,"}")).join(F(Kt(n,c),{escape:!0}))}else{var u=J({key:r.name,value:n,style:o||"simple",explode:i||!1,escape:!0});t.url=t.url.split("{".concat(a,"}")).join(u)}}function Zt(e){var t=e.req,n=e.value,r=e.parameter;if(t.query=t.query||{},r.content){var a=_()(r.content)[0];t.query[r.name]=Kt(n,a)}else if(!1===n&&(n="false"),0===n&&(n="0"),n){var o=r.style,i=r.explode,s=r.allowReserved;t.query[r.name]={value:n,serializationOption:{style:o,explode:i,allowReserved:s}}}else if(r.allowEmptyValue&&void 0!==n){var c=r.name;t.query[c]=t.query[c]||{},t.query[c].allowEmptyValue=!0}}var Xt=["accept","authorization","content-type"];function Qt(e){var t=e.req,n=e.parameter,r=e.value;if(t.headers=t.headers||{},!(Xt.indexOf(n.name.toLowerCase())>-1))if(n.content){var a=_()(n.content)[0];t.headers[n.name]=Kt(r,a)}else void 0!==r&&(t.headers[n.name]=J({key:n.name,value:r,style:n.style||"simple",explode:void 0!==n.explode&&n.explode,escape:!1}))}function en(e){var t=e.req,n=e.parameter,r=e.value;t.headers=t.headers||{};var a=d()(r);if(n.content){var i,s=_()(n.content)[0];t.headers.Cookie=o()(i="".concat(n.name,"=")).call(i,Kt(r,s))}else if("undefined"!==a){var c="object"===a&&!Array.isArray(r)&&n.explode?"":"".concat(n.name,"=");t.headers.Cookie=c+J({key:n.name,value:r,escape:!1,style:n.style||"form",explode:void 0!==n.explode&&n.explode})}}var tn=n(176),nn=n.n(tn);function rn(e,t){var n=e.operation,r=e.requestBody,a=e.securities,i=e.spec,s=e.attachContentTypeForEmptyPayload,c=e.requestContentType;t=function(e){var t=e.request,n=e.securities,r=void 0===n?{}:n,a=e.operation,i=void 0===a?{}:a,s=e.spec,c=le()({},t),u=r.authorized,l=void 0===u?{}:u,p=i.security||s.security||[],f=l&&!!_()(l).length,d=Ft()(s,["components","securitySchemes"])||{};if(c.headers=c.headers||{},c.query=c.query||{},!_()(r).length||!f||!p||Array.isArray(i.security)&&!i.security.length)return t;return p.forEach((function(e){_()(e).forEach((function(e){var t=l[e],n=d[e];if(t){var r=t.value||t,a=n.type;if(t)if("apiKey"===a)"query"===n.in&&(c.query[n.name]=r),"header"===n.in&&(c.headers[n.name]=r),"cookie"===n.in&&(c.cookies[n.name]=r);else if("http"===a){if(/^basic$/i.test(n.scheme)){var i,s=r.username||"",u=r.password||"",p=nn()(o()(i="".concat(s,":")).call(i,u));c.headers.Authorization="Basic ".concat(p)}/^bearer$/i.test(n.scheme)&&(c.headers.Authorization="Bearer ".concat(r))}else if("oauth2"===a||"openIdConnect"===a){var f,h=t.token||{},m=h[n["x-tokenName"]||"access_token"],v=h.token_type;v&&"bearer"!==v.toLowerCase()||(v="Bearer"),c.headers.Authorization=o()(f="".concat(v," ")).call(f,m)}}}))})),c}({request:t,securities:a,operation:n,spec:i});var u=n.requestBody||{},l=_()(u.content||{}),p=c&&l.indexOf(c)>-1;if(r||s){if(c&&p)t.headers["Content-Type"]=c;else if(!c){var f=l[0];f&&(t.headers["Content-Type"]=f,c=f)}}else c&&p&&(t.headers["Content-Type"]=c);if(!e.responseContentType&&n.responses){var h,v=P()(h=k()(n.responses)).call(h,(function(e){var t=m()(e,2),n=t[0],r=t[1],a=parseInt(n,10);return a>=200&&a<300&&Wt()(r.content)})).reduce((function(e,t){var n=m()(t,2)[1];return o()(e).call(e,_()(n.content))}),[]);v.length>0&&(t.headers.accept=v.join(", "))}if(r)if(c){if(l.indexOf(c)>-1)if("application/x-www-form-urlencoded"===c||"multipart/form-data"===c)if("object"===d()(r)){var g=(u.content[c]||{}).encoding||{};t.form={},_()(r).forEach((function(e){t.form[e]={value:r[e],encoding:g[e]||{}}}))}else t.form=r;else t.body=r}else t.body=r;return t}function an(e,t){var n,r,a=e.spec,i=e.operation,s=e.securities,c=e.requestContentType,u=e.responseContentType,l=e.attachContentTypeForEmptyPayload;if((t=function(e){var t=e.request,n=e.securities,r=void 0===n?{}:n,a=e.operation,i=void 0===a?{}:a,s=e.spec,c=le()({},t),u=r.authorized,l=void 0===u?{}:u,p=r.specSecurity,f=void 0===p?[]:p,d=i.security||f,h=l&&!!_()(l).length,m=s.securityDefinitions;if(c.headers=c.headers||{},c.query=c.query||{},!_()(r).length||!h||!d||Array.isArray(i.security)&&!i.security.length)return t;return d.forEach((function(e){_()(e).forEach((function(e){var t=l[e];if(t){var n=t.token,r=t.value||t,a=m[e],i=a.type,s=a["x-tokenName"]||"access_token",u=n&&n[s],p=n&&n.token_type;if(t)if("apiKey"===i){var f="query"===a.in?"query":"headers";c[f]=c[f]||{},c[f][a.name]=r}else if("basic"===i)if(r.header)c.headers.authorization=r.header;else{var d,h=r.username||"",v=r.password||"";r.base64=nn()(o()(d="".concat(h,":")).call(d,v)),c.headers.authorization="Basic ".concat(r.base64)}else if("oauth2"===i&&u){var g;p=p&&"bearer"!==p.toLowerCase()?p:"Bearer",c.headers.authorization=o()(g="".concat(p," ")).call(g,u)}}}))})),c}({request:t,securities:s,operation:i,spec:a})).body||t.form||l)if(c)t.headers["Content-Type"]=c;else if(Array.isArray(i.consumes)){var p=m()(i.consumes,1);t.headers["Content-Type"]=p[0]}else if(Array.isArray(a.consumes)){var f=m()(a.consumes,1);t.headers["Content-Type"]=f[0]}else i.parameters&&P()(n=i.parameters).call(n,(function(e){return"file"===e.type})).length?t.headers["Content-Type"]="multipart/form-data":i.parameters&&P()(r=i.parameters).call(r,(function(e){return"formData"===e.in})).length&&(t.headers["Content-Type"]="application/x-www-form-urlencoded");else if(c){var d,h,v=i.parameters&&P()(d=i.parameters).call(d,(function(e){return"body"===e.in})).length>0,g=i.parameters&&P()(h=i.parameters).call(h,(function(e){return"formData"===e.in})).length>0;(v||g)&&(t.headers["Content-Type"]=c)}return!u&&Array.isArray(i.produces)&&i.produces.length>0&&(t.headers.accept=i.produces.join(", ")),t}var on=["http","fetch","spec","operationId","pathName","method","parameters","securities"],sn=function(e){return Array.isArray(e)?e:[]},cn=Xe("OperationNotFoundError",(function(e,t,n){this.originalError=n,ve()(this,t||{})})),un={buildRequest:pn};function ln(e){var t=e.http,n=e.fetch,r=e.spec,a=e.operationId,o=e.pathName,i=e.method,s=e.parameters,c=e.securities,u=Vt()(e,on),l=t||n||$;o&&i&&!a&&(a=Object(Dt.c)(o,i));var p=un.buildRequest(le()({spec:r,operationId:a,parameters:s,securities:c,http:l},u));return p.body&&(Wt()(p.body)||Array.isArray(p.body))&&(p.body=w()(p.body)),l(p)}function pn(e){var t,n,a=e.spec,i=e.operationId,s=e.responseContentType,c=e.scheme,u=e.requestInterceptor,l=e.responseInterceptor,p=e.contextUrl,f=e.userFetch,d=e.server,h=e.serverVariables,v=e.http,g=e.parameters,y=e.parameterBuilders,b=Object(Dt.b)(a);y||(y=b?r:Yt);var E={url:"",credentials:v&&v.withCredentials?"include":"same-origin",headers:{},cookies:{}};u&&(E.requestInterceptor=u),l&&(E.responseInterceptor=l),f&&(E.userFetch=f);var x=Object(Dt.a)(a,i);if(!x)throw new cn("Operation ".concat(i," not found"));var S,w=x.operation,j=void 0===w?{}:w,C=x.method,A=x.pathName;if(E.url+=(S={spec:a,scheme:c,contextUrl:p,server:d,serverVariables:h,pathName:A,method:C},Object(Dt.b)(S.spec)?function(e){var t=e.spec,n=e.pathName,r=e.method,a=e.server,i=e.contextUrl,s=e.serverVariables,c=void 0===s?{}:s,u=Ft()(t,["paths",n,(r||"").toLowerCase(),"servers"])||Ft()(t,["paths",n,"servers"])||Ft()(t,["servers"]),l="",p=null;if(a&&u&&u.length){var f=O()(u).call(u,(function(e){return e.url}));f.indexOf(a)>-1&&(l=a,p=u[f.indexOf(a)])}if(!l&&u&&u.length){l=u[0].url;var d=m()(u,1);p=d[0]}return l.indexOf("{")>-1&&function(e){for(var t,n=[],r=/{([^}]+)}/g;t=r.exec(e);)n.push(t[1]);return n}(l).forEach((function(e){if(p.variables&&p.variables[e]){var t=p.variables[e],n=c[e]||t.default,r=new RegExp("{".concat(e,"}"),"g");l=l.replace(r,n)}})),function(){var e,t,n=arguments.length>0&&void 0!==arguments[0]?arguments[0]:"",r=arguments.length>1&&void 0!==arguments[1]?arguments[1]:"",a=n&&r?Ze.a.parse(Ze.a.resolve(r,n)):Ze.a.parse(n),i=Ze.a.parse(r),s=fn(a.protocol)||fn(i.protocol)||"",c=a.host||i.host,u=a.pathname||"";return"/"===(e=s&&c?o()(t="".concat(s,"://")).call(t,c+u):u)[e.length-1]?U()(e).call(e,0,-1):e}(l,i)}(S):function(e){var t,n,r=e.spec,a=e.scheme,i=e.contextUrl,s=void 0===i?"":i,c=Ze.a.parse(s),u=Array.isArray(r.schemes)?r.schemes[0]:null,l=a||u||fn(c.protocol)||"http",p=r.host||c.host||"",f=r.basePath||"";return"/"===(t=l&&p?o()(n="".concat(l,"://")).call(n,p+f):f)[t.length-1]?U()(t).call(t,0,-1):t}(S)),!i)return delete E.cookies,E;E.url+=A,E.method="".concat(C).toUpperCase(),g=g||{};var k=a.paths[A]||{};s&&(E.headers.accept=s);var I=function(e){var t={};e.forEach((function(e){t[e.in]||(t[e.in]={}),t[e.in][e.name]=e}));var n=[];return _()(t).forEach((function(e){_()(t[e]).forEach((function(r){n.push(t[e][r])}))})),n}(o()(t=o()(n=[]).call(n,sn(j.parameters))).call(t,sn(k.parameters)));I.forEach((function(e){var t,n,r=y[e.in];if("body"===e.in&&e.schema&&e.schema.properties&&(t=g),void 0===(t=e&&e.name&&g[e.name]))t=e&&e.name&&g[o()(n="".concat(e.in,".")).call(n,e.name)];else if(function(e,t){return P()(t).call(t,(function(t){return t.name===e}))}(e.name,I).length>1){var i;console.warn(o()(i="Parameter '".concat(e.name,"' is ambiguous because the defined spec has more than one parameter with the name: '")).call(i,e.name,"' and the passed-in parameter values did not define an 'in' value."))}if(null!==t){if(void 0!==e.default&&void 0===t&&(t=e.default),void 0===t&&e.required&&!e.allowEmptyValue)throw new Error("Required parameter ".concat(e.name," is not provided"));if(b&&e.schema&&"object"===e.schema.type&&"string"==typeof t)try{t=JSON.parse(t)}catch(e){throw new Error("Could not parse object parameter value string as JSON")}r&&r({req:E,parameter:e,value:t,operation:j,spec:a})}}));var N=le()(le()({},e),{},{operation:j});if((E=b?rn(N,E):an(N,E)).cookies&&_()(E.cookies).length){var T=_()(E.cookies).reduce((function(e,t){var n=E.cookies[t];return e+(e?"&":"")+$t.a.serialize(t,n)}),"");E.headers.Cookie=T}return E.cookies&&delete E.cookies,ce(E),E}var fn=function(e){return e?e.replace(/\W/g,""):null};function dn(e,t){return hn.apply(this,arguments)}function hn(){return(hn=s()(u.a.mark((function e(t,n){var r,a,o,i,s,c,l,p,f,d,h,m,v=arguments;return u.a.wrap((function(e){for(;;)switch(e.prev=e.next){case 0:return r=v.length>2&&void 0!==v[2]?v[2]:{},a=r.returnEntireTree,o=r.baseDoc,i=r.requestInterceptor,s=r.responseInterceptor,c=r.parameterMacro,l=r.modelPropertyMacro,p=r.useCircularStructures,f={pathDiscriminator:n,baseDoc:o,requestInterceptor:i,responseInterceptor:s,parameterMacro:c,modelPropertyMacro:l,useCircularStructures:p},d=Object(Dt.d)({spec:t}),h=d.spec,e.next=6,Lt(le()(le()({},f),{},{spec:h,allowMetaPatches:!0,skipNormalization:!0}));case 6:return m=e.sent,!a&&Array.isArray(n)&&n.length&&(m.spec=Ft()(m.spec,n)||null),e.abrupt("return",m);case 9:case"end":return e.stop()}}),e)})))).apply(this,arguments)}var mn=n(238);t.default=function(e){var t,n,r,a=e.configs,i=e.getConfigs;return{fn:{fetch:(t=$,n=a.preFetch,r=a.postFetch,r=r||function(e){return e},n=n||function(e){return e},function(e){return"string"==typeof e&&(e={url:e}),H.mergeInQueryOrForm(e),e=n(e),r(t(e))}),buildRequest:pn,execute:ln,resolve:Lt,resolveSubtree:function(e,t,n){var r;if(void 0===n){var a=i();n={modelPropertyMacro:a.modelPropertyMacro,parameterMacro:a.parameterMacro,requestInterceptor:a.requestInterceptor,responseInterceptor:a.responseInterceptor}}for(var s=arguments.length,c=new Array(s>3?s-3:0),u=3;u<s;u++)c[u-3]=arguments[u];return dn.apply(void 0,o()(r=[e,t,n]).call(r,c))},serializeRes:Z,opId:Dt.e},statePlugins:{configs:{wrapActions:mn}}}}},function(e,t,n){"use strict";n.r(t),n.d(t,"default",(function(){return u}));var r=n(142),a=n(124),o=n(245),i=n(246),s=n(247),c={getLocalConfig:function(){return Object(r.parseYamlConfig)('---\nurl: "https://petstore.swagger.io/v2/swagger.json"\ndom_id: "#swagger-ui"\nvalidatorUrl: "https://validator.swagger.io/validator"\n')}};function u(){return{statePlugins:{spec:{actions:o,selectors:c},configs:{reducers:s.default,actions:a,selectors:i}}}}},function(e,t,n){var r=n(349),a=n(168),o=n(635),i=n(47),s=n(327);e.exports=function(e,t,n){var c=i(e)?r:o;return n&&s(e,t,n)&&(t=void 0),c(e,a(t,3))}},function(e,t){e.exports=require("memoizee")},function(e,t,n){e.exports=n(678)},function(e,t,n){e.exports=n(683)},function(e,t,n){var r=n(687);e.exports=function(e,t,n){return null==e?e:r(e,t,n)}},function(e,t){e.exports=require("react-redux")},function(e,t,n){e.exports=n(748)},function(e,t,n){var r=n(87);e.exports=function(e){return r(e).toLowerCase()}},function(e,t){e.exports=require("react-debounce-input")},function(e,t,n){var r=n(336),a=n(329),o=n(166),i=n(337);e.exports=function(e){return r(e)||a(e)||o(e)||i()},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){"use strict";var r=n(21),a=n(34),o=n(128),i=n(41),s=n(56),c=n(60),u=n(129),l=n(189),p=n(130),f=n(35),d=n(111),h=f("isConcatSpreadable"),m=9007199254740991,v="Maximum allowed index exceeded",g=d>=51||!a((function(){var e=[];return e[h]=!1,e.concat()[0]!==e})),y=p("concat"),b=function(e){if(!i(e))return!1;var t=e[h];return void 0!==t?!!t:o(e)};r({target:"Array",proto:!0,forced:!g||!y},{concat:function(e){var t,n,r,a,o,i=s(this),p=l(i,0),f=0;for(t=-1,r=arguments.length;t<r;t++)if(b(o=-1===t?i:arguments[t])){if(f+(a=c(o.length))>m)throw TypeError(v);for(n=0;n<a;n++,f++)n in o&&u(p,f,o[n])}else{if(f>=m)throw TypeError(v);u(p,f++,o)}return p.length=f,p}})},function(e,t,n){var r=n(185);e.exports=r&&!Symbol.sham&&"symbol"==typeof Symbol.iterator},function(e,t,n){var r=n(44),a=n(34),o=n(188);e.exports=!r&&!a((function(){return 7!=Object.defineProperty(o("div"),"a",{get:function(){return 7}}).a}))},function(e,t,n){var r=n(34),a=/#|\.prototype\./,o=function(e,t){var n=s[i(e)];return n==u||n!=c&&("function"==typeof t?r(t):!!t)},i=o.normalize=function(e){return String(e).replace(a,".").toLowerCase()},s=o.data={},c=o.NATIVE="N",u=o.POLYFILL="P";e.exports=o},function(e,t,n){"use strict";var r=n(21),a=n(38),o=n(55),i=n(83),s=n(44),c=n(185),u=n(34),l=n(51),p=n(128),f=n(41),d=n(151),h=n(46),m=n(56),v=n(58),g=n(150),y=n(61),b=n(96),E=n(100),x=n(131),S=n(154),w=n(296),j=n(194),O=n(110),C=n(59),_=n(148),A=n(67),k=n(101),I=n(186),P=n(153),N=n(132),T=n(152),R=n(35),M=n(195),q=n(40),D=n(85),B=n(68),L=n(76).forEach,U=P("hidden"),V="Symbol",z=R("toPrimitive"),F=B.set,J=B.getterFor(V),W=Object.prototype,H=a.Symbol,$=o("JSON","stringify"),Y=O.f,K=C.f,G=w.f,Z=_.f,X=I("symbols"),Q=I("op-symbols"),ee=I("string-to-symbol-registry"),te=I("symbol-to-string-registry"),ne=I("wks"),re=a.QObject,ae=!re||!re.prototype||!re.prototype.findChild,oe=s&&u((function(){return 7!=E(K({},"a",{get:function(){return K(this,"a",{value:7}).a}})).a}))?function(e,t,n){var r=Y(W,t);r&&delete W[t],K(e,t,n),r&&e!==W&&K(W,t,r)}:K,ie=function(e,t){var n=X[e]=E(H.prototype);return F(n,{type:V,tag:e,description:t}),s||(n.description=t),n},se=function(e,t,n){e===W&&se(Q,t,n),h(e);var r=g(t);return h(n),l(X,r)?(n.enumerable?(l(e,U)&&e[U][r]&&(e[U][r]=!1),n=E(n,{enumerable:b(0,!1)})):(l(e,U)||K(e,U,b(1,{})),e[U][r]=!0),oe(e,r,n)):K(e,r,n)},ce=function(e,t){h(e);var n=v(t),r=x(n).concat(fe(n));return L(r,(function(t){s&&!ue.call(n,t)||se(e,t,n[t])})),e},ue=function(e){var t=g(e),n=Z.call(this,t);return!(this===W&&l(X,t)&&!l(Q,t))&&(!(n||!l(this,t)||!l(X,t)||l(this,U)&&this[U][t])||n)},le=function(e,t){var n=v(e),r=g(t);if(n!==W||!l(X,r)||l(Q,r)){var a=Y(n,r);return!a||!l(X,r)||l(n,U)&&n[U][r]||(a.enumerable=!0),a}},pe=function(e){var t=G(v(e)),n=[];return L(t,(function(e){l(X,e)||l(N,e)||n.push(e)})),n},fe=function(e){var t=e===W,n=G(t?Q:v(e)),r=[];return L(n,(function(e){!l(X,e)||t&&!l(W,e)||r.push(X[e])})),r};(c||(k((H=function(){if(this instanceof H)throw TypeError("Symbol is not a constructor");var e=arguments.length&&void 0!==arguments[0]?y(arguments[0]):void 0,t=T(e),n=function(e){this===W&&n.call(Q,e),l(this,U)&&l(this[U],t)&&(this[U][t]=!1),oe(this,t,b(1,e))};return s&&ae&&oe(W,t,{configurable:!0,set:n}),ie(t,e)}).prototype,"toString",(function(){return J(this).tag})),k(H,"withoutSetter",(function(e){return ie(T(e),e)})),_.f=ue,C.f=se,O.f=le,S.f=w.f=pe,j.f=fe,M.f=function(e){return ie(R(e),e)},s&&(K(H.prototype,"description",{configurable:!0,get:function(){return J(this).description}}),i||k(W,"propertyIsEnumerable",ue,{unsafe:!0}))),r({global:!0,wrap:!0,forced:!c,sham:!c},{Symbol:H}),L(x(ne),(function(e){q(e)})),r({target:V,stat:!0,forced:!c},{for:function(e){var t=y(e);if(l(ee,t))return ee[t];var n=H(t);return ee[t]=n,te[n]=t,n},keyFor:function(e){if(!d(e))throw TypeError(e+" is not a symbol");if(l(te,e))return te[e]},useSetter:function(){ae=!0},useSimple:function(){ae=!1}}),r({target:"Object",stat:!0,forced:!c,sham:!s},{create:function(e,t){return void 0===t?E(e):ce(E(e),t)},defineProperty:se,defineProperties:ce,getOwnPropertyDescriptor:le}),r({target:"Object",stat:!0,forced:!c},{getOwnPropertyNames:pe,getOwnPropertySymbols:fe}),r({target:"Object",stat:!0,forced:u((function(){j.f(1)}))},{getOwnPropertySymbols:function(e){return j.f(m(e))}}),$)&&r({target:"JSON",stat:!0,forced:!c||u((function(){var e=H();return"[null]"!=$([e])||"{}"!=$({a:e})||"{}"!=$(Object(e))}))},{stringify:function(e,t,n){for(var r,a=[e],o=1;arguments.length>o;)a.push(arguments[o++]);if(r=t,(f(t)||void 0!==e)&&!d(e))return p(t)||(t=function(e,t){if("function"==typeof r&&(t=r.call(this,e,t)),!d(t))return t}),a[1]=t,$.apply(null,a)}});H.prototype[z]||A(H.prototype,z,H.prototype.valueOf),D(H,V),N[U]=!0},function(e,t,n){var r=n(51),a=n(58),o=n(191).indexOf,i=n(132);e.exports=function(e,t){var n,s=a(e),c=0,u=[];for(n in s)!r(i,n)&&r(s,n)&&u.push(n);for(;t.length>c;)r(s,n=t[c++])&&(~o(u,n)||u.push(n));return u}},function(e,t,n){var r=n(55);e.exports=r("document","documentElement")},function(e,t,n){var r=n(58),a=n(154).f,o={}.toString,i="object"==typeof window&&window&&Object.getOwnPropertyNames?Object.getOwnPropertyNames(window):[];e.exports.f=function(e){return i&&"[object Window]"==o.call(e)?function(e){try{return a(e)}catch(e){return i.slice()}}(e):a(r(e))}},function(e,t,n){var r=n(38),a=n(298),o=r.WeakMap;e.exports="function"==typeof o&&/native code/.test(a(o))},function(e,t,n){var r=n(187),a=Function.toString;"function"!=typeof r.inspectSource&&(r.inspectSource=function(e){return a.call(e)}),e.exports=r.inspectSource},function(e,t,n){n(40)("iterator")},function(e,t,n){"use strict";var r=n(301).IteratorPrototype,a=n(100),o=n(96),i=n(85),s=n(113),c=function(){return this};e.exports=function(e,t,n){var u=t+" Iterator";return e.prototype=a(r,{next:o(1,n)}),i(e,u,!1,!0),s[u]=c,e}},function(e,t,n){"use strict";var r,a,o,i=n(34),s=n(155),c=n(67),u=n(51),l=n(35),p=n(83),f=l("iterator"),d=!1;[].keys&&("next"in(o=[].keys())?(a=s(s(o)))!==Object.prototype&&(r=a):d=!0);var h=null==r||i((function(){var e={};return r[f].call(e)!==e}));h&&(r={}),p&&!h||u(r,f)||c(r,f,(function(){return this})),e.exports={IteratorPrototype:r,BUGGY_SAFARI_ITERATORS:d}},function(e,t,n){var r=n(34);e.exports=!r((function(){function e(){}return e.prototype.constructor=null,Object.getPrototypeOf(new e)!==e.prototype}))},function(e,t,n){var r=n(112),a=n(61),o=n(97),i=function(e){return function(t,n){var i,s,c=a(o(t)),u=r(n),l=c.length;return u<0||u>=l?e?"":void 0:(i=c.charCodeAt(u))<55296||i>56319||u+1===l||(s=c.charCodeAt(u+1))<56320||s>57343?e?c.charAt(u):i:e?c.slice(u,u+2):s-56320+(i-55296<<10)+65536}};e.exports={codeAt:i(!1),charAt:i(!0)}},function(e,t,n){var r=n(453),a=Array.prototype;e.exports=function(e){var t=e.filter;return e===a||e instanceof Array&&t===a.filter?r:t}},function(e,t,n){n(456);var r=n(31);e.exports=r.Object.keys},function(e,t,n){n(461);var r=n(31).Object,a=e.exports=function(e,t,n){return r.defineProperty(e,t,n)};r.defineProperty.sham&&(a.sham=!0)},function(e,t,n){"use strict";var r=n(66),a=n(41),o=[].slice,i={},s=function(e,t,n){if(!(t in i)){for(var r=[],a=0;a<t;a++)r[a]="a["+a+"]";i[t]=Function("C,a","return new C("+r.join(",")+")")}return i[t](e,n)};e.exports=Function.bind||function(e){var t=r(this),n=o.call(arguments,1),i=function(){var r=n.concat(o.call(arguments));return this instanceof i?s(t,r.length,r):t.apply(e,r)};return a(t.prototype)&&(i.prototype=t.prototype),i}},function(e,t,n){n(467);var r=n(31);e.exports=r.Object.assign},function(e,t,n){"use strict";var r=n(44),a=n(34),o=n(131),i=n(194),s=n(148),c=n(56),u=n(149),l=Object.assign,p=Object.defineProperty;e.exports=!l||a((function(){if(r&&1!==l({b:1},l(p({},"a",{enumerable:!0,get:function(){p(this,"b",{value:3,enumerable:!1})}}),{b:2})).b)return!0;var e={},t={},n=Symbol(),a="abcdefghijklmnopqrst";return e[n]=7,a.split("").forEach((function(e){t[e]=e})),7!=l({},e)[n]||o(l({},t)).join("")!=a}))?function(e,t){for(var n=c(e),a=arguments.length,l=1,p=i.f,f=s.f;a>l;)for(var d,h=u(arguments[l++]),m=p?o(h).concat(p(h)):o(h),v=m.length,g=0;v>g;)d=m[g++],r&&!f.call(h,d)||(n[d]=h[d]);return n}:l},function(e,t,n){var r=n(469),a=Array.prototype;e.exports=function(e){var t=e.slice;return e===a||e instanceof Array&&t===a.slice?r:t}},function(e,t,n){n(472);var r=n(31);e.exports=r.Array.isArray},function(e,t,n){(function(t){var n="object"==typeof t&&t&&t.Object===Object&&t;e.exports=n}).call(this,n(184))},function(e,t){var n=Function.prototype.toString;e.exports=function(e){if(null!=e){try{return n.call(e)}catch(e){}try{return e+""}catch(e){}}return""}},function(e,t,n){var r=n(202),a=n(88);e.exports=function(e,t,n){(void 0!==n&&!a(e[t],n)||void 0===n&&!(t in e))&&r(e,t,n)}},function(e,t,n){var r=n(103),a=function(){try{var e=r(Object,"defineProperty");return e({},"",{}),e}catch(e){}}();e.exports=a},function(e,t,n){var r=n(511)();e.exports=r},function(e,t,n){(function(e){var r=n(62),a=t&&!t.nodeType&&t,o=a&&"object"==typeof e&&e&&!e.nodeType&&e,i=o&&o.exports===a?r.Buffer:void 0,s=i?i.allocUnsafe:void 0;e.exports=function(e,t){if(t)return e.slice();var n=e.length,r=s?s(n):new e.constructor(n);return e.copy(r),r}}).call(this,n(203)(e))},function(e,t,n){var r=n(204);e.exports=function(e,t){var n=t?r(e.buffer):e.buffer;return new e.constructor(n,e.byteOffset,e.length)}},function(e,t,n){var r=n(62).Uint8Array;e.exports=r},function(e,t){e.exports=function(e,t){var n=-1,r=e.length;for(t||(t=Array(r));++n<r;)t[n]=e[n];return t}},function(e,t,n){var r=n(513),a=n(205),o=n(163);e.exports=function(e){return"function"!=typeof e.constructor||o(e)?{}:r(a(e))}},function(e,t){e.exports=function(e,t){return function(n){return e(t(n))}}},function(e,t){e.exports=function(e,t){if(("constructor"!==t||"function"!=typeof e[t])&&"__proto__"!=t)return e[t]}},function(e,t,n){var r=n(519),a=n(133),o=n(47),i=n(134),s=n(165),c=n(164),u=Object.prototype.hasOwnProperty;e.exports=function(e,t){var n=o(e),l=!n&&a(e),p=!n&&!l&&i(e),f=!n&&!l&&!p&&c(e),d=n||l||p||f,h=d?r(e.length,String):[],m=h.length;for(var v in e)!t&&!u.call(e,v)||d&&("length"==v||p&&("offset"==v||"parent"==v)||f&&("buffer"==v||"byteLength"==v||"byteOffset"==v)||s(v,m))||h.push(v);return h}},function(e,t,n){var r=n(524),a=Math.max;e.exports=function(e,t,n){return t=a(void 0===t?e.length-1:t,0),function(){for(var o=arguments,i=-1,s=a(o.length-t,0),c=Array(s);++i<s;)c[i]=o[t+i];i=-1;for(var u=Array(t+1);++i<t;)u[i]=o[i];return u[t]=n(c),r(e,this,u)}}},function(e,t,n){var r=n(525),a=n(527)(r);e.exports=a},function(e,t,n){var r=n(88),a=n(105),o=n(165),i=n(48);e.exports=function(e,t,n){if(!i(n))return!1;var s=typeof t;return!!("number"==s?a(n)&&o(t,n.length):"string"==s&&t in n)&&r(n[t],e)}},function(e,t){e.exports=function(e,t){(null==t||t>e.length)&&(t=e.length);for(var n=0,r=new Array(t);n<t;n++)r[n]=e[n];return r},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){var r=n(147),a=n(212),o=n(330);e.exports=function(e){if(void 0!==r&&null!=a(e)||null!=e["@@iterator"])return o(e)},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){e.exports=n(536)},function(e,t,n){n(114),n(537);var r=n(31);e.exports=r.Array.from},function(e,t,n){"use strict";var r=n(99),a=n(56),o=n(538),i=n(334),s=n(60),c=n(129),u=n(136);e.exports=function(e){var t,n,l,p,f,d,h=a(e),m="function"==typeof this?this:Array,v=arguments.length,g=v>1?arguments[1]:void 0,y=void 0!==g,b=u(h),E=0;if(y&&(g=r(g,v>2?arguments[2]:void 0,2)),null==b||m==Array&&i(b))for(n=new m(t=s(h.length));t>E;E++)d=y?g(h[E],E):h[E],c(n,E,d);else for(f=(p=b.call(h)).next,n=new m;!(l=f.call(p)).done;E++)d=y?o(p,g,[l.value,E],!0):l.value,c(n,E,d);return n.length=E,n}},function(e,t,n){var r=n(46);e.exports=function(e){var t=e.return;if(void 0!==t)return r(t.call(e)).value}},function(e,t,n){var r=n(35),a=n(113),o=r("iterator"),i=Array.prototype;e.exports=function(e){return void 0!==e&&(a.Array===e||i[o]===e)}},function(e,t,n){var r=n(35)("iterator"),a=!1;try{var o=0,i={next:function(){return{done:!!o++}},return:function(){a=!0}};i[r]=function(){return this},Array.from(i,(function(){throw 2}))}catch(e){}e.exports=function(e,t){if(!t&&!a)return!1;var n=!1;try{var o={};o[r]=function(){return{next:function(){return{done:n=!0}}}},e(o)}catch(e){}return n}},function(e,t,n){var r=n(211);e.exports=function(e){if(r(e))return e},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t){e.exports=function(){throw new TypeError("Invalid attempt to destructure non-iterable instance.\nIn order to be iterable, non-array objects must have a [Symbol.iterator]() method.")},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){n(77);var r=n(546),a=n(86),o=Array.prototype,i={DOMTokenList:!0,NodeList:!0};e.exports=function(e){var t=e.forEach;return e===o||e instanceof Array&&t===o.forEach||i.hasOwnProperty(a(e))?r:t}},function(e,t,n){var r=n(568);e.exports=function(e){if(r(e))throw TypeError("The method doesn't accept regular expressions");return e}},function(e,t,n){var r=n(35)("match");e.exports=function(e){var t=/./;try{"/./"[e](t)}catch(n){try{return t[r]=!1,"/./"[e](t)}catch(e){}}return!1}},function(e,t,n){var r=n(570),a=Array.prototype;e.exports=function(e){var t=e.indexOf;return e===a||e instanceof Array&&t===a.indexOf?r:t}},function(e,t){e.exports="\t\n\v\f\r                　\u2028\u2029\ufeff"},function(e,t){e.exports=function(e,t){for(var n=-1,r=null==e?0:e.length,a=Array(r);++n<r;)a[n]=t(e[n],n,e);return a}},function(e,t){e.exports=function(e,t,n){var r=-1,a=e.length;t<0&&(t=-t>a?0:a+t),(n=n>a?a:n)<0&&(n+=a),a=t>n?0:n-t>>>0,t>>>=0;for(var o=Array(a);++r<a;)o[r]=e[r+t];return o}},function(e,t){var n=RegExp("[\\u200d\\ud800-\\udfff\\u0300-\\u036f\\ufe20-\\ufe2f\\u20d0-\\u20ff\\ufe0e\\ufe0f]");e.exports=function(e){return n.test(e)}},function(e,t){e.exports=function(e,t,n,r){var a=-1,o=null==e?0:e.length;for(r&&o&&(n=e[++a]);++a<o;)n=t(n,e[a],a,e);return n}},function(e,t,n){var r=n(604),a=n(70);e.exports=function e(t,n,o,i,s){return t===n||(null==t||null==n||!a(t)&&!a(n)?t!=t&&n!=n:r(t,n,o,i,e,s))}},function(e,t,n){var r=n(605),a=n(349),o=n(608);e.exports=function(e,t,n,i,s,c){var u=1&n,l=e.length,p=t.length;if(l!=p&&!(u&&p>l))return!1;var f=c.get(e),d=c.get(t);if(f&&d)return f==t&&d==e;var h=-1,m=!0,v=2&n?new r:void 0;for(c.set(e,t),c.set(t,e);++h<l;){var g=e[h],y=t[h];if(i)var b=u?i(y,g,h,t,e,c):i(g,y,h,e,t,c);if(void 0!==b){if(b)continue;m=!1;break}if(v){if(!a(t,(function(e,t){if(!o(v,t)&&(g===e||s(g,e,n,i,c)))return v.push(t)}))){m=!1;break}}else if(g!==y&&!s(g,y,n,i,c)){m=!1;break}}return c.delete(e),c.delete(t),m}},function(e,t){e.exports=function(e,t){for(var n=-1,r=null==e?0:e.length;++n<r;)if(t(e[n],n,e))return!0;return!1}},function(e,t,n){var r=n(351),a=n(214),o=n(117);e.exports=function(e){return r(e,o,a)}},function(e,t,n){var r=n(213),a=n(47);e.exports=function(e,t,n){var o=t(e);return a(e)?o:r(o,n(e))}},function(e,t){e.exports=function(){return[]}},function(e,t,n){var r=n(163),a=n(614),o=Object.prototype.hasOwnProperty;e.exports=function(e){if(!r(e))return a(e);var t=[];for(var n in Object(e))o.call(e,n)&&"constructor"!=n&&t.push(n);return t}},function(e,t,n){var r=n(48);e.exports=function(e){return e==e&&!r(e)}},function(e,t){e.exports=function(e,t){return function(n){return null!=n&&(n[e]===t&&(void 0!==t||e in Object(n)))}}},function(e,t,n){var r=n(633),a=n(48),o=n(167),i=/^[-+]0x[0-9a-f]+$/i,s=/^0b[01]+$/i,c=/^0o[0-7]+$/i,u=parseInt;e.exports=function(e){if("number"==typeof e)return e;if(o(e))return NaN;if(a(e)){var t="function"==typeof e.valueOf?e.valueOf():e;e=a(t)?t+"":t}if("string"!=typeof e)return 0===e?e:+e;e=r(e);var n=s.test(e);return n||c.test(e)?u(e.slice(2),n?2:8):i.test(e)?NaN:+e}},function(e,t,n){var r=n(636),a=n(637)(r);e.exports=a},function(e,t,n){e.exports=n(643)},function(e,t,n){e.exports=n(644)},function(e,t,n){e.exports=n(663)},function(e,t,n){n(362),n(69),n(84),n(667),n(369),n(370),n(672),n(114);var r=n(31);e.exports=r.Promise},function(e,t,n){"use strict";var r=n(21),a=n(155),o=n(156),i=n(100),s=n(67),c=n(96),u=n(106),l=n(61),p=function(e,t){var n=this;if(!(n instanceof p))return new p(e,t);o&&(n=o(new Error(void 0),a(n))),void 0!==t&&s(n,"message",l(t));var r=[];return u(e,r.push,{that:r}),s(n,"errors",r),n};p.prototype=i(Error.prototype,{constructor:c(5,p),message:c(5,""),name:c(5,"AggregateError")}),r({global:!0},{AggregateError:p})},function(e,t,n){var r=n(38);e.exports=r.Promise},function(e,t,n){"use strict";var r=n(55),a=n(59),o=n(35),i=n(44),s=o("species");e.exports=function(e){var t=r(e),n=a.f;i&&t&&!t[s]&&n(t,s,{configurable:!0,get:function(){return this}})}},function(e,t,n){var r=n(46),a=n(66),o=n(35)("species");e.exports=function(e,t){var n,i=r(e).constructor;return void 0===i||null==(n=r(i)[o])?t:a(n)}},function(e,t,n){var r,a,o,i,s=n(38),c=n(34),u=n(99),l=n(295),p=n(188),f=n(367),d=n(157),h=s.setImmediate,m=s.clearImmediate,v=s.process,g=s.MessageChannel,y=s.Dispatch,b=0,E={},x="onreadystatechange";try{r=s.location}catch(e){}var S=function(e){if(E.hasOwnProperty(e)){var t=E[e];delete E[e],t()}},w=function(e){return function(){S(e)}},j=function(e){S(e.data)},O=function(e){s.postMessage(String(e),r.protocol+"//"+r.host)};h&&m||(h=function(e){for(var t=[],n=arguments.length,r=1;n>r;)t.push(arguments[r++]);return E[++b]=function(){("function"==typeof e?e:Function(e)).apply(void 0,t)},a(b),b},m=function(e){delete E[e]},d?a=function(e){v.nextTick(w(e))}:y&&y.now?a=function(e){y.now(w(e))}:g&&!f?(i=(o=new g).port2,o.port1.onmessage=j,a=u(i.postMessage,i,1)):s.addEventListener&&"function"==typeof postMessage&&!s.importScripts&&r&&"file:"!==r.protocol&&!c(O)?(a=O,s.addEventListener("message",j,!1)):a=x in p("script")?function(e){l.appendChild(p("script")).onreadystatechange=function(){l.removeChild(this),S(e)}}:function(e){setTimeout(w(e),0)}),e.exports={set:h,clear:m}},function(e,t,n){var r=n(98);e.exports=/(?:iphone|ipod|ipad).*applewebkit/i.test(r)},function(e,t,n){var r=n(46),a=n(41),o=n(140);e.exports=function(e,t){if(r(e),a(t)&&t.constructor===e)return t;var n=o.f(e);return(0,n.resolve)(t),n.promise}},function(e,t,n){"use strict";var r=n(21),a=n(66),o=n(140),i=n(169),s=n(106);r({target:"Promise",stat:!0},{allSettled:function(e){var t=this,n=o.f(t),r=n.resolve,c=n.reject,u=i((function(){var n=a(t.resolve),o=[],i=0,c=1;s(e,(function(e){var a=i++,s=!1;o.push(void 0),c++,n.call(t,e).then((function(e){s||(s=!0,o[a]={status:"fulfilled",value:e},--c||r(o))}),(function(e){s||(s=!0,o[a]={status:"rejected",reason:e},--c||r(o))}))})),--c||r(o)}));return u.error&&c(u.value),n.promise}})},function(e,t,n){"use strict";var r=n(21),a=n(66),o=n(55),i=n(140),s=n(169),c=n(106),u="No one promise resolved";r({target:"Promise",stat:!0},{any:function(e){var t=this,n=i.f(t),r=n.resolve,l=n.reject,p=s((function(){var n=a(t.resolve),i=[],s=0,p=1,f=!1;c(e,(function(e){var a=s++,c=!1;i.push(void 0),p++,n.call(t,e).then((function(e){c||f||(f=!0,r(e))}),(function(e){c||f||(c=!0,i[a]=e,--p||l(new(o("AggregateError"))(i,u)))}))})),--p||l(new(o("AggregateError"))(i,u))}));return p.error&&l(p.value),n.promise}})},function(e,t,n){var r=n(44),a=n(131),o=n(58),i=n(148).f,s=function(e){return function(t){for(var n,s=o(t),c=a(s),u=c.length,l=0,p=[];u>l;)n=c[l++],r&&!i.call(s,n)||p.push(e?[n,s[n]]:s[n]);return p}};e.exports={entries:s(!0),values:s(!1)}},function(e,t,n){e.exports=n(695)},function(e,t,n){e.exports=n(698)},function(e,t,n){var r=n(158),a=n(708),o=n(209),i=n(709),s=n(710),c=n(317),u=n(320),l=n(711),p=n(712),f=n(350),d=n(376),h=n(137),m=n(713),v=n(714),g=n(321),y=n(47),b=n(134),E=n(718),x=n(48),S=n(720),w=n(117),j=n(135),O="[object Arguments]",C="[object Function]",_="[object Object]",A={};A[O]=A["[object Array]"]=A["[object ArrayBuffer]"]=A["[object DataView]"]=A["[object Boolean]"]=A["[object Date]"]=A["[object Float32Array]"]=A["[object Float64Array]"]=A["[object Int8Array]"]=A["[object Int16Array]"]=A["[object Int32Array]"]=A["[object Map]"]=A["[object Number]"]=A[_]=A["[object RegExp]"]=A["[object Set]"]=A["[object String]"]=A["[object Symbol]"]=A["[object Uint8Array]"]=A["[object Uint8ClampedArray]"]=A["[object Uint16Array]"]=A["[object Uint32Array]"]=!0,A["[object Error]"]=A[C]=A["[object WeakMap]"]=!1,e.exports=function e(t,n,k,I,P,N){var T,R=1&n,M=2&n,q=4&n;if(k&&(T=P?k(t,I,P,N):k(t)),void 0!==T)return T;if(!x(t))return t;var D=y(t);if(D){if(T=m(t),!R)return u(t,T)}else{var B=h(t),L=B==C||"[object GeneratorFunction]"==B;if(b(t))return c(t,R);if(B==_||B==O||L&&!P){if(T=M||L?{}:g(t),!R)return M?p(t,s(T,t)):l(t,i(T,t))}else{if(!A[B])return P?t:{};T=v(t,B,R)}}N||(N=new r);var U=N.get(t);if(U)return U;N.set(t,T),S(t)?t.forEach((function(r){T.add(e(r,n,k,r,t,N))})):E(t)&&t.forEach((function(r,a){T.set(a,e(r,n,k,a,t,N))}));var V=D?void 0:(q?M?d:f:M?j:w)(t);return a(V||t,(function(r,a){V&&(r=t[a=r]),o(T,a,e(r,n,k,a,t,N))})),T}},function(e,t,n){var r=n(213),a=n(205),o=n(214),i=n(352),s=Object.getOwnPropertySymbols?function(e){for(var t=[];e;)r(t,o(e)),e=a(e);return t}:i;e.exports=s},function(e,t,n){var r=n(351),a=n(375),o=n(135);e.exports=function(e){return r(e,o,a)}},function(e,t){!function(e){!function(t){var n="URLSearchParams"in e,r="Symbol"in e&&"iterator"in Symbol,a="FileReader"in e&&"Blob"in e&&function(){try{return new Blob,!0}catch(e){return!1}}(),o="FormData"in e,i="ArrayBuffer"in e;if(i)var s=["[object Int8Array]","[object Uint8Array]","[object Uint8ClampedArray]","[object Int16Array]","[object Uint16Array]","[object Int32Array]","[object Uint32Array]","[object Float32Array]","[object Float64Array]"],c=ArrayBuffer.isView||function(e){return e&&s.indexOf(Object.prototype.toString.call(e))>-1};function u(e){if("string"!=typeof e&&(e=String(e)),/[^a-z0-9\-#$%&'*+.^_`|~]/i.test(e))throw new TypeError("Invalid character in header field name");return e.toLowerCase()}function l(e){return"string"!=typeof e&&(e=String(e)),e}function p(e){var t={next:function(){var t=e.shift();return{done:void 0===t,value:t}}};return r&&(t[Symbol.iterator]=function(){return t}),t}function f(e){this.map={},e instanceof f?e.forEach((function(e,t){this.append(t,e)}),this):Array.isArray(e)?e.forEach((function(e){this.append(e[0],e[1])}),this):e&&Object.getOwnPropertyNames(e).forEach((function(t){this.append(t,e[t])}),this)}function d(e){if(e.bodyUsed)return Promise.reject(new TypeError("Already read"));e.bodyUsed=!0}function h(e){return new Promise((function(t,n){e.onload=function(){t(e.result)},e.onerror=function(){n(e.error)}}))}function m(e){var t=new FileReader,n=h(t);return t.readAsArrayBuffer(e),n}function v(e){if(e.slice)return e.slice(0);var t=new Uint8Array(e.byteLength);return t.set(new Uint8Array(e)),t.buffer}function g(){return this.bodyUsed=!1,this._initBody=function(e){var t;this._bodyInit=e,e?"string"==typeof e?this._bodyText=e:a&&Blob.prototype.isPrototypeOf(e)?this._bodyBlob=e:o&&FormData.prototype.isPrototypeOf(e)?this._bodyFormData=e:n&&URLSearchParams.prototype.isPrototypeOf(e)?this._bodyText=e.toString():i&&a&&((t=e)&&DataView.prototype.isPrototypeOf(t))?(this._bodyArrayBuffer=v(e.buffer),this._bodyInit=new Blob([this._bodyArrayBuffer])):i&&(ArrayBuffer.prototype.isPrototypeOf(e)||c(e))?this._bodyArrayBuffer=v(e):this._bodyText=e=Object.prototype.toString.call(e):this._bodyText="",this.headers.get("content-type")||("string"==typeof e?this.headers.set("content-type","text/plain;charset=UTF-8"):this._bodyBlob&&this._bodyBlob.type?this.headers.set("content-type",this._bodyBlob.type):n&&URLSearchParams.prototype.isPrototypeOf(e)&&this.headers.set("content-type","application/x-www-form-urlencoded;charset=UTF-8"))},a&&(this.blob=function(){var e=d(this);if(e)return e;if(this._bodyBlob)return Promise.resolve(this._bodyBlob);if(this._bodyArrayBuffer)return Promise.resolve(new Blob([this._bodyArrayBuffer]));if(this._bodyFormData)throw new Error("could not read FormData body as blob");return Promise.resolve(new Blob([this._bodyText]))},this.arrayBuffer=function(){return this._bodyArrayBuffer?d(this)||Promise.resolve(this._bodyArrayBuffer):this.blob().then(m)}),this.text=function(){var e,t,n,r=d(this);if(r)return r;if(this._bodyBlob)return e=this._bodyBlob,t=new FileReader,n=h(t),t.readAsText(e),n;if(this._bodyArrayBuffer)return Promise.resolve(function(e){for(var t=new Uint8Array(e),n=new Array(t.length),r=0;r<t.length;r++)n[r]=String.fromCharCode(t[r]);return n.join("")}(this._bodyArrayBuffer));if(this._bodyFormData)throw new Error("could not read FormData body as text");return Promise.resolve(this._bodyText)},o&&(this.formData=function(){return this.text().then(E)}),this.json=function(){return this.text().then(JSON.parse)},this}f.prototype.append=function(e,t){e=u(e),t=l(t);var n=this.map[e];this.map[e]=n?n+", "+t:t},f.prototype.delete=function(e){delete this.map[u(e)]},f.prototype.get=function(e){return e=u(e),this.has(e)?this.map[e]:null},f.prototype.has=function(e){return this.map.hasOwnProperty(u(e))},f.prototype.set=function(e,t){this.map[u(e)]=l(t)},f.prototype.forEach=function(e,t){for(var n in this.map)this.map.hasOwnProperty(n)&&e.call(t,this.map[n],n,this)},f.prototype.keys=function(){var e=[];return this.forEach((function(t,n){e.push(n)})),p(e)},f.prototype.values=function(){var e=[];return this.forEach((function(t){e.push(t)})),p(e)},f.prototype.entries=function(){var e=[];return this.forEach((function(t,n){e.push([n,t])})),p(e)},r&&(f.prototype[Symbol.iterator]=f.prototype.entries);var y=["DELETE","GET","HEAD","OPTIONS","POST","PUT"];function b(e,t){var n,r,a=(t=t||{}).body;if(e instanceof b){if(e.bodyUsed)throw new TypeError("Already read");this.url=e.url,this.credentials=e.credentials,t.headers||(this.headers=new f(e.headers)),this.method=e.method,this.mode=e.mode,this.signal=e.signal,a||null==e._bodyInit||(a=e._bodyInit,e.bodyUsed=!0)}else this.url=String(e);if(this.credentials=t.credentials||this.credentials||"same-origin",!t.headers&&this.headers||(this.headers=new f(t.headers)),this.method=(n=t.method||this.method||"GET",r=n.toUpperCase(),y.indexOf(r)>-1?r:n),this.mode=t.mode||this.mode||null,this.signal=t.signal||this.signal,this.referrer=null,("GET"===this.method||"HEAD"===this.method)&&a)throw new TypeError("Body not allowed for GET or HEAD requests");this._initBody(a)}function E(e){var t=new FormData;return e.trim().split("&").forEach((function(e){if(e){var n=e.split("="),r=n.shift().replace(/\+/g," "),a=n.join("=").replace(/\+/g," ");t.append(decodeURIComponent(r),decodeURIComponent(a))}})),t}function x(e,t){t||(t={}),this.type="default",this.status=void 0===t.status?200:t.status,this.ok=this.status>=200&&this.status<300,this.statusText="statusText"in t?t.statusText:"OK",this.headers=new f(t.headers),this.url=t.url||"",this._initBody(e)}b.prototype.clone=function(){return new b(this,{body:this._bodyInit})},g.call(b.prototype),g.call(x.prototype),x.prototype.clone=function(){return new x(this._bodyInit,{status:this.status,statusText:this.statusText,headers:new f(this.headers),url:this.url})},x.error=function(){var e=new x(null,{status:0,statusText:""});return e.type="error",e};var S=[301,302,303,307,308];x.redirect=function(e,t){if(-1===S.indexOf(t))throw new RangeError("Invalid status code");return new x(null,{status:t,headers:{location:e}})},t.DOMException=e.DOMException;try{new t.DOMException}catch(e){t.DOMException=function(e,t){this.message=e,this.name=t;var n=Error(e);this.stack=n.stack},t.DOMException.prototype=Object.create(Error.prototype),t.DOMException.prototype.constructor=t.DOMException}function w(e,n){return new Promise((function(r,o){var i=new b(e,n);if(i.signal&&i.signal.aborted)return o(new t.DOMException("Aborted","AbortError"));var s=new XMLHttpRequest;function c(){s.abort()}s.onload=function(){var e,t,n={status:s.status,statusText:s.statusText,headers:(e=s.getAllResponseHeaders()||"",t=new f,e.replace(/\r?\n[\t ]+/g," ").split(/\r?\n/).forEach((function(e){var n=e.split(":"),r=n.shift().trim();if(r){var a=n.join(":").trim();t.append(r,a)}})),t)};n.url="responseURL"in s?s.responseURL:n.headers.get("X-Request-URL");var a="response"in s?s.response:s.responseText;r(new x(a,n))},s.onerror=function(){o(new TypeError("Network request failed"))},s.ontimeout=function(){o(new TypeError("Network request failed"))},s.onabort=function(){o(new t.DOMException("Aborted","AbortError"))},s.open(i.method,i.url,!0),"include"===i.credentials?s.withCredentials=!0:"omit"===i.credentials&&(s.withCredentials=!1),"responseType"in s&&a&&(s.responseType="blob"),i.headers.forEach((function(e,t){s.setRequestHeader(t,e)})),i.signal&&(i.signal.addEventListener("abort",c),s.onreadystatechange=function(){4===s.readyState&&i.signal.removeEventListener("abort",c)}),s.send(void 0===i._bodyInit?null:i._bodyInit)}))}w.polyfill=!0,e.fetch||(e.fetch=w,e.Headers=f,e.Request=b,e.Response=x),t.Headers=f,t.Request=b,t.Response=x,t.fetch=w,Object.defineProperty(t,"__esModule",{value:!0})}({})}("undefined"!=typeof self?self:this)},function(e,t,n){"use strict";var r=n(21),a=n(38),o=n(170),i=n(34),s=n(67),c=n(106),u=n(119),l=n(41),p=n(85),f=n(59).f,d=n(76).forEach,h=n(44),m=n(68),v=m.set,g=m.getterFor;e.exports=function(e,t,n){var m,y=-1!==e.indexOf("Map"),b=-1!==e.indexOf("Weak"),E=y?"set":"add",x=a[e],S=x&&x.prototype,w={};if(h&&"function"==typeof x&&(b||S.forEach&&!i((function(){(new x).entries().next()})))){m=t((function(t,n){v(u(t,m,e),{type:e,collection:new x}),null!=n&&c(n,t[E],{that:t,AS_ENTRIES:y})}));var j=g(e);d(["add","clear","delete","forEach","get","has","set","keys","values","entries"],(function(e){var t="add"==e||"set"==e;!(e in S)||b&&"clear"==e||s(m.prototype,e,(function(n,r){var a=j(this).collection;if(!t&&b&&!l(n))return"get"==e&&void 0;var o=a[e](0===n?0:n,r);return t?this:o}))})),b||f(m.prototype,"size",{configurable:!0,get:function(){return j(this).collection.size}})}else m=n.getConstructor(t,e,y,E),o.enable();return p(m,e,!1,!0),w[e]=m,r({global:!0,forced:!0},w),b||n.setStrong(m,e,y),m}},function(e,t,n){var r=n(34),a=n(35),o=n(83),i=a("iterator");e.exports=!r((function(){var e=new URL("b?a=1&b=2&c=3","http://a"),t=e.searchParams,n="";return e.pathname="c%20d",t.forEach((function(e,r){t.delete("b"),n+=r+e})),o&&!e.toJSON||!t.sort||"http://a/c%20d?a=1&c=3"!==e.href||"3"!==t.get("c")||"a=1"!==String(new URLSearchParams("?a=1"))||!t[i]||"a"!==new URL("https://a@b").username||"b"!==new URLSearchParams(new URLSearchParams("a=b")).get("a")||"xn--e1aybc"!==new URL("http://тест").host||"#%D0%B1"!==new URL("http://a#б").hash||"a1c3"!==n||"x"!==new URL("http://x",void 0).host}))},function(e,t){e.exports="data:image/svg+xml;base64,PHN2ZyB3aWR0aD0iMjAwcHgiICBoZWlnaHQ9IjIwMHB4IiAgeG1sbnM9Imh0dHA6Ly93d3cudzMub3JnLzIwMDAvc3ZnIiB2aWV3Qm94PSIwIDAgMTAwIDEwMCIgcHJlc2VydmVBc3BlY3RSYXRpbz0ieE1pZFlNaWQiIGNsYXNzPSJsZHMtcm9sbGluZyIgc3R5bGU9ImJhY2tncm91bmQtaW1hZ2U6IG5vbmU7IGJhY2tncm91bmQtcG9zaXRpb246IGluaXRpYWwgaW5pdGlhbDsgYmFja2dyb3VuZC1yZXBlYXQ6IGluaXRpYWwgaW5pdGlhbDsiPjxjaXJjbGUgY3g9IjUwIiBjeT0iNTAiIGZpbGw9Im5vbmUiIG5nLWF0dHItc3Ryb2tlPSJ7e2NvbmZpZy5jb2xvcn19IiBuZy1hdHRyLXN0cm9rZS13aWR0aD0ie3tjb25maWcud2lkdGh9fSIgbmctYXR0ci1yPSJ7e2NvbmZpZy5yYWRpdXN9fSIgbmctYXR0ci1zdHJva2UtZGFzaGFycmF5PSJ7e2NvbmZpZy5kYXNoYXJyYXl9fSIgc3Ryb2tlPSIjNTU1NTU1IiBzdHJva2Utd2lkdGg9IjEwIiByPSIzNSIgc3Ryb2tlLWRhc2hhcnJheT0iMTY0LjkzMzYxNDMxMzQ2NDE1IDU2Ljk3Nzg3MTQzNzgyMTM4Ij48YW5pbWF0ZVRyYW5zZm9ybSBhdHRyaWJ1dGVOYW1lPSJ0cmFuc2Zvcm0iIHR5cGU9InJvdGF0ZSIgY2FsY01vZGU9ImxpbmVhciIgdmFsdWVzPSIwIDUwIDUwOzM2MCA1MCA1MCIga2V5VGltZXM9IjA7MSIgZHVyPSIxcyIgYmVnaW49IjBzIiByZXBlYXRDb3VudD0iaW5kZWZpbml0ZSI+PC9hbmltYXRlVHJhbnNmb3JtPjwvY2lyY2xlPjwvc3ZnPgo="},function(e,t){e.exports=require("redux-immutable")},function(e,t,n){var r=n(482),a=n(522)((function(e,t,n){r(e,t,n)}));e.exports=a},function(e,t,n){"use strict";Object.defineProperty(t,"__esModule",{value:!0}),t.sanitizeUrl=void 0;var r=/^([^\w]*)(javascript|data|vbscript)/im,a=/[\u0000-\u001F\u007F-\u009F\u2000-\u200D\uFEFF]/gim,o=/^([^:]+):/gm,i=[".","/"];t.sanitizeUrl=function(e){if(!e)return"about:blank";var t=e.replace(a,"").trim();if(function(e){return i.indexOf(e[0])>-1}(t))return t;var n=t.match(o);if(!n)return t;var s=n[0];return r.test(s)?"about:blank":t}},function(e,t,n){var r=n(586),a=n(593)((function(e,t,n){return t=t.toLowerCase(),e+(n?r(t):t)}));e.exports=a},function(e,t,n){var r=n(601)(n(629));e.exports=r},function(e,t){e.exports=require("xml")},function(e,t){e.exports=require("css.escape")},function(e,t){e.exports=require("sha.js")},function(e,t,n){var r=n(346),a=n(357),o=n(168),i=n(642),s=n(47);e.exports=function(e,t,n){var c=s(e)?r:i,u=arguments.length<3;return c(e,o(t,4),n,u,a)}},function(e,t,n){var r=n(104),a=n(47),o=n(70);e.exports=function(e){return"string"==typeof e||!a(e)&&o(e)&&"[object String]"==r(e)}},function(e,t,n){var r=n(48),a=n(686),o=n(356),i=Math.max,s=Math.min;e.exports=function(e,t,n){var c,u,l,p,f,d,h=0,m=!1,v=!1,g=!0;if("function"!=typeof e)throw new TypeError("Expected a function");function y(t){var n=c,r=u;return c=u=void 0,h=t,p=e.apply(r,n)}function b(e){return h=e,f=setTimeout(x,t),m?y(e):p}function E(e){var n=e-d;return void 0===d||n>=t||n<0||v&&e-h>=l}function x(){var e=a();if(E(e))return S(e);f=setTimeout(x,function(e){var n=t-(e-d);return v?s(n,l-(e-h)):n}(e))}function S(e){return f=void 0,g&&c?y(e):(c=u=void 0,p)}function w(){var e=a(),n=E(e);if(c=arguments,u=this,d=e,n){if(void 0===f)return b(d);if(v)return clearTimeout(f),f=setTimeout(x,t),y(d)}return void 0===f&&(f=setTimeout(x,t)),p}return t=o(t)||0,r(n)&&(m=!!n.leading,l=(v="maxWait"in n)?i(o(n.maxWait)||0,t):l,g="trailing"in n?!!n.trailing:g),w.cancel=function(){void 0!==f&&clearTimeout(f),h=0,c=d=u=f=void 0},w.flush=function(){return void 0===f?p:S(a())},w}},function(e,t){e.exports=require("react-dom")},function(e,t,n){var r=n(343),a=n(374),o=n(722),i=n(138),s=n(116),c=n(725),u=n(726),l=n(376),p=u((function(e,t){var n={};if(null==e)return n;var u=!1;t=r(t,(function(t){return t=i(t,e),u||(u=t.length>1),t})),s(e,l(e),n),u&&(n=a(n,7,c));for(var p=t.length;p--;)o(n,t[p]);return n}));e.exports=p},function(e,t,n){e.exports=n(730)},function(e,t){e.exports=function(e){const t={literal:"true false null"},n=[e.C_LINE_COMMENT_MODE,e.C_BLOCK_COMMENT_MODE],r=[e.QUOTE_STRING_MODE,e.C_NUMBER_MODE],a={end:",",endsWithParent:!0,excludeEnd:!0,contains:r,keywords:t},o={begin:/\{/,end:/\}/,contains:[{className:"attr",begin:/"/,end:/"/,contains:[e.BACKSLASH_ESCAPE],illegal:"\\n"},e.inherit(a,{begin:/:/})].concat(n),illegal:"\\S"},i={begin:"\\[",end:"\\]",contains:[e.inherit(a)],illegal:"\\S"};return r.push(o,i),n.forEach((function(e){r.push(e)})),{name:"JSON",contains:r,keywords:t,illegal:"\\S"}}},function(e,t){const n="[A-Za-z$_][0-9A-Za-z$_]*",r=["as","in","of","if","for","while","finally","var","new","function","do","return","void","else","break","catch","instanceof","with","throw","case","default","try","switch","continue","typeof","delete","let","yield","const","class","debugger","async","await","static","import","from","export","extends"],a=["true","false","null","undefined","NaN","Infinity"],o=[].concat(["setInterval","setTimeout","clearInterval","clearTimeout","require","exports","eval","isFinite","isNaN","parseFloat","parseInt","decodeURI","decodeURIComponent","encodeURI","encodeURIComponent","escape","unescape"],["arguments","this","super","console","window","document","localStorage","module","global"],["Intl","DataView","Number","Math","Date","String","RegExp","Object","Function","Boolean","Error","Symbol","Set","Map","WeakSet","WeakMap","Proxy","Reflect","JSON","Promise","Float64Array","Int16Array","Int32Array","Int8Array","Uint16Array","Uint32Array","Float32Array","Array","Uint8Array","Uint8ClampedArray","ArrayBuffer","BigInt64Array","BigUint64Array","BigInt"],["EvalError","InternalError","RangeError","ReferenceError","SyntaxError","TypeError","URIError"]);function i(e){return s("(?=",e,")")}function s(...e){return e.map((e=>{return(t=e)?"string"==typeof t?t:t.source:null;var t})).join("")}e.exports=function(e){const t=n,c="<>",u="</>",l={begin:/<[A-Za-z0-9\\._:-]+/,end:/\/[A-Za-z0-9\\._:-]+>|\/>/,isTrulyOpeningTag:(e,t)=>{const n=e[0].length+e.index,r=e.input[n];"<"!==r?">"===r&&(((e,{after:t})=>{const n="</"+e[0].slice(1);return-1!==e.input.indexOf(n,t)})(e,{after:n})||t.ignoreMatch()):t.ignoreMatch()}},p={$pattern:n,keyword:r,literal:a,built_in:o},f="\\.([0-9](_?[0-9])*)",d="0|[1-9](_?[0-9])*|0[0-7]*[89][0-9]*",h={className:"number",variants:[{begin:`(\\b(${d})((${f})|\\.)?|(${f}))[eE][+-]?([0-9](_?[0-9])*)\\b`},{begin:`\\b(${d})\\b((${f})\\b|\\.)?|(${f})\\b`},{begin:"\\b(0|[1-9](_?[0-9])*)n\\b"},{begin:"\\b0[xX][0-9a-fA-F](_?[0-9a-fA-F])*n?\\b"},{begin:"\\b0[bB][0-1](_?[0-1])*n?\\b"},{begin:"\\b0[oO][0-7](_?[0-7])*n?\\b"},{begin:"\\b0[0-7]+n?\\b"}],relevance:0},m={className:"subst",begin:"\\$\\{",end:"\\}",keywords:p,contains:[]},v={begin:"html`",end:"",starts:{end:"`",returnEnd:!1,contains:[e.BACKSLASH_ESCAPE,m],subLanguage:"xml"}},g={begin:"css`",end:"",starts:{end:"`",returnEnd:!1,contains:[e.BACKSLASH_ESCAPE,m],subLanguage:"css"}},y={className:"string",begin:"`",end:"`",contains:[e.BACKSLASH_ESCAPE,m]},b={className:"comment",variants:[e.COMMENT(/\/\*\*(?!\/)/,"\\*/",{relevance:0,contains:[{className:"doctag",begin:"@[A-Za-z]+",contains:[{className:"type",begin:"\\{",end:"\\}",relevance:0},{className:"variable",begin:t+"(?=\\s*(-)|$)",endsParent:!0,relevance:0},{begin:/(?=[^\n])\s/,relevance:0}]}]}),e.C_BLOCK_COMMENT_MODE,e.C_LINE_COMMENT_MODE]},E=[e.APOS_STRING_MODE,e.QUOTE_STRING_MODE,v,g,y,h,e.REGEXP_MODE];m.contains=E.concat({begin:/\{/,end:/\}/,keywords:p,contains:["self"].concat(E)});const x=[].concat(b,m.contains),S=x.concat([{begin:/\(/,end:/\)/,keywords:p,contains:["self"].concat(x)}]),w={className:"params",begin:/\(/,end:/\)/,excludeBegin:!0,excludeEnd:!0,keywords:p,contains:S};return{name:"Javascript",aliases:["js","jsx","mjs","cjs"],keywords:p,exports:{PARAMS_CONTAINS:S},illegal:/#(?![$_A-z])/,contains:[e.SHEBANG({label:"shebang",binary:"node",relevance:5}),{label:"use_strict",className:"meta",relevance:10,begin:/^\s*['"]use (strict|asm)['"]/},e.APOS_STRING_MODE,e.QUOTE_STRING_MODE,v,g,y,b,h,{begin:s(/[{,\n]\s*/,i(s(/(((\/\/.*$)|(\/\*(\*[^/]|[^*])*\*\/))\s*)*/,t+"\\s*:"))),relevance:0,contains:[{className:"attr",begin:t+i("\\s*:"),relevance:0}]},{begin:"("+e.RE_STARTERS_RE+"|\\b(case|return|throw)\\b)\\s*",keywords:"return throw case",contains:[b,e.REGEXP_MODE,{className:"function",begin:"(\\([^()]*(\\([^()]*(\\([^()]*\\)[^()]*)*\\)[^()]*)*\\)|"+e.UNDERSCORE_IDENT_RE+")\\s*=>",returnBegin:!0,end:"\\s*=>",contains:[{className:"params",variants:[{begin:e.UNDERSCORE_IDENT_RE,relevance:0},{className:null,begin:/\(\s*\)/,skip:!0},{begin:/\(/,end:/\)/,excludeBegin:!0,excludeEnd:!0,keywords:p,contains:S}]}]},{begin:/,/,relevance:0},{className:"",begin:/\s/,end:/\s*/,skip:!0},{variants:[{begin:c,end:u},{begin:l.begin,"on:begin":l.isTrulyOpeningTag,end:l.end}],subLanguage:"xml",contains:[{begin:l.begin,end:l.end,skip:!0,contains:["self"]}]}],relevance:0},{className:"function",beginKeywords:"function",end:/[{;]/,excludeEnd:!0,keywords:p,contains:["self",e.inherit(e.TITLE_MODE,{begin:t}),w],illegal:/%/},{beginKeywords:"while if switch catch for"},{className:"function",begin:e.UNDERSCORE_IDENT_RE+"\\([^()]*(\\([^()]*(\\([^()]*\\)[^()]*)*\\)[^()]*)*\\)\\s*\\{",returnBegin:!0,contains:[w,e.inherit(e.TITLE_MODE,{begin:t})]},{variants:[{begin:"\\."+t},{begin:"\\$"+t}],relevance:0},{className:"class",beginKeywords:"class",end:/[{;=]/,excludeEnd:!0,illegal:/[:"[\]]/,contains:[{beginKeywords:"extends"},e.UNDERSCORE_TITLE_MODE]},{begin:/\b(?=constructor)/,end:/[{;]/,excludeEnd:!0,contains:[e.inherit(e.TITLE_MODE,{begin:t}),"self",w]},{begin:"(get|set)\\s+(?="+t+"\\()",end:/\{/,keywords:"get set",contains:[e.inherit(e.TITLE_MODE,{begin:t}),{begin:/\(\)/},w]},{begin:/\$[(.]/}]}}},function(e,t){function n(e){return e?"string"==typeof e?e:e.source:null}function r(e){return a("(?=",e,")")}function a(...e){return e.map((e=>n(e))).join("")}function o(...e){return"("+e.map((e=>n(e))).join("|")+")"}e.exports=function(e){const t=a(/[A-Z_]/,a("(",/[A-Z0-9_.-]*:/,")?"),/[A-Z0-9_.-]*/),n={className:"symbol",begin:/&[a-z]+;|&#[0-9]+;|&#x[a-f0-9]+;/},i={begin:/\s/,contains:[{className:"meta-keyword",begin:/#?[a-z_][a-z1-9_-]+/,illegal:/\n/}]},s=e.inherit(i,{begin:/\(/,end:/\)/}),c=e.inherit(e.APOS_STRING_MODE,{className:"meta-string"}),u=e.inherit(e.QUOTE_STRING_MODE,{className:"meta-string"}),l={endsWithParent:!0,illegal:/</,relevance:0,contains:[{className:"attr",begin:/[A-Za-z0-9._:-]+/,relevance:0},{begin:/=\s*/,relevance:0,contains:[{className:"string",endsParent:!0,variants:[{begin:/"/,end:/"/,contains:[n]},{begin:/'/,end:/'/,contains:[n]},{begin:/[^\s"'=<>`]+/}]}]}]};return{name:"HTML, XML",aliases:["html","xhtml","rss","atom","xjb","xsd","xsl","plist","wsf","svg"],case_insensitive:!0,contains:[{className:"meta",begin:/<![a-z]/,end:/>/,relevance:10,contains:[i,u,c,s,{begin:/\[/,end:/\]/,contains:[{className:"meta",begin:/<![a-z]/,end:/>/,contains:[i,s,u,c]}]}]},e.COMMENT(/<!--/,/-->/,{relevance:10}),{begin:/<!\[CDATA\[/,end:/\]\]>/,relevance:10},n,{className:"meta",begin:/<\?xml/,end:/\?>/,relevance:10},{className:"tag",begin:/<style(?=\s|>)/,end:/>/,keywords:{name:"style"},contains:[l],starts:{end:/<\/style>/,returnEnd:!0,subLanguage:["css","xml"]}},{className:"tag",begin:/<script(?=\s|>)/,end:/>/,keywords:{name:"script"},contains:[l],starts:{end:/<\/script>/,returnEnd:!0,subLanguage:["javascript","handlebars","xml"]}},{className:"tag",begin:/<>|<\/>/},{className:"tag",begin:a(/</,r(a(t,o(/\/>/,/>/,/\s/)))),end:/\/?>/,contains:[{className:"name",begin:t,relevance:0,starts:l}]},{className:"tag",begin:a(/<\//,r(a(t,/>/))),contains:[{className:"name",begin:t,relevance:0},{begin:/>/,relevance:0,endsParent:!0}]}]}}},function(e,t){e.exports=function(e){var t="true false yes no null",n="[\\w#;/?:@&=+$,.~*'()[\\]]+",r={className:"string",relevance:0,variants:[{begin:/'/,end:/'/},{begin:/"/,end:/"/},{begin:/\S+/}],contains:[e.BACKSLASH_ESCAPE,{className:"template-variable",variants:[{begin:/\{\{/,end:/\}\}/},{begin:/%\{/,end:/\}/}]}]},a=e.inherit(r,{variants:[{begin:/'/,end:/'/},{begin:/"/,end:/"/},{begin:/[^\s,{}[\]]+/}]}),o={className:"number",begin:"\\b[0-9]{4}(-[0-9][0-9]){0,2}([Tt \\t][0-9][0-9]?(:[0-9][0-9]){2})?(\\.[0-9]*)?([ \\t])*(Z|[-+][0-9][0-9]?(:[0-9][0-9])?)?\\b"},i={end:",",endsWithParent:!0,excludeEnd:!0,keywords:t,relevance:0},s={begin:/\{/,end:/\}/,contains:[i],illegal:"\\n",relevance:0},c={begin:"\\[",end:"\\]",contains:[i],illegal:"\\n",relevance:0},u=[{className:"attr",variants:[{begin:"\\w[\\w :\\/.-]*:(?=[ \t]|$)"},{begin:'"\\w[\\w :\\/.-]*":(?=[ \t]|$)'},{begin:"'\\w[\\w :\\/.-]*':(?=[ \t]|$)"}]},{className:"meta",begin:"^---\\s*$",relevance:10},{className:"string",begin:"[\\|>]([1-9]?[+-])?[ ]*\\n( +)[^ ][^\\n]*\\n(\\2[^\\n]+\\n?)*"},{begin:"<%[%=-]?",end:"[%-]?%>",subLanguage:"ruby",excludeBegin:!0,excludeEnd:!0,relevance:0},{className:"type",begin:"!\\w+!"+n},{className:"type",begin:"!<"+n+">"},{className:"type",begin:"!"+n},{className:"type",begin:"!!"+n},{className:"meta",begin:"&"+e.UNDERSCORE_IDENT_RE+"$"},{className:"meta",begin:"\\*"+e.UNDERSCORE_IDENT_RE+"$"},{className:"bullet",begin:"-(?=[ ]|$)",relevance:0},e.HASH_COMMENT_MODE,{beginKeywords:t,keywords:{literal:t}},o,{className:"number",begin:e.C_NUMBER_RE+"\\b",relevance:0},s,c,r],l=[...u];return l.pop(),l.push(a),i.contains=l,{name:"YAML",case_insensitive:!0,aliases:["yml"],contains:u}}},function(e,t){function n(...e){return e.map((e=>{return(t=e)?"string"==typeof t?t:t.source:null;var t})).join("")}e.exports=function(e){const t="HTTP/(2|1\\.[01])",r={className:"attribute",begin:n("^",/[A-Za-z][A-Za-z0-9-]*/,"(?=\\:\\s)"),starts:{contains:[{className:"punctuation",begin:/: /,relevance:0,starts:{end:"$",relevance:0}}]}},a=[r,{begin:"\\n\\n",starts:{subLanguage:[],endsWithParent:!0}}];return{name:"HTTP",aliases:["https"],illegal:/\S/,contains:[{begin:"^(?="+t+" \\d{3})",end:/$/,contains:[{className:"meta",begin:t},{className:"number",begin:"\\b\\d{3}\\b"}],starts:{end:/\b\B/,illegal:/\S/,contains:a}},{begin:"(?=^[A-Z]+ (.*?) "+t+"$)",end:/$/,contains:[{className:"string",begin:" ",end:" ",excludeBegin:!0,excludeEnd:!0},{className:"meta",begin:t},{className:"keyword",begin:"[A-Z]+"}],starts:{end:/\b\B/,illegal:/\S/,contains:a}},e.inherit(r,{relevance:0})]}}},function(e,t){function n(...e){return e.map((e=>{return(t=e)?"string"==typeof t?t:t.source:null;var t})).join("")}e.exports=function(e){const t={},r={begin:/\$\{/,end:/\}/,contains:["self",{begin:/:-/,contains:[t]}]};Object.assign(t,{className:"variable",variants:[{begin:n(/\$[\w\d#@][\w\d_]*/,"(?![\\w\\d])(?![$])")},r]});const a={className:"subst",begin:/\$\(/,end:/\)/,contains:[e.BACKSLASH_ESCAPE]},o={begin:/<<-?\s*(?=\w+)/,starts:{contains:[e.END_SAME_AS_BEGIN({begin:/(\w+)/,end:/(\w+)/,className:"string"})]}},i={className:"string",begin:/"/,end:/"/,contains:[e.BACKSLASH_ESCAPE,t,a]};a.contains.push(i);const s={begin:/\$\(\(/,end:/\)\)/,contains:[{begin:/\d+#[0-9a-f]+/,className:"number"},e.NUMBER_MODE,t]},c=e.SHEBANG({binary:`(${["fish","bash","zsh","sh","csh","ksh","tcsh","dash","scsh"].join("|")})`,relevance:10}),u={className:"function",begin:/\w[\w\d_]*\s*\(\s*\)\s*\{/,returnBegin:!0,contains:[e.inherit(e.TITLE_MODE,{begin:/\w[\w\d_]*/})],relevance:0};return{name:"Bash",aliases:["sh","zsh"],keywords:{$pattern:/\b[a-z._-]+\b/,keyword:"if then else elif fi for while in do done case esac function",literal:"true false",built_in:"break cd continue eval exec exit export getopts hash pwd readonly return shift test times trap umask unset alias bind builtin caller command declare echo enable help let local logout mapfile printf read readarray source type typeset ulimit unalias set shopt autoload bg bindkey bye cap chdir clone comparguments compcall compctl compdescribe compfiles compgroups compquote comptags comptry compvalues dirs disable disown echotc echoti emulate fc fg float functions getcap getln history integer jobs kill limit log noglob popd print pushd pushln rehash sched setcap setopt stat suspend ttyctl unfunction unhash unlimit unsetopt vared wait whence where which zcompile zformat zftp zle zmodload zparseopts zprof zpty zregexparse zsocket zstyle ztcp"},contains:[c,e.SHEBANG(),u,s,e.HASH_COMMENT_MODE,o,i,{className:"",begin:/\\"/},{className:"string",begin:/'/,end:/'/},t]}}},function(e,t){e.exports=function(e){const t={$pattern:/-?[A-z\.\-]+\b/,keyword:"if else foreach return do while until elseif begin for trap data dynamicparam end break throw param continue finally in switch exit filter try process catch hidden static parameter",built_in:"ac asnp cat cd CFS chdir clc clear clhy cli clp cls clv cnsn compare copy cp cpi cpp curl cvpa dbp del diff dir dnsn ebp echo|0 epal epcsv epsn erase etsn exsn fc fhx fl ft fw gal gbp gc gcb gci gcm gcs gdr gerr ghy gi gin gjb gl gm gmo gp gps gpv group gsn gsnp gsv gtz gu gv gwmi h history icm iex ihy ii ipal ipcsv ipmo ipsn irm ise iwmi iwr kill lp ls man md measure mi mount move mp mv nal ndr ni nmo npssc nsn nv ogv oh popd ps pushd pwd r rbp rcjb rcsn rd rdr ren ri rjb rm rmdir rmo rni rnp rp rsn rsnp rujb rv rvpa rwmi sajb sal saps sasv sbp sc scb select set shcm si sl sleep sls sort sp spjb spps spsv start stz sujb sv swmi tee trcm type wget where wjb write"},n={begin:"`[\\s\\S]",relevance:0},r={className:"variable",variants:[{begin:/\$\B/},{className:"keyword",begin:/\$this/},{begin:/\$[\w\d][\w\d_:]*/}]},a={className:"string",variants:[{begin:/"/,end:/"/},{begin:/@"/,end:/^"@/}],contains:[n,r,{className:"variable",begin:/\$[A-z]/,end:/[^A-z]/}]},o={className:"string",variants:[{begin:/'/,end:/'/},{begin:/@'/,end:/^'@/}]},i=e.inherit(e.COMMENT(null,null),{variants:[{begin:/#/,end:/$/},{begin:/<#/,end:/#>/}],contains:[{className:"doctag",variants:[{begin:/\.(synopsis|description|example|inputs|outputs|notes|link|component|role|functionality)/},{begin:/\.(parameter|forwardhelptargetname|forwardhelpcategory|remotehelprunspace|externalhelp)\s+\S+/}]}]}),s={className:"built_in",variants:[{begin:"(".concat("Add|Clear|Close|Copy|Enter|Exit|Find|Format|Get|Hide|Join|Lock|Move|New|Open|Optimize|Pop|Push|Redo|Remove|Rename|Reset|Resize|Search|Select|Set|Show|Skip|Split|Step|Switch|Undo|Unlock|Watch|Backup|Checkpoint|Compare|Compress|Convert|ConvertFrom|ConvertTo|Dismount|Edit|Expand|Export|Group|Import|Initialize|Limit|Merge|Mount|Out|Publish|Restore|Save|Sync|Unpublish|Update|Approve|Assert|Build|Complete|Confirm|Deny|Deploy|Disable|Enable|Install|Invoke|Register|Request|Restart|Resume|Start|Stop|Submit|Suspend|Uninstall|Unregister|Wait|Debug|Measure|Ping|Repair|Resolve|Test|Trace|Connect|Disconnect|Read|Receive|Send|Write|Block|Grant|Protect|Revoke|Unblock|Unprotect|Use|ForEach|Sort|Tee|Where",")+(-)[\\w\\d]+")}]},c={className:"class",beginKeywords:"class enum",end:/\s*[{]/,excludeEnd:!0,relevance:0,contains:[e.TITLE_MODE]},u={className:"function",begin:/function\s+/,end:/\s*\{|$/,excludeEnd:!0,returnBegin:!0,relevance:0,contains:[{begin:"function",relevance:0,className:"keyword"},{className:"title",begin:/\w[\w\d]*((-)[\w\d]+)*/,relevance:0},{begin:/\(/,end:/\)/,className:"params",relevance:0,contains:[r]}]},l={begin:/using\s/,end:/$/,returnBegin:!0,contains:[a,o,{className:"keyword",begin:/(using|assembly|command|module|namespace|type)/}]},p={variants:[{className:"operator",begin:"(".concat("-and|-as|-band|-bnot|-bor|-bxor|-casesensitive|-ccontains|-ceq|-cge|-cgt|-cle|-clike|-clt|-cmatch|-cne|-cnotcontains|-cnotlike|-cnotmatch|-contains|-creplace|-csplit|-eq|-exact|-f|-file|-ge|-gt|-icontains|-ieq|-ige|-igt|-ile|-ilike|-ilt|-imatch|-in|-ine|-inotcontains|-inotlike|-inotmatch|-ireplace|-is|-isnot|-isplit|-join|-le|-like|-lt|-match|-ne|-not|-notcontains|-notin|-notlike|-notmatch|-or|-regex|-replace|-shl|-shr|-split|-wildcard|-xor",")\\b")},{className:"literal",begin:/(-)[\w\d]+/,relevance:0}]},f={className:"function",begin:/\[.*\]\s*[\w]+[ ]??\(/,end:/$/,returnBegin:!0,relevance:0,contains:[{className:"keyword",begin:"(".concat(t.keyword.toString().replace(/\s/g,"|"),")\\b"),endsParent:!0,relevance:0},e.inherit(e.TITLE_MODE,{endsParent:!0})]},d=[f,i,n,e.NUMBER_MODE,a,o,s,r,{className:"literal",begin:/\$(null|true|false)\b/},{className:"selector-tag",begin:/@\B/,relevance:0}],h={begin:/\[/,end:/\]/,excludeBegin:!0,excludeEnd:!0,relevance:0,contains:[].concat("self",d,{begin:"("+["string","char","byte","int","long","bool","decimal","single","double","DateTime","xml","array","hashtable","void"].join("|")+")",className:"built_in",relevance:0},{className:"type",begin:/[\.\w\d]+/,relevance:0})};return f.contains.unshift(h),{name:"PowerShell",aliases:["ps","ps1"],case_insensitive:!0,keywords:t,contains:d.concat(c,u,l,p,h)}}},function(e,t,n){var r=n(374);e.exports=function(e){return r(e,5)}},function(e,t,n){e.exports=n(743)},function(e,t){e.exports=require("traverse")},function(e,t){e.exports=require("cookie")},function(e,t){e.exports=require("zenscroll")},function(e,t,n){e.exports=n(758)},function(e,t){e.exports=require("js-file-download")},function(e,t,n){e.exports=n(765)},function(e,t,n){e.exports=n(768)},function(e,t){e.exports=require("xml-but-prettier")},function(e,t){e.exports=require("react-immutable-pure-component")},function(e,t){e.exports=require("autolinker")},function(e,t,n){e.exports=n(774)},function(e,t,n){var r=n(416);n(438),n(439),n(440),n(441),n(442),n(443),n(444),e.exports=r},function(e,t,n){n(289),n(84),n(293),n(422),n(423),n(424),n(425),n(299),n(426),n(427),n(428),n(429),n(430),n(431),n(432),n(433),n(434),n(435),n(436),n(437);var r=n(31);e.exports=r.Symbol},function(e,t,n){var r=n(41),a=n(151),o=n(418),i=n(35)("toPrimitive");e.exports=function(e,t){if(!r(e)||a(e))return e;var n,s=e[i];if(void 0!==s){if(void 0===t&&(t="default"),n=s.call(e,t),!r(n)||a(n))return n;throw TypeError("Can't convert object to primitive value")}return void 0===t&&(t="number"),o(e,t)}},function(e,t,n){var r=n(41);e.exports=function(e,t){var n,a;if("string"===t&&"function"==typeof(n=e.toString)&&!r(a=n.call(e)))return a;if("function"==typeof(n=e.valueOf)&&!r(a=n.call(e)))return a;if("string"!==t&&"function"==typeof(n=e.toString)&&!r(a=n.call(e)))return a;throw TypeError("Can't convert object to primitive value")}},function(e,t,n){var r=n(38);e.exports=function(e,t){try{Object.defineProperty(r,e,{value:t,configurable:!0,writable:!0})}catch(n){r[e]=t}return t}},function(e,t,n){var r=n(41),a=n(128),o=n(35)("species");e.exports=function(e){var t;return a(e)&&("function"!=typeof(t=e.constructor)||t!==Array&&!a(t.prototype)?r(t)&&null===(t=t[o])&&(t=void 0):t=void 0),void 0===t?Array:t}},function(e,t,n){"use strict";var r=n(196),a=n(86);e.exports=r?{}.toString:function(){return"[object "+a(this)+"]"}},function(e,t,n){n(40)("asyncIterator")},function(e,t){},function(e,t,n){n(40)("hasInstance")},function(e,t,n){n(40)("isConcatSpreadable")},function(e,t,n){n(40)("match")},function(e,t,n){n(40)("matchAll")},function(e,t,n){n(40)("replace")},function(e,t,n){n(40)("search")},function(e,t,n){n(40)("species")},function(e,t,n){n(40)("split")},function(e,t,n){n(40)("toPrimitive")},function(e,t,n){n(40)("toStringTag")},function(e,t,n){n(40)("unscopables")},function(e,t,n){var r=n(38);n(85)(r.JSON,"JSON",!0)},function(e,t){},function(e,t){},function(e,t,n){n(40)("asyncDispose")},function(e,t,n){n(40)("dispose")},function(e,t,n){n(40)("matcher")},function(e,t,n){n(40)("metadata")},function(e,t,n){n(40)("observable")},function(e,t,n){n(40)("patternMatch")},function(e,t,n){n(40)("replaceAll")},function(e,t,n){e.exports=n(446)},function(e,t,n){var r=n(447);e.exports=r},function(e,t,n){n(69),n(84),n(114),n(299);var r=n(195);e.exports=r.f("iterator")},function(e,t,n){var r=n(41);e.exports=function(e){if(!r(e)&&null!==e)throw TypeError("Can't set "+String(e)+" as a prototype");return e}},function(e,t,n){var r=n(450);e.exports=r},function(e,t,n){var r=n(451),a=Array.prototype;e.exports=function(e){var t=e.concat;return e===a||e instanceof Array&&t===a.concat?r:t}},function(e,t,n){n(289);var r=n(39);e.exports=r("Array").concat},function(e,t,n){var r=n(304);e.exports=r},function(e,t,n){n(454);var r=n(39);e.exports=r("Array").filter},function(e,t,n){"use strict";var r=n(21),a=n(76).filter;r({target:"Array",proto:!0,forced:!n(130)("filter")},{filter:function(e){return a(this,e,arguments.length>1?arguments[1]:void 0)}})},function(e,t,n){var r=n(305);e.exports=r},function(e,t,n){var r=n(21),a=n(56),o=n(131);r({target:"Object",stat:!0,forced:n(34)((function(){o(1)}))},{keys:function(e){return o(a(e))}})},function(e,t,n){var r=n(458);e.exports=r},function(e,t,n){n(459);var r=n(31);r.JSON||(r.JSON={stringify:JSON.stringify}),e.exports=function(e,t,n){return r.JSON.stringify.apply(null,arguments)}},function(e,t,n){var r=n(21),a=n(55),o=n(34),i=a("JSON","stringify"),s=/[\uD800-\uDFFF]/g,c=/^[\uD800-\uDBFF]$/,u=/^[\uDC00-\uDFFF]$/,l=function(e,t,n){var r=n.charAt(t-1),a=n.charAt(t+1);return c.test(e)&&!u.test(a)||u.test(e)&&!c.test(r)?"\\u"+e.charCodeAt(0).toString(16):e},p=o((function(){return'"\\udf06\\ud834"'!==i("\udf06\ud834")||'"\\udead"'!==i("\udead")}));i&&r({target:"JSON",stat:!0,forced:p},{stringify:function(e,t,n){var r=i.apply(null,arguments);return"string"==typeof r?r.replace(s,l):r}})},function(e,t,n){var r=n(306);e.exports=r},function(e,t,n){var r=n(21),a=n(44);r({target:"Object",stat:!0,forced:!a,sham:!a},{defineProperty:n(59).f})},function(e,t,n){var r=n(463);e.exports=r},function(e,t,n){var r=n(464),a=Function.prototype;e.exports=function(e){var t=e.bind;return e===a||e instanceof Function&&t===a.bind?r:t}},function(e,t,n){n(465);var r=n(39);e.exports=r("Function").bind},function(e,t,n){n(21)({target:"Function",proto:!0},{bind:n(307)})},function(e,t,n){var r=n(308);e.exports=r},function(e,t,n){var r=n(21),a=n(309);r({target:"Object",stat:!0,forced:Object.assign!==a},{assign:a})},function(e,t,n){var r=n(310);e.exports=r},function(e,t,n){n(470);var r=n(39);e.exports=r("Array").slice},function(e,t,n){"use strict";var r=n(21),a=n(41),o=n(128),i=n(192),s=n(60),c=n(58),u=n(129),l=n(35),p=n(130)("slice"),f=l("species"),d=[].slice,h=Math.max;r({target:"Array",proto:!0,forced:!p},{slice:function(e,t){var n,r,l,p=c(this),m=s(p.length),v=i(e,m),g=i(void 0===t?m:t,m);if(o(p)&&("function"!=typeof(n=p.constructor)||n!==Array&&!o(n.prototype)?a(n)&&null===(n=n[f])&&(n=void 0):n=void 0,n===Array||void 0===n))return d.call(p,v,g);for(r=new(void 0===n?Array:n)(h(g-v,0)),l=0;v<g;v++,l++)v in p&&u(r,l,p[v]);return r.length=l,r}})},function(e,t,n){var r=n(311);e.exports=r},function(e,t,n){n(21)({target:"Array",stat:!0},{isArray:n(128)})},function(e,t,n){var r=n(474);e.exports=r},function(e,t,n){var r=n(475),a=Array.prototype;e.exports=function(e){var t=e.reduce;return e===a||e instanceof Array&&t===a.reduce?r:t}},function(e,t,n){n(476);var r=n(39);e.exports=r("Array").reduce},function(e,t,n){"use strict";var r=n(21),a=n(477).left,o=n(102),i=n(111),s=n(157);r({target:"Array",proto:!0,forced:!o("reduce")||!s&&i>79&&i<83},{reduce:function(e){return a(this,e,arguments.length,arguments.length>1?arguments[1]:void 0)}})},function(e,t,n){var r=n(66),a=n(56),o=n(149),i=n(60),s=function(e){return function(t,n,s,c){r(n);var u=a(t),l=o(u),p=i(u.length),f=e?p-1:0,d=e?-1:1;if(s<2)for(;;){if(f in l){c=l[f],f+=d;break}if(f+=d,e?f<0:p<=f)throw TypeError("Reduce of empty array with no initial value")}for(;e?f>=0:p>f;f+=d)f in l&&(c=n(c,l[f],f,u));return c}};e.exports={left:s(!1),right:s(!0)}},function(e,t,n){var r=n(479);e.exports=r},function(e,t,n){var r=n(480),a=Array.prototype;e.exports=function(e){var t=e.map;return e===a||e instanceof Array&&t===a.map?r:t}},function(e,t,n){n(481);var r=n(39);e.exports=r("Array").map},function(e,t,n){"use strict";var r=n(21),a=n(76).map;r({target:"Array",proto:!0,forced:!n(130)("map")},{map:function(e){return a(this,e,arguments.length>1?arguments[1]:void 0)}})},function(e,t,n){var r=n(158),a=n(314),o=n(316),i=n(512),s=n(48),c=n(135),u=n(323);e.exports=function e(t,n,l,p,f){t!==n&&o(n,(function(o,c){if(f||(f=new r),s(o))i(t,n,c,l,e,p,f);else{var d=p?p(u(t,c),o,c+"",t,n,f):void 0;void 0===d&&(d=o),a(t,c,d)}}),c)}},function(e,t){e.exports=function(){this.__data__=[],this.size=0}},function(e,t,n){var r=n(160),a=Array.prototype.splice;e.exports=function(e){var t=this.__data__,n=r(t,e);return!(n<0)&&(n==t.length-1?t.pop():a.call(t,n,1),--this.size,!0)}},function(e,t,n){var r=n(160);e.exports=function(e){var t=this.__data__,n=r(t,e);return n<0?void 0:t[n][1]}},function(e,t,n){var r=n(160);e.exports=function(e){return r(this.__data__,e)>-1}},function(e,t,n){var r=n(160);e.exports=function(e,t){var n=this.__data__,a=r(n,e);return a<0?(++this.size,n.push([e,t])):n[a][1]=t,this}},function(e,t,n){var r=n(159);e.exports=function(){this.__data__=new r,this.size=0}},function(e,t){e.exports=function(e){var t=this.__data__,n=t.delete(e);return this.size=t.size,n}},function(e,t){e.exports=function(e){return this.__data__.get(e)}},function(e,t){e.exports=function(e){return this.__data__.has(e)}},function(e,t,n){var r=n(159),a=n(200),o=n(201);e.exports=function(e,t){var n=this.__data__;if(n instanceof r){var i=n.__data__;if(!a||i.length<199)return i.push([e,t]),this.size=++n.size,this;n=this.__data__=new o(i)}return n.set(e,t),this.size=n.size,this}},function(e,t,n){var r=n(89),a=n(496),o=n(48),i=n(313),s=/^\[object .+?Constructor\]$/,c=Function.prototype,u=Object.prototype,l=c.toString,p=u.hasOwnProperty,f=RegExp("^"+l.call(p).replace(/[\\^$.*+?()[\]{}|]/g,"\\$&").replace(/hasOwnProperty|(function).*?(?=\\\()| for .+?(?=\\\])/g,"$1.*?")+"$");e.exports=function(e){return!(!o(e)||a(e))&&(r(e)?f:s).test(i(e))}},function(e,t,n){var r=n(115),a=Object.prototype,o=a.hasOwnProperty,i=a.toString,s=r?r.toStringTag:void 0;e.exports=function(e){var t=o.call(e,s),n=e[s];try{e[s]=void 0;var r=!0}catch(e){}var a=i.call(e);return r&&(t?e[s]=n:delete e[s]),a}},function(e,t){var n=Object.prototype.toString;e.exports=function(e){return n.call(e)}},function(e,t,n){var r,a=n(497),o=(r=/[^.]+$/.exec(a&&a.keys&&a.keys.IE_PROTO||""))?"Symbol(src)_1."+r:"";e.exports=function(e){return!!o&&o in e}},function(e,t,n){var r=n(62)["__core-js_shared__"];e.exports=r},function(e,t){e.exports=function(e,t){return null==e?void 0:e[t]}},function(e,t,n){var r=n(500),a=n(159),o=n(200);e.exports=function(){this.size=0,this.__data__={hash:new r,map:new(o||a),string:new r}}},function(e,t,n){var r=n(501),a=n(502),o=n(503),i=n(504),s=n(505);function c(e){var t=-1,n=null==e?0:e.length;for(this.clear();++t<n;){var r=e[t];this.set(r[0],r[1])}}c.prototype.clear=r,c.prototype.delete=a,c.prototype.get=o,c.prototype.has=i,c.prototype.set=s,e.exports=c},function(e,t,n){var r=n(161);e.exports=function(){this.__data__=r?r(null):{},this.size=0}},function(e,t){e.exports=function(e){var t=this.has(e)&&delete this.__data__[e];return this.size-=t?1:0,t}},function(e,t,n){var r=n(161),a=Object.prototype.hasOwnProperty;e.exports=function(e){var t=this.__data__;if(r){var n=t[e];return"__lodash_hash_undefined__"===n?void 0:n}return a.call(t,e)?t[e]:void 0}},function(e,t,n){var r=n(161),a=Object.prototype.hasOwnProperty;e.exports=function(e){var t=this.__data__;return r?void 0!==t[e]:a.call(t,e)}},function(e,t,n){var r=n(161);e.exports=function(e,t){var n=this.__data__;return this.size+=this.has(e)?0:1,n[e]=r&&void 0===t?"__lodash_hash_undefined__":t,this}},function(e,t,n){var r=n(162);e.exports=function(e){var t=r(this,e).delete(e);return this.size-=t?1:0,t}},function(e,t){e.exports=function(e){var t=typeof e;return"string"==t||"number"==t||"symbol"==t||"boolean"==t?"__proto__"!==e:null===e}},function(e,t,n){var r=n(162);e.exports=function(e){return r(this,e).get(e)}},function(e,t,n){var r=n(162);e.exports=function(e){return r(this,e).has(e)}},function(e,t,n){var r=n(162);e.exports=function(e,t){var n=r(this,e),a=n.size;return n.set(e,t),this.size+=n.size==a?0:1,this}},function(e,t){e.exports=function(e){return function(t,n,r){for(var a=-1,o=Object(t),i=r(t),s=i.length;s--;){var c=i[e?s:++a];if(!1===n(o[c],c,o))break}return t}}},function(e,t,n){var r=n(314),a=n(317),o=n(318),i=n(320),s=n(321),c=n(133),u=n(47),l=n(515),p=n(134),f=n(89),d=n(48),h=n(120),m=n(164),v=n(323),g=n(518);e.exports=function(e,t,n,y,b,E,x){var S=v(e,n),w=v(t,n),j=x.get(w);if(j)r(e,n,j);else{var O=E?E(S,w,n+"",e,t,x):void 0,C=void 0===O;if(C){var _=u(w),A=!_&&p(w),k=!_&&!A&&m(w);O=w,_||A||k?u(S)?O=S:l(S)?O=i(S):A?(C=!1,O=a(w,!0)):k?(C=!1,O=o(w,!0)):O=[]:h(w)||c(w)?(O=S,c(S)?O=g(S):d(S)&&!f(S)||(O=s(w))):C=!1}C&&(x.set(w,O),b(O,w,y,E,x),x.delete(w)),r(e,n,O)}}},function(e,t,n){var r=n(48),a=Object.create,o=function(){function e(){}return function(t){if(!r(t))return{};if(a)return a(t);e.prototype=t;var n=new e;return e.prototype=void 0,n}}();e.exports=o},function(e,t,n){var r=n(104),a=n(70);e.exports=function(e){return a(e)&&"[object Arguments]"==r(e)}},function(e,t,n){var r=n(105),a=n(70);e.exports=function(e){return a(e)&&r(e)}},function(e,t){e.exports=function(){return!1}},function(e,t,n){var r=n(104),a=n(206),o=n(70),i={};i["[object Float32Array]"]=i["[object Float64Array]"]=i["[object Int8Array]"]=i["[object Int16Array]"]=i["[object Int32Array]"]=i["[object Uint8Array]"]=i["[object Uint8ClampedArray]"]=i["[object Uint16Array]"]=i["[object Uint32Array]"]=!0,i["[object Arguments]"]=i["[object Array]"]=i["[object ArrayBuffer]"]=i["[object Boolean]"]=i["[object DataView]"]=i["[object Date]"]=i["[object Error]"]=i["[object Function]"]=i["[object Map]"]=i["[object Number]"]=i["[object Object]"]=i["[object RegExp]"]=i["[object Set]"]=i["[object String]"]=i["[object WeakMap]"]=!1,e.exports=function(e){return o(e)&&a(e.length)&&!!i[r(e)]}},function(e,t,n){var r=n(116),a=n(135);e.exports=function(e){return r(e,a(e))}},function(e,t){e.exports=function(e,t){for(var n=-1,r=Array(e);++n<e;)r[n]=t(n);return r}},function(e,t,n){var r=n(48),a=n(163),o=n(521),i=Object.prototype.hasOwnProperty;e.exports=function(e){if(!r(e))return o(e);var t=a(e),n=[];for(var s in e)("constructor"!=s||!t&&i.call(e,s))&&n.push(s);return n}},function(e,t){e.exports=function(e){var t=[];if(null!=e)for(var n in Object(e))t.push(n);return t}},function(e,t,n){var r=n(523),a=n(327);e.exports=function(e){return r((function(t,n){var r=-1,o=n.length,i=o>1?n[o-1]:void 0,s=o>2?n[2]:void 0;for(i=e.length>3&&"function"==typeof i?(o--,i):void 0,s&&a(n[0],n[1],s)&&(i=o<3?void 0:i,o=1),t=Object(t);++r<o;){var c=n[r];c&&e(t,c,r,i)}return t}))}},function(e,t,n){var r=n(210),a=n(325),o=n(326);e.exports=function(e,t){return o(a(e,t,r),e+"")}},function(e,t){e.exports=function(e,t,n){switch(n.length){case 0:return e.call(t);case 1:return e.call(t,n[0]);case 2:return e.call(t,n[0],n[1]);case 3:return e.call(t,n[0],n[1],n[2])}return e.apply(t,n)}},function(e,t,n){var r=n(526),a=n(315),o=n(210),i=a?function(e,t){return a(e,"toString",{configurable:!0,enumerable:!1,value:r(t),writable:!0})}:o;e.exports=i},function(e,t){e.exports=function(e){return function(){return e}}},function(e,t){var n=Date.now;e.exports=function(e){var t=0,r=0;return function(){var a=n(),o=16-(a-r);if(r=a,o>0){if(++t>=800)return arguments[0]}else t=0;return e.apply(void 0,arguments)}}},function(e,t,n){"use strict";(function(e){var r=n(529),a=n(530),o=n(531);function i(){return c.TYPED_ARRAY_SUPPORT?2147483647:1073741823}function s(e,t){if(i()<t)throw new RangeError("Invalid typed array length");return c.TYPED_ARRAY_SUPPORT?(e=new Uint8Array(t)).__proto__=c.prototype:(null===e&&(e=new c(t)),e.length=t),e}function c(e,t,n){if(!(c.TYPED_ARRAY_SUPPORT||this instanceof c))return new c(e,t,n);if("number"==typeof e){if("string"==typeof t)throw new Error("If encoding is specified then the first argument must be a string");return p(this,e)}return u(this,e,t,n)}function u(e,t,n,r){if("number"==typeof t)throw new TypeError('"value" argument must not be a number');return"undefined"!=typeof ArrayBuffer&&t instanceof ArrayBuffer?function(e,t,n,r){if(t.byteLength,n<0||t.byteLength<n)throw new RangeError("'offset' is out of bounds");if(t.byteLength<n+(r||0))throw new RangeError("'length' is out of bounds");t=void 0===n&&void 0===r?new Uint8Array(t):void 0===r?new Uint8Array(t,n):new Uint8Array(t,n,r);c.TYPED_ARRAY_SUPPORT?(e=t).__proto__=c.prototype:e=f(e,t);return e}(e,t,n,r):"string"==typeof t?function(e,t,n){"string"==typeof n&&""!==n||(n="utf8");if(!c.isEncoding(n))throw new TypeError('"encoding" must be a valid string encoding');var r=0|h(t,n),a=(e=s(e,r)).write(t,n);a!==r&&(e=e.slice(0,a));return e}(e,t,n):function(e,t){if(c.isBuffer(t)){var n=0|d(t.length);return 0===(e=s(e,n)).length||t.copy(e,0,0,n),e}if(t){if("undefined"!=typeof ArrayBuffer&&t.buffer instanceof ArrayBuffer||"length"in t)return"number"!=typeof t.length||(r=t.length)!=r?s(e,0):f(e,t);if("Buffer"===t.type&&o(t.data))return f(e,t.data)}var r;throw new TypeError("First argument must be a string, Buffer, ArrayBuffer, Array, or array-like object.")}(e,t)}function l(e){if("number"!=typeof e)throw new TypeError('"size" argument must be a number');if(e<0)throw new RangeError('"size" argument must not be negative')}function p(e,t){if(l(t),e=s(e,t<0?0:0|d(t)),!c.TYPED_ARRAY_SUPPORT)for(var n=0;n<t;++n)e[n]=0;return e}function f(e,t){var n=t.length<0?0:0|d(t.length);e=s(e,n);for(var r=0;r<n;r+=1)e[r]=255&t[r];return e}function d(e){if(e>=i())throw new RangeError("Attempt to allocate Buffer larger than maximum size: 0x"+i().toString(16)+" bytes");return 0|e}function h(e,t){if(c.isBuffer(e))return e.length;if("undefined"!=typeof ArrayBuffer&&"function"==typeof ArrayBuffer.isView&&(ArrayBuffer.isView(e)||e instanceof ArrayBuffer))return e.byteLength;"string"!=typeof e&&(e=""+e);var n=e.length;if(0===n)return 0;for(var r=!1;;)switch(t){case"ascii":case"latin1":case"binary":return n;case"utf8":case"utf-8":case void 0:return V(e).length;case"ucs2":case"ucs-2":case"utf16le":case"utf-16le":return 2*n;case"hex":return n>>>1;case"base64":return z(e).length;default:if(r)return V(e).length;t=(""+t).toLowerCase(),r=!0}}function m(e,t,n){var r=!1;if((void 0===t||t<0)&&(t=0),t>this.length)return"";if((void 0===n||n>this.length)&&(n=this.length),n<=0)return"";if((n>>>=0)<=(t>>>=0))return"";for(e||(e="utf8");;)switch(e){case"hex":return I(this,t,n);case"utf8":case"utf-8":return C(this,t,n);case"ascii":return A(this,t,n);case"latin1":case"binary":return k(this,t,n);case"base64":return O(this,t,n);case"ucs2":case"ucs-2":case"utf16le":case"utf-16le":return P(this,t,n);default:if(r)throw new TypeError("Unknown encoding: "+e);e=(e+"").toLowerCase(),r=!0}}function v(e,t,n){var r=e[t];e[t]=e[n],e[n]=r}function g(e,t,n,r,a){if(0===e.length)return-1;if("string"==typeof n?(r=n,n=0):n>2147483647?n=2147483647:n<-2147483648&&(n=-2147483648),n=+n,isNaN(n)&&(n=a?0:e.length-1),n<0&&(n=e.length+n),n>=e.length){if(a)return-1;n=e.length-1}else if(n<0){if(!a)return-1;n=0}if("string"==typeof t&&(t=c.from(t,r)),c.isBuffer(t))return 0===t.length?-1:y(e,t,n,r,a);if("number"==typeof t)return t&=255,c.TYPED_ARRAY_SUPPORT&&"function"==typeof Uint8Array.prototype.indexOf?a?Uint8Array.prototype.indexOf.call(e,t,n):Uint8Array.prototype.lastIndexOf.call(e,t,n):y(e,[t],n,r,a);throw new TypeError("val must be string, number or Buffer")}function y(e,t,n,r,a){var o,i=1,s=e.length,c=t.length;if(void 0!==r&&("ucs2"===(r=String(r).toLowerCase())||"ucs-2"===r||"utf16le"===r||"utf-16le"===r)){if(e.length<2||t.length<2)return-1;i=2,s/=2,c/=2,n/=2}function u(e,t){return 1===i?e[t]:e.readUInt16BE(t*i)}if(a){var l=-1;for(o=n;o<s;o++)if(u(e,o)===u(t,-1===l?0:o-l)){if(-1===l&&(l=o),o-l+1===c)return l*i}else-1!==l&&(o-=o-l),l=-1}else for(n+c>s&&(n=s-c),o=n;o>=0;o--){for(var p=!0,f=0;f<c;f++)if(u(e,o+f)!==u(t,f)){p=!1;break}if(p)return o}return-1}function b(e,t,n,r){n=Number(n)||0;var a=e.length-n;r?(r=Number(r))>a&&(r=a):r=a;var o=t.length;if(o%2!=0)throw new TypeError("Invalid hex string");r>o/2&&(r=o/2);for(var i=0;i<r;++i){var s=parseInt(t.substr(2*i,2),16);if(isNaN(s))return i;e[n+i]=s}return i}function E(e,t,n,r){return F(V(t,e.length-n),e,n,r)}function x(e,t,n,r){return F(function(e){for(var t=[],n=0;n<e.length;++n)t.push(255&e.charCodeAt(n));return t}(t),e,n,r)}function S(e,t,n,r){return x(e,t,n,r)}function w(e,t,n,r){return F(z(t),e,n,r)}function j(e,t,n,r){return F(function(e,t){for(var n,r,a,o=[],i=0;i<e.length&&!((t-=2)<0);++i)r=(n=e.charCodeAt(i))>>8,a=n%256,o.push(a),o.push(r);return o}(t,e.length-n),e,n,r)}function O(e,t,n){return 0===t&&n===e.length?r.fromByteArray(e):r.fromByteArray(e.slice(t,n))}function C(e,t,n){n=Math.min(e.length,n);for(var r=[],a=t;a<n;){var o,i,s,c,u=e[a],l=null,p=u>239?4:u>223?3:u>191?2:1;if(a+p<=n)switch(p){case 1:u<128&&(l=u);break;case 2:128==(192&(o=e[a+1]))&&(c=(31&u)<<6|63&o)>127&&(l=c);break;case 3:o=e[a+1],i=e[a+2],128==(192&o)&&128==(192&i)&&(c=(15&u)<<12|(63&o)<<6|63&i)>2047&&(c<55296||c>57343)&&(l=c);break;case 4:o=e[a+1],i=e[a+2],s=e[a+3],128==(192&o)&&128==(192&i)&&128==(192&s)&&(c=(15&u)<<18|(63&o)<<12|(63&i)<<6|63&s)>65535&&c<1114112&&(l=c)}null===l?(l=65533,p=1):l>65535&&(l-=65536,r.push(l>>>10&1023|55296),l=56320|1023&l),r.push(l),a+=p}return function(e){var t=e.length;if(t<=_)return String.fromCharCode.apply(String,e);var n="",r=0;for(;r<t;)n+=String.fromCharCode.apply(String,e.slice(r,r+=_));return n}(r)}t.Buffer=c,t.SlowBuffer=function(e){+e!=e&&(e=0);return c.alloc(+e)},t.INSPECT_MAX_BYTES=50,c.TYPED_ARRAY_SUPPORT=void 0!==e.TYPED_ARRAY_SUPPORT?e.TYPED_ARRAY_SUPPORT:function(){try{var e=new Uint8Array(1);return e.__proto__={__proto__:Uint8Array.prototype,foo:function(){return 42}},42===e.foo()&&"function"==typeof e.subarray&&0===e.subarray(1,1).byteLength}catch(e){return!1}}(),t.kMaxLength=i(),c.poolSize=8192,c._augment=function(e){return e.__proto__=c.prototype,e},c.from=function(e,t,n){return u(null,e,t,n)},c.TYPED_ARRAY_SUPPORT&&(c.prototype.__proto__=Uint8Array.prototype,c.__proto__=Uint8Array,"undefined"!=typeof Symbol&&Symbol.species&&c[Symbol.species]===c&&Object.defineProperty(c,Symbol.species,{value:null,configurable:!0})),c.alloc=function(e,t,n){return function(e,t,n,r){return l(t),t<=0?s(e,t):void 0!==n?"string"==typeof r?s(e,t).fill(n,r):s(e,t).fill(n):s(e,t)}(null,e,t,n)},c.allocUnsafe=function(e){return p(null,e)},c.allocUnsafeSlow=function(e){return p(null,e)},c.isBuffer=function(e){return!(null==e||!e._isBuffer)},c.compare=function(e,t){if(!c.isBuffer(e)||!c.isBuffer(t))throw new TypeError("Arguments must be Buffers");if(e===t)return 0;for(var n=e.length,r=t.length,a=0,o=Math.min(n,r);a<o;++a)if(e[a]!==t[a]){n=e[a],r=t[a];break}return n<r?-1:r<n?1:0},c.isEncoding=function(e){switch(String(e).toLowerCase()){case"hex":case"utf8":case"utf-8":case"ascii":case"latin1":case"binary":case"base64":case"ucs2":case"ucs-2":case"utf16le":case"utf-16le":return!0;default:return!1}},c.concat=function(e,t){if(!o(e))throw new TypeError('"list" argument must be an Array of Buffers');if(0===e.length)return c.alloc(0);var n;if(void 0===t)for(t=0,n=0;n<e.length;++n)t+=e[n].length;var r=c.allocUnsafe(t),a=0;for(n=0;n<e.length;++n){var i=e[n];if(!c.isBuffer(i))throw new TypeError('"list" argument must be an Array of Buffers');i.copy(r,a),a+=i.length}return r},c.byteLength=h,c.prototype._isBuffer=!0,c.prototype.swap16=function(){var e=this.length;if(e%2!=0)throw new RangeError("Buffer size must be a multiple of 16-bits");for(var t=0;t<e;t+=2)v(this,t,t+1);return this},c.prototype.swap32=function(){var e=this.length;if(e%4!=0)throw new RangeError("Buffer size must be a multiple of 32-bits");for(var t=0;t<e;t+=4)v(this,t,t+3),v(this,t+1,t+2);return this},c.prototype.swap64=function(){var e=this.length;if(e%8!=0)throw new RangeError("Buffer size must be a multiple of 64-bits");for(var t=0;t<e;t+=8)v(this,t,t+7),v(this,t+1,t+6),v(this,t+2,t+5),v(this,t+3,t+4);return this},c.prototype.toString=function(){var e=0|this.length;return 0===e?"":0===arguments.length?C(this,0,e):m.apply(this,arguments)},c.prototype.equals=function(e){if(!c.isBuffer(e))throw new TypeError("Argument must be a Buffer");return this===e||0===c.compare(this,e)},c.prototype.inspect=function(){var e="",n=t.INSPECT_MAX_BYTES;return this.length>0&&(e=this.toString("hex",0,n).match(/.{2}/g).join(" "),this.length>n&&(e+=" ... ")),"<Buffer "+e+">"},c.prototype.compare=function(e,t,n,r,a){if(!c.isBuffer(e))throw new TypeError("Argument must be a Buffer");if(void 0===t&&(t=0),void 0===n&&(n=e?e.length:0),void 0===r&&(r=0),void 0===a&&(a=this.length),t<0||n>e.length||r<0||a>this.length)throw new RangeError("out of range index");if(r>=a&&t>=n)return 0;if(r>=a)return-1;if(t>=n)return 1;if(this===e)return 0;for(var o=(a>>>=0)-(r>>>=0),i=(n>>>=0)-(t>>>=0),s=Math.min(o,i),u=this.slice(r,a),l=e.slice(t,n),p=0;p<s;++p)if(u[p]!==l[p]){o=u[p],i=l[p];break}return o<i?-1:i<o?1:0},c.prototype.includes=function(e,t,n){return-1!==this.indexOf(e,t,n)},c.prototype.indexOf=function(e,t,n){return g(this,e,t,n,!0)},c.prototype.lastIndexOf=function(e,t,n){return g(this,e,t,n,!1)},c.prototype.write=function(e,t,n,r){if(void 0===t)r="utf8",n=this.length,t=0;else if(void 0===n&&"string"==typeof t)r=t,n=this.length,t=0;else{if(!isFinite(t))throw new Error("Buffer.write(string, encoding, offset[, length]) is no longer supported");t|=0,isFinite(n)?(n|=0,void 0===r&&(r="utf8")):(r=n,n=void 0)}var a=this.length-t;if((void 0===n||n>a)&&(n=a),e.length>0&&(n<0||t<0)||t>this.length)throw new RangeError("Attempt to write outside buffer bounds");r||(r="utf8");for(var o=!1;;)switch(r){case"hex":return b(this,e,t,n);case"utf8":case"utf-8":return E(this,e,t,n);case"ascii":return x(this,e,t,n);case"latin1":case"binary":return S(this,e,t,n);case"base64":return w(this,e,t,n);case"ucs2":case"ucs-2":case"utf16le":case"utf-16le":return j(this,e,t,n);default:if(o)throw new TypeError("Unknown encoding: "+r);r=(""+r).toLowerCase(),o=!0}},c.prototype.toJSON=function(){return{type:"Buffer",data:Array.prototype.slice.call(this._arr||this,0)}};var _=4096;function A(e,t,n){var r="";n=Math.min(e.length,n);for(var a=t;a<n;++a)r+=String.fromCharCode(127&e[a]);return r}function k(e,t,n){var r="";n=Math.min(e.length,n);for(var a=t;a<n;++a)r+=String.fromCharCode(e[a]);return r}function I(e,t,n){var r=e.length;(!t||t<0)&&(t=0),(!n||n<0||n>r)&&(n=r);for(var a="",o=t;o<n;++o)a+=U(e[o]);return a}function P(e,t,n){for(var r=e.slice(t,n),a="",o=0;o<r.length;o+=2)a+=String.fromCharCode(r[o]+256*r[o+1]);return a}function N(e,t,n){if(e%1!=0||e<0)throw new RangeError("offset is not uint");if(e+t>n)throw new RangeError("Trying to access beyond buffer length")}function T(e,t,n,r,a,o){if(!c.isBuffer(e))throw new TypeError('"buffer" argument must be a Buffer instance');if(t>a||t<o)throw new RangeError('"value" argument is out of bounds');if(n+r>e.length)throw new RangeError("Index out of range")}function R(e,t,n,r){t<0&&(t=65535+t+1);for(var a=0,o=Math.min(e.length-n,2);a<o;++a)e[n+a]=(t&255<<8*(r?a:1-a))>>>8*(r?a:1-a)}function M(e,t,n,r){t<0&&(t=4294967295+t+1);for(var a=0,o=Math.min(e.length-n,4);a<o;++a)e[n+a]=t>>>8*(r?a:3-a)&255}function q(e,t,n,r,a,o){if(n+r>e.length)throw new RangeError("Index out of range");if(n<0)throw new RangeError("Index out of range")}function D(e,t,n,r,o){return o||q(e,0,n,4),a.write(e,t,n,r,23,4),n+4}function B(e,t,n,r,o){return o||q(e,0,n,8),a.write(e,t,n,r,52,8),n+8}c.prototype.slice=function(e,t){var n,r=this.length;if((e=~~e)<0?(e+=r)<0&&(e=0):e>r&&(e=r),(t=void 0===t?r:~~t)<0?(t+=r)<0&&(t=0):t>r&&(t=r),t<e&&(t=e),c.TYPED_ARRAY_SUPPORT)(n=this.subarray(e,t)).__proto__=c.prototype;else{var a=t-e;n=new c(a,void 0);for(var o=0;o<a;++o)n[o]=this[o+e]}return n},c.prototype.readUIntLE=function(e,t,n){e|=0,t|=0,n||N(e,t,this.length);for(var r=this[e],a=1,o=0;++o<t&&(a*=256);)r+=this[e+o]*a;return r},c.prototype.readUIntBE=function(e,t,n){e|=0,t|=0,n||N(e,t,this.length);for(var r=this[e+--t],a=1;t>0&&(a*=256);)r+=this[e+--t]*a;return r},c.prototype.readUInt8=function(e,t){return t||N(e,1,this.length),this[e]},c.prototype.readUInt16LE=function(e,t){return t||N(e,2,this.length),this[e]|this[e+1]<<8},c.prototype.readUInt16BE=function(e,t){return t||N(e,2,this.length),this[e]<<8|this[e+1]},c.prototype.readUInt32LE=function(e,t){return t||N(e,4,this.length),(this[e]|this[e+1]<<8|this[e+2]<<16)+16777216*this[e+3]},c.prototype.readUInt32BE=function(e,t){return t||N(e,4,this.length),16777216*this[e]+(this[e+1]<<16|this[e+2]<<8|this[e+3])},c.prototype.readIntLE=function(e,t,n){e|=0,t|=0,n||N(e,t,this.length);for(var r=this[e],a=1,o=0;++o<t&&(a*=256);)r+=this[e+o]*a;return r>=(a*=128)&&(r-=Math.pow(2,8*t)),r},c.prototype.readIntBE=function(e,t,n){e|=0,t|=0,n||N(e,t,this.length);for(var r=t,a=1,o=this[e+--r];r>0&&(a*=256);)o+=this[e+--r]*a;return o>=(a*=128)&&(o-=Math.pow(2,8*t)),o},c.prototype.readInt8=function(e,t){return t||N(e,1,this.length),128&this[e]?-1*(255-this[e]+1):this[e]},c.prototype.readInt16LE=function(e,t){t||N(e,2,this.length);var n=this[e]|this[e+1]<<8;return 32768&n?4294901760|n:n},c.prototype.readInt16BE=function(e,t){t||N(e,2,this.length);var n=this[e+1]|this[e]<<8;return 32768&n?4294901760|n:n},c.prototype.readInt32LE=function(e,t){return t||N(e,4,this.length),this[e]|this[e+1]<<8|this[e+2]<<16|this[e+3]<<24},c.prototype.readInt32BE=function(e,t){return t||N(e,4,this.length),this[e]<<24|this[e+1]<<16|this[e+2]<<8|this[e+3]},c.prototype.readFloatLE=function(e,t){return t||N(e,4,this.length),a.read(this,e,!0,23,4)},c.prototype.readFloatBE=function(e,t){return t||N(e,4,this.length),a.read(this,e,!1,23,4)},c.prototype.readDoubleLE=function(e,t){return t||N(e,8,this.length),a.read(this,e,!0,52,8)},c.prototype.readDoubleBE=function(e,t){return t||N(e,8,this.length),a.read(this,e,!1,52,8)},c.prototype.writeUIntLE=function(e,t,n,r){(e=+e,t|=0,n|=0,r)||T(this,e,t,n,Math.pow(2,8*n)-1,0);var a=1,o=0;for(this[t]=255&e;++o<n&&(a*=256);)this[t+o]=e/a&255;return t+n},c.prototype.writeUIntBE=function(e,t,n,r){(e=+e,t|=0,n|=0,r)||T(this,e,t,n,Math.pow(2,8*n)-1,0);var a=n-1,o=1;for(this[t+a]=255&e;--a>=0&&(o*=256);)this[t+a]=e/o&255;return t+n},c.prototype.writeUInt8=function(e,t,n){return e=+e,t|=0,n||T(this,e,t,1,255,0),c.TYPED_ARRAY_SUPPORT||(e=Math.floor(e)),this[t]=255&e,t+1},c.prototype.writeUInt16LE=function(e,t,n){return e=+e,t|=0,n||T(this,e,t,2,65535,0),c.TYPED_ARRAY_SUPPORT?(this[t]=255&e,this[t+1]=e>>>8):R(this,e,t,!0),t+2},c.prototype.writeUInt16BE=function(e,t,n){return e=+e,t|=0,n||T(this,e,t,2,65535,0),c.TYPED_ARRAY_SUPPORT?(this[t]=e>>>8,this[t+1]=255&e):R(this,e,t,!1),t+2},c.prototype.writeUInt32LE=function(e,t,n){return e=+e,t|=0,n||T(this,e,t,4,4294967295,0),c.TYPED_ARRAY_SUPPORT?(this[t+3]=e>>>24,this[t+2]=e>>>16,this[t+1]=e>>>8,this[t]=255&e):M(this,e,t,!0),t+4},c.prototype.writeUInt32BE=function(e,t,n){return e=+e,t|=0,n||T(this,e,t,4,4294967295,0),c.TYPED_ARRAY_SUPPORT?(this[t]=e>>>24,this[t+1]=e>>>16,this[t+2]=e>>>8,this[t+3]=255&e):M(this,e,t,!1),t+4},c.prototype.writeIntLE=function(e,t,n,r){if(e=+e,t|=0,!r){var a=Math.pow(2,8*n-1);T(this,e,t,n,a-1,-a)}var o=0,i=1,s=0;for(this[t]=255&e;++o<n&&(i*=256);)e<0&&0===s&&0!==this[t+o-1]&&(s=1),this[t+o]=(e/i>>0)-s&255;return t+n},c.prototype.writeIntBE=function(e,t,n,r){if(e=+e,t|=0,!r){var a=Math.pow(2,8*n-1);T(this,e,t,n,a-1,-a)}var o=n-1,i=1,s=0;for(this[t+o]=255&e;--o>=0&&(i*=256);)e<0&&0===s&&0!==this[t+o+1]&&(s=1),this[t+o]=(e/i>>0)-s&255;return t+n},c.prototype.writeInt8=function(e,t,n){return e=+e,t|=0,n||T(this,e,t,1,127,-128),c.TYPED_ARRAY_SUPPORT||(e=Math.floor(e)),e<0&&(e=255+e+1),this[t]=255&e,t+1},c.prototype.writeInt16LE=function(e,t,n){return e=+e,t|=0,n||T(this,e,t,2,32767,-32768),c.TYPED_ARRAY_SUPPORT?(this[t]=255&e,this[t+1]=e>>>8):R(this,e,t,!0),t+2},c.prototype.writeInt16BE=function(e,t,n){return e=+e,t|=0,n||T(this,e,t,2,32767,-32768),c.TYPED_ARRAY_SUPPORT?(this[t]=e>>>8,this[t+1]=255&e):R(this,e,t,!1),t+2},c.prototype.writeInt32LE=function(e,t,n){return e=+e,t|=0,n||T(this,e,t,4,2147483647,-2147483648),c.TYPED_ARRAY_SUPPORT?(this[t]=255&e,this[t+1]=e>>>8,this[t+2]=e>>>16,this[t+3]=e>>>24):M(this,e,t,!0),t+4},c.prototype.writeInt32BE=function(e,t,n){return e=+e,t|=0,n||T(this,e,t,4,2147483647,-2147483648),e<0&&(e=4294967295+e+1),c.TYPED_ARRAY_SUPPORT?(this[t]=e>>>24,this[t+1]=e>>>16,this[t+2]=e>>>8,this[t+3]=255&e):M(this,e,t,!1),t+4},c.prototype.writeFloatLE=function(e,t,n){return D(this,e,t,!0,n)},c.prototype.writeFloatBE=function(e,t,n){return D(this,e,t,!1,n)},c.prototype.writeDoubleLE=function(e,t,n){return B(this,e,t,!0,n)},c.prototype.writeDoubleBE=function(e,t,n){return B(this,e,t,!1,n)},c.prototype.copy=function(e,t,n,r){if(n||(n=0),r||0===r||(r=this.length),t>=e.length&&(t=e.length),t||(t=0),r>0&&r<n&&(r=n),r===n)return 0;if(0===e.length||0===this.length)return 0;if(t<0)throw new RangeError("targetStart out of bounds");if(n<0||n>=this.length)throw new RangeError("sourceStart out of bounds");if(r<0)throw new RangeError("sourceEnd out of bounds");r>this.length&&(r=this.length),e.length-t<r-n&&(r=e.length-t+n);var a,o=r-n;if(this===e&&n<t&&t<r)for(a=o-1;a>=0;--a)e[a+t]=this[a+n];else if(o<1e3||!c.TYPED_ARRAY_SUPPORT)for(a=0;a<o;++a)e[a+t]=this[a+n];else Uint8Array.prototype.set.call(e,this.subarray(n,n+o),t);return o},c.prototype.fill=function(e,t,n,r){if("string"==typeof e){if("string"==typeof t?(r=t,t=0,n=this.length):"string"==typeof n&&(r=n,n=this.length),1===e.length){var a=e.charCodeAt(0);a<256&&(e=a)}if(void 0!==r&&"string"!=typeof r)throw new TypeError("encoding must be a string");if("string"==typeof r&&!c.isEncoding(r))throw new TypeError("Unknown encoding: "+r)}else"number"==typeof e&&(e&=255);if(t<0||this.length<t||this.length<n)throw new RangeError("Out of range index");if(n<=t)return this;var o;if(t>>>=0,n=void 0===n?this.length:n>>>0,e||(e=0),"number"==typeof e)for(o=t;o<n;++o)this[o]=e;else{var i=c.isBuffer(e)?e:V(new c(e,r).toString()),s=i.length;for(o=0;o<n-t;++o)this[o+t]=i[o%s]}return this};var L=/[^+\/0-9A-Za-z-_]/g;function U(e){return e<16?"0"+e.toString(16):e.toString(16)}function V(e,t){var n;t=t||1/0;for(var r=e.length,a=null,o=[],i=0;i<r;++i){if((n=e.charCodeAt(i))>55295&&n<57344){if(!a){if(n>56319){(t-=3)>-1&&o.push(239,191,189);continue}if(i+1===r){(t-=3)>-1&&o.push(239,191,189);continue}a=n;continue}if(n<56320){(t-=3)>-1&&o.push(239,191,189),a=n;continue}n=65536+(a-55296<<10|n-56320)}else a&&(t-=3)>-1&&o.push(239,191,189);if(a=null,n<128){if((t-=1)<0)break;o.push(n)}else if(n<2048){if((t-=2)<0)break;o.push(n>>6|192,63&n|128)}else if(n<65536){if((t-=3)<0)break;o.push(n>>12|224,n>>6&63|128,63&n|128)}else{if(!(n<1114112))throw new Error("Invalid code point");if((t-=4)<0)break;o.push(n>>18|240,n>>12&63|128,n>>6&63|128,63&n|128)}}return o}function z(e){return r.toByteArray(function(e){if((e=function(e){return e.trim?e.trim():e.replace(/^\s+|\s+$/g,"")}(e).replace(L,"")).length<2)return"";for(;e.length%4!=0;)e+="=";return e}(e))}function F(e,t,n,r){for(var a=0;a<r&&!(a+n>=t.length||a>=e.length);++a)t[a+n]=e[a];return a}}).call(this,n(184))},function(e,t){e.exports=require("base64-js")},function(e,t){e.exports=require("ieee754")},function(e,t){e.exports=require("isarray")},function(e,t,n){var r=n(211),a=n(328);e.exports=function(e){if(r(e))return a(e)},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){var r=n(311);e.exports=r},function(e,t,n){n(69),n(114),n(77);var r=n(136);e.exports=r},function(e,t){e.exports={CSSRuleList:0,CSSStyleDeclaration:0,CSSValueList:0,ClientRectList:0,DOMRectList:0,DOMStringList:0,DOMTokenList:1,DataTransferItemList:0,FileList:0,HTMLAllCollection:0,HTMLCollection:0,HTMLFormElement:0,HTMLSelectElement:0,MediaList:0,MimeTypeArray:0,NamedNodeMap:0,NodeList:1,PaintRequestList:0,Plugin:0,PluginArray:0,SVGLengthList:0,SVGNumberList:0,SVGPathSegList:0,SVGPointList:0,SVGStringList:0,SVGTransformList:0,SourceBufferList:0,StyleSheetList:0,TextTrackCueList:0,TextTrackList:0,TouchList:0}},function(e,t,n){var r=n(331);e.exports=r},function(e,t,n){var r=n(21),a=n(332);r({target:"Array",stat:!0,forced:!n(335)((function(e){Array.from(e)}))},{from:a})},function(e,t,n){var r=n(46),a=n(333);e.exports=function(e,t,n,o){try{return o?t(r(n)[0],n[1]):t(n)}catch(t){throw a(e),t}}},function(e,t,n){e.exports=n(540)},function(e,t,n){var r=n(310);e.exports=r},function(e,t){e.exports=function(){throw new TypeError("Invalid attempt to spread non-iterable instance.\nIn order to be iterable, non-array objects must have a [Symbol.iterator]() method.")},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){var r=n(147),a=n(212);e.exports=function(e,t){var n=null==e?null:void 0!==r&&a(e)||e["@@iterator"];if(null!=n){var o,i,s=[],c=!0,u=!1;try{for(n=n.call(e);!(c=(o=n.next()).done)&&(s.push(o.value),!t||s.length!==t);c=!0);}catch(e){u=!0,i=e}finally{try{c||null==n.return||n.return()}finally{if(u)throw i}}return s}},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){n(77);var r=n(544),a=n(86),o=Array.prototype,i={DOMTokenList:!0,NodeList:!0};e.exports=function(e){var t=e.entries;return e===o||e instanceof Array&&t===o.entries||i.hasOwnProperty(a(e))?r:t}},function(e,t,n){var r=n(545);e.exports=r},function(e,t,n){n(69),n(84);var r=n(39);e.exports=r("Array").entries},function(e,t,n){var r=n(547);e.exports=r},function(e,t,n){n(548);var r=n(39);e.exports=r("Array").forEach},function(e,t,n){"use strict";var r=n(21),a=n(549);r({target:"Array",proto:!0,forced:[].forEach!=a},{forEach:a})},function(e,t,n){"use strict";var r=n(76).forEach,a=n(102)("forEach");e.exports=a?[].forEach:function(e){return r(this,e,arguments.length>1?arguments[1]:void 0)}},function(e,t,n){var r=n(551);e.exports=r},function(e,t,n){var r=n(552),a=Array.prototype;e.exports=function(e){var t=e.sort;return e===a||e instanceof Array&&t===a.sort?r:t}},function(e,t,n){n(553);var r=n(39);e.exports=r("Array").sort},function(e,t,n){"use strict";var r=n(21),a=n(66),o=n(56),i=n(60),s=n(61),c=n(34),u=n(554),l=n(102),p=n(555),f=n(556),d=n(111),h=n(557),m=[],v=m.sort,g=c((function(){m.sort(void 0)})),y=c((function(){m.sort(null)})),b=l("sort"),E=!c((function(){if(d)return d<70;if(!(p&&p>3)){if(f)return!0;if(h)return h<603;var e,t,n,r,a="";for(e=65;e<76;e++){switch(t=String.fromCharCode(e),e){case 66:case 69:case 70:case 72:n=3;break;case 68:case 71:n=4;break;default:n=2}for(r=0;r<47;r++)m.push({k:t+r,v:n})}for(m.sort((function(e,t){return t.v-e.v})),r=0;r<m.length;r++)t=m[r].k.charAt(0),a.charAt(a.length-1)!==t&&(a+=t);return"DGBEFHACIJK"!==a}}));r({target:"Array",proto:!0,forced:g||!y||!b||!E},{sort:function(e){void 0!==e&&a(e);var t=o(this);if(E)return void 0===e?v.call(t):v.call(t,e);var n,r,c=[],l=i(t.length);for(r=0;r<l;r++)r in t&&c.push(t[r]);for(n=(c=u(c,function(e){return function(t,n){return void 0===n?-1:void 0===t?1:void 0!==e?+e(t,n)||0:s(t)>s(n)?1:-1}}(e))).length,r=0;r<n;)t[r]=c[r++];for(;r<l;)delete t[r++];return t}})},function(e,t){var n=Math.floor,r=function(e,t){var i=e.length,s=n(i/2);return i<8?a(e,t):o(r(e.slice(0,s),t),r(e.slice(s),t),t)},a=function(e,t){for(var n,r,a=e.length,o=1;o<a;){for(r=o,n=e[o];r&&t(e[r-1],n)>0;)e[r]=e[--r];r!==o++&&(e[r]=n)}return e},o=function(e,t,n){for(var r=e.length,a=t.length,o=0,i=0,s=[];o<r||i<a;)o<r&&i<a?s.push(n(e[o],t[i])<=0?e[o++]:t[i++]):s.push(o<r?e[o++]:t[i++]);return s};e.exports=r},function(e,t,n){var r=n(98).match(/firefox\/(\d+)/i);e.exports=!!r&&+r[1]},function(e,t,n){var r=n(98);e.exports=/MSIE|Trident/.test(r)},function(e,t,n){var r=n(98).match(/AppleWebKit\/(\d+)\./);e.exports=!!r&&+r[1]},function(e,t,n){var r=n(559);e.exports=r},function(e,t,n){var r=n(560),a=Array.prototype;e.exports=function(e){var t=e.some;return e===a||e instanceof Array&&t===a.some?r:t}},function(e,t,n){n(561);var r=n(39);e.exports=r("Array").some},function(e,t,n){"use strict";var r=n(21),a=n(76).some;r({target:"Array",proto:!0,forced:!n(102)("some")},{some:function(e){return a(this,e,arguments.length>1?arguments[1]:void 0)}})},function(e,t,n){var r=n(563);e.exports=r},function(e,t,n){var r=n(564),a=n(566),o=Array.prototype,i=String.prototype;e.exports=function(e){var t=e.includes;return e===o||e instanceof Array&&t===o.includes?r:"string"==typeof e||e===i||e instanceof String&&t===i.includes?a:t}},function(e,t,n){n(565);var r=n(39);e.exports=r("Array").includes},function(e,t,n){"use strict";var r=n(21),a=n(191).includes,o=n(197);r({target:"Array",proto:!0},{includes:function(e){return a(this,e,arguments.length>1?arguments[1]:void 0)}}),o("includes")},function(e,t,n){n(567);var r=n(39);e.exports=r("String").includes},function(e,t,n){"use strict";var r=n(21),a=n(339),o=n(97),i=n(61);r({target:"String",proto:!0,forced:!n(340)("includes")},{includes:function(e){return!!~i(o(this)).indexOf(i(a(e)),arguments.length>1?arguments[1]:void 0)}})},function(e,t,n){var r=n(41),a=n(127),o=n(35)("match");e.exports=function(e){var t;return r(e)&&(void 0!==(t=e[o])?!!t:"RegExp"==a(e))}},function(e,t,n){var r=n(341);e.exports=r},function(e,t,n){n(571);var r=n(39);e.exports=r("Array").indexOf},function(e,t,n){"use strict";var r=n(21),a=n(191).indexOf,o=n(102),i=[].indexOf,s=!!i&&1/[1].indexOf(1,-0)<0,c=o("indexOf");r({target:"Array",proto:!0,forced:s||!c},{indexOf:function(e){return s?i.apply(this,arguments)||0:a(this,e,arguments.length>1?arguments[1]:void 0)}})},function(e,t,n){var r=n(573);e.exports=r},function(e,t,n){var r=n(574),a=Array.prototype;e.exports=function(e){var t=e.find;return e===a||e instanceof Array&&t===a.find?r:t}},function(e,t,n){n(575);var r=n(39);e.exports=r("Array").find},function(e,t,n){"use strict";var r=n(21),a=n(76).find,o=n(197),i="find",s=!0;i in[]&&Array(1).find((function(){s=!1})),r({target:"Array",proto:!0,forced:s},{find:function(e){return a(this,e,arguments.length>1?arguments[1]:void 0)}}),o(i)},function(e,t,n){var r=n(577);e.exports=r},function(e,t,n){var r=n(578),a=String.prototype;e.exports=function(e){var t=e.startsWith;return"string"==typeof e||e===a||e instanceof String&&t===a.startsWith?r:t}},function(e,t,n){n(579);var r=n(39);e.exports=r("String").startsWith},function(e,t,n){"use strict";var r,a=n(21),o=n(110).f,i=n(60),s=n(61),c=n(339),u=n(97),l=n(340),p=n(83),f="".startsWith,d=Math.min,h=l("startsWith");a({target:"String",proto:!0,forced:!!(p||h||(r=o(String.prototype,"startsWith"),!r||r.writable))&&!h},{startsWith:function(e){var t=s(u(this));c(e);var n=i(d(arguments.length>1?arguments[1]:void 0,t.length)),r=s(e);return f?f.call(t,r,n):t.slice(n,n+r.length)===r}})},function(e,t,n){var r=n(581);e.exports=r},function(e,t,n){var r=n(582),a=String.prototype;e.exports=function(e){var t=e.trim;return"string"==typeof e||e===a||e instanceof String&&t===a.trim?r:t}},function(e,t,n){n(583);var r=n(39);e.exports=r("String").trim},function(e,t,n){"use strict";var r=n(21),a=n(584).trim;r({target:"String",proto:!0,forced:n(585)("trim")},{trim:function(){return a(this)}})},function(e,t,n){var r=n(97),a=n(61),o="["+n(342)+"]",i=RegExp("^"+o+o+"*"),s=RegExp(o+o+"*$"),c=function(e){return function(t){var n=a(r(t));return 1&e&&(n=n.replace(i,"")),2&e&&(n=n.replace(s,"")),n}};e.exports={start:c(1),end:c(2),trim:c(3)}},function(e,t,n){var r=n(34),a=n(342);e.exports=function(e){return r((function(){return!!a[e]()||"​᠎"!="​᠎"[e]()||a[e].name!==e}))}},function(e,t,n){var r=n(87),a=n(218);e.exports=function(e){return a(r(e).toLowerCase())}},function(e,t,n){var r=n(115),a=n(343),o=n(47),i=n(167),s=r?r.prototype:void 0,c=s?s.toString:void 0;e.exports=function e(t){if("string"==typeof t)return t;if(o(t))return a(t,e)+"";if(i(t))return c?c.call(t):"";var n=t+"";return"0"==n&&1/t==-Infinity?"-0":n}},function(e,t,n){var r=n(589),a=n(345),o=n(590),i=n(87);e.exports=function(e){return function(t){t=i(t);var n=a(t)?o(t):void 0,s=n?n[0]:t.charAt(0),c=n?r(n,1).join(""):t.slice(1);return s[e]()+c}}},function(e,t,n){var r=n(344);e.exports=function(e,t,n){var a=e.length;return n=void 0===n?a:n,!t&&n>=a?e:r(e,t,n)}},function(e,t,n){var r=n(591),a=n(345),o=n(592);e.exports=function(e){return a(e)?o(e):r(e)}},function(e,t){e.exports=function(e){return e.split("")}},function(e,t){var n="[\\ud800-\\udfff]",r="[\\u0300-\\u036f\\ufe20-\\ufe2f\\u20d0-\\u20ff]",a="\\ud83c[\\udffb-\\udfff]",o="[^\\ud800-\\udfff]",i="(?:\\ud83c[\\udde6-\\uddff]){2}",s="[\\ud800-\\udbff][\\udc00-\\udfff]",c="(?:"+r+"|"+a+")"+"?",u="[\\ufe0e\\ufe0f]?",l=u+c+("(?:\\u200d(?:"+[o,i,s].join("|")+")"+u+c+")*"),p="(?:"+[o+r+"?",r,i,s,n].join("|")+")",f=RegExp(a+"(?="+a+")|"+p+l,"g");e.exports=function(e){return e.match(f)||[]}},function(e,t,n){var r=n(346),a=n(594),o=n(597),i=RegExp("['’]","g");e.exports=function(e){return function(t){return r(o(a(t).replace(i,"")),e,"")}}},function(e,t,n){var r=n(595),a=n(87),o=/[\xc0-\xd6\xd8-\xf6\xf8-\xff\u0100-\u017f]/g,i=RegExp("[\\u0300-\\u036f\\ufe20-\\ufe2f\\u20d0-\\u20ff]","g");e.exports=function(e){return(e=a(e))&&e.replace(o,r).replace(i,"")}},function(e,t,n){var r=n(596)({"À":"A","Á":"A","Â":"A","Ã":"A","Ä":"A","Å":"A","à":"a","á":"a","â":"a","ã":"a","ä":"a","å":"a","Ç":"C","ç":"c","Ð":"D","ð":"d","È":"E","É":"E","Ê":"E","Ë":"E","è":"e","é":"e","ê":"e","ë":"e","Ì":"I","Í":"I","Î":"I","Ï":"I","ì":"i","í":"i","î":"i","ï":"i","Ñ":"N","ñ":"n","Ò":"O","Ó":"O","Ô":"O","Õ":"O","Ö":"O","Ø":"O","ò":"o","ó":"o","ô":"o","õ":"o","ö":"o","ø":"o","Ù":"U","Ú":"U","Û":"U","Ü":"U","ù":"u","ú":"u","û":"u","ü":"u","Ý":"Y","ý":"y","ÿ":"y","Æ":"Ae","æ":"ae","Þ":"Th","þ":"th","ß":"ss","Ā":"A","Ă":"A","Ą":"A","ā":"a","ă":"a","ą":"a","Ć":"C","Ĉ":"C","Ċ":"C","Č":"C","ć":"c","ĉ":"c","ċ":"c","č":"c","Ď":"D","Đ":"D","ď":"d","đ":"d","Ē":"E","Ĕ":"E","Ė":"E","Ę":"E","Ě":"E","ē":"e","ĕ":"e","ė":"e","ę":"e","ě":"e","Ĝ":"G","Ğ":"G","Ġ":"G","Ģ":"G","ĝ":"g","ğ":"g","ġ":"g","ģ":"g","Ĥ":"H","Ħ":"H","ĥ":"h","ħ":"h","Ĩ":"I","Ī":"I","Ĭ":"I","Į":"I","İ":"I","ĩ":"i","ī":"i","ĭ":"i","į":"i","ı":"i","Ĵ":"J","ĵ":"j","Ķ":"K","ķ":"k","ĸ":"k","Ĺ":"L","Ļ":"L","Ľ":"L","Ŀ":"L","Ł":"L","ĺ":"l","ļ":"l","ľ":"l","ŀ":"l","ł":"l","Ń":"N","Ņ":"N","Ň":"N","Ŋ":"N","ń":"n","ņ":"n","ň":"n","ŋ":"n","Ō":"O","Ŏ":"O","Ő":"O","ō":"o","ŏ":"o","ő":"o","Ŕ":"R","Ŗ":"R","Ř":"R","ŕ":"r","ŗ":"r","ř":"r","Ś":"S","Ŝ":"S","Ş":"S","Š":"S","ś":"s","ŝ":"s","ş":"s","š":"s","Ţ":"T","Ť":"T","Ŧ":"T","ţ":"t","ť":"t","ŧ":"t","Ũ":"U","Ū":"U","Ŭ":"U","Ů":"U","Ű":"U","Ų":"U","ũ":"u","ū":"u","ŭ":"u","ů":"u","ű":"u","ų":"u","Ŵ":"W","ŵ":"w","Ŷ":"Y","ŷ":"y","Ÿ":"Y","Ź":"Z","Ż":"Z","Ž":"Z","ź":"z","ż":"z","ž":"z","Ĳ":"IJ","ĳ":"ij","Œ":"Oe","œ":"oe","ŉ":"'n","ſ":"s"});e.exports=r},function(e,t){e.exports=function(e){return function(t){return null==e?void 0:e[t]}}},function(e,t,n){var r=n(598),a=n(599),o=n(87),i=n(600);e.exports=function(e,t,n){return e=o(e),void 0===(t=n?void 0:t)?a(e)?i(e):r(e):e.match(t)||[]}},function(e,t){var n=/[^\x00-\x2f\x3a-\x40\x5b-\x60\x7b-\x7f]+/g;e.exports=function(e){return e.match(n)||[]}},function(e,t){var n=/[a-z][A-Z]|[A-Z]{2}[a-z]|[0-9][a-zA-Z]|[a-zA-Z][0-9]|[^a-zA-Z0-9 ]/;e.exports=function(e){return n.test(e)}},function(e,t){var n="\\u2700-\\u27bf",r="a-z\\xdf-\\xf6\\xf8-\\xff",a="A-Z\\xc0-\\xd6\\xd8-\\xde",o="\\xac\\xb1\\xd7\\xf7\\x00-\\x2f\\x3a-\\x40\\x5b-\\x60\\x7b-\\xbf\\u2000-\\u206f \\t\\x0b\\f\\xa0\\ufeff\\n\\r\\u2028\\u2029\\u1680\\u180e\\u2000\\u2001\\u2002\\u2003\\u2004\\u2005\\u2006\\u2007\\u2008\\u2009\\u200a\\u202f\\u205f\\u3000",i="["+o+"]",s="\\d+",c="[\\u2700-\\u27bf]",u="["+r+"]",l="[^\\ud800-\\udfff"+o+s+n+r+a+"]",p="(?:\\ud83c[\\udde6-\\uddff]){2}",f="[\\ud800-\\udbff][\\udc00-\\udfff]",d="["+a+"]",h="(?:"+u+"|"+l+")",m="(?:"+d+"|"+l+")",v="(?:['’](?:d|ll|m|re|s|t|ve))?",g="(?:['’](?:D|LL|M|RE|S|T|VE))?",y="(?:[\\u0300-\\u036f\\ufe20-\\ufe2f\\u20d0-\\u20ff]|\\ud83c[\\udffb-\\udfff])?",b="[\\ufe0e\\ufe0f]?",E=b+y+("(?:\\u200d(?:"+["[^\\ud800-\\udfff]",p,f].join("|")+")"+b+y+")*"),x="(?:"+[c,p,f].join("|")+")"+E,S=RegExp([d+"?"+u+"+"+v+"(?="+[i,d,"$"].join("|")+")",m+"+"+g+"(?="+[i,d+h,"$"].join("|")+")",d+"?"+h+"+"+v,d+"+"+g,"\\d*(?:1ST|2ND|3RD|(?![123])\\dTH)(?=\\b|[a-z_])","\\d*(?:1st|2nd|3rd|(?![123])\\dth)(?=\\b|[A-Z_])",s,x].join("|"),"g");e.exports=function(e){return e.match(S)||[]}},function(e,t,n){var r=n(168),a=n(105),o=n(117);e.exports=function(e){return function(t,n,i){var s=Object(t);if(!a(t)){var c=r(n,3);t=o(t),n=function(e){return c(s[e],e,s)}}var u=e(t,n,i);return u>-1?s[c?t[u]:u]:void 0}}},function(e,t,n){var r=n(603),a=n(619),o=n(355);e.exports=function(e){var t=a(e);return 1==t.length&&t[0][2]?o(t[0][0],t[0][1]):function(n){return n===e||r(n,e,t)}}},function(e,t,n){var r=n(158),a=n(347);e.exports=function(e,t,n,o){var i=n.length,s=i,c=!o;if(null==e)return!s;for(e=Object(e);i--;){var u=n[i];if(c&&u[2]?u[1]!==e[u[0]]:!(u[0]in e))return!1}for(;++i<s;){var l=(u=n[i])[0],p=e[l],f=u[1];if(c&&u[2]){if(void 0===p&&!(l in e))return!1}else{var d=new r;if(o)var h=o(p,f,l,e,t,d);if(!(void 0===h?a(f,p,3,o,d):h))return!1}}return!0}},function(e,t,n){var r=n(158),a=n(348),o=n(609),i=n(612),s=n(137),c=n(47),u=n(134),l=n(164),p="[object Arguments]",f="[object Array]",d="[object Object]",h=Object.prototype.hasOwnProperty;e.exports=function(e,t,n,m,v,g){var y=c(e),b=c(t),E=y?f:s(e),x=b?f:s(t),S=(E=E==p?d:E)==d,w=(x=x==p?d:x)==d,j=E==x;if(j&&u(e)){if(!u(t))return!1;y=!0,S=!1}if(j&&!S)return g||(g=new r),y||l(e)?a(e,t,n,m,v,g):o(e,t,E,n,m,v,g);if(!(1&n)){var O=S&&h.call(e,"__wrapped__"),C=w&&h.call(t,"__wrapped__");if(O||C){var _=O?e.value():e,A=C?t.value():t;return g||(g=new r),v(_,A,n,m,g)}}return!!j&&(g||(g=new r),i(e,t,n,m,v,g))}},function(e,t,n){var r=n(201),a=n(606),o=n(607);function i(e){var t=-1,n=null==e?0:e.length;for(this.__data__=new r;++t<n;)this.add(e[t])}i.prototype.add=i.prototype.push=a,i.prototype.has=o,e.exports=i},function(e,t){e.exports=function(e){return this.__data__.set(e,"__lodash_hash_undefined__"),this}},function(e,t){e.exports=function(e){return this.__data__.has(e)}},function(e,t){e.exports=function(e,t){return e.has(t)}},function(e,t,n){var r=n(115),a=n(319),o=n(88),i=n(348),s=n(610),c=n(611),u=r?r.prototype:void 0,l=u?u.valueOf:void 0;e.exports=function(e,t,n,r,u,p,f){switch(n){case"[object DataView]":if(e.byteLength!=t.byteLength||e.byteOffset!=t.byteOffset)return!1;e=e.buffer,t=t.buffer;case"[object ArrayBuffer]":return!(e.byteLength!=t.byteLength||!p(new a(e),new a(t)));case"[object Boolean]":case"[object Date]":case"[object Number]":return o(+e,+t);case"[object Error]":return e.name==t.name&&e.message==t.message;case"[object RegExp]":case"[object String]":return e==t+"";case"[object Map]":var d=s;case"[object Set]":var h=1&r;if(d||(d=c),e.size!=t.size&&!h)return!1;var m=f.get(e);if(m)return m==t;r|=2,f.set(e,t);var v=i(d(e),d(t),r,u,p,f);return f.delete(e),v;case"[object Symbol]":if(l)return l.call(e)==l.call(t)}return!1}},function(e,t){e.exports=function(e){var t=-1,n=Array(e.size);return e.forEach((function(e,r){n[++t]=[r,e]})),n}},function(e,t){e.exports=function(e){var t=-1,n=Array(e.size);return e.forEach((function(e){n[++t]=e})),n}},function(e,t,n){var r=n(350),a=Object.prototype.hasOwnProperty;e.exports=function(e,t,n,o,i,s){var c=1&n,u=r(e),l=u.length;if(l!=r(t).length&&!c)return!1;for(var p=l;p--;){var f=u[p];if(!(c?f in t:a.call(t,f)))return!1}var d=s.get(e),h=s.get(t);if(d&&h)return d==t&&h==e;var m=!0;s.set(e,t),s.set(t,e);for(var v=c;++p<l;){var g=e[f=u[p]],y=t[f];if(o)var b=c?o(y,g,f,t,e,s):o(g,y,f,e,t,s);if(!(void 0===b?g===y||i(g,y,n,o,s):b)){m=!1;break}v||(v="constructor"==f)}if(m&&!v){var E=e.constructor,x=t.constructor;E==x||!("constructor"in e)||!("constructor"in t)||"function"==typeof E&&E instanceof E&&"function"==typeof x&&x instanceof x||(m=!1)}return s.delete(e),s.delete(t),m}},function(e,t){e.exports=function(e,t){for(var n=-1,r=null==e?0:e.length,a=0,o=[];++n<r;){var i=e[n];t(i,n,e)&&(o[a++]=i)}return o}},function(e,t,n){var r=n(322)(Object.keys,Object);e.exports=r},function(e,t,n){var r=n(103)(n(62),"DataView");e.exports=r},function(e,t,n){var r=n(103)(n(62),"Promise");e.exports=r},function(e,t,n){var r=n(103)(n(62),"Set");e.exports=r},function(e,t,n){var r=n(103)(n(62),"WeakMap");e.exports=r},function(e,t,n){var r=n(354),a=n(117);e.exports=function(e){for(var t=a(e),n=t.length;n--;){var o=t[n],i=e[o];t[n]=[o,i,r(i)]}return t}},function(e,t,n){var r=n(347),a=n(36),o=n(623),i=n(216),s=n(354),c=n(355),u=n(118);e.exports=function(e,t){return i(e)&&s(t)?c(u(e),t):function(n){var i=a(n,e);return void 0===i&&i===t?o(n,e):r(t,i,3)}}},function(e,t,n){var r=n(622),a=/[^.[\]]+|\[(?:(-?\d+(?:\.\d+)?)|(["'])((?:(?!\2)[^\\]|\\.)*?)\2)\]|(?=(?:\.|\[\])(?:\.|\[\]|$))/g,o=/\\(\\)?/g,i=r((function(e){var t=[];return 46===e.charCodeAt(0)&&t.push(""),e.replace(a,(function(e,n,r,a){t.push(r?a.replace(o,"$1"):n||e)})),t}));e.exports=i},function(e,t,n){var r=n(219);e.exports=function(e){var t=r(e,(function(e){return 500===n.size&&n.clear(),e})),n=t.cache;return t}},function(e,t,n){var r=n(624),a=n(625);e.exports=function(e,t){return null!=e&&a(e,t,r)}},function(e,t){e.exports=function(e,t){return null!=e&&t in Object(e)}},function(e,t,n){var r=n(138),a=n(133),o=n(47),i=n(165),s=n(206),c=n(118);e.exports=function(e,t,n){for(var u=-1,l=(t=r(t,e)).length,p=!1;++u<l;){var f=c(t[u]);if(!(p=null!=e&&n(e,f)))break;e=e[f]}return p||++u!=l?p:!!(l=null==e?0:e.length)&&s(l)&&i(f,l)&&(o(e)||a(e))}},function(e,t,n){var r=n(627),a=n(628),o=n(216),i=n(118);e.exports=function(e){return o(e)?r(i(e)):a(e)}},function(e,t){e.exports=function(e){return function(t){return null==t?void 0:t[e]}}},function(e,t,n){var r=n(215);e.exports=function(e){return function(t){return r(t,e)}}},function(e,t,n){var r=n(630),a=n(168),o=n(631),i=Math.max;e.exports=function(e,t,n){var s=null==e?0:e.length;if(!s)return-1;var c=null==n?0:o(n);return c<0&&(c=i(s+c,0)),r(e,a(t,3),c)}},function(e,t){e.exports=function(e,t,n,r){for(var a=e.length,o=n+(r?1:-1);r?o--:++o<a;)if(t(e[o],o,e))return o;return-1}},function(e,t,n){var r=n(632);e.exports=function(e){var t=r(e),n=t%1;return t==t?n?t-n:t:0}},function(e,t,n){var r=n(356),a=1/0;e.exports=function(e){return e?(e=r(e))===a||e===-1/0?17976931348623157e292*(e<0?-1:1):e==e?e:0:0===e?e:0}},function(e,t,n){var r=n(634),a=/^\s+/;e.exports=function(e){return e?e.slice(0,r(e)+1).replace(a,""):e}},function(e,t){var n=/\s/;e.exports=function(e){for(var t=e.length;t--&&n.test(e.charAt(t)););return t}},function(e,t,n){var r=n(357);e.exports=function(e,t){var n;return r(e,(function(e,r,a){return!(n=t(e,r,a))})),!!n}},function(e,t,n){var r=n(316),a=n(117);e.exports=function(e,t){return e&&r(e,t,a)}},function(e,t,n){var r=n(105);e.exports=function(e,t){return function(n,a){if(null==n)return n;if(!r(n))return e(n,a);for(var o=n.length,i=t?o:-1,s=Object(n);(t?i--:++i<o)&&!1!==a(s[i],i,s););return n}}},function(e,t,n){var r=n(639);e.exports=r},function(e,t,n){var r=n(640),a=Array.prototype;e.exports=function(e){var t=e.every;return e===a||e instanceof Array&&t===a.every?r:t}},function(e,t,n){n(641);var r=n(39);e.exports=r("Array").every},function(e,t,n){"use strict";var r=n(21),a=n(76).every;r({target:"Array",proto:!0,forced:!n(102)("every")},{every:function(e){return a(this,e,arguments.length>1?arguments[1]:void 0)}})},function(e,t){e.exports=function(e,t,n,r,a){return a(e,(function(e,a,o){n=r?(r=!1,e):t(n,e,a,o)})),n}},function(e,t,n){var r=n(305);e.exports=r},function(e,t,n){var r=n(645);e.exports=r},function(e,t,n){n(293);var r=n(31);e.exports=r.Object.getOwnPropertySymbols},function(e,t,n){e.exports=n(647)},function(e,t,n){var r=n(304);e.exports=r},function(e,t,n){e.exports=n(649)},function(e,t,n){var r=n(650);e.exports=r},function(e,t,n){n(651);var r=n(31).Object,a=e.exports=function(e,t){return r.getOwnPropertyDescriptor(e,t)};r.getOwnPropertyDescriptor.sham&&(a.sham=!0)},function(e,t,n){var r=n(21),a=n(34),o=n(58),i=n(110).f,s=n(44),c=a((function(){i(1)}));r({target:"Object",stat:!0,forced:!s||c,sham:!s},{getOwnPropertyDescriptor:function(e,t){return i(o(e),t)}})},function(e,t,n){e.exports=n(653)},function(e,t,n){var r=n(338);e.exports=r},function(e,t,n){e.exports=n(655)},function(e,t,n){var r=n(656);e.exports=r},function(e,t,n){n(657);var r=n(31);e.exports=r.Object.getOwnPropertyDescriptors},function(e,t,n){var r=n(21),a=n(44),o=n(658),i=n(58),s=n(110),c=n(129);r({target:"Object",stat:!0,sham:!a},{getOwnPropertyDescriptors:function(e){for(var t,n,r=i(e),a=s.f,u=o(r),l={},p=0;u.length>p;)void 0!==(n=a(r,t=u[p++]))&&c(l,t,n);return l}})},function(e,t,n){var r=n(55),a=n(154),o=n(194),i=n(46);e.exports=r("Reflect","ownKeys")||function(e){var t=a.f(i(e)),n=o.f;return n?t.concat(n(e)):t}},function(e,t,n){e.exports=n(660)},function(e,t,n){var r=n(661);e.exports=r},function(e,t,n){n(662);var r=n(31).Object,a=e.exports=function(e,t){return r.defineProperties(e,t)};r.defineProperties.sham&&(a.sham=!0)},function(e,t,n){var r=n(21),a=n(44);r({target:"Object",stat:!0,forced:!a,sham:!a},{defineProperties:n(190)})},function(e,t,n){var r=n(341);e.exports=r},function(e,t,n){var r=n(358),a=n(360);e.exports=function(e,t){if(null==e)return{};var n,o,i={},s=r(e);for(o=0;o<s.length;o++)n=s[o],a(t).call(t,n)>=0||(i[n]=e[n]);return i},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){e.exports=n(666)},function(e,t,n){var r=n(361);n(673),n(674),n(675),n(676),e.exports=r},function(e,t,n){"use strict";var r,a,o,i,s=n(21),c=n(83),u=n(38),l=n(55),p=n(363),f=n(101),d=n(139),h=n(156),m=n(85),v=n(364),g=n(41),y=n(66),b=n(119),E=n(298),x=n(106),S=n(335),w=n(365),j=n(366).set,O=n(668),C=n(368),_=n(670),A=n(140),k=n(169),I=n(68),P=n(292),N=n(35),T=n(671),R=n(157),M=n(111),q=N("species"),D="Promise",B=I.get,L=I.set,U=I.getterFor(D),V=p&&p.prototype,z=p,F=V,J=u.TypeError,W=u.document,H=u.process,$=A.f,Y=$,K=!!(W&&W.createEvent&&u.dispatchEvent),G="function"==typeof PromiseRejectionEvent,Z="unhandledrejection",X=!1,Q=P(D,(function(){var e=E(z),t=e!==String(z);if(!t&&66===M)return!0;if(c&&!F.finally)return!0;if(M>=51&&/native code/.test(e))return!1;var n=new z((function(e){e(1)})),r=function(e){e((function(){}),(function(){}))};return(n.constructor={})[q]=r,!(X=n.then((function(){}))instanceof r)||!t&&T&&!G})),ee=Q||!S((function(e){z.all(e).catch((function(){}))})),te=function(e){var t;return!(!g(e)||"function"!=typeof(t=e.then))&&t},ne=function(e,t){if(!e.notified){e.notified=!0;var n=e.reactions;O((function(){for(var r=e.value,a=1==e.state,o=0;n.length>o;){var i,s,c,u=n[o++],l=a?u.ok:u.fail,p=u.resolve,f=u.reject,d=u.domain;try{l?(a||(2===e.rejection&&ie(e),e.rejection=1),!0===l?i=r:(d&&d.enter(),i=l(r),d&&(d.exit(),c=!0)),i===u.promise?f(J("Promise-chain cycle")):(s=te(i))?s.call(i,p,f):p(i)):f(r)}catch(e){d&&!c&&d.exit(),f(e)}}e.reactions=[],e.notified=!1,t&&!e.rejection&&ae(e)}))}},re=function(e,t,n){var r,a;K?((r=W.createEvent("Event")).promise=t,r.reason=n,r.initEvent(e,!1,!0),u.dispatchEvent(r)):r={promise:t,reason:n},!G&&(a=u["on"+e])?a(r):e===Z&&_("Unhandled promise rejection",n)},ae=function(e){j.call(u,(function(){var t,n=e.facade,r=e.value;if(oe(e)&&(t=k((function(){R?H.emit("unhandledRejection",r,n):re(Z,n,r)})),e.rejection=R||oe(e)?2:1,t.error))throw t.value}))},oe=function(e){return 1!==e.rejection&&!e.parent},ie=function(e){j.call(u,(function(){var t=e.facade;R?H.emit("rejectionHandled",t):re("rejectionhandled",t,e.value)}))},se=function(e,t,n){return function(r){e(t,r,n)}},ce=function(e,t,n){e.done||(e.done=!0,n&&(e=n),e.value=t,e.state=2,ne(e,!0))},ue=function(e,t,n){if(!e.done){e.done=!0,n&&(e=n);try{if(e.facade===t)throw J("Promise can't be resolved itself");var r=te(t);r?O((function(){var n={done:!1};try{r.call(t,se(ue,n,e),se(ce,n,e))}catch(t){ce(n,t,e)}})):(e.value=t,e.state=1,ne(e,!1))}catch(t){ce({done:!1},t,e)}}};if(Q&&(F=(z=function(e){b(this,z,D),y(e),r.call(this);var t=B(this);try{e(se(ue,t),se(ce,t))}catch(e){ce(t,e)}}).prototype,(r=function(e){L(this,{type:D,done:!1,notified:!1,parent:!1,reactions:[],rejection:!1,state:0,value:void 0})}).prototype=d(F,{then:function(e,t){var n=U(this),r=$(w(this,z));return r.ok="function"!=typeof e||e,r.fail="function"==typeof t&&t,r.domain=R?H.domain:void 0,n.parent=!0,n.reactions.push(r),0!=n.state&&ne(n,!1),r.promise},catch:function(e){return this.then(void 0,e)}}),a=function(){var e=new r,t=B(e);this.promise=e,this.resolve=se(ue,t),this.reject=se(ce,t)},A.f=$=function(e){return e===z||e===o?new a(e):Y(e)},!c&&"function"==typeof p&&V!==Object.prototype)){i=V.then,X||(f(V,"then",(function(e,t){var n=this;return new z((function(e,t){i.call(n,e,t)})).then(e,t)}),{unsafe:!0}),f(V,"catch",F.catch,{unsafe:!0}));try{delete V.constructor}catch(e){}h&&h(V,F)}s({global:!0,wrap:!0,forced:Q},{Promise:z}),m(z,D,!1,!0),v(D),o=l(D),s({target:D,stat:!0,forced:Q},{reject:function(e){var t=$(this);return t.reject.call(void 0,e),t.promise}}),s({target:D,stat:!0,forced:c||Q},{resolve:function(e){return C(c&&this===o?z:this,e)}}),s({target:D,stat:!0,forced:ee},{all:function(e){var t=this,n=$(t),r=n.resolve,a=n.reject,o=k((function(){var n=y(t.resolve),o=[],i=0,s=1;x(e,(function(e){var c=i++,u=!1;o.push(void 0),s++,n.call(t,e).then((function(e){u||(u=!0,o[c]=e,--s||r(o))}),a)})),--s||r(o)}));return o.error&&a(o.value),n.promise},race:function(e){var t=this,n=$(t),r=n.reject,a=k((function(){var a=y(t.resolve);x(e,(function(e){a.call(t,e).then(n.resolve,r)}))}));return a.error&&r(a.value),n.promise}})},function(e,t,n){var r,a,o,i,s,c,u,l,p=n(38),f=n(110).f,d=n(366).set,h=n(367),m=n(669),v=n(157),g=p.MutationObserver||p.WebKitMutationObserver,y=p.document,b=p.process,E=p.Promise,x=f(p,"queueMicrotask"),S=x&&x.value;S||(r=function(){var e,t;for(v&&(e=b.domain)&&e.exit();a;){t=a.fn,a=a.next;try{t()}catch(e){throw a?i():o=void 0,e}}o=void 0,e&&e.enter()},h||v||m||!g||!y?E&&E.resolve?((u=E.resolve(void 0)).constructor=E,l=u.then,i=function(){l.call(u,r)}):i=v?function(){b.nextTick(r)}:function(){d.call(p,r)}:(s=!0,c=y.createTextNode(""),new g(r).observe(c,{characterData:!0}),i=function(){c.data=s=!s})),e.exports=S||function(e){var t={fn:e,next:void 0};o&&(o.next=t),a||(a=t,i()),o=t}},function(e,t,n){var r=n(98);e.exports=/web0s(?!.*chrome)/i.test(r)},function(e,t,n){var r=n(38);e.exports=function(e,t){var n=r.console;n&&n.error&&(1===arguments.length?n.error(e):n.error(e,t))}},function(e,t){e.exports="object"==typeof window},function(e,t,n){"use strict";var r=n(21),a=n(83),o=n(363),i=n(34),s=n(55),c=n(365),u=n(368),l=n(101);if(r({target:"Promise",proto:!0,real:!0,forced:!!o&&i((function(){o.prototype.finally.call({then:function(){}},(function(){}))}))},{finally:function(e){var t=c(this,s("Promise")),n="function"==typeof e;return this.then(n?function(n){return u(t,e()).then((function(){return n}))}:e,n?function(n){return u(t,e()).then((function(){throw n}))}:e)}}),!a&&"function"==typeof o){var p=s("Promise").prototype.finally;o.prototype.finally!==p&&l(o.prototype,"finally",p,{unsafe:!0})}},function(e,t,n){n(362)},function(e,t,n){n(369)},function(e,t,n){"use strict";var r=n(21),a=n(140),o=n(169);r({target:"Promise",stat:!0},{try:function(e){var t=a.f(this),n=o(e);return(n.error?t.reject:t.resolve)(n.value),t.promise}})},function(e,t,n){n(370)},function(e,t){e.exports=require("regenerator-runtime")},function(e,t,n){var r=n(306);e.exports=r},function(e,t,n){var r=n(361);n(77),e.exports=r},function(e,t,n){var r=n(681);e.exports=r},function(e,t,n){n(682);var r=n(31);e.exports=r.Object.values},function(e,t,n){var r=n(21),a=n(371).values;r({target:"Object",stat:!0},{values:function(e){return a(e)}})},function(e,t,n){var r=n(684);e.exports=r},function(e,t,n){n(685);var r=n(31);e.exports=r.Date.now},function(e,t,n){n(21)({target:"Date",stat:!0},{now:function(){return(new Date).getTime()}})},function(e,t,n){var r=n(62);e.exports=function(){return r.Date.now()}},function(e,t,n){var r=n(209),a=n(138),o=n(165),i=n(48),s=n(118);e.exports=function(e,t,n,c){if(!i(e))return e;for(var u=-1,l=(t=a(t,e)).length,p=l-1,f=e;null!=f&&++u<l;){var d=s(t[u]),h=n;if("__proto__"===d||"constructor"===d||"prototype"===d)return e;if(u!=p){var m=f[d];void 0===(h=c?c(m,d,f):void 0)&&(h=i(m)?m:o(t[u+1])?[]:{})}r(f,d,h),f=f[d]}return e}},function(e,t,n){e.exports=n(689)},function(e,t,n){var r=n(308);e.exports=r},function(e,t,n){e.exports=n(691)},function(e,t,n){var r=n(692);e.exports=r},function(e,t,n){n(693);var r=n(31).Object;e.exports=function(e,t){return r.create(e,t)}},function(e,t,n){n(21)({target:"Object",stat:!0,sham:!n(44)},{create:n(100)})},function(e,t,n){var r=n(372);function a(t,n){return e.exports=a=r||function(e,t){return e.__proto__=t,e},e.exports.default=e.exports,e.exports.__esModule=!0,a(t,n)}e.exports=a,e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){var r=n(696);e.exports=r},function(e,t,n){n(697);var r=n(31);e.exports=r.Object.setPrototypeOf},function(e,t,n){n(21)({target:"Object",stat:!0},{setPrototypeOf:n(156)})},function(e,t,n){var r=n(699);e.exports=r},function(e,t,n){n(700);var r=n(31);e.exports=r.Reflect.construct},function(e,t,n){var r=n(21),a=n(55),o=n(66),i=n(46),s=n(41),c=n(100),u=n(307),l=n(34),p=a("Reflect","construct"),f=l((function(){function e(){}return!(p((function(){}),[],e)instanceof e)})),d=!l((function(){p((function(){}))})),h=f||d;r({target:"Reflect",stat:!0,forced:h,sham:h},{construct:function(e,t){o(e),i(t);var n=arguments.length<3?e:o(arguments[2]);if(d&&!f)return p(e,t,n);if(e==n){switch(t.length){case 0:return new e;case 1:return new e(t[0]);case 2:return new e(t[0],t[1]);case 3:return new e(t[0],t[1],t[2]);case 4:return new e(t[0],t[1],t[2],t[3])}var r=[null];return r.push.apply(r,t),new(u.apply(e,r))}var a=n.prototype,l=c(s(a)?a:Object.prototype),h=Function.apply.call(e,l,t);return s(h)?h:l}})},function(e,t,n){var r=n(372),a=n(702);function o(t){return e.exports=o=r?a:function(e){return e.__proto__||a(e)},e.exports.default=e.exports,e.exports.__esModule=!0,o(t)}e.exports=o,e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){e.exports=n(703)},function(e,t,n){var r=n(704);e.exports=r},function(e,t,n){n(705);var r=n(31);e.exports=r.Object.getPrototypeOf},function(e,t,n){var r=n(21),a=n(34),o=n(56),i=n(155),s=n(302);r({target:"Object",stat:!0,forced:a((function(){i(1)})),sham:!s},{getPrototypeOf:function(e){return i(o(e))}})},function(e,t,n){var r=n(373);e.exports=function(){if("undefined"==typeof Reflect||!r)return!1;if(r.sham)return!1;if("function"==typeof Proxy)return!0;try{return Boolean.prototype.valueOf.call(r(Boolean,[],(function(){}))),!0}catch(e){return!1}},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t,n){var r=n(18).default,a=n(10);e.exports=function(e,t){return!t||"object"!==r(t)&&"function"!=typeof t?a(e):t},e.exports.default=e.exports,e.exports.__esModule=!0},function(e,t){e.exports=function(e,t){for(var n=-1,r=null==e?0:e.length;++n<r&&!1!==t(e[n],n,e););return e}},function(e,t,n){var r=n(116),a=n(117);e.exports=function(e,t){return e&&r(t,a(t),e)}},function(e,t,n){var r=n(116),a=n(135);e.exports=function(e,t){return e&&r(t,a(t),e)}},function(e,t,n){var r=n(116),a=n(214);e.exports=function(e,t){return r(e,a(e),t)}},function(e,t,n){var r=n(116),a=n(375);e.exports=function(e,t){return r(e,a(e),t)}},function(e,t){var n=Object.prototype.hasOwnProperty;e.exports=function(e){var t=e.length,r=new e.constructor(t);return t&&"string"==typeof e[0]&&n.call(e,"index")&&(r.index=e.index,r.input=e.input),r}},function(e,t,n){var r=n(204),a=n(715),o=n(716),i=n(717),s=n(318);e.exports=function(e,t,n){var c=e.constructor;switch(t){case"[object ArrayBuffer]":return r(e);case"[object Boolean]":case"[object Date]":return new c(+e);case"[object DataView]":return a(e,n);case"[object Float32Array]":case"[object Float64Array]":case"[object Int8Array]":case"[object Int16Array]":case"[object Int32Array]":case"[object Uint8Array]":case"[object Uint8ClampedArray]":case"[object Uint16Array]":case"[object Uint32Array]":return s(e,n);case"[object Map]":return new c;case"[object Number]":case"[object String]":return new c(e);case"[object RegExp]":return o(e);case"[object Set]":return new c;case"[object Symbol]":return i(e)}}},function(e,t,n){var r=n(204);e.exports=function(e,t){var n=t?r(e.buffer):e.buffer;return new e.constructor(n,e.byteOffset,e.byteLength)}},function(e,t){var n=/\w*$/;e.exports=function(e){var t=new e.constructor(e.source,n.exec(e));return t.lastIndex=e.lastIndex,t}},function(e,t,n){var r=n(115),a=r?r.prototype:void 0,o=a?a.valueOf:void 0;e.exports=function(e){return o?Object(o.call(e)):{}}},function(e,t,n){var r=n(719),a=n(207),o=n(208),i=o&&o.isMap,s=i?a(i):r;e.exports=s},function(e,t,n){var r=n(137),a=n(70);e.exports=function(e){return a(e)&&"[object Map]"==r(e)}},function(e,t,n){var r=n(721),a=n(207),o=n(208),i=o&&o.isSet,s=i?a(i):r;e.exports=s},function(e,t,n){var r=n(137),a=n(70);e.exports=function(e){return a(e)&&"[object Set]"==r(e)}},function(e,t,n){var r=n(138),a=n(723),o=n(724),i=n(118);e.exports=function(e,t){return t=r(t,e),null==(e=o(e,t))||delete e[i(a(t))]}},function(e,t){e.exports=function(e){var t=null==e?0:e.length;return t?e[t-1]:void 0}},function(e,t,n){var r=n(215),a=n(344);e.exports=function(e,t){return t.length<2?e:r(e,a(t,0,-1))}},function(e,t,n){var r=n(120);e.exports=function(e){return r(e)?void 0:e}},function(e,t,n){var r=n(727),a=n(325),o=n(326);e.exports=function(e){return o(a(e,void 0,r),e+"")}},function(e,t,n){var r=n(728);e.exports=function(e){return(null==e?0:e.length)?r(e,1):[]}},function(e,t,n){var r=n(213),a=n(729);e.exports=function e(t,n,o,i,s){var c=-1,u=t.length;for(o||(o=a),s||(s=[]);++c<u;){var l=t[c];n>0&&o(l)?n>1?e(l,n-1,o,i,s):r(s,l):i||(s[s.length]=l)}return s}},function(e,t,n){var r=n(115),a=n(133),o=n(47),i=r?r.isConcatSpreadable:void 0;e.exports=function(e){return o(e)||a(e)||!!(i&&e&&e[i])}},function(e,t,n){var r=n(731);e.exports=r},function(e,t,n){var r=n(732),a=String.prototype;e.exports=function(e){var t=e.repeat;return"string"==typeof e||e===a||e instanceof String&&t===a.repeat?r:t}},function(e,t,n){n(733);var r=n(39);e.exports=r("String").repeat},function(e,t,n){n(21)({target:"String",proto:!0},{repeat:n(734)})},function(e,t,n){"use strict";var r=n(112),a=n(61),o=n(97);e.exports=function(e){var t=a(o(this)),n="",i=r(e);if(i<0||i==1/0)throw RangeError("Wrong number of repetitions");for(;i>0;(i>>>=1)&&(t+=t))1&i&&(n+=t);return n}},function(e,t,n){var r=n(331);e.exports=r},function(e,t,n){var r=n(737);e.exports=r},function(e,t,n){n(738);var r=n(31);e.exports=r.Object.entries},function(e,t,n){var r=n(21),a=n(371).entries;r({target:"Object",stat:!0},{entries:function(e){return a(e)}})},function(e,t,n){var r=n(740);e.exports=r},function(e,t,n){var r=n(741),a=Array.prototype;e.exports=function(e){var t=e.splice;return e===a||e instanceof Array&&t===a.splice?r:t}},function(e,t,n){n(742);var r=n(39);e.exports=r("Array").splice},function(e,t,n){"use strict";var r=n(21),a=n(192),o=n(112),i=n(60),s=n(56),c=n(189),u=n(129),l=n(130)("splice"),p=Math.max,f=Math.min,d=9007199254740991,h="Maximum allowed length exceeded";r({target:"Array",proto:!0,forced:!l},{splice:function(e,t){var n,r,l,m,v,g,y=s(this),b=i(y.length),E=a(e,b),x=arguments.length;if(0===x?n=r=0:1===x?(n=0,r=b-E):(n=x-2,r=f(p(o(t),0),b-E)),b+n-r>d)throw TypeError(h);for(l=c(y,r),m=0;m<r;m++)(v=E+m)in y&&u(l,m,y[v]);if(l.length=r,n<r){for(m=E;m<b-r;m++)g=m+n,(v=m+r)in y?y[g]=y[v]:delete y[g];for(m=b;m>b-r+n;m--)delete y[m-1]}else if(n>r)for(m=b-r;m>E;m--)g=m+n-1,(v=m+r-1)in y?y[g]=y[v]:delete y[g];for(m=0;m<n;m++)y[m+E]=arguments[m+2];return y.length=b-r+n,l}})},function(e,t,n){var r=n(744);n(77),e.exports=r},function(e,t,n){n(69),n(84),n(745);var r=n(31);e.exports=r.WeakMap},function(e,t,n){"use strict";var r,a=n(38),o=n(139),i=n(170),s=n(378),c=n(747),u=n(41),l=n(68).enforce,p=n(297),f=!a.ActiveXObject&&"ActiveXObject"in a,d=Object.isExtensible,h=function(e){return function(){return e(this,arguments.length?arguments[0]:void 0)}},m=e.exports=s("WeakMap",h,c);if(p&&f){r=c.getConstructor(h,"WeakMap",!0),i.enable();var v=m.prototype,g=v.delete,y=v.has,b=v.get,E=v.set;o(v,{delete:function(e){if(u(e)&&!d(e)){var t=l(this);return t.frozen||(t.frozen=new r),g.call(this,e)||t.frozen.delete(e)}return g.call(this,e)},has:function(e){if(u(e)&&!d(e)){var t=l(this);return t.frozen||(t.frozen=new r),y.call(this,e)||t.frozen.has(e)}return y.call(this,e)},get:function(e){if(u(e)&&!d(e)){var t=l(this);return t.frozen||(t.frozen=new r),y.call(this,e)?b.call(this,e):t.frozen.get(e)}return b.call(this,e)},set:function(e,t){if(u(e)&&!d(e)){var n=l(this);n.frozen||(n.frozen=new r),y.call(this,e)?E.call(this,e,t):n.frozen.set(e,t)}else E.call(this,e,t);return this}})}},function(e,t,n){var r=n(34);e.exports=!r((function(){return Object.isExtensible(Object.preventExtensions({}))}))},function(e,t,n){"use strict";var r=n(139),a=n(170).getWeakData,o=n(46),i=n(41),s=n(119),c=n(106),u=n(76),l=n(51),p=n(68),f=p.set,d=p.getterFor,h=u.find,m=u.findIndex,v=0,g=function(e){return e.frozen||(e.frozen=new y)},y=function(){this.entries=[]},b=function(e,t){return h(e.entries,(function(e){return e[0]===t}))};y.prototype={get:function(e){var t=b(this,e);if(t)return t[1]},has:function(e){return!!b(this,e)},set:function(e,t){var n=b(this,e);n?n[1]=t:this.entries.push([e,t])},delete:function(e){var t=m(this.entries,(function(t){return t[0]===e}));return~t&&this.entries.splice(t,1),!!~t}},e.exports={getConstructor:function(e,t,n,u){var p=e((function(e,r){s(e,p,t),f(e,{type:t,id:v++,frozen:void 0}),null!=r&&c(r,e[u],{that:e,AS_ENTRIES:n})})),h=d(t),m=function(e,t,n){var r=h(e),i=a(o(t),!0);return!0===i?g(r).set(t,n):i[r.id]=n,e};return r(p.prototype,{delete:function(e){var t=h(this);if(!i(e))return!1;var n=a(e);return!0===n?g(t).delete(e):n&&l(n,t.id)&&delete n[t.id]},has:function(e){var t=h(this);if(!i(e))return!1;var n=a(e);return!0===n?g(t).has(e):n&&l(n,t.id)}}),r(p.prototype,n?{get:function(e){var t=h(this);if(i(e)){var n=a(e);return!0===n?g(t).get(e):n?n[t.id]:void 0}},set:function(e,t){return m(this,e,t)}}:{add:function(e){return m(this,e,!0)}}),p}}},function(e,t,n){var r=n(749);n(77),e.exports=r},function(e,t,n){n(217);var r=n(31);e.exports=r.URLSearchParams},function(e,t,n){var r=n(46),a=n(136);e.exports=function(e){var t=a(e);if("function"!=typeof t)throw TypeError(String(e)+" is not iterable");return r(t.call(e))}},function(e,t,n){var r=n(752);e.exports=r},function(e,t,n){n(753),n(755),n(217);var r=n(31);e.exports=r.URL},function(e,t,n){"use strict";n(114);var r,a=n(21),o=n(44),i=n(379),s=n(38),c=n(190),u=n(101),l=n(119),p=n(51),f=n(309),d=n(332),h=n(303).codeAt,m=n(754),v=n(61),g=n(85),y=n(217),b=n(68),E=s.URL,x=y.URLSearchParams,S=y.getState,w=b.set,j=b.getterFor("URL"),O=Math.floor,C=Math.pow,_="Invalid scheme",A="Invalid host",k="Invalid port",I=/[A-Za-z]/,P=/[\d+-.A-Za-z]/,N=/\d/,T=/^0x/i,R=/^[0-7]+$/,M=/^\d+$/,q=/^[\dA-Fa-f]+$/,D=/[\0\t\n\r #%/:<>?@[\\\]^|]/,B=/[\0\t\n\r #/:<>?@[\\\]^|]/,L=/^[\u0000-\u0020]+|[\u0000-\u0020]+$/g,U=/[\t\n\r]/g,V=function(e,t){var n,r,a;if("["==t.charAt(0)){if("]"!=t.charAt(t.length-1))return A;if(!(n=F(t.slice(1,-1))))return A;e.host=n}else if(Z(e)){if(t=m(t),D.test(t))return A;if(null===(n=z(t)))return A;e.host=n}else{if(B.test(t))return A;for(n="",r=d(t),a=0;a<r.length;a++)n+=K(r[a],W);e.host=n}},z=function(e){var t,n,r,a,o,i,s,c=e.split(".");if(c.length&&""==c[c.length-1]&&c.pop(),(t=c.length)>4)return e;for(n=[],r=0;r<t;r++){if(""==(a=c[r]))return e;if(o=10,a.length>1&&"0"==a.charAt(0)&&(o=T.test(a)?16:8,a=a.slice(8==o?1:2)),""===a)i=0;else{if(!(10==o?M:8==o?R:q).test(a))return e;i=parseInt(a,o)}n.push(i)}for(r=0;r<t;r++)if(i=n[r],r==t-1){if(i>=C(256,5-t))return null}else if(i>255)return null;for(s=n.pop(),r=0;r<n.length;r++)s+=n[r]*C(256,3-r);return s},F=function(e){var t,n,r,a,o,i,s,c=[0,0,0,0,0,0,0,0],u=0,l=null,p=0,f=function(){return e.charAt(p)};if(":"==f()){if(":"!=e.charAt(1))return;p+=2,l=++u}for(;f();){if(8==u)return;if(":"!=f()){for(t=n=0;n<4&&q.test(f());)t=16*t+parseInt(f(),16),p++,n++;if("."==f()){if(0==n)return;if(p-=n,u>6)return;for(r=0;f();){if(a=null,r>0){if(!("."==f()&&r<4))return;p++}if(!N.test(f()))return;for(;N.test(f());){if(o=parseInt(f(),10),null===a)a=o;else{if(0==a)return;a=10*a+o}if(a>255)return;p++}c[u]=256*c[u]+a,2!=++r&&4!=r||u++}if(4!=r)return;break}if(":"==f()){if(p++,!f())return}else if(f())return;c[u++]=t}else{if(null!==l)return;p++,l=++u}}if(null!==l)for(i=u-l,u=7;0!=u&&i>0;)s=c[u],c[u--]=c[l+i-1],c[l+--i]=s;else if(8!=u)return;return c},J=function(e){var t,n,r,a;if("number"==typeof e){for(t=[],n=0;n<4;n++)t.unshift(e%256),e=O(e/256);return t.join(".")}if("object"==typeof e){for(t="",r=function(e){for(var t=null,n=1,r=null,a=0,o=0;o<8;o++)0!==e[o]?(a>n&&(t=r,n=a),r=null,a=0):(null===r&&(r=o),++a);return a>n&&(t=r,n=a),t}(e),n=0;n<8;n++)a&&0===e[n]||(a&&(a=!1),r===n?(t+=n?":":"::",a=!0):(t+=e[n].toString(16),n<7&&(t+=":")));return"["+t+"]"}return e},W={},H=f({},W,{" ":1,'"':1,"<":1,">":1,"`":1}),$=f({},H,{"#":1,"?":1,"{":1,"}":1}),Y=f({},$,{"/":1,":":1,";":1,"=":1,"@":1,"[":1,"\\":1,"]":1,"^":1,"|":1}),K=function(e,t){var n=h(e,0);return n>32&&n<127&&!p(t,e)?e:encodeURIComponent(e)},G={ftp:21,file:null,http:80,https:443,ws:80,wss:443},Z=function(e){return p(G,e.scheme)},X=function(e){return""!=e.username||""!=e.password},Q=function(e){return!e.host||e.cannotBeABaseURL||"file"==e.scheme},ee=function(e,t){var n;return 2==e.length&&I.test(e.charAt(0))&&(":"==(n=e.charAt(1))||!t&&"|"==n)},te=function(e){var t;return e.length>1&&ee(e.slice(0,2))&&(2==e.length||"/"===(t=e.charAt(2))||"\\"===t||"?"===t||"#"===t)},ne=function(e){var t=e.path,n=t.length;!n||"file"==e.scheme&&1==n&&ee(t[0],!0)||t.pop()},re=function(e){return"."===e||"%2e"===e.toLowerCase()},ae={},oe={},ie={},se={},ce={},ue={},le={},pe={},fe={},de={},he={},me={},ve={},ge={},ye={},be={},Ee={},xe={},Se={},we={},je={},Oe=function(e,t,n,a){var o,i,s,c,u,l=n||ae,f=0,h="",m=!1,v=!1,g=!1;for(n||(e.scheme="",e.username="",e.password="",e.host=null,e.port=null,e.path=[],e.query=null,e.fragment=null,e.cannotBeABaseURL=!1,t=t.replace(L,"")),t=t.replace(U,""),o=d(t);f<=o.length;){switch(i=o[f],l){case ae:if(!i||!I.test(i)){if(n)return _;l=ie;continue}h+=i.toLowerCase(),l=oe;break;case oe:if(i&&(P.test(i)||"+"==i||"-"==i||"."==i))h+=i.toLowerCase();else{if(":"!=i){if(n)return _;h="",l=ie,f=0;continue}if(n&&(Z(e)!=p(G,h)||"file"==h&&(X(e)||null!==e.port)||"file"==e.scheme&&!e.host))return;if(e.scheme=h,n)return void(Z(e)&&G[e.scheme]==e.port&&(e.port=null));h="","file"==e.scheme?l=ge:Z(e)&&a&&a.scheme==e.scheme?l=se:Z(e)?l=pe:"/"==o[f+1]?(l=ce,f++):(e.cannotBeABaseURL=!0,e.path.push(""),l=Se)}break;case ie:if(!a||a.cannotBeABaseURL&&"#"!=i)return _;if(a.cannotBeABaseURL&&"#"==i){e.scheme=a.scheme,e.path=a.path.slice(),e.query=a.query,e.fragment="",e.cannotBeABaseURL=!0,l=je;break}l="file"==a.scheme?ge:ue;continue;case se:if("/"!=i||"/"!=o[f+1]){l=ue;continue}l=fe,f++;break;case ce:if("/"==i){l=de;break}l=xe;continue;case ue:if(e.scheme=a.scheme,i==r)e.username=a.username,e.password=a.password,e.host=a.host,e.port=a.port,e.path=a.path.slice(),e.query=a.query;else if("/"==i||"\\"==i&&Z(e))l=le;else if("?"==i)e.username=a.username,e.password=a.password,e.host=a.host,e.port=a.port,e.path=a.path.slice(),e.query="",l=we;else{if("#"!=i){e.username=a.username,e.password=a.password,e.host=a.host,e.port=a.port,e.path=a.path.slice(),e.path.pop(),l=xe;continue}e.username=a.username,e.password=a.password,e.host=a.host,e.port=a.port,e.path=a.path.slice(),e.query=a.query,e.fragment="",l=je}break;case le:if(!Z(e)||"/"!=i&&"\\"!=i){if("/"!=i){e.username=a.username,e.password=a.password,e.host=a.host,e.port=a.port,l=xe;continue}l=de}else l=fe;break;case pe:if(l=fe,"/"!=i||"/"!=h.charAt(f+1))continue;f++;break;case fe:if("/"!=i&&"\\"!=i){l=de;continue}break;case de:if("@"==i){m&&(h="%40"+h),m=!0,s=d(h);for(var y=0;y<s.length;y++){var b=s[y];if(":"!=b||g){var E=K(b,Y);g?e.password+=E:e.username+=E}else g=!0}h=""}else if(i==r||"/"==i||"?"==i||"#"==i||"\\"==i&&Z(e)){if(m&&""==h)return"Invalid authority";f-=d(h).length+1,h="",l=he}else h+=i;break;case he:case me:if(n&&"file"==e.scheme){l=be;continue}if(":"!=i||v){if(i==r||"/"==i||"?"==i||"#"==i||"\\"==i&&Z(e)){if(Z(e)&&""==h)return A;if(n&&""==h&&(X(e)||null!==e.port))return;if(c=V(e,h))return c;if(h="",l=Ee,n)return;continue}"["==i?v=!0:"]"==i&&(v=!1),h+=i}else{if(""==h)return A;if(c=V(e,h))return c;if(h="",l=ve,n==me)return}break;case ve:if(!N.test(i)){if(i==r||"/"==i||"?"==i||"#"==i||"\\"==i&&Z(e)||n){if(""!=h){var x=parseInt(h,10);if(x>65535)return k;e.port=Z(e)&&x===G[e.scheme]?null:x,h=""}if(n)return;l=Ee;continue}return k}h+=i;break;case ge:if(e.scheme="file","/"==i||"\\"==i)l=ye;else{if(!a||"file"!=a.scheme){l=xe;continue}if(i==r)e.host=a.host,e.path=a.path.slice(),e.query=a.query;else if("?"==i)e.host=a.host,e.path=a.path.slice(),e.query="",l=we;else{if("#"!=i){te(o.slice(f).join(""))||(e.host=a.host,e.path=a.path.slice(),ne(e)),l=xe;continue}e.host=a.host,e.path=a.path.slice(),e.query=a.query,e.fragment="",l=je}}break;case ye:if("/"==i||"\\"==i){l=be;break}a&&"file"==a.scheme&&!te(o.slice(f).join(""))&&(ee(a.path[0],!0)?e.path.push(a.path[0]):e.host=a.host),l=xe;continue;case be:if(i==r||"/"==i||"\\"==i||"?"==i||"#"==i){if(!n&&ee(h))l=xe;else if(""==h){if(e.host="",n)return;l=Ee}else{if(c=V(e,h))return c;if("localhost"==e.host&&(e.host=""),n)return;h="",l=Ee}continue}h+=i;break;case Ee:if(Z(e)){if(l=xe,"/"!=i&&"\\"!=i)continue}else if(n||"?"!=i)if(n||"#"!=i){if(i!=r&&(l=xe,"/"!=i))continue}else e.fragment="",l=je;else e.query="",l=we;break;case xe:if(i==r||"/"==i||"\\"==i&&Z(e)||!n&&("?"==i||"#"==i)){if(".."===(u=(u=h).toLowerCase())||"%2e."===u||".%2e"===u||"%2e%2e"===u?(ne(e),"/"==i||"\\"==i&&Z(e)||e.path.push("")):re(h)?"/"==i||"\\"==i&&Z(e)||e.path.push(""):("file"==e.scheme&&!e.path.length&&ee(h)&&(e.host&&(e.host=""),h=h.charAt(0)+":"),e.path.push(h)),h="","file"==e.scheme&&(i==r||"?"==i||"#"==i))for(;e.path.length>1&&""===e.path[0];)e.path.shift();"?"==i?(e.query="",l=we):"#"==i&&(e.fragment="",l=je)}else h+=K(i,$);break;case Se:"?"==i?(e.query="",l=we):"#"==i?(e.fragment="",l=je):i!=r&&(e.path[0]+=K(i,W));break;case we:n||"#"!=i?i!=r&&("'"==i&&Z(e)?e.query+="%27":e.query+="#"==i?"%23":K(i,W)):(e.fragment="",l=je);break;case je:i!=r&&(e.fragment+=K(i,H))}f++}},Ce=function(e){var t,n,r=l(this,Ce,"URL"),a=arguments.length>1?arguments[1]:void 0,i=v(e),s=w(r,{type:"URL"});if(void 0!==a)if(a instanceof Ce)t=j(a);else if(n=Oe(t={},v(a)))throw TypeError(n);if(n=Oe(s,i,null,t))throw TypeError(n);var c=s.searchParams=new x,u=S(c);u.updateSearchParams(s.query),u.updateURL=function(){s.query=String(c)||null},o||(r.href=Ae.call(r),r.origin=ke.call(r),r.protocol=Ie.call(r),r.username=Pe.call(r),r.password=Ne.call(r),r.host=Te.call(r),r.hostname=Re.call(r),r.port=Me.call(r),r.pathname=qe.call(r),r.search=De.call(r),r.searchParams=Be.call(r),r.hash=Le.call(r))},_e=Ce.prototype,Ae=function(){var e=j(this),t=e.scheme,n=e.username,r=e.password,a=e.host,o=e.port,i=e.path,s=e.query,c=e.fragment,u=t+":";return null!==a?(u+="//",X(e)&&(u+=n+(r?":"+r:"")+"@"),u+=J(a),null!==o&&(u+=":"+o)):"file"==t&&(u+="//"),u+=e.cannotBeABaseURL?i[0]:i.length?"/"+i.join("/"):"",null!==s&&(u+="?"+s),null!==c&&(u+="#"+c),u},ke=function(){var e=j(this),t=e.scheme,n=e.port;if("blob"==t)try{return new Ce(t.path[0]).origin}catch(e){return"null"}return"file"!=t&&Z(e)?t+"://"+J(e.host)+(null!==n?":"+n:""):"null"},Ie=function(){return j(this).scheme+":"},Pe=function(){return j(this).username},Ne=function(){return j(this).password},Te=function(){var e=j(this),t=e.host,n=e.port;return null===t?"":null===n?J(t):J(t)+":"+n},Re=function(){var e=j(this).host;return null===e?"":J(e)},Me=function(){var e=j(this).port;return null===e?"":String(e)},qe=function(){var e=j(this),t=e.path;return e.cannotBeABaseURL?t[0]:t.length?"/"+t.join("/"):""},De=function(){var e=j(this).query;return e?"?"+e:""},Be=function(){return j(this).searchParams},Le=function(){var e=j(this).fragment;return e?"#"+e:""},Ue=function(e,t){return{get:e,set:t,configurable:!0,enumerable:!0}};if(o&&c(_e,{href:Ue(Ae,(function(e){var t=j(this),n=v(e),r=Oe(t,n);if(r)throw TypeError(r);S(t.searchParams).updateSearchParams(t.query)})),origin:Ue(ke),protocol:Ue(Ie,(function(e){var t=j(this);Oe(t,v(e)+":",ae)})),username:Ue(Pe,(function(e){var t=j(this),n=d(v(e));if(!Q(t)){t.username="";for(var r=0;r<n.length;r++)t.username+=K(n[r],Y)}})),password:Ue(Ne,(function(e){var t=j(this),n=d(v(e));if(!Q(t)){t.password="";for(var r=0;r<n.length;r++)t.password+=K(n[r],Y)}})),host:Ue(Te,(function(e){var t=j(this);t.cannotBeABaseURL||Oe(t,v(e),he)})),hostname:Ue(Re,(function(e){var t=j(this);t.cannotBeABaseURL||Oe(t,v(e),me)})),port:Ue(Me,(function(e){var t=j(this);Q(t)||(""==(e=v(e))?t.port=null:Oe(t,e,ve))})),pathname:Ue(qe,(function(e){var t=j(this);t.cannotBeABaseURL||(t.path=[],Oe(t,v(e),Ee))})),search:Ue(De,(function(e){var t=j(this);""==(e=v(e))?t.query=null:("?"==e.charAt(0)&&(e=e.slice(1)),t.query="",Oe(t,e,we)),S(t.searchParams).updateSearchParams(t.query)})),searchParams:Ue(Be),hash:Ue(Le,(function(e){var t=j(this);""!=(e=v(e))?("#"==e.charAt(0)&&(e=e.slice(1)),t.fragment="",Oe(t,e,je)):t.fragment=null}))}),u(_e,"toJSON",(function(){return Ae.call(this)}),{enumerable:!0}),u(_e,"toString",(function(){return Ae.call(this)}),{enumerable:!0}),E){var Ve=E.createObjectURL,ze=E.revokeObjectURL;Ve&&u(Ce,"createObjectURL",(function(e){return Ve.apply(E,arguments)})),ze&&u(Ce,"revokeObjectURL",(function(e){return ze.apply(E,arguments)}))}g(Ce,"URL"),a({global:!0,forced:!i,sham:!o},{URL:Ce})},function(e,t,n){"use strict";var r=2147483647,a=/[^\0-\u007E]/,o=/[.\u3002\uFF0E\uFF61]/g,i="Overflow: input needs wider integers to process",s=Math.floor,c=String.fromCharCode,u=function(e){return e+22+75*(e<26)},l=function(e,t,n){var r=0;for(e=n?s(e/700):e>>1,e+=s(e/t);e>455;r+=36)e=s(e/35);return s(r+36*e/(e+38))},p=function(e){var t,n,a=[],o=(e=function(e){for(var t=[],n=0,r=e.length;n<r;){var a=e.charCodeAt(n++);if(a>=55296&&a<=56319&&n<r){var o=e.charCodeAt(n++);56320==(64512&o)?t.push(((1023&a)<<10)+(1023&o)+65536):(t.push(a),n--)}else t.push(a)}return t}(e)).length,p=128,f=0,d=72;for(t=0;t<e.length;t++)(n=e[t])<128&&a.push(c(n));var h=a.length,m=h;for(h&&a.push("-");m<o;){var v=r;for(t=0;t<e.length;t++)(n=e[t])>=p&&n<v&&(v=n);var g=m+1;if(v-p>s((r-f)/g))throw RangeError(i);for(f+=(v-p)*g,p=v,t=0;t<e.length;t++){if((n=e[t])<p&&++f>r)throw RangeError(i);if(n==p){for(var y=f,b=36;;b+=36){var E=b<=d?1:b>=d+26?26:b-d;if(y<E)break;var x=y-E,S=36-E;a.push(c(u(E+x%S))),y=s(x/S)}a.push(c(u(y))),d=l(f,g,m==h),f=0,++m}}++f,++p}return a.join("")};e.exports=function(e){var t,n,r=[],i=e.toLowerCase().replace(o,".").split(".");for(t=0;t<i.length;t++)n=i[t],r.push(a.test(n)?"xn--"+p(n):n);return r.join(".")}},function(e,t){},function(e,t,n){n(757);var r=n(31);e.exports=r.setTimeout},function(e,t,n){var r=n(21),a=n(38),o=n(98),i=[].slice,s=function(e){return function(t,n){var r=arguments.length>2,a=r?i.call(arguments,2):void 0;return e(r?function(){("function"==typeof t?t:Function(t)).apply(this,a)}:t,n)}};r({global:!0,bind:!0,forced:/MSIE .\./.test(o)},{setTimeout:s(a.setTimeout),setInterval:s(a.setInterval)})},function(e,t,n){var r=n(759);n(77),e.exports=r},function(e,t,n){n(69),n(760),n(84),n(114);var r=n(31);e.exports=r.Map},function(e,t,n){"use strict";var r=n(378),a=n(761);e.exports=r("Map",(function(e){return function(){return e(this,arguments.length?arguments[0]:void 0)}}),a)},function(e,t,n){"use strict";var r=n(59).f,a=n(100),o=n(139),i=n(99),s=n(119),c=n(106),u=n(198),l=n(364),p=n(44),f=n(170).fastKey,d=n(68),h=d.set,m=d.getterFor;e.exports={getConstructor:function(e,t,n,u){var l=e((function(e,r){s(e,l,t),h(e,{type:t,index:a(null),first:void 0,last:void 0,size:0}),p||(e.size=0),null!=r&&c(r,e[u],{that:e,AS_ENTRIES:n})})),d=m(t),v=function(e,t,n){var r,a,o=d(e),i=g(e,t);return i?i.value=n:(o.last=i={index:a=f(t,!0),key:t,value:n,previous:r=o.last,next:void 0,removed:!1},o.first||(o.first=i),r&&(r.next=i),p?o.size++:e.size++,"F"!==a&&(o.index[a]=i)),e},g=function(e,t){var n,r=d(e),a=f(t);if("F"!==a)return r.index[a];for(n=r.first;n;n=n.next)if(n.key==t)return n};return o(l.prototype,{clear:function(){for(var e=d(this),t=e.index,n=e.first;n;)n.removed=!0,n.previous&&(n.previous=n.previous.next=void 0),delete t[n.index],n=n.next;e.first=e.last=void 0,p?e.size=0:this.size=0},delete:function(e){var t=this,n=d(t),r=g(t,e);if(r){var a=r.next,o=r.previous;delete n.index[r.index],r.removed=!0,o&&(o.next=a),a&&(a.previous=o),n.first==r&&(n.first=a),n.last==r&&(n.last=o),p?n.size--:t.size--}return!!r},forEach:function(e){for(var t,n=d(this),r=i(e,arguments.length>1?arguments[1]:void 0,3);t=t?t.next:n.first;)for(r(t.value,t.key,this);t&&t.removed;)t=t.previous},has:function(e){return!!g(this,e)}}),o(l.prototype,n?{get:function(e){var t=g(this,e);return t&&t.value},set:function(e,t){return v(this,0===e?0:e,t)}}:{add:function(e){return v(this,e=0===e?0:e,e)}}),p&&r(l.prototype,"size",{get:function(){return d(this).size}}),l},setStrong:function(e,t,n){var r=t+" Iterator",a=m(t),o=m(r);u(e,t,(function(e,t){h(this,{type:r,target:e,state:a(e),kind:t,last:void 0})}),(function(){for(var e=o(this),t=e.kind,n=e.last;n&&n.removed;)n=n.previous;return e.target&&(e.last=n=n?n.next:e.state.first)?"keys"==t?{value:n.key,done:!1}:"values"==t?{value:n.value,done:!1}:{value:[n.key,n.value],done:!1}:(e.target=void 0,{value:void 0,done:!0})}),n?"entries":"values",!n,!0),l(t)}}},function(e,t,n){n(77);var r=n(763),a=n(86),o=Array.prototype,i={DOMTokenList:!0,NodeList:!0};e.exports=function(e){var t=e.keys;return e===o||e instanceof Array&&t===o.keys||i.hasOwnProperty(a(e))?r:t}},function(e,t,n){var r=n(764);e.exports=r},function(e,t,n){n(69),n(84);var r=n(39);e.exports=r("Array").keys},function(e,t,n){n(77);var r=n(766),a=n(86),o=Array.prototype,i={DOMTokenList:!0,NodeList:!0};e.exports=function(e){var t=e.values;return e===o||e instanceof Array&&t===o.values||i.hasOwnProperty(a(e))?r:t}},function(e,t,n){var r=n(767);e.exports=r},function(e,t,n){n(69),n(84);var r=n(39);e.exports=r("Array").values},function(e,t,n){var r=n(769);e.exports=r},function(e,t,n){var r=n(770),a=Array.prototype;e.exports=function(e){var t=e.lastIndexOf;return e===a||e instanceof Array&&t===a.lastIndexOf?r:t}},function(e,t,n){n(771);var r=n(39);e.exports=r("Array").lastIndexOf},function(e,t,n){var r=n(21),a=n(772);r({target:"Array",proto:!0,forced:a!==[].lastIndexOf},{lastIndexOf:a})},function(e,t,n){"use strict";var r=n(58),a=n(112),o=n(60),i=n(102),s=Math.min,c=[].lastIndexOf,u=!!c&&1/[1].lastIndexOf(1,-0)<0,l=i("lastIndexOf"),p=u||!l;e.exports=p?function(e){if(u)return c.apply(this,arguments)||0;var t=r(this),n=o(t.length),i=n-1;for(arguments.length>1&&(i=s(i,a(arguments[1]))),i<0&&(i=n+i);i>=0;i--)if(i in t&&t[i]===e)return i||0;return-1}:c},function(e,t,n){var r={"./all.js":276,"./auth/actions.js":74,"./auth/index.js":239,"./auth/reducers.js":240,"./auth/selectors.js":241,"./auth/spec-wrap-actions.js":242,"./configs/actions.js":124,"./configs/helpers.js":142,"./configs/index.js":278,"./configs/reducers.js":247,"./configs/selectors.js":246,"./configs/spec-actions.js":245,"./deep-linking/helpers.js":145,"./deep-linking/index.js":248,"./deep-linking/layout.js":249,"./deep-linking/operation-tag-wrapper.jsx":251,"./deep-linking/operation-wrapper.jsx":250,"./download-url.js":244,"./err/actions.js":54,"./err/error-transformers/hook.js":109,"./err/error-transformers/transformers/not-of-type.js":222,"./err/error-transformers/transformers/parameter-oneof.js":223,"./err/index.js":220,"./err/reducers.js":221,"./err/selectors.js":224,"./filter/index.js":252,"./filter/opsFilter.js":253,"./layout/actions.js":94,"./layout/index.js":225,"./layout/reducers.js":226,"./layout/selectors.js":227,"./layout/spec-extensions/wrap-selector.js":228,"./logs/index.js":237,"./oas3/actions.js":50,"./oas3/auth-extensions/wrap-selectors.js":257,"./oas3/components/callbacks.jsx":260,"./oas3/components/http-auth.jsx":265,"./oas3/components/index.js":259,"./oas3/components/operation-link.jsx":261,"./oas3/components/operation-servers.jsx":266,"./oas3/components/request-body-editor.jsx":264,"./oas3/components/request-body.jsx":143,"./oas3/components/servers-container.jsx":263,"./oas3/components/servers.jsx":262,"./oas3/helpers.jsx":32,"./oas3/index.js":255,"./oas3/reducers.js":275,"./oas3/selectors.js":274,"./oas3/spec-extensions/selectors.js":258,"./oas3/spec-extensions/wrap-selectors.js":256,"./oas3/wrap-components/auth-item.jsx":269,"./oas3/wrap-components/index.js":267,"./oas3/wrap-components/json-schema-string.jsx":273,"./oas3/wrap-components/markdown.jsx":268,"./oas3/wrap-components/model.jsx":272,"./oas3/wrap-components/online-validator-badge.js":271,"./oas3/wrap-components/version-stamp.jsx":270,"./on-complete/index.js":254,"./request-snippets/fn.js":141,"./request-snippets/index.js":234,"./request-snippets/request-snippets.jsx":236,"./request-snippets/selectors.js":235,"./samples/fn.js":122,"./samples/index.js":233,"./spec/actions.js":42,"./spec/index.js":229,"./spec/reducers.js":230,"./spec/selectors.js":78,"./spec/wrap-actions.js":231,"./swagger-js/configs-wrap-actions.js":238,"./swagger-js/index.js":277,"./util/index.js":243,"./view/index.js":232,"./view/root-injects.jsx":144};function a(e){var t=o(e);return n(t)}function o(e){if(!n.o(r,e)){var t=new Error("Cannot find module '"+e+"'");throw t.code="MODULE_NOT_FOUND",t}return r[e]}a.keys=function(){return Object.keys(r)},a.resolve=o,e.exports=a,a.id=773},function(e,t,n){"use strict";n.r(t);var r={};n.r(r),n.d(r,"Container",(function(){return _n})),n.d(r,"Col",(function(){return kn})),n.d(r,"Row",(function(){return In})),n.d(r,"Button",(function(){return Pn})),n.d(r,"TextArea",(function(){return Nn})),n.d(r,"Input",(function(){return Tn})),n.d(r,"Select",(function(){return Rn})),n.d(r,"Link",(function(){return Mn})),n.d(r,"Collapse",(function(){return Dn}));var a={};n.r(a),n.d(a,"JsonSchemaForm",(function(){return _r})),n.d(a,"JsonSchema_string",(function(){return Ar})),n.d(a,"JsonSchema_array",(function(){return kr})),n.d(a,"JsonSchemaArrayItemText",(function(){return Ir})),n.d(a,"JsonSchemaArrayItemFile",(function(){return Pr})),n.d(a,"JsonSchema_boolean",(function(){return Nr})),n.d(a,"JsonSchema_object",(function(){return Rr}));var o=n(18),i=n.n(o),s=n(2),c=n.n(s),u=n(12),l=n.n(u),p=n(15),f=n.n(p),d=n(30),h=n.n(d),m=n(71),v=n.n(m),g=n(3),y=n.n(g),b=n(6),E=n.n(b),x=n(7),S=n.n(x),w=n(33),j=n.n(w),O=n(20),C=n.n(O),_=n(19),A=n.n(_),k=n(23),I=n.n(k),P=n(28),N=n.n(P),T=n(4),R=n.n(T),M=n(0),q=n.n(M),D=n(146),B=n(1),L=n.n(B),U=n(381),V=n(121),z=n(382),F=n.n(z),J=n(54),W=n(25),H=n(5),$=function(e){return e};var Y=function(){function e(){var t,n=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{};E()(this,e),v()(this,{state:{},plugins:[],pluginsOptions:{},system:{configs:{},fn:{},components:{},rootInjects:{},statePlugins:{}},boundSystem:{},toolbox:{}},n),this.getSystem=j()(t=this._getSystem).call(t,this),this.store=Q($,Object(B.fromJS)(this.state),this.getSystem),this.buildSystem(!1),this.register(this.plugins)}return S()(e,[{key:"getStore",value:function(){return this.store}},{key:"register",value:function(e){var t=!(arguments.length>1&&void 0!==arguments[1])||arguments[1],n=K(e,this.getSystem(),this.pluginsOptions);Z(this.system,n),t&&this.buildSystem();var r=G.call(this.system,e,this.getSystem());r&&this.buildSystem()}},{key:"buildSystem",value:function(){var e=!(arguments.length>0&&void 0!==arguments[0])||arguments[0],t=this.getStore().dispatch,n=this.getStore().getState;this.boundSystem=C()({},this.getRootInjects(),this.getWrappedAndBoundActions(t),this.getWrappedAndBoundSelectors(n,this.getSystem),this.getStateThunks(n),this.getFn(),this.getConfigs()),e&&this.rebuildReducer()}},{key:"_getSystem",value:function(){return this.boundSystem}},{key:"getRootInjects",value:function(){var e,t,n;return C()({getSystem:this.getSystem,getStore:j()(e=this.getStore).call(e,this),getComponents:j()(t=this.getComponents).call(t,this),getState:this.getStore().getState,getConfigs:j()(n=this._getConfigs).call(n,this),Im:L.a,React:q.a},this.system.rootInjects||{})}},{key:"_getConfigs",value:function(){return this.system.configs}},{key:"getConfigs",value:function(){return{configs:this.system.configs}}},{key:"setConfigs",value:function(e){this.system.configs=e}},{key:"rebuildReducer",value:function(){var e,t,n,r;this.store.replaceReducer((r=this.system.statePlugins,e=Object(H.x)(r,(function(e){return e.reducers})),n=N()(t=f()(e)).call(t,(function(t,n){return t[n]=function(e){return function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:new B.Map,n=arguments.length>1?arguments[1]:void 0;if(!e)return t;var r=e[n.type];if(r){var a=X(r)(t,n);return null===a?t:a}return t}}(e[n]),t}),{}),f()(n).length?Object(U.combineReducers)(n):$))}},{key:"getType",value:function(e){var t=e[0].toUpperCase()+A()(e).call(e,1);return Object(H.y)(this.system.statePlugins,(function(n,r){var a=n[e];if(a)return y()({},r+t,a)}))}},{key:"getSelectors",value:function(){return this.getType("selectors")}},{key:"getActions",value:function(){var e=this.getType("actions");return Object(H.x)(e,(function(e){return Object(H.y)(e,(function(e,t){if(Object(H.r)(e))return y()({},t,e)}))}))}},{key:"getWrappedAndBoundActions",value:function(e){var t=this,n=this.getBoundActions(e);return Object(H.x)(n,(function(e,n){var r=t.system.statePlugins[A()(n).call(n,0,-7)].wrapActions;return r?Object(H.x)(e,(function(e,n){var a=r[n];return a?(I()(a)||(a=[a]),N()(a).call(a,(function(e,n){var r=function(){return n(e,t.getSystem()).apply(void 0,arguments)};if(!Object(H.r)(r))throw new TypeError("wrapActions needs to return a function that returns a new function (ie the wrapped action)");return X(r)}),e||Function.prototype)):e})):e}))}},{key:"getWrappedAndBoundSelectors",value:function(e,t){var n=this,r=this.getBoundSelectors(e,t);return Object(H.x)(r,(function(t,r){var a=[A()(r).call(r,0,-9)],o=n.system.statePlugins[a].wrapSelectors;return o?Object(H.x)(t,(function(t,r){var i=o[r];return i?(I()(i)||(i=[i]),N()(i).call(i,(function(t,r){var o=function(){for(var o,i=arguments.length,s=new Array(i),u=0;u<i;u++)s[u]=arguments[u];return r(t,n.getSystem()).apply(void 0,c()(o=[e().getIn(a)]).call(o,s))};if(!Object(H.r)(o))throw new TypeError("wrapSelector needs to return a function that returns a new function (ie the wrapped action)");return o}),t||Function.prototype)):t})):t}))}},{key:"getStates",value:function(e){var t;return N()(t=f()(this.system.statePlugins)).call(t,(function(t,n){return t[n]=e.get(n),t}),{})}},{key:"getStateThunks",value:function(e){var t;return N()(t=f()(this.system.statePlugins)).call(t,(function(t,n){return t[n]=function(){return e().get(n)},t}),{})}},{key:"getFn",value:function(){return{fn:this.system.fn}}},{key:"getComponents",value:function(e){var t=this,n=this.system.components[e];return I()(n)?N()(n).call(n,(function(e,n){return n(e,t.getSystem())})):void 0!==e?this.system.components[e]:this.system.components}},{key:"getBoundSelectors",value:function(e,t){return Object(H.x)(this.getSelectors(),(function(n,r){var a=[A()(r).call(r,0,-9)],o=function(){return e().getIn(a)};return Object(H.x)(n,(function(e){return function(){for(var n,r=arguments.length,a=new Array(r),i=0;i<r;i++)a[i]=arguments[i];var s=X(e).apply(null,c()(n=[o()]).call(n,a));return"function"==typeof s&&(s=X(s)(t())),s}}))}))}},{key:"getBoundActions",value:function(e){e=e||this.getStore().dispatch;var t=this.getActions(),n=function e(t){return"function"!=typeof t?Object(H.x)(t,(function(t){return e(t)})):function(){var e=null;try{e=t.apply(void 0,arguments)}catch(t){e={type:J.NEW_THROWN_ERR,error:!0,payload:Object(V.serializeError)(t)}}finally{return e}}};return Object(H.x)(t,(function(t){return Object(D.bindActionCreators)(n(t),e)}))}},{key:"getMapStateToProps",value:function(){var e=this;return function(){return C()({},e.getSystem())}}},{key:"getMapDispatchToProps",value:function(e){var t=this;return function(n){return v()({},t.getWrappedAndBoundActions(n),t.getFn(),e)}}}]),e}();function K(e,t,n){if(Object(H.t)(e)&&!Object(H.p)(e))return F()({},e);if(Object(H.s)(e))return K(e(t),t,n);if(Object(H.p)(e)){var r,a="chain"===n.pluginLoadType?t.getComponents():{};return N()(r=R()(e).call(e,(function(e){return K(e,t,n)}))).call(r,Z,a)}return{}}function G(e,t){var n=this,r=arguments.length>2&&void 0!==arguments[2]?arguments[2]:{},a=r.hasLoaded,o=a;return Object(H.t)(e)&&!Object(H.p)(e)&&"function"==typeof e.afterLoad&&(o=!0,X(e.afterLoad).call(this,t)),Object(H.s)(e)?G.call(this,e(t),t,{hasLoaded:o}):Object(H.p)(e)?R()(e).call(e,(function(e){return G.call(n,e,t,{hasLoaded:o})})):o}function Z(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{},t=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};if(!Object(H.t)(e))return{};if(!Object(H.t)(t))return e;t.wrapComponents&&(Object(H.x)(t.wrapComponents,(function(n,r){var a=e.components&&e.components[r];a&&I()(a)?(e.components[r]=c()(a).call(a,[n]),delete t.wrapComponents[r]):a&&(e.components[r]=[a,n],delete t.wrapComponents[r])})),f()(t.wrapComponents).length||delete t.wrapComponents);var n=e.statePlugins;if(Object(H.t)(n))for(var r in n){var a=n[r];if(Object(H.t)(a)){var o=a.wrapActions,i=a.wrapSelectors;if(Object(H.t)(o))for(var s in o){var u,l=o[s];if(I()(l)||(l=[l],o[s]=l),t&&t.statePlugins&&t.statePlugins[r]&&t.statePlugins[r].wrapActions&&t.statePlugins[r].wrapActions[s])t.statePlugins[r].wrapActions[s]=c()(u=o[s]).call(u,t.statePlugins[r].wrapActions[s])}if(Object(H.t)(i))for(var p in i){var d,h=i[p];if(I()(h)||(h=[h],i[p]=h),t&&t.statePlugins&&t.statePlugins[r]&&t.statePlugins[r].wrapSelectors&&t.statePlugins[r].wrapSelectors[p])t.statePlugins[r].wrapSelectors[p]=c()(d=i[p]).call(d,t.statePlugins[r].wrapSelectors[p])}}}return v()(e,t)}function X(e){var t=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{},n=t.logErrors,r=void 0===n||n;return"function"!=typeof e?e:function(){try{for(var t,n=arguments.length,a=new Array(n),o=0;o<n;o++)a[o]=arguments[o];return e.call.apply(e,c()(t=[this]).call(t,a))}catch(e){return r&&console.error(e),null}}}function Q(e,t,n){return function(e,t,n){var r=[Object(H.J)(n)],a=W.a.__REDUX_DEVTOOLS_EXTENSION_COMPOSE__||D.compose;return Object(D.createStore)(e,t,a(D.applyMiddleware.apply(void 0,r)))}(e,t,n)}var ee=n(220),te=n(225),ne=n(229),re=n(232),ae=n(233),oe=n(234),ie=n(237),se=n(277),ce=n(239),ue=n(243),le=n(244),pe=n(278),fe=n(248),de=n(252),he=n(254),me=n(10),ve=n.n(me),ge=n(8),ye=n.n(ge),be=n(9),Ee=n.n(be),xe=n(17),Se=n.n(xe),we=(n(11),n(26),n(53)),je=function(e){ye()(n,e);var t=Ee()(n);function n(e,r){var a;E()(this,n),a=t.call(this,e,r),y()(ve()(a),"toggleShown",(function(){var e=a.props,t=e.layoutActions,n=e.tag,r=e.operationId,o=e.isShown,i=a.getResolvedSubtree();o||void 0!==i||a.requestResolvedSubtree(),t.show(["operations",n,r],!o)})),y()(ve()(a),"onCancelClick",(function(){a.setState({tryItOutEnabled:!a.state.tryItOutEnabled})})),y()(ve()(a),"onTryoutClick",(function(){a.setState({tryItOutEnabled:!a.state.tryItOutEnabled})})),y()(ve()(a),"onExecute",(function(){a.setState({executeInProgress:!0})})),y()(ve()(a),"getResolvedSubtree",(function(){var e=a.props,t=e.specSelectors,n=e.path,r=e.method,o=e.specPath;return o?t.specResolvedSubtree(o.toJS()):t.specResolvedSubtree(["paths",n,r])})),y()(ve()(a),"requestResolvedSubtree",(function(){var e=a.props,t=e.specActions,n=e.path,r=e.method,o=e.specPath;return o?t.requestResolvedSubtree(o.toJS()):t.requestResolvedSubtree(["paths",n,r])}));var o=e.getConfigs().tryItOutEnabled;return a.state={tryItOutEnabled:!0===o||"true"===o,executeInProgress:!1},a}return S()(n,[{key:"mapStateToProps",value:function(e,t){var n,r=t.op,a=t.layoutSelectors,o=(0,t.getConfigs)(),i=o.docExpansion,s=o.deepLinking,u=o.displayOperationId,l=o.displayRequestDuration,p=o.supportedSubmitMethods,f=a.showSummary(),d=r.getIn(["operation","__originalOperationId"])||r.getIn(["operation","operationId"])||Object(we.e)(r.get("operation"),t.path,t.method)||r.get("id"),h=["operations",t.tag,d],m=s&&"false"!==s,v=Se()(p).call(p,t.method)>=0&&(void 0===t.allowTryItOut?t.specSelectors.allowTryItOutFor(t.path,t.method):t.allowTryItOut),g=r.getIn(["operation","security"])||t.specSelectors.security();return{operationId:d,isDeepLinkingEnabled:m,showSummary:f,displayOperationId:u,displayRequestDuration:l,allowTryItOut:v,security:g,isAuthorized:t.authSelectors.isAuthorized(g),isShown:a.isShown(h,"full"===i),jumpToKey:c()(n="paths.".concat(t.path,".")).call(n,t.method),response:t.specSelectors.responseFor(t.path,t.method),request:t.specSelectors.requestFor(t.path,t.method)}}},{key:"componentDidMount",value:function(){var e=this.props.isShown,t=this.getResolvedSubtree();e&&void 0===t&&this.requestResolvedSubtree()}},{key:"UNSAFE_componentWillReceiveProps",value:function(e){var t=e.response,n=e.isShown,r=this.getResolvedSubtree();t!==this.props.response&&this.setState({executeInProgress:!1}),n&&void 0===r&&this.requestResolvedSubtree()}},{key:"render",value:function(){var e=this.props,t=e.op,n=e.tag,r=e.path,a=e.method,o=e.security,i=e.isAuthorized,s=e.operationId,c=e.showSummary,u=e.isShown,l=e.jumpToKey,p=e.allowTryItOut,f=e.response,d=e.request,h=e.displayOperationId,m=e.displayRequestDuration,v=e.isDeepLinkingEnabled,g=e.specPath,y=e.specSelectors,b=e.specActions,E=e.getComponent,x=e.getConfigs,S=e.layoutSelectors,w=e.layoutActions,j=e.authActions,O=e.authSelectors,C=e.oas3Actions,_=e.oas3Selectors,A=e.fn,k=E("operation"),I=this.getResolvedSubtree()||Object(B.Map)(),P=Object(B.fromJS)({op:I,tag:n,path:r,summary:t.getIn(["operation","summary"])||"",deprecated:I.get("deprecated")||t.getIn(["operation","deprecated"])||!1,method:a,security:o,isAuthorized:i,operationId:s,originalOperationId:I.getIn(["operation","__originalOperationId"]),showSummary:c,isShown:u,jumpToKey:l,allowTryItOut:p,request:d,displayOperationId:h,displayRequestDuration:m,isDeepLinkingEnabled:v,executeInProgress:this.state.executeInProgress,tryItOutEnabled:this.state.tryItOutEnabled});return q.a.createElement(k,{operation:P,response:f,request:d,isShown:u,toggleShown:this.toggleShown,onTryoutClick:this.onTryoutClick,onCancelClick:this.onCancelClick,onExecute:this.onExecute,specPath:g,specActions:b,specSelectors:y,oas3Actions:C,oas3Selectors:_,layoutActions:w,layoutSelectors:S,authActions:j,authSelectors:O,getComponent:E,getConfigs:x,fn:A})}}]),n}(M.PureComponent);y()(je,"defaultProps",{showSummary:!0,response:null,allowTryItOut:!0,displayOperationId:!1,displayRequestDuration:!1});var Oe=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"getLayout",value:function(){var e=this.props,t=e.getComponent,n=e.layoutSelectors.current(),r=t(n,!0);return r||function(){return q.a.createElement("h1",null,' No layout defined for "',n,'" ')}}},{key:"render",value:function(){var e=this.getLayout();return q.a.createElement(e,null)}}]),n}(q.a.Component);Oe.defaultProps={};var Ce=function(e){ye()(n,e);var t=Ee()(n);function n(){var e,r;E()(this,n);for(var a=arguments.length,o=new Array(a),i=0;i<a;i++)o[i]=arguments[i];return r=t.call.apply(t,c()(e=[this]).call(e,o)),y()(ve()(r),"close",(function(){r.props.authActions.showDefinitions(!1)})),r}return S()(n,[{key:"render",value:function(){var e,t=this.props,n=t.authSelectors,r=t.authActions,a=t.getComponent,o=t.errSelectors,i=t.specSelectors,s=t.fn.AST,c=void 0===s?{}:s,u=n.shownDefinitions(),l=a("auths");return q.a.createElement("div",{className:"dialog-ux"},q.a.createElement("div",{className:"backdrop-ux"}),q.a.createElement("div",{className:"modal-ux"},q.a.createElement("div",{className:"modal-dialog-ux"},q.a.createElement("div",{className:"modal-ux-inner"},q.a.createElement("div",{className:"modal-ux-header"},q.a.createElement("h3",null,"Available authorizations"),q.a.createElement("button",{type:"button",className:"close-modal",onClick:this.close},q.a.createElement("svg",{width:"20",height:"20"},q.a.createElement("use",{href:"#close",xlinkHref:"#close"})))),q.a.createElement("div",{className:"modal-ux-content"},R()(e=u.valueSeq()).call(e,(function(e,t){return q.a.createElement(l,{key:t,AST:c,definitions:e,getComponent:a,errSelectors:o,authSelectors:n,authActions:r,specSelectors:i})})))))))}}]),n}(q.a.Component),_e=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e=this.props,t=e.isAuthorized,n=e.showPopup,r=e.onClick,a=(0,e.getComponent)("authorizationPopup",!0);return q.a.createElement("div",{className:"auth-wrapper"},q.a.createElement("button",{className:t?"btn authorize locked":"btn authorize unlocked",onClick:r},q.a.createElement("span",null,"Authorize"),q.a.createElement("svg",{width:"20",height:"20"},q.a.createElement("use",{href:t?"#locked":"#unlocked",xlinkHref:t?"#locked":"#unlocked"}))),n&&q.a.createElement(a,null))}}]),n}(q.a.Component),Ae=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e=this.props,t=e.authActions,n=e.authSelectors,r=e.specSelectors,a=e.getComponent,o=r.securityDefinitions(),i=n.definitionsToAuthorize(),s=a("authorizeBtn");return o?q.a.createElement(s,{onClick:function(){return t.showDefinitions(i)},isAuthorized:!!n.authorized().size,showPopup:!!n.shownDefinitions(),getComponent:a}):null}}]),n}(q.a.Component),ke=function(e){ye()(n,e);var t=Ee()(n);function n(){var e,r;E()(this,n);for(var a=arguments.length,o=new Array(a),i=0;i<a;i++)o[i]=arguments[i];return r=t.call.apply(t,c()(e=[this]).call(e,o)),y()(ve()(r),"onClick",(function(e){e.stopPropagation();var t=r.props.onClick;t&&t()})),r}return S()(n,[{key:"render",value:function(){var e=this.props.isAuthorized;return q.a.createElement("button",{className:e?"authorization__btn locked":"authorization__btn unlocked","aria-label":e?"authorization button locked":"authorization button unlocked",onClick:this.onClick},q.a.createElement("svg",{width:"20",height:"20"},q.a.createElement("use",{href:e?"#locked":"#unlocked",xlinkHref:e?"#locked":"#unlocked"})))}}]),n}(q.a.Component),Ie=function(e){ye()(n,e);var t=Ee()(n);function n(e,r){var a;return E()(this,n),a=t.call(this,e,r),y()(ve()(a),"onAuthChange",(function(e){var t=e.name;a.setState(y()({},t,e))})),y()(ve()(a),"submitAuth",(function(e){e.preventDefault(),a.props.authActions.authorizeWithPersistOption(a.state)})),y()(ve()(a),"logoutClick",(function(e){e.preventDefault();var t=a.props,n=t.authActions,r=t.definitions,o=R()(r).call(r,(function(e,t){return t})).toArray();a.setState(N()(o).call(o,(function(e,t){return e[t]="",e}),{})),n.logoutWithPersistOption(o)})),y()(ve()(a),"close",(function(e){e.preventDefault(),a.props.authActions.showDefinitions(!1)})),a.state={},a}return S()(n,[{key:"render",value:function(){var e,t=this,n=this.props,r=n.definitions,a=n.getComponent,o=n.authSelectors,i=n.errSelectors,s=a("AuthItem"),c=a("oauth2",!0),u=a("Button"),p=o.authorized(),f=l()(r).call(r,(function(e,t){return!!p.get(t)})),d=l()(r).call(r,(function(e){return"oauth2"!==e.get("type")})),h=l()(r).call(r,(function(e){return"oauth2"===e.get("type")}));return q.a.createElement("div",{className:"auth-container"},!!d.size&&q.a.createElement("form",{onSubmit:this.submitAuth},R()(d).call(d,(function(e,n){return q.a.createElement(s,{key:n,schema:e,name:n,getComponent:a,onAuthChange:t.onAuthChange,authorized:p,errSelectors:i})})).toArray(),q.a.createElement("div",{className:"auth-btn-wrapper"},d.size===f.size?q.a.createElement(u,{className:"btn modal-btn auth",onClick:this.logoutClick},"Logout"):q.a.createElement(u,{type:"submit",className:"btn modal-btn auth authorize"},"Authorize"),q.a.createElement(u,{className:"btn modal-btn auth btn-done",onClick:this.close},"Close"))),h&&h.size?q.a.createElement("div",null,q.a.createElement("div",{className:"scope-def"},q.a.createElement("p",null,"Scopes are used to grant an application different levels of access to data on behalf of the end user. Each API may declare one or more scopes."),q.a.createElement("p",null,"API requires the following scopes. Select which ones you want to grant to Swagger UI.")),R()(e=l()(r).call(r,(function(e){return"oauth2"===e.get("type")}))).call(e,(function(e,t){return q.a.createElement("div",{key:t},q.a.createElement(c,{authorized:p,schema:e,name:t}))})).toArray()):null)}}]),n}(q.a.Component),Pe=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e,t=this.props,n=t.schema,r=t.name,a=t.getComponent,o=t.onAuthChange,i=t.authorized,s=t.errSelectors,c=a("apiKeyAuth"),u=a("basicAuth"),l=n.get("type");switch(l){case"apiKey":e=q.a.createElement(c,{key:r,schema:n,name:r,errSelectors:s,authorized:i,getComponent:a,onChange:o});break;case"basic":e=q.a.createElement(u,{key:r,schema:n,name:r,errSelectors:s,authorized:i,getComponent:a,onChange:o});break;default:e=q.a.createElement("div",{key:r},"Unknown security definition type ",l)}return q.a.createElement("div",{key:"".concat(r,"-jump")},e)}}]),n}(q.a.Component),Ne=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e=this.props.error,t=e.get("level"),n=e.get("message"),r=e.get("source");return q.a.createElement("div",{className:"errors"},q.a.createElement("b",null,r," ",t),q.a.createElement("span",null,n))}}]),n}(q.a.Component),Te=function(e){ye()(n,e);var t=Ee()(n);function n(e,r){var a;E()(this,n),a=t.call(this,e,r),y()(ve()(a),"onChange",(function(e){var t=a.props.onChange,n=e.target.value,r=C()({},a.state,{value:n});a.setState(r),t(r)}));var o=a.props,i=o.name,s=o.schema,c=a.getValue();return a.state={name:i,schema:s,value:c},a}return S()(n,[{key:"getValue",value:function(){var e=this.props,t=e.name,n=e.authorized;return n&&n.getIn([t,"value"])}},{key:"render",value:function(){var e,t,n=this.props,r=n.schema,a=n.getComponent,o=n.errSelectors,i=n.name,s=a("Input"),c=a("Row"),u=a("Col"),p=a("authError"),f=a("Markdown",!0),d=a("JumpToPath",!0),h=this.getValue(),m=l()(e=o.allErrors()).call(e,(function(e){return e.get("authId")===i}));return q.a.createElement("div",null,q.a.createElement("h4",null,q.a.createElement("code",null,i||r.get("name"))," (apiKey)",q.a.createElement(d,{path:["securityDefinitions",i]})),h&&q.a.createElement("h6",null,"Authorized"),q.a.createElement(c,null,q.a.createElement(f,{source:r.get("description")})),q.a.createElement(c,null,q.a.createElement("p",null,"Name: ",q.a.createElement("code",null,r.get("name")))),q.a.createElement(c,null,q.a.createElement("p",null,"In: ",q.a.createElement("code",null,r.get("in")))),q.a.createElement(c,null,q.a.createElement("label",null,"Value:"),h?q.a.createElement("code",null," ****** "):q.a.createElement(u,null,q.a.createElement(s,{type:"text",onChange:this.onChange,autoFocus:!0}))),R()(t=m.valueSeq()).call(t,(function(e,t){return q.a.createElement(p,{error:e,key:t})})))}}]),n}(q.a.Component),Re=function(e){ye()(n,e);var t=Ee()(n);function n(e,r){var a;E()(this,n),a=t.call(this,e,r),y()(ve()(a),"onChange",(function(e){var t=a.props.onChange,n=e.target,r=n.value,o=n.name,i=a.state.value;i[o]=r,a.setState({value:i}),t(a.state)}));var o=a.props,i=o.schema,s=o.name,c=a.getValue().username;return a.state={name:s,schema:i,value:c?{username:c}:{}},a}return S()(n,[{key:"getValue",value:function(){var e=this.props,t=e.authorized,n=e.name;return t&&t.getIn([n,"value"])||{}}},{key:"render",value:function(){var e,t,n=this.props,r=n.schema,a=n.getComponent,o=n.name,i=n.errSelectors,s=a("Input"),c=a("Row"),u=a("Col"),p=a("authError"),f=a("JumpToPath",!0),d=a("Markdown",!0),h=this.getValue().username,m=l()(e=i.allErrors()).call(e,(function(e){return e.get("authId")===o}));return q.a.createElement("div",null,q.a.createElement("h4",null,"Basic authorization",q.a.createElement(f,{path:["securityDefinitions",o]})),h&&q.a.createElement("h6",null,"Authorized"),q.a.createElement(c,null,q.a.createElement(d,{source:r.get("description")})),q.a.createElement(c,null,q.a.createElement("label",null,"Username:"),h?q.a.createElement("code",null," ",h," "):q.a.createElement(u,null,q.a.createElement(s,{type:"text",required:"required",name:"username",onChange:this.onChange,autoFocus:!0}))),q.a.createElement(c,null,q.a.createElement("label",null,"Password:"),h?q.a.createElement("code",null," ****** "):q.a.createElement(u,null,q.a.createElement(s,{autoComplete:"new-password",name:"password",type:"password",onChange:this.onChange}))),R()(t=m.valueSeq()).call(t,(function(e,t){return q.a.createElement(p,{error:e,key:t})})))}}]),n}(q.a.Component);function Me(e){var t=e.example,n=e.showValue,r=e.getComponent,a=e.getConfigs,o=r("Markdown",!0),i=r("highlightCode");return t?q.a.createElement("div",{className:"example"},t.get("description")?q.a.createElement("section",{className:"example__section"},q.a.createElement("div",{className:"example__section-header"},"Example Description"),q.a.createElement("p",null,q.a.createElement(o,{source:t.get("description")}))):null,n&&t.has("value")?q.a.createElement("section",{className:"example__section"},q.a.createElement("div",{className:"example__section-header"},"Example Value"),q.a.createElement(i,{getConfigs:a,value:Object(H.I)(t.get("value"))})):null):null}var qe=n(407),De=n.n(qe),Be=function(e){ye()(n,e);var t=Ee()(n);function n(){var e,r;E()(this,n);for(var a=arguments.length,o=new Array(a),i=0;i<a;i++)o[i]=arguments[i];return r=t.call.apply(t,c()(e=[this]).call(e,o)),y()(ve()(r),"_onSelect",(function(e){var t=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{},n=t.isSyntheticChange,a=void 0!==n&&n;"function"==typeof r.props.onSelect&&r.props.onSelect(e,{isSyntheticChange:a})})),y()(ve()(r),"_onDomSelect",(function(e){if("function"==typeof r.props.onSelect){var t=e.target.selectedOptions[0].getAttribute("value");r._onSelect(t,{isSyntheticChange:!1})}})),y()(ve()(r),"getCurrentExample",(function(){var e=r.props,t=e.examples,n=e.currentExampleKey,a=t.get(n),o=t.keySeq().first(),i=t.get(o);return a||i||De()({})})),r}return S()(n,[{key:"componentDidMount",value:function(){var e=this.props,t=e.onSelect,n=e.examples;if("function"==typeof t){var r=n.first(),a=n.keyOf(r);this._onSelect(a,{isSyntheticChange:!0})}}},{key:"UNSAFE_componentWillReceiveProps",value:function(e){var t=e.currentExampleKey,n=e.examples;if(n!==this.props.examples&&!n.has(t)){var r=n.first(),a=n.keyOf(r);this._onSelect(a,{isSyntheticChange:!0})}}},{key:"render",value:function(){var e=this.props,t=e.examples,n=e.currentExampleKey,r=e.isValueModified,a=e.isModifiedValueAvailable,o=e.showLabels;return q.a.createElement("div",{className:"examples-select"},o?q.a.createElement("span",{className:"examples-select__section-label"},"Examples: "):null,q.a.createElement("select",{className:"examples-select-element",onChange:this._onDomSelect,value:a&&r?"__MODIFIED__VALUE__":n||""},a?q.a.createElement("option",{value:"__MODIFIED__VALUE__"},"[Modified value]"):null,R()(t).call(t,(function(e,t){return q.a.createElement("option",{key:t,value:t},e.get("summary")||t)})).valueSeq()))}}]),n}(q.a.PureComponent);y()(Be,"defaultProps",{examples:L.a.Map({}),onSelect:function(){for(var e,t,n=arguments.length,r=new Array(n),a=0;a<n;a++)r[a]=arguments[a];return(e=console).log.apply(e,c()(t=["DEBUG: ExamplesSelect was not given an onSelect callback"]).call(t,r))},currentExampleKey:null,showLabels:!0});var Le=function(e){return B.List.isList(e)?e:Object(H.I)(e)},Ue=function(e){ye()(n,e);var t=Ee()(n);function n(e){var r;E()(this,n),r=t.call(this,e),y()(ve()(r),"_getStateForCurrentNamespace",(function(){var e=r.props.currentNamespace;return(r.state[e]||Object(B.Map)()).toObject()})),y()(ve()(r),"_setStateForCurrentNamespace",(function(e){var t=r.props.currentNamespace;return r._setStateForNamespace(t,e)})),y()(ve()(r),"_setStateForNamespace",(function(e,t){var n=(r.state[e]||Object(B.Map)()).mergeDeep(t);return r.setState(y()({},e,n))})),y()(ve()(r),"_isCurrentUserInputSameAsExampleValue",(function(){var e=r.props.currentUserInputValue;return r._getCurrentExampleValue()===e})),y()(ve()(r),"_getValueForExample",(function(e,t){var n=(t||r.props).examples;return Le((n||Object(B.Map)({})).getIn([e,"value"]))})),y()(ve()(r),"_getCurrentExampleValue",(function(e){var t=(e||r.props).currentKey;return r._getValueForExample(t,e||r.props)})),y()(ve()(r),"_onExamplesSelect",(function(e){var t=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{},n=t.isSyntheticChange,a=r.props,o=a.onSelect,i=a.updateValue,s=a.currentUserInputValue,u=a.userHasEditedBody,l=r._getStateForCurrentNamespace(),p=l.lastUserEditedValue,f=r._getValueForExample(e);if("__MODIFIED__VALUE__"===e)return i(Le(p)),r._setStateForCurrentNamespace({isModifiedValueSelected:!0});if("function"==typeof o){for(var d,h=arguments.length,m=new Array(h>2?h-2:0),v=2;v<h;v++)m[v-2]=arguments[v];o.apply(void 0,c()(d=[e,{isSyntheticChange:n}]).call(d,m))}r._setStateForCurrentNamespace({lastDownstreamValue:f,isModifiedValueSelected:n&&u||!!s&&s!==f}),n||"function"==typeof i&&i(Le(f))}));var a=r._getCurrentExampleValue();return r.state=y()({},e.currentNamespace,Object(B.Map)({lastUserEditedValue:r.props.currentUserInputValue,lastDownstreamValue:a,isModifiedValueSelected:r.props.userHasEditedBody||r.props.currentUserInputValue!==a})),r}return S()(n,[{key:"componentWillUnmount",value:function(){this.props.setRetainRequestBodyValueFlag(!1)}},{key:"UNSAFE_componentWillReceiveProps",value:function(e){var t=e.currentUserInputValue,n=e.examples,r=e.onSelect,a=e.userHasEditedBody,o=this._getStateForCurrentNamespace(),i=o.lastUserEditedValue,s=o.lastDownstreamValue,c=this._getValueForExample(e.currentKey,e),u=l()(n).call(n,(function(e){return e.get("value")===t||Object(H.I)(e.get("value"))===t}));u.size?r(u.has(e.currentKey)?e.currentKey:u.keySeq().first(),{isSyntheticChange:!0}):t!==this.props.currentUserInputValue&&t!==i&&t!==s&&(this.props.setRetainRequestBodyValueFlag(!0),this._setStateForNamespace(e.currentNamespace,{lastUserEditedValue:e.currentUserInputValue,isModifiedValueSelected:a||t!==c}))}},{key:"render",value:function(){var e=this.props,t=e.currentUserInputValue,n=e.examples,r=e.currentKey,a=e.getComponent,o=e.userHasEditedBody,i=this._getStateForCurrentNamespace(),s=i.lastDownstreamValue,c=i.lastUserEditedValue,u=i.isModifiedValueSelected,l=a("ExamplesSelect");return q.a.createElement(l,{examples:n,currentExampleKey:r,onSelect:this._onExamplesSelect,isModifiedValueAvailable:!!c&&c!==s,isValueModified:void 0!==t&&u&&t!==this._getCurrentExampleValue()||o})}}]),n}(q.a.PureComponent);y()(Ue,"defaultProps",{userHasEditedBody:!1,examples:Object(B.Map)({}),currentNamespace:"__DEFAULT__NAMESPACE__",setRetainRequestBodyValueFlag:function(){},onSelect:function(){for(var e,t,n=arguments.length,r=new Array(n),a=0;a<n;a++)r[a]=arguments[a];return(e=console).log.apply(e,c()(t=["ExamplesSelectValueRetainer: no `onSelect` function was provided"]).call(t,r))},updateValue:function(){for(var e,t,n=arguments.length,r=new Array(n),a=0;a<n;a++)r[a]=arguments[a];return(e=console).log.apply(e,c()(t=["ExamplesSelectValueRetainer: no `updateValue` function was provided"]).call(t,r))}});var Ve=n(91),ze=n.n(Ve),Fe=n(107),Je=n.n(Fe),We=n(29),He=n.n(We),$e=n(79),Ye=n.n($e);var Ke=function(e){ye()(n,e);var t=Ee()(n);function n(e,r){var a;E()(this,n),a=t.call(this,e,r),y()(ve()(a),"close",(function(e){e.preventDefault(),a.props.authActions.showDefinitions(!1)})),y()(ve()(a),"authorize",(function(){var e=a.props,t=e.authActions,n=e.errActions,r=e.getConfigs,o=e.authSelectors,i=e.oas3Selectors,s=r(),c=o.getConfigs();n.clear({authId:name,type:"auth",source:"auth"}),function(e){var t=e.auth,n=e.authActions,r=e.errActions,a=e.configs,o=e.authConfigs,i=void 0===o?{}:o,s=e.currentServer,c=t.schema,u=t.scopes,l=t.name,p=t.clientId,f=c.get("flow"),d=[];switch(f){case"password":return void n.authorizePassword(t);case"application":return void n.authorizeApplication(t);case"accessCode":d.push("response_type=code");break;case"implicit":d.push("response_type=token");break;case"clientCredentials":case"client_credentials":return void n.authorizeApplication(t);case"authorizationCode":case"authorization_code":d.push("response_type=code")}"string"==typeof p&&d.push("client_id="+encodeURIComponent(p));var h=a.oauth2RedirectUrl;if(void 0!==h){d.push("redirect_uri="+encodeURIComponent(h));var m=[];if(I()(u)?m=u:L.a.List.isList(u)&&(m=u.toArray()),m.length>0){var v=i.scopeSeparator||" ";d.push("scope="+encodeURIComponent(m.join(v)))}var g=Object(H.a)(new Date);if(d.push("state="+encodeURIComponent(g)),void 0!==i.realm&&d.push("realm="+encodeURIComponent(i.realm)),("authorizationCode"===f||"authorization_code"===f||"accessCode"===f)&&i.usePkceWithAuthorizationCodeGrant){var y=Object(H.j)(),b=Object(H.c)(y);d.push("code_challenge="+b),d.push("code_challenge_method=S256"),t.codeVerifier=y}var E=i.additionalQueryStringParams;for(var x in E){var S;void 0!==E[x]&&d.push(R()(S=[x,E[x]]).call(S,encodeURIComponent).join("="))}var w,j=c.get("authorizationUrl"),O=[s?Ye()(Object(H.F)(j),s,!0).toString():Object(H.F)(j),d.join("&")].join(-1===Se()(j).call(j,"?")?"?":"&");w="implicit"===f?n.preAuthorizeImplicit:i.useBasicAuthenticationWithAccessCodeGrant?n.authorizeAccessCodeWithBasicAuthentication:n.authorizeAccessCodeWithFormParams,W.a.swaggerUIRedirectOauth2={auth:t,state:g,redirectUrl:h,callback:w,errCb:r.newAuthErr},W.a.open(O)}else r.newAuthErr({authId:l,source:"validation",level:"error",message:"oauth2RedirectUrl configuration is not passed. Oauth2 authorization cannot be performed."})}({auth:a.state,currentServer:i.serverEffectiveValue(i.selectedServer()),authActions:t,errActions:n,configs:s,authConfigs:c})})),y()(ve()(a),"onScopeChange",(function(e){var t,n,r=e.target,o=r.checked,i=r.dataset.value;if(o&&-1===Se()(t=a.state.scopes).call(t,i)){var s,u=c()(s=a.state.scopes).call(s,[i]);a.setState({scopes:u})}else if(!o&&Se()(n=a.state.scopes).call(n,i)>-1){var p;a.setState({scopes:l()(p=a.state.scopes).call(p,(function(e){return e!==i}))})}})),y()(ve()(a),"onInputChange",(function(e){var t=e.target,n=t.dataset.name,r=t.value,o=y()({},n,r);a.setState(o)})),y()(ve()(a),"selectScopes",(function(e){var t;e.target.dataset.all?a.setState({scopes:ze()(Je()(t=a.props.schema.get("allowedScopes")||a.props.schema.get("scopes")).call(t))}):a.setState({scopes:[]})})),y()(ve()(a),"logout",(function(e){e.preventDefault();var t=a.props,n=t.authActions,r=t.errActions,o=t.name;r.clear({authId:o,type:"auth",source:"auth"}),n.logoutWithPersistOption([o])}));var o=a.props,i=o.name,s=o.schema,u=o.authorized,p=o.authSelectors,f=u&&u.get(i),d=p.getConfigs()||{},h=f&&f.get("username")||"",m=f&&f.get("clientId")||d.clientId||"",v=f&&f.get("clientSecret")||d.clientSecret||"",g=f&&f.get("passwordType")||"basic",b=f&&f.get("scopes")||d.scopes||[];return"string"==typeof b&&(b=b.split(d.scopeSeparator||" ")),a.state={appName:d.appName,name:i,schema:s,scopes:b,clientId:m,clientSecret:v,username:h,password:"",passwordType:g},a}return S()(n,[{key:"render",value:function(){var e,t,n=this,r=this.props,a=r.schema,o=r.getComponent,i=r.authSelectors,s=r.errSelectors,u=r.name,p=r.specSelectors,f=o("Input"),d=o("Row"),h=o("Col"),m=o("Button"),v=o("authError"),g=o("JumpToPath",!0),y=o("Markdown",!0),b=o("InitializedInput"),E=p.isOAS3,x=E()?a.get("openIdConnectUrl"):null,S="implicit",w="password",j=E()?x?"authorization_code":"authorizationCode":"accessCode",O=E()?x?"client_credentials":"clientCredentials":"application",C=a.get("flow"),_=a.get("allowedScopes")||a.get("scopes"),A=!!i.authorized().get(u),k=l()(e=s.allErrors()).call(e,(function(e){return e.get("authId")===u})),I=!l()(k).call(k,(function(e){return"validation"===e.get("source")})).size,P=a.get("description");return q.a.createElement("div",null,q.a.createElement("h4",null,u," (OAuth2, ",a.get("flow"),") ",q.a.createElement(g,{path:["securityDefinitions",u]})),this.state.appName?q.a.createElement("h5",null,"Application: ",this.state.appName," "):null,P&&q.a.createElement(y,{source:a.get("description")}),A&&q.a.createElement("h6",null,"Authorized"),x&&q.a.createElement("p",null,"OpenID Connect URL: ",q.a.createElement("code",null,x)),(C===S||C===j)&&q.a.createElement("p",null,"Authorization URL: ",q.a.createElement("code",null,a.get("authorizationUrl"))),(C===w||C===j||C===O)&&q.a.createElement("p",null,"Token URL:",q.a.createElement("code",null," ",a.get("tokenUrl"))),q.a.createElement("p",{className:"flow"},"Flow: ",q.a.createElement("code",null,a.get("flow"))),C!==w?null:q.a.createElement(d,null,q.a.createElement(d,null,q.a.createElement("label",{htmlFor:"oauth_username"},"username:"),A?q.a.createElement("code",null," ",this.state.username," "):q.a.createElement(h,{tablet:10,desktop:10},q.a.createElement("input",{id:"oauth_username",type:"text","data-name":"username",onChange:this.onInputChange,autoFocus:!0}))),q.a.createElement(d,null,q.a.createElement("label",{htmlFor:"oauth_password"},"password:"),A?q.a.createElement("code",null," ****** "):q.a.createElement(h,{tablet:10,desktop:10},q.a.createElement("input",{id:"oauth_password",type:"password","data-name":"password",onChange:this.onInputChange}))),q.a.createElement(d,null,q.a.createElement("label",{htmlFor:"password_type"},"Client credentials location:"),A?q.a.createElement("code",null," ",this.state.passwordType," "):q.a.createElement(h,{tablet:10,desktop:10},q.a.createElement("select",{id:"password_type","data-name":"passwordType",onChange:this.onInputChange},q.a.createElement("option",{value:"basic"},"Authorization header"),q.a.createElement("option",{value:"request-body"},"Request body"))))),(C===O||C===S||C===j||C===w)&&(!A||A&&this.state.clientId)&&q.a.createElement(d,null,q.a.createElement("label",{htmlFor:"client_id"},"client_id:"),A?q.a.createElement("code",null," ****** "):q.a.createElement(h,{tablet:10,desktop:10},q.a.createElement(b,{id:"client_id",type:"text",required:C===w,initialValue:this.state.clientId,"data-name":"clientId",onChange:this.onInputChange}))),(C===O||C===j||C===w)&&q.a.createElement(d,null,q.a.createElement("label",{htmlFor:"client_secret"},"client_secret:"),A?q.a.createElement("code",null," ****** "):q.a.createElement(h,{tablet:10,desktop:10},q.a.createElement(b,{id:"client_secret",initialValue:this.state.clientSecret,type:"password","data-name":"clientSecret",onChange:this.onInputChange}))),!A&&_&&_.size?q.a.createElement("div",{className:"scopes"},q.a.createElement("h2",null,"Scopes:",q.a.createElement("a",{onClick:this.selectScopes,"data-all":!0},"select all"),q.a.createElement("a",{onClick:this.selectScopes},"select none")),R()(_).call(_,(function(e,t){var r,a,o,i,s;return q.a.createElement(d,{key:t},q.a.createElement("div",{className:"checkbox"},q.a.createElement(f,{"data-value":t,id:c()(r=c()(a="".concat(t,"-")).call(a,C,"-checkbox-")).call(r,n.state.name),disabled:A,checked:He()(o=n.state.scopes).call(o,t),type:"checkbox",onChange:n.onScopeChange}),q.a.createElement("label",{htmlFor:c()(i=c()(s="".concat(t,"-")).call(s,C,"-checkbox-")).call(i,n.state.name)},q.a.createElement("span",{className:"item"}),q.a.createElement("div",{className:"text"},q.a.createElement("p",{className:"name"},t),q.a.createElement("p",{className:"description"},e)))))})).toArray()):null,R()(t=k.valueSeq()).call(t,(function(e,t){return q.a.createElement(v,{error:e,key:t})})),q.a.createElement("div",{className:"auth-btn-wrapper"},I&&(A?q.a.createElement(m,{className:"btn modal-btn auth authorize",onClick:this.logout},"Logout"):q.a.createElement(m,{className:"btn modal-btn auth authorize",onClick:this.authorize},"Authorize")),q.a.createElement(m,{className:"btn modal-btn auth btn-done",onClick:this.close},"Close")))}}]),n}(q.a.Component),Ge=function(e){ye()(n,e);var t=Ee()(n);function n(){var e,r;E()(this,n);for(var a=arguments.length,o=new Array(a),i=0;i<a;i++)o[i]=arguments[i];return r=t.call.apply(t,c()(e=[this]).call(e,o)),y()(ve()(r),"onClick",(function(){var e=r.props,t=e.specActions,n=e.path,a=e.method;t.clearResponse(n,a),t.clearRequest(n,a)})),r}return S()(n,[{key:"render",value:function(){return q.a.createElement("button",{className:"btn btn-clear opblock-control__btn",onClick:this.onClick},"Clear")}}]),n}(M.Component),Ze=function(e){var t=e.headers;return q.a.createElement("div",null,q.a.createElement("h5",null,"Response headers"),q.a.createElement("pre",{className:"microlight"},t))},Xe=function(e){var t=e.duration;return q.a.createElement("div",null,q.a.createElement("h5",null,"Request duration"),q.a.createElement("pre",{className:"microlight"},t," ms"))},Qe=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"shouldComponentUpdate",value:function(e){return this.props.response!==e.response||this.props.path!==e.path||this.props.method!==e.method||this.props.displayRequestDuration!==e.displayRequestDuration}},{key:"render",value:function(){var e,t=this.props,n=t.response,r=t.getComponent,a=t.getConfigs,o=t.displayRequestDuration,i=t.specSelectors,s=t.path,u=t.method,l=a(),p=l.showMutatedRequest,d=l.requestSnippetsEnabled,h=p?i.mutatedRequestFor(s,u):i.requestFor(s,u),m=n.get("status"),v=h.get("url"),g=n.get("headers").toJS(),y=n.get("notDocumented"),b=n.get("error"),E=n.get("text"),x=n.get("duration"),S=f()(g),w=g["content-type"]||g["Content-Type"],j=r("responseBody"),O=R()(S).call(S,(function(e){var t=I()(g[e])?g[e].join():g[e];return q.a.createElement("span",{className:"headerline",key:e}," ",e,": ",t," ")})),C=0!==O.length,_=r("Markdown",!0),A=r("RequestSnippets",!0),k=r("curl");return q.a.createElement("div",null,h&&(!0===d||"true"===d?q.a.createElement(A,{request:h}):q.a.createElement(k,{request:h,getConfigs:a})),v&&q.a.createElement("div",null,q.a.createElement("h4",null,"Request URL"),q.a.createElement("div",{className:"request-url"},q.a.createElement("pre",{className:"microlight"},v))),q.a.createElement("h4",null,"Server response"),q.a.createElement("table",{className:"responses-table live-responses-table"},q.a.createElement("thead",null,q.a.createElement("tr",{className:"responses-header"},q.a.createElement("td",{className:"col_header response-col_status"},"Code"),q.a.createElement("td",{className:"col_header response-col_description"},"Details"))),q.a.createElement("tbody",null,q.a.createElement("tr",{className:"response"},q.a.createElement("td",{className:"response-col_status"},m,y?q.a.createElement("div",{className:"response-undocumented"},q.a.createElement("i",null," Undocumented ")):null),q.a.createElement("td",{className:"response-col_description"},b?q.a.createElement(_,{source:c()(e="".concat(""!==n.get("name")?"".concat(n.get("name"),": "):"")).call(e,n.get("message"))}):null,E?q.a.createElement(j,{content:E,contentType:w,url:v,headers:g,getConfigs:a,getComponent:r}):null,C?q.a.createElement(Ze,{headers:O}):null,o&&x?q.a.createElement(Xe,{duration:x}):null)))))}}]),n}(q.a.Component),et=n(178),tt=["get","put","post","delete","options","head","patch"],nt=c()(tt).call(tt,["trace"]),rt=function(e){ye()(n,e);var t=Ee()(n);function n(){var e,r;E()(this,n);for(var a=arguments.length,o=new Array(a),i=0;i<a;i++)o[i]=arguments[i];return r=t.call.apply(t,c()(e=[this]).call(e,o)),y()(ve()(r),"renderOperationTag",(function(e,t){var n=r.props,a=n.specSelectors,o=n.getComponent,i=n.oas3Selectors,s=n.layoutSelectors,u=n.layoutActions,l=n.getConfigs,p=o("OperationContainer",!0),f=o("OperationTag"),d=e.get("operations");return q.a.createElement(f,{key:"operation-"+t,tagObj:e,tag:t,oas3Selectors:i,layoutSelectors:s,layoutActions:u,getConfigs:l,getComponent:o,specUrl:a.url()},q.a.createElement("div",{className:"operation-tag-content"},R()(d).call(d,(function(e){var n,r=e.get("path"),o=e.get("method"),i=L.a.List(["paths",r,o]),s=a.isOAS3()?nt:tt;return-1===Se()(s).call(s,o)?null:q.a.createElement(p,{key:c()(n="".concat(r,"-")).call(n,o),specPath:i,op:e,path:r,method:o,tag:t})})).toArray()))})),r}return S()(n,[{key:"render",value:function(){var e=this.props.specSelectors.taggedOperations();return 0===e.size?q.a.createElement("h3",null," No operations defined in spec!"):q.a.createElement("div",null,R()(e).call(e,this.renderOperationTag).toArray(),e.size<1?q.a.createElement("h3",null," No operations defined in spec! "):null)}}]),n}(q.a.Component),at=n(80),ot=n.n(at);function it(e){return e.match(/^(?:[a-z]+:)?\/\//i)}function st(e,t){return e?it(e)?(n=e).match(/^\/\//i)?c()(r="".concat(window.location.protocol)).call(r,n):n:new ot.a(e,t).href:t;var n,r}function ct(e,t){var n=arguments.length>2&&void 0!==arguments[2]?arguments[2]:{},r=n.selectedServer,a=void 0===r?"":r;if(e){if(it(e))return e;var o=st(a,t);return it(o)?new ot.a(e,o).href:new ot.a(e,window.location.href).href}}function ut(e,t){var n=arguments.length>2&&void 0!==arguments[2]?arguments[2]:{},r=n.selectedServer,a=void 0===r?"":r;try{return ct(e,t,{selectedServer:a})}catch(e){return}}var lt=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e,t=this.props,n=t.tagObj,r=t.tag,a=t.children,o=t.oas3Selectors,i=t.layoutSelectors,s=t.layoutActions,c=t.getConfigs,u=t.getComponent,l=t.specUrl,p=c(),f=p.docExpansion,d=p.deepLinking,h=d&&"false"!==d,m=u("Collapse"),v=u("Markdown",!0),g=u("DeepLink"),y=u("Link"),b=n.getIn(["tagDetails","description"],null),E=n.getIn(["tagDetails","externalDocs","description"]),x=n.getIn(["tagDetails","externalDocs","url"]);e=Object(H.s)(o)&&Object(H.s)(o.selectedServer)?ut(x,l,{selectedServer:o.selectedServer()}):x;var S=["operations-tag",r],w=i.isShown(S,"full"===f||"list"===f);return q.a.createElement("div",{className:w?"opblock-tag-section is-open":"opblock-tag-section"},q.a.createElement("h3",{onClick:function(){return s.show(S,!w)},className:b?"opblock-tag":"opblock-tag no-desc",id:R()(S).call(S,(function(e){return Object(H.g)(e)})).join("-"),"data-tag":r,"data-is-open":w},q.a.createElement(g,{enabled:h,isShown:w,path:Object(H.d)(r),text:r}),b?q.a.createElement("small",null,q.a.createElement(v,{source:b})):q.a.createElement("small",null),q.a.createElement("div",null,E?q.a.createElement("small",null,E,e?": ":null,e?q.a.createElement(y,{href:Object(H.F)(e),onClick:function(e){return e.stopPropagation()},target:"_blank"},e):null):null),q.a.createElement("button",{"aria-expanded":w,className:"expand-operation",title:w?"Collapse operation":"Expand operation",onClick:function(){return s.show(S,!w)}},q.a.createElement("svg",{className:"arrow",width:"20",height:"20","aria-hidden":"true",focusable:"false"},q.a.createElement("use",{href:w?"#large-arrow-up":"#large-arrow-down",xlinkHref:w?"#large-arrow-up":"#large-arrow-down"})))),q.a.createElement(m,{isOpened:w},a))}}]),n}(q.a.Component);y()(lt,"defaultProps",{tagObj:L.a.fromJS({}),tag:""});var pt=function(e){ye()(r,e);var t=Ee()(r);function r(){return E()(this,r),t.apply(this,arguments)}return S()(r,[{key:"render",value:function(){var e=this.props,t=e.specPath,r=e.response,a=e.request,o=e.toggleShown,i=e.onTryoutClick,s=e.onCancelClick,c=e.onExecute,u=e.fn,l=e.getComponent,p=e.getConfigs,f=e.specActions,d=e.specSelectors,h=e.authActions,m=e.authSelectors,v=e.oas3Actions,g=e.oas3Selectors,y=this.props.operation,b=y.toJS(),E=b.deprecated,x=b.isShown,S=b.path,w=b.method,j=b.op,O=b.tag,C=b.operationId,_=b.allowTryItOut,A=b.displayRequestDuration,k=b.tryItOutEnabled,I=b.executeInProgress,P=j.description,N=j.externalDocs,T=j.schemes,R=N?ut(N.url,d.url(),{selectedServer:g.selectedServer()}):"",M=y.getIn(["op"]),D=M.get("responses"),B=Object(H.n)(M,["parameters"]),L=d.operationScheme(S,w),U=["operations",O,C],V=Object(H.m)(M),z=l("responses"),F=l("parameters"),J=l("execute"),W=l("clear"),$=l("Collapse"),Y=l("Markdown",!0),K=l("schemes"),G=l("OperationServers"),Z=l("OperationExt"),X=l("OperationSummary"),Q=l("Link"),ee=p().showExtensions;if(D&&r&&r.size>0){var te=!D.get(String(r.get("status")))&&!D.get("default");r=r.set("notDocumented",te)}var ne=[S,w];return q.a.createElement("div",{className:E?"opblock opblock-deprecated":x?"opblock opblock-".concat(w," is-open"):"opblock opblock-".concat(w),id:Object(H.g)(U.join("-"))},q.a.createElement(X,{operationProps:y,isShown:x,toggleShown:o,getComponent:l,authActions:h,authSelectors:m,specPath:t}),q.a.createElement($,{isOpened:x},q.a.createElement("div",{className:"opblock-body"},M&&M.size||null===M?null:q.a.createElement("img",{height:"32px",width:"32px",src:n(380),className:"opblock-loading-animation"}),E&&q.a.createElement("h4",{className:"opblock-title_normal"}," Warning: Deprecated"),P&&q.a.createElement("div",{className:"opblock-description-wrapper"},q.a.createElement("div",{className:"opblock-description"},q.a.createElement(Y,{source:P}))),R?q.a.createElement("div",{className:"opblock-external-docs-wrapper"},q.a.createElement("h4",{className:"opblock-title_normal"},"Find more details"),q.a.createElement("div",{className:"opblock-external-docs"},q.a.createElement("span",{className:"opblock-external-docs__description"},q.a.createElement(Y,{source:N.description})),q.a.createElement(Q,{target:"_blank",className:"opblock-external-docs__link",href:Object(H.F)(R)},R))):null,M&&M.size?q.a.createElement(F,{parameters:B,specPath:t.push("parameters"),operation:M,onChangeKey:ne,onTryoutClick:i,onCancelClick:s,tryItOutEnabled:k,allowTryItOut:_,fn:u,getComponent:l,specActions:f,specSelectors:d,pathMethod:[S,w],getConfigs:p,oas3Actions:v,oas3Selectors:g}):null,k?q.a.createElement(G,{getComponent:l,path:S,method:w,operationServers:M.get("servers"),pathServers:d.paths().getIn([S,"servers"]),getSelectedServer:g.selectedServer,setSelectedServer:v.setSelectedServer,setServerVariableValue:v.setServerVariableValue,getServerVariable:g.serverVariableValue,getEffectiveServerValue:g.serverEffectiveValue}):null,k&&_&&T&&T.size?q.a.createElement("div",{className:"opblock-schemes"},q.a.createElement(K,{schemes:T,path:S,method:w,specActions:f,currentScheme:L})):null,q.a.createElement("div",{className:k&&r&&_?"btn-group":"execute-wrapper"},k&&_?q.a.createElement(J,{operation:M,specActions:f,specSelectors:d,oas3Selectors:g,oas3Actions:v,path:S,method:w,onExecute:c,disabled:I}):null,k&&r&&_?q.a.createElement(W,{specActions:f,path:S,method:w}):null),I?q.a.createElement("div",{className:"loading-container"},q.a.createElement("div",{className:"loading"})):null,D?q.a.createElement(z,{responses:D,request:a,tryItOutResponse:r,getComponent:l,getConfigs:p,specSelectors:d,oas3Actions:v,oas3Selectors:g,specActions:f,produces:d.producesOptionsFor([S,w]),producesValue:d.currentProducesFor([S,w]),specPath:t.push("responses"),path:S,method:w,displayRequestDuration:A,fn:u}):null,ee&&V.size?q.a.createElement(Z,{extensions:V,getComponent:l}):null)))}}]),r}(M.PureComponent);y()(pt,"defaultProps",{operation:null,response:null,request:null,specPath:Object(B.List)(),summary:""});var ft=n(87),dt=n.n(ft),ht=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e,t=this.props,n=t.isShown,r=t.toggleShown,a=t.getComponent,o=t.authActions,i=t.authSelectors,s=t.operationProps,u=t.specPath,l=s.toJS(),p=l.summary,f=l.isAuthorized,d=l.method,h=l.op,m=l.showSummary,v=l.path,g=l.operationId,y=l.originalOperationId,b=l.displayOperationId,E=h.summary,x=s.get("security"),S=a("authorizeOperationBtn"),w=a("OperationSummaryMethod"),j=a("OperationSummaryPath"),O=a("JumpToPath",!0),C=x&&!!x.count(),_=C&&1===x.size&&x.first().isEmpty(),A=!C||_;return q.a.createElement("div",{className:"opblock-summary opblock-summary-".concat(d)},q.a.createElement("button",{"aria-label":c()(e="".concat(d," ")).call(e,v.replace(/\//g,"​/")),"aria-expanded":n,className:"opblock-summary-control",onClick:r},q.a.createElement(w,{method:d}),q.a.createElement(j,{getComponent:a,operationProps:s,specPath:u}),m?q.a.createElement("div",{className:"opblock-summary-description"},dt()(E||p)):null,b&&(y||g)?q.a.createElement("span",{className:"opblock-summary-operation-id"},y||g):null,q.a.createElement("svg",{className:"arrow",width:"20",height:"20","aria-hidden":"true",focusable:"false"},q.a.createElement("use",{href:n?"#large-arrow-up":"#large-arrow-down",xlinkHref:n?"#large-arrow-up":"#large-arrow-down"}))),A?null:q.a.createElement(S,{isAuthorized:f,onClick:function(){var e=i.definitionsForRequirements(x);o.showDefinitions(e)}}),q.a.createElement(O,{path:u}))}}]),n}(M.PureComponent);y()(ht,"defaultProps",{operationProps:null,specPath:Object(B.List)(),summary:""});var mt=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e=this.props.method;return q.a.createElement("span",{className:"opblock-summary-method"},e.toUpperCase())}}]),n}(M.PureComponent);y()(mt,"defaultProps",{operationProps:null});var vt=n(175),gt=n.n(vt),yt=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){for(var e,t=this.props,n=t.getComponent,r=t.operationProps.toJS(),a=r.deprecated,o=r.isShown,i=r.path,s=r.tag,u=r.operationId,l=r.isDeepLinkingEnabled,p=i.split(/(?=\/)/g),f=1;f<p.length;f+=2)gt()(p).call(p,f,0,q.a.createElement("wbr",{key:f}));var d=n("DeepLink");return q.a.createElement("span",{className:a?"opblock-summary-path__deprecated":"opblock-summary-path","data-path":i},q.a.createElement(d,{enabled:l,isShown:o,path:Object(H.d)(c()(e="".concat(s,"/")).call(e,u)),text:p}))}}]),n}(M.PureComponent),bt=n(13),Et=n.n(bt),xt=function(e){var t,n=e.extensions,r=(0,e.getComponent)("OperationExtRow");return q.a.createElement("div",{className:"opblock-section"},q.a.createElement("div",{className:"opblock-section-header"},q.a.createElement("h4",null,"Extensions")),q.a.createElement("div",{className:"table-container"},q.a.createElement("table",null,q.a.createElement("thead",null,q.a.createElement("tr",null,q.a.createElement("td",{className:"col_header"},"Field"),q.a.createElement("td",{className:"col_header"},"Value"))),q.a.createElement("tbody",null,R()(t=n.entrySeq()).call(t,(function(e){var t,n=Et()(e,2),a=n[0],o=n[1];return q.a.createElement(r,{key:c()(t="".concat(a,"-")).call(t,o),xKey:a,xVal:o})}))))))},St=function(e){var t=e.xKey,n=e.xVal,r=n?n.toJS?n.toJS():n:null;return q.a.createElement("tr",null,q.a.createElement("td",null,t),q.a.createElement("td",null,h()(r)))},wt=n(22),jt=n.n(wt),Ot=n(43),Ct=n.n(Ot),_t=n(81),At=n(36),kt=n.n(At),It=n(89),Pt=n.n(It),Nt=n(408),Tt=n.n(Nt),Rt=n(123),Mt=function(e){var t=e.value,n=e.fileName,r=e.className,a=e.downloadable,o=e.getConfigs,i=e.canCopy,s=e.language,c=Pt()(o)?o():null,u=!1!==kt()(c,"syntaxHighlight")&&kt()(c,"syntaxHighlight.activated",!0),p=Object(M.useRef)(null);Object(M.useEffect)((function(){var e,t=l()(e=ze()(p.current.childNodes)).call(e,(function(e){return!!e.nodeType&&e.classList.contains("microlight")}));return jt()(t).call(t,(function(e){return e.addEventListener("mousewheel",f,{passive:!1})})),function(){jt()(t).call(t,(function(e){return e.removeEventListener("mousewheel",f)}))}}),[t,r,s]);var f=function(e){var t=e.target,n=e.deltaY,r=t.scrollHeight,a=t.offsetHeight,o=t.scrollTop;r>a&&(0===o&&n<0||a+o>=r&&n>0)&&e.preventDefault()};return q.a.createElement("div",{className:"highlight-code",ref:p},a?q.a.createElement("div",{className:"download-contents",onClick:function(){Tt()(t,n)}},"Download"):null,i&&q.a.createElement("div",{className:"copy-to-clipboard"},q.a.createElement(Rt.CopyToClipboard,{text:t},q.a.createElement("button",null))),u?q.a.createElement(_t.a,{language:s,className:Ct()(r,"microlight"),style:Object(_t.b)(kt()(c,"syntaxHighlight.theme","agate"))},t):q.a.createElement("pre",{className:Ct()(r,"microlight")},t))};Mt.defaultProps={fileName:"response.txt"};var qt=Mt;var Dt=function(e){ye()(n,e);var t=Ee()(n);function n(){var e,r;E()(this,n);for(var a=arguments.length,o=new Array(a),i=0;i<a;i++)o[i]=arguments[i];return r=t.call.apply(t,c()(e=[this]).call(e,o)),y()(ve()(r),"onChangeProducesWrapper",(function(e){return r.props.specActions.changeProducesValue([r.props.path,r.props.method],e)})),y()(ve()(r),"onResponseContentTypeChange",(function(e){var t=e.controlsAcceptHeader,n=e.value,a=r.props,o=a.oas3Actions,i=a.path,s=a.method;t&&o.setResponseContentType({value:n,path:i,method:s})})),r}return S()(n,[{key:"render",value:function(){var e,t,r=this,a=this.props,o=a.responses,i=a.tryItOutResponse,s=a.getComponent,u=a.getConfigs,l=a.specSelectors,p=a.fn,f=a.producesValue,d=a.displayRequestDuration,h=a.specPath,m=a.path,v=a.method,g=a.oas3Selectors,y=a.oas3Actions,b=Object(H.f)(o),E=s("contentType"),x=s("liveResponse"),S=s("response"),w=this.props.produces&&this.props.produces.size?this.props.produces:n.defaultProps.produces,j=l.isOAS3()?Object(H.k)(o):null,O=function(e){var t=arguments.length>1&&void 0!==arguments[1]?arguments[1]:"_";return e.replace(/[^\w-]/g,t)}(c()(e="".concat(v)).call(e,m,"_responses")),C="".concat(O,"_select");return q.a.createElement("div",{className:"responses-wrapper"},q.a.createElement("div",{className:"opblock-section-header"},q.a.createElement("h4",null,"Responses"),l.isOAS3()?null:q.a.createElement("label",{htmlFor:C},q.a.createElement("span",null,"Response content type"),q.a.createElement(E,{value:f,ariaControls:O,ariaLabel:"Response content type",className:"execute-content-type",contentTypes:w,controlId:C,onChange:this.onChangeProducesWrapper}))),q.a.createElement("div",{className:"responses-inner"},i?q.a.createElement("div",null,q.a.createElement(x,{response:i,getComponent:s,getConfigs:u,specSelectors:l,path:this.props.path,method:this.props.method,displayRequestDuration:d}),q.a.createElement("h4",null,"Responses")):null,q.a.createElement("table",{"aria-live":"polite",className:"responses-table",id:O,role:"region"},q.a.createElement("thead",null,q.a.createElement("tr",{className:"responses-header"},q.a.createElement("td",{className:"col_header response-col_status"},"Code"),q.a.createElement("td",{className:"col_header response-col_description"},"Description"),l.isOAS3()?q.a.createElement("td",{className:"col col_header response-col_links"},"Links"):null)),q.a.createElement("tbody",null,R()(t=o.entrySeq()).call(t,(function(e){var t=Et()(e,2),n=t[0],a=t[1],o=i&&i.get("status")==n?"response_current":"";return q.a.createElement(S,{key:n,path:m,method:v,specPath:h.push(n),isDefault:b===n,fn:p,className:o,code:n,response:a,specSelectors:l,controlsAcceptHeader:a===j,onContentTypeChange:r.onResponseContentTypeChange,contentType:f,getConfigs:u,activeExamplesKey:g.activeExamplesMember(m,v,"responses",n),oas3Actions:y,getComponent:s})})).toArray()))))}}]),n}(q.a.Component);y()(Dt,"defaultProps",{tryItOutResponse:null,produces:Object(B.fromJS)(["application/json"]),displayRequestDuration:!1});var Bt=n(24),Lt=n.n(Bt),Ut=n(409),Vt=n.n(Ut),zt=n(92),Ft=function(e){ye()(n,e);var t=Ee()(n);function n(e,r){var a;return E()(this,n),a=t.call(this,e,r),y()(ve()(a),"_onContentTypeChange",(function(e){var t=a.props,n=t.onContentTypeChange,r=t.controlsAcceptHeader;a.setState({responseContentType:e}),n({value:e,controlsAcceptHeader:r})})),y()(ve()(a),"getTargetExamplesKey",(function(){var e=a.props,t=e.response,n=e.contentType,r=e.activeExamplesKey,o=a.state.responseContentType||n,i=t.getIn(["content",o],Object(B.Map)({})).get("examples",null).keySeq().first();return r||i})),a.state={responseContentType:""},a}return S()(n,[{key:"render",value:function(){var e,t,n,r,a,o=this.props,i=o.path,s=o.method,u=o.code,l=o.response,p=o.className,f=o.specPath,d=o.fn,h=o.getComponent,m=o.getConfigs,v=o.specSelectors,g=o.contentType,y=o.controlsAcceptHeader,b=o.oas3Actions,E=d.inferSchema,x=v.isOAS3(),S=m().showExtensions,w=S?Object(H.m)(l):null,j=l.get("headers"),O=l.get("links"),C=h("ResponseExtension"),_=h("headers"),A=h("highlightCode"),k=h("modelExample"),I=h("Markdown",!0),P=h("operationLink"),N=h("contentType"),T=h("ExamplesSelect"),M=h("Example"),D=this.state.responseContentType||g,L=l.getIn(["content",D],Object(B.Map)({})),U=L.get("examples",null);if(x){var V=L.get("schema");n=V?E(V.toJS()):null,r=V?Object(B.List)(["content",this.state.responseContentType,"schema"]):f}else n=l.get("schema"),r=l.has("schema")?f.push("schema"):f;var z,F=!1,J={includeReadOnly:!0};if(x){var W;if(z=null===(W=L.get("schema"))||void 0===W?void 0:W.toJS(),U){var $=this.getTargetExamplesKey(),Y=function(e){return e.get("value")};void 0===(a=Y(U.get($,Object(B.Map)({}))))&&(a=Y(Vt()(U).call(U).next().value)),F=!0}else void 0!==L.get("example")&&(a=L.get("example"),F=!0)}else{z=n,J=Lt()(Lt()({},J),{},{includeWriteOnly:!0});var K=l.getIn(["examples",D]);K&&(a=K,F=!0)}var G=function(e,t,n){if(null!=e){var r=null;return Object(zt.a)(e)&&(r="json"),q.a.createElement("div",null,q.a.createElement(t,{className:"example",getConfigs:n,language:r,value:Object(H.I)(e)}))}return null}(Object(H.o)(z,D,J,F?a:void 0),A,m);return q.a.createElement("tr",{className:"response "+(p||""),"data-code":u},q.a.createElement("td",{className:"response-col_status"},u),q.a.createElement("td",{className:"response-col_description"},q.a.createElement("div",{className:"response-col_description__inner"},q.a.createElement(I,{source:l.get("description")})),S&&w.size?R()(e=w.entrySeq()).call(e,(function(e){var t,n=Et()(e,2),r=n[0],a=n[1];return q.a.createElement(C,{key:c()(t="".concat(r,"-")).call(t,a),xKey:r,xVal:a})})):null,x&&l.get("content")?q.a.createElement("section",{className:"response-controls"},q.a.createElement("div",{className:Ct()("response-control-media-type",{"response-control-media-type--accept-controller":y})},q.a.createElement("small",{className:"response-control-media-type__title"},"Media type"),q.a.createElement(N,{value:this.state.responseContentType,contentTypes:l.get("content")?l.get("content").keySeq():Object(B.Seq)(),onChange:this._onContentTypeChange,ariaLabel:"Media Type"}),y?q.a.createElement("small",{className:"response-control-media-type__accept-message"},"Controls ",q.a.createElement("code",null,"Accept")," header."):null),U?q.a.createElement("div",{className:"response-control-examples"},q.a.createElement("small",{className:"response-control-examples__title"},"Examples"),q.a.createElement(T,{examples:U,currentExampleKey:this.getTargetExamplesKey(),onSelect:function(e){return b.setActiveExamplesMember({name:e,pathMethod:[i,s],contextType:"responses",contextName:u})},showLabels:!1})):null):null,G||n?q.a.createElement(k,{specPath:r,getComponent:h,getConfigs:m,specSelectors:v,schema:Object(H.i)(n),example:G,includeReadOnly:!0}):null,x&&U?q.a.createElement(M,{example:U.get(this.getTargetExamplesKey(),Object(B.Map)({})),getComponent:h,getConfigs:m,omitValue:!0}):null,j?q.a.createElement(_,{headers:j,getComponent:h}):null),x?q.a.createElement("td",{className:"response-col_links"},O?R()(t=O.toSeq().entrySeq()).call(t,(function(e){var t=Et()(e,2),n=t[0],r=t[1];return q.a.createElement(P,{key:n,name:n,link:r,getComponent:h})})):q.a.createElement("i",null,"No links")):null)}}]),n}(q.a.Component);y()(Ft,"defaultProps",{response:Object(B.fromJS)({}),onContentTypeChange:function(){}});var Jt=function(e){var t=e.xKey,n=e.xVal;return q.a.createElement("div",{className:"response__extension"},t,": ",String(n))},Wt=n(410),Ht=n.n(Wt),$t=n(411),Yt=n.n($t),Kt=n(286),Gt=n.n(Kt),Zt=function(e){ye()(n,e);var t=Ee()(n);function n(){var e,r;E()(this,n);for(var a=arguments.length,o=new Array(a),i=0;i<a;i++)o[i]=arguments[i];return r=t.call.apply(t,c()(e=[this]).call(e,o)),y()(ve()(r),"state",{parsedContent:null}),y()(ve()(r),"updateParsedContent",(function(e){var t=r.props.content;if(e!==t)if(t&&t instanceof Blob){var n=new FileReader;n.onload=function(){r.setState({parsedContent:n.result})},n.readAsText(t)}else r.setState({parsedContent:t.toString()})})),r}return S()(n,[{key:"componentDidMount",value:function(){this.updateParsedContent(null)}},{key:"componentDidUpdate",value:function(e){this.updateParsedContent(e.content)}},{key:"render",value:function(){var e,t,n=this.props,r=n.content,a=n.contentType,o=n.url,i=n.headers,s=void 0===i?{}:i,c=n.getConfigs,u=n.getComponent,l=this.state.parsedContent,p=u("highlightCode"),f="response_"+(new Date).getTime();if(o=o||"",/^application\/octet-stream/i.test(a)||s["Content-Disposition"]&&/attachment/i.test(s["Content-Disposition"])||s["content-disposition"]&&/attachment/i.test(s["content-disposition"])||s["Content-Description"]&&/File Transfer/i.test(s["Content-Description"])||s["content-description"]&&/File Transfer/i.test(s["content-description"]))if("Blob"in window){var d=a||"text/html",m=r instanceof Blob?r:new Blob([r],{type:d}),v=ot.a.createObjectURL(m),g=[d,o.substr(Ht()(o).call(o,"/")+1),v].join(":"),y=s["content-disposition"]||s["Content-Disposition"];if(void 0!==y){var b=Object(H.h)(y);null!==b&&(g=b)}t=W.a.navigator&&W.a.navigator.msSaveOrOpenBlob?q.a.createElement("div",null,q.a.createElement("a",{href:v,onClick:function(){return W.a.navigator.msSaveOrOpenBlob(m,g)}},"Download file")):q.a.createElement("div",null,q.a.createElement("a",{href:v,download:g},"Download file"))}else t=q.a.createElement("pre",{className:"microlight"},"Download headers detected but your browser does not support downloading binary via XHR (Blob).");else if(/json/i.test(a)){var E=null;Object(zt.a)(r)&&(E="json");try{e=h()(JSON.parse(r),null,"  ")}catch(t){e="can't parse JSON.  Raw result:\n\n"+r}t=q.a.createElement(p,{language:E,downloadable:!0,fileName:"".concat(f,".json"),value:e,getConfigs:c,canCopy:!0})}else/xml/i.test(a)?(e=Yt()(r,{textNodesOnSameLine:!0,indentor:"  "}),t=q.a.createElement(p,{downloadable:!0,fileName:"".concat(f,".xml"),value:e,getConfigs:c,canCopy:!0})):t="text/html"===Gt()(a)||/text\/plain/.test(a)?q.a.createElement(p,{downloadable:!0,fileName:"".concat(f,".html"),value:r,getConfigs:c,canCopy:!0}):"text/csv"===Gt()(a)||/text\/csv/.test(a)?q.a.createElement(p,{downloadable:!0,fileName:"".concat(f,".csv"),value:r,getConfigs:c,canCopy:!0}):/^image\//i.test(a)?He()(a).call(a,"svg")?q.a.createElement("div",null," ",r," "):q.a.createElement("img",{src:ot.a.createObjectURL(r)}):/^audio\//i.test(a)?q.a.createElement("pre",{className:"microlight"},q.a.createElement("audio",{controls:!0},q.a.createElement("source",{src:o,type:a}))):"string"==typeof r?q.a.createElement(p,{downloadable:!0,fileName:"".concat(f,".txt"),value:r,getConfigs:c,canCopy:!0}):r.size>0?l?q.a.createElement("div",null,q.a.createElement("p",{className:"i"},"Unrecognized response type; displaying content as text."),q.a.createElement(p,{downloadable:!0,fileName:"".concat(f,".txt"),value:l,getConfigs:c,canCopy:!0})):q.a.createElement("p",{className:"i"},"Unrecognized response type; unable to display."):null;return t?q.a.createElement("div",null,q.a.createElement("h5",null,"Response body"),t):null}}]),n}(q.a.PureComponent),Xt=n(14),Qt=n.n(Xt),en=n(173),tn=n.n(en),nn=function(e){ye()(n,e);var t=Ee()(n);function n(e){var r;return E()(this,n),r=t.call(this,e),y()(ve()(r),"onChange",(function(e,t,n){var a=r.props;(0,a.specActions.changeParamByIdentity)(a.onChangeKey,e,t,n)})),y()(ve()(r),"onChangeConsumesWrapper",(function(e){var t=r.props;(0,t.specActions.changeConsumesValue)(t.onChangeKey,e)})),y()(ve()(r),"toggleTab",(function(e){return"parameters"===e?r.setState({parametersVisible:!0,callbackVisible:!1}):"callbacks"===e?r.setState({callbackVisible:!0,parametersVisible:!1}):void 0})),y()(ve()(r),"onChangeMediaType",(function(e){var t=e.value,n=e.pathMethod,a=r.props,o=a.specActions,i=a.oas3Selectors,s=a.oas3Actions,c=i.hasUserEditedBody.apply(i,Qt()(n)),u=i.shouldRetainRequestBodyValue.apply(i,Qt()(n));s.setRequestContentType({value:t,pathMethod:n}),s.initRequestBodyValidateError({pathMethod:n}),c||(u||s.setRequestBodyValue({value:void 0,pathMethod:n}),o.clearResponse.apply(o,Qt()(n)),o.clearRequest.apply(o,Qt()(n)),o.clearValidateParams(n))})),r.state={callbackVisible:!1,parametersVisible:!0},r}return S()(n,[{key:"render",value:function(){var e,t,n=this,r=this.props,a=r.onTryoutClick,o=r.parameters,i=r.allowTryItOut,s=r.tryItOutEnabled,u=r.specPath,l=r.fn,p=r.getComponent,f=r.getConfigs,d=r.specSelectors,h=r.specActions,m=r.pathMethod,v=r.oas3Actions,g=r.oas3Selectors,y=r.operation,b=p("parameterRow"),E=p("TryItOutButton"),x=p("contentType"),S=p("Callbacks",!0),w=p("RequestBody",!0),j=s&&i,O=d.isOAS3(),C=y.get("requestBody"),_=N()(e=tn()(N()(o).call(o,(function(e,t){var n,r=t.get("in");return null!==(n=e[r])&&void 0!==n||(e[r]=[]),e[r].push(t),e}),{}))).call(e,(function(e,t){return c()(e).call(e,t)}),[]);return q.a.createElement("div",{className:"opblock-section"},q.a.createElement("div",{className:"opblock-section-header"},O?q.a.createElement("div",{className:"tab-header"},q.a.createElement("div",{onClick:function(){return n.toggleTab("parameters")},className:"tab-item ".concat(this.state.parametersVisible&&"active")},q.a.createElement("h4",{className:"opblock-title"},q.a.createElement("span",null,"Parameters"))),y.get("callbacks")?q.a.createElement("div",{onClick:function(){return n.toggleTab("callbacks")},className:"tab-item ".concat(this.state.callbackVisible&&"active")},q.a.createElement("h4",{className:"opblock-title"},q.a.createElement("span",null,"Callbacks"))):null):q.a.createElement("div",{className:"tab-header"},q.a.createElement("h4",{className:"opblock-title"},"Parameters")),i?q.a.createElement(E,{isOAS3:d.isOAS3(),hasUserEditedBody:g.hasUserEditedBody.apply(g,Qt()(m)),enabled:s,onCancelClick:this.props.onCancelClick,onTryoutClick:a,onResetClick:function(){return v.setRequestBodyValue({value:void 0,pathMethod:m})}}):null),this.state.parametersVisible?q.a.createElement("div",{className:"parameters-container"},_.length?q.a.createElement("div",{className:"table-container"},q.a.createElement("table",{className:"parameters"},q.a.createElement("thead",null,q.a.createElement("tr",null,q.a.createElement("th",{className:"col_header parameters-col_name"},"Name"),q.a.createElement("th",{className:"col_header parameters-col_description"},"Description"))),q.a.createElement("tbody",null,R()(_).call(_,(function(e,t){var r;return q.a.createElement(b,{fn:l,specPath:u.push(t.toString()),getComponent:p,getConfigs:f,rawParam:e,param:d.parameterWithMetaByIdentity(m,e),key:c()(r="".concat(e.get("in"),".")).call(r,e.get("name")),onChange:n.onChange,onChangeConsumes:n.onChangeConsumesWrapper,specSelectors:d,specActions:h,oas3Actions:v,oas3Selectors:g,pathMethod:m,isExecute:j})}))))):q.a.createElement("div",{className:"opblock-description-wrapper"},q.a.createElement("p",null,"No parameters"))):null,this.state.callbackVisible?q.a.createElement("div",{className:"callbacks-container opblock-description-wrapper"},q.a.createElement(S,{callbacks:Object(B.Map)(y.get("callbacks")),specPath:A()(u).call(u,0,-1).push("callbacks")})):null,O&&C&&this.state.parametersVisible&&q.a.createElement("div",{className:"opblock-section opblock-section-request-body"},q.a.createElement("div",{className:"opblock-section-header"},q.a.createElement("h4",{className:"opblock-title parameter__name ".concat(C.get("required")&&"required")},"Request body"),q.a.createElement("label",null,q.a.createElement(x,{value:g.requestContentType.apply(g,Qt()(m)),contentTypes:C.get("content",Object(B.List)()).keySeq(),onChange:function(e){n.onChangeMediaType({value:e,pathMethod:m})},className:"body-param-content-type",ariaLabel:"Request content type"}))),q.a.createElement("div",{className:"opblock-description-wrapper"},q.a.createElement(w,{setRetainRequestBodyValueFlag:function(e){return v.setRetainRequestBodyValueFlag({value:e,pathMethod:m})},userHasEditedBody:g.hasUserEditedBody.apply(g,Qt()(m)),specPath:A()(u).call(u,0,-1).push("requestBody"),requestBody:C,requestBodyValue:g.requestBodyValue.apply(g,Qt()(m)),requestBodyInclusionSetting:g.requestBodyInclusionSetting.apply(g,Qt()(m)),requestBodyErrors:g.requestBodyErrors.apply(g,Qt()(m)),isExecute:j,getConfigs:f,activeExamplesKey:g.activeExamplesMember.apply(g,c()(t=Qt()(m)).call(t,["requestBody","requestBody"])),updateActiveExamplesKey:function(e){n.props.oas3Actions.setActiveExamplesMember({name:e,pathMethod:n.props.pathMethod,contextType:"requestBody",contextName:"requestBody"})},onChange:function(e,t){if(t){var n=g.requestBodyValue.apply(g,Qt()(m)),r=B.Map.isMap(n)?n:Object(B.Map)();return v.setRequestBodyValue({pathMethod:m,value:r.setIn(t,e)})}v.setRequestBodyValue({value:e,pathMethod:m})},onChangeIncludeEmpty:function(e,t){v.setRequestBodyInclusion({pathMethod:m,value:t,name:e})},contentType:g.requestContentType.apply(g,Qt()(m))}))))}}]),n}(M.Component);y()(nn,"defaultProps",{onTryoutClick:Function.prototype,onCancelClick:Function.prototype,tryItOutEnabled:!1,allowTryItOut:!0,onChangeKey:[],specPath:[]});var rn=function(e){var t=e.xKey,n=e.xVal;return q.a.createElement("div",{className:"parameter__extension"},t,": ",String(n))},an={onChange:function(){},isIncludedOptions:{}},on=function(e){ye()(n,e);var t=Ee()(n);function n(){var e,r;E()(this,n);for(var a=arguments.length,o=new Array(a),i=0;i<a;i++)o[i]=arguments[i];return r=t.call.apply(t,c()(e=[this]).call(e,o)),y()(ve()(r),"onCheckboxChange",(function(e){(0,r.props.onChange)(e.target.checked)})),r}return S()(n,[{key:"componentDidMount",value:function(){var e=this.props,t=e.isIncludedOptions,n=e.onChange,r=t.shouldDispatchInit,a=t.defaultValue;r&&n(a)}},{key:"render",value:function(){var e=this.props,t=e.isIncluded,n=e.isDisabled;return q.a.createElement("div",null,q.a.createElement("label",{className:Ct()("parameter__empty_value_toggle",{disabled:n})},q.a.createElement("input",{type:"checkbox",disabled:n,checked:!n&&t,onChange:this.onCheckboxChange}),"Send empty value"))}}]),n}(M.Component);y()(on,"defaultProps",an);var sn=n(125),cn=function(e){ye()(n,e);var t=Ee()(n);function n(e,r){var a;return E()(this,n),a=t.call(this,e,r),y()(ve()(a),"onChangeWrapper",(function(e){var t=arguments.length>1&&void 0!==arguments[1]&&arguments[1],n=a.props,r=n.onChange,o=n.rawParam;return r(o,""===e||e&&0===e.size?null:e,t)})),y()(ve()(a),"_onExampleSelect",(function(e){a.props.oas3Actions.setActiveExamplesMember({name:e,pathMethod:a.props.pathMethod,contextType:"parameters",contextName:a.getParamKey()})})),y()(ve()(a),"onChangeIncludeEmpty",(function(e){var t=a.props,n=t.specActions,r=t.param,o=t.pathMethod,i=r.get("name"),s=r.get("in");return n.updateEmptyParamInclusion(o,i,s,e)})),y()(ve()(a),"setDefaultValue",(function(){var e=a.props,t=e.specSelectors,n=e.pathMethod,r=e.rawParam,o=e.oas3Selectors,i=t.parameterWithMetaByIdentity(n,r)||Object(B.Map)(),s=Object(sn.a)(i,{isOAS3:t.isOAS3()}).schema,u=i.get("content",Object(B.Map)()).keySeq().first(),l=s?Object(H.o)(s.toJS(),u,{includeWriteOnly:!0}):null;if(i&&void 0===i.get("value")&&"body"!==i.get("in")){var p;if(t.isSwagger2())p=void 0!==i.get("x-example")?i.get("x-example"):void 0!==i.getIn(["schema","example"])?i.getIn(["schema","example"]):s&&s.getIn(["default"]);else if(t.isOAS3()){var f,d=o.activeExamplesMember.apply(o,c()(f=Qt()(n)).call(f,["parameters",a.getParamKey()]));p=void 0!==i.getIn(["examples",d,"value"])?i.getIn(["examples",d,"value"]):void 0!==i.getIn(["content",u,"example"])?i.getIn(["content",u,"example"]):void 0!==i.get("example")?i.get("example"):void 0!==(s&&s.get("example"))?s&&s.get("example"):void 0!==(s&&s.get("default"))?s&&s.get("default"):i.get("default")}void 0===p||B.List.isList(p)||(p=Object(H.I)(p)),void 0!==p?a.onChangeWrapper(p):s&&"object"===s.get("type")&&l&&!i.get("examples")&&a.onChangeWrapper(B.List.isList(l)?l:Object(H.I)(l))}})),a.setDefaultValue(),a}return S()(n,[{key:"UNSAFE_componentWillReceiveProps",value:function(e){var t,n=e.specSelectors,r=e.pathMethod,a=e.rawParam,o=n.isOAS3(),i=n.parameterWithMetaByIdentity(r,a)||new B.Map;if(i=i.isEmpty()?a:i,o){var s=Object(sn.a)(i,{isOAS3:o}).schema;t=s?s.get("enum"):void 0}else t=i?i.get("enum"):void 0;var c,u=i?i.get("value"):void 0;void 0!==u?c=u:a.get("required")&&t&&t.size&&(c=t.first()),void 0!==c&&c!==u&&this.onChangeWrapper(Object(H.w)(c)),this.setDefaultValue()}},{key:"getParamKey",value:function(){var e,t=this.props.param;return t?c()(e="".concat(t.get("name"),"-")).call(e,t.get("in")):null}},{key:"render",value:function(){var e,t,n,r,a=this.props,o=a.param,i=a.rawParam,s=a.getComponent,u=a.getConfigs,l=a.isExecute,p=a.fn,f=a.onChangeConsumes,d=a.specSelectors,h=a.pathMethod,m=a.specPath,v=a.oas3Selectors,g=d.isOAS3(),y=u(),b=y.showExtensions,E=y.showCommonExtensions;if(o||(o=i),!i)return null;var x,S,w,j,O=s("JsonSchemaForm"),C=s("ParamBody"),_=o.get("in"),A="body"!==_?null:q.a.createElement(C,{getComponent:s,getConfigs:u,fn:p,param:o,consumes:d.consumesOptionsFor(h),consumesValue:d.contentTypeValues(h).get("requestContentType"),onChange:this.onChangeWrapper,onChangeConsumes:f,isExecute:l,specSelectors:d,pathMethod:h}),k=s("modelExample"),I=s("Markdown",!0),P=s("ParameterExt"),N=s("ParameterIncludeEmpty"),T=s("ExamplesSelectValueRetainer"),M=s("Example"),D=Object(sn.a)(o,{isOAS3:g}).schema,L=d.parameterWithMetaByIdentity(h,i)||Object(B.Map)(),U=D?D.get("format"):null,V=D?D.get("type"):null,z=D?D.getIn(["items","type"]):null,F="formData"===_,J="FormData"in W.a,$=o.get("required"),Y=L?L.get("value"):"",K=E?Object(H.l)(D):null,G=b?Object(H.m)(o):null,Z=!1;return void 0!==o&&D&&(x=D.get("items")),void 0!==x?(S=x.get("enum"),w=x.get("default")):D&&(S=D.get("enum")),S&&S.size&&S.size>0&&(Z=!0),void 0!==o&&(D&&(w=D.get("default")),void 0===w&&(w=o.get("default")),void 0===(j=o.get("example"))&&(j=o.get("x-example"))),q.a.createElement("tr",{"data-param-name":o.get("name"),"data-param-in":o.get("in")},q.a.createElement("td",{className:"parameters-col_name"},q.a.createElement("div",{className:$?"parameter__name required":"parameter__name"},o.get("name"),$?q.a.createElement("span",null," *"):null),q.a.createElement("div",{className:"parameter__type"},V,z&&"[".concat(z,"]"),U&&q.a.createElement("span",{className:"prop-format"},"($",U,")")),q.a.createElement("div",{className:"parameter__deprecated"},g&&o.get("deprecated")?"deprecated":null),q.a.createElement("div",{className:"parameter__in"},"(",o.get("in"),")"),E&&K.size?R()(e=K.entrySeq()).call(e,(function(e){var t,n=Et()(e,2),r=n[0],a=n[1];return q.a.createElement(P,{key:c()(t="".concat(r,"-")).call(t,a),xKey:r,xVal:a})})):null,b&&G.size?R()(t=G.entrySeq()).call(t,(function(e){var t,n=Et()(e,2),r=n[0],a=n[1];return q.a.createElement(P,{key:c()(t="".concat(r,"-")).call(t,a),xKey:r,xVal:a})})):null),q.a.createElement("td",{className:"parameters-col_description"},o.get("description")?q.a.createElement(I,{source:o.get("description")}):null,!A&&l||!Z?null:q.a.createElement(I,{className:"parameter__enum",source:"<i>Available values</i> : "+R()(S).call(S,(function(e){return e})).toArray().join(", ")}),!A&&l||void 0===w?null:q.a.createElement(I,{className:"parameter__default",source:"<i>Default value</i> : "+w}),!A&&l||void 0===j?null:q.a.createElement(I,{source:"<i>Example</i> : "+j}),F&&!J&&q.a.createElement("div",null,"Error: your browser does not support FormData"),g&&o.get("examples")?q.a.createElement("section",{className:"parameter-controls"},q.a.createElement(T,{examples:o.get("examples"),onSelect:this._onExampleSelect,updateValue:this.onChangeWrapper,getComponent:s,defaultToFirstExample:!0,currentKey:v.activeExamplesMember.apply(v,c()(n=Qt()(h)).call(n,["parameters",this.getParamKey()])),currentUserInputValue:Y})):null,A?null:q.a.createElement(O,{fn:p,getComponent:s,value:Y,required:$,disabled:!l,description:o.get("name"),onChange:this.onChangeWrapper,errors:L.get("errors"),schema:D}),A&&D?q.a.createElement(k,{getComponent:s,specPath:m.push("schema"),getConfigs:u,isExecute:l,specSelectors:d,schema:D,example:A,includeWriteOnly:!0}):null,!A&&l&&o.get("allowEmptyValue")?q.a.createElement(N,{onChange:this.onChangeIncludeEmpty,isIncluded:d.parameterInclusionSettingFor(h,o.get("name"),o.get("in")),isDisabled:!Object(H.q)(Y)}):null,g&&o.get("examples")?q.a.createElement(M,{example:o.getIn(["examples",v.activeExamplesMember.apply(v,c()(r=Qt()(h)).call(r,["parameters",this.getParamKey()]))]),getComponent:s,getConfigs:u}):null))}}]),n}(M.Component),un=n(177),ln=n.n(un),pn=function(e){ye()(n,e);var t=Ee()(n);function n(){var e,r;E()(this,n);for(var a=arguments.length,o=new Array(a),i=0;i<a;i++)o[i]=arguments[i];return r=t.call.apply(t,c()(e=[this]).call(e,o)),y()(ve()(r),"handleValidateParameters",(function(){var e=r.props,t=e.specSelectors,n=e.specActions,a=e.path,o=e.method;return n.validateParams([a,o]),t.validateBeforeExecute([a,o])})),y()(ve()(r),"handleValidateRequestBody",(function(){var e=r.props,t=e.path,n=e.method,a=e.specSelectors,o=e.oas3Selectors,i=e.oas3Actions,s={missingBodyValue:!1,missingRequiredKeys:[]};i.clearRequestBodyValidateError({path:t,method:n});var c=a.getOAS3RequiredRequestBodyContentType([t,n]),u=o.requestBodyValue(t,n),l=o.validateBeforeExecute([t,n]),p=o.requestContentType(t,n);if(!l)return s.missingBodyValue=!0,i.setRequestBodyValidateError({path:t,method:n,validationErrors:s}),!1;if(!c)return!0;var f=o.validateShallowRequired({oas3RequiredRequestBodyContentType:c,oas3RequestContentType:p,oas3RequestBodyValue:u});return!f||f.length<1||(jt()(f).call(f,(function(e){s.missingRequiredKeys.push(e)})),i.setRequestBodyValidateError({path:t,method:n,validationErrors:s}),!1)})),y()(ve()(r),"handleValidationResultPass",(function(){var e=r.props,t=e.specActions,n=e.operation,a=e.path,o=e.method;r.props.onExecute&&r.props.onExecute(),t.execute({operation:n,path:a,method:o})})),y()(ve()(r),"handleValidationResultFail",(function(){var e=r.props,t=e.specActions,n=e.path,a=e.method;t.clearValidateParams([n,a]),ln()((function(){t.validateParams([n,a])}),40)})),y()(ve()(r),"handleValidationResult",(function(e){e?r.handleValidationResultPass():r.handleValidationResultFail()})),y()(ve()(r),"onClick",(function(){var e=r.handleValidateParameters(),t=r.handleValidateRequestBody(),n=e&&t;r.handleValidationResult(n)})),y()(ve()(r),"onChangeProducesWrapper",(function(e){return r.props.specActions.changeProducesValue([r.props.path,r.props.method],e)})),r}return S()(n,[{key:"render",value:function(){var e=this.props.disabled;return q.a.createElement("button",{className:"btn execute opblock-control__btn",onClick:this.onClick,disabled:e},"Execute")}}]),n}(M.Component),fn=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e,t=this.props,n=t.headers,r=t.getComponent,a=r("Property"),o=r("Markdown",!0);return n&&n.size?q.a.createElement("div",{className:"headers-wrapper"},q.a.createElement("h4",{className:"headers__title"},"Headers:"),q.a.createElement("table",{className:"headers"},q.a.createElement("thead",null,q.a.createElement("tr",{className:"header-row"},q.a.createElement("th",{className:"header-col"},"Name"),q.a.createElement("th",{className:"header-col"},"Description"),q.a.createElement("th",{className:"header-col"},"Type"))),q.a.createElement("tbody",null,R()(e=n.entrySeq()).call(e,(function(e){var t=Et()(e,2),n=t[0],r=t[1];if(!L.a.Map.isMap(r))return null;var i=r.get("description"),s=r.getIn(["schema"])?r.getIn(["schema","type"]):r.getIn(["type"]),c=r.getIn(["schema","example"]);return q.a.createElement("tr",{key:n},q.a.createElement("td",{className:"header-col"},n),q.a.createElement("td",{className:"header-col"},i?q.a.createElement(o,{source:i}):null),q.a.createElement("td",{className:"header-col"},s," ",c?q.a.createElement(a,{propKey:"Example",propVal:c,propClass:"header-example"}):null))})).toArray()))):null}}]),n}(q.a.Component),dn=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e=this.props,t=e.editorActions,n=e.errSelectors,r=e.layoutSelectors,a=e.layoutActions,o=(0,e.getComponent)("Collapse");if(t&&t.jumpToLine)var i=t.jumpToLine;var s=n.allErrors(),c=l()(s).call(s,(function(e){return"thrown"===e.get("type")||"error"===e.get("level")}));if(!c||c.count()<1)return null;var u=r.isShown(["errorPane"],!0),p=c.sortBy((function(e){return e.get("line")}));return q.a.createElement("pre",{className:"errors-wrapper"},q.a.createElement("hgroup",{className:"error"},q.a.createElement("h4",{className:"errors__title"},"Errors"),q.a.createElement("button",{className:"btn errors__clear-btn",onClick:function(){return a.show(["errorPane"],!u)}},u?"Hide":"Show")),q.a.createElement(o,{isOpened:u,animated:!0},q.a.createElement("div",{className:"errors"},R()(p).call(p,(function(e,t){var n=e.get("type");return"thrown"===n||"auth"===n?q.a.createElement(hn,{key:t,error:e.get("error")||e,jumpToLine:i}):"spec"===n?q.a.createElement(mn,{key:t,error:e,jumpToLine:i}):void 0})))))}}]),n}(q.a.Component),hn=function(e){var t=e.error,n=e.jumpToLine;if(!t)return null;var r=t.get("line");return q.a.createElement("div",{className:"error-wrapper"},t?q.a.createElement("div",null,q.a.createElement("h4",null,t.get("source")&&t.get("level")?vn(t.get("source"))+" "+t.get("level"):"",t.get("path")?q.a.createElement("small",null," at ",t.get("path")):null),q.a.createElement("span",{className:"message thrown"},t.get("message")),q.a.createElement("div",{className:"error-line"},r&&n?q.a.createElement("a",{onClick:j()(n).call(n,null,r)},"Jump to line ",r):null)):null)},mn=function(e){var t=e.error,n=e.jumpToLine,r=null;return t.get("path")?r=B.List.isList(t.get("path"))?q.a.createElement("small",null,"at ",t.get("path").join(".")):q.a.createElement("small",null,"at ",t.get("path")):t.get("line")&&!n&&(r=q.a.createElement("small",null,"on line ",t.get("line"))),q.a.createElement("div",{className:"error-wrapper"},t?q.a.createElement("div",null,q.a.createElement("h4",null,vn(t.get("source"))+" "+t.get("level")," ",r),q.a.createElement("span",{className:"message"},t.get("message")),q.a.createElement("div",{className:"error-line"},n?q.a.createElement("a",{onClick:j()(n).call(n,null,t.get("line"))},"Jump to line ",t.get("line")):null)):null)};function vn(e){var t;return R()(t=(e||"").split(" ")).call(t,(function(e){return e[0].toUpperCase()+A()(e).call(e,1)})).join(" ")}hn.defaultProps={jumpToLine:null};var gn=function(e){ye()(n,e);var t=Ee()(n);function n(){var e,r;E()(this,n);for(var a=arguments.length,o=new Array(a),i=0;i<a;i++)o[i]=arguments[i];return r=t.call.apply(t,c()(e=[this]).call(e,o)),y()(ve()(r),"onChangeWrapper",(function(e){return r.props.onChange(e.target.value)})),r}return S()(n,[{key:"componentDidMount",value:function(){this.props.contentTypes&&this.props.onChange(this.props.contentTypes.first())}},{key:"UNSAFE_componentWillReceiveProps",value:function(e){var t;e.contentTypes&&e.contentTypes.size&&(He()(t=e.contentTypes).call(t,e.value)||e.onChange(e.contentTypes.first()))}},{key:"render",value:function(){var e=this.props,t=e.ariaControls,n=e.ariaLabel,r=e.className,a=e.contentTypes,o=e.controlId,i=e.value;return a&&a.size?q.a.createElement("div",{className:"content-type-wrapper "+(r||"")},q.a.createElement("select",{"aria-controls":t,"aria-label":n,className:"content-type",id:o,onChange:this.onChangeWrapper,value:i||""},R()(a).call(a,(function(e){return q.a.createElement("option",{key:e,value:e},e)})).toArray())):null}}]),n}(q.a.Component);y()(gn,"defaultProps",{onChange:function(){},value:null,contentTypes:Object(B.fromJS)(["application/json"])});var yn=n(27),bn=n.n(yn),En=n(49),xn=n.n(En),Sn=n(90),wn=n.n(Sn),jn=["fullscreen","full"],On=["hide","keepContents","mobile","tablet","desktop","large"];function Cn(){for(var e,t=arguments.length,n=new Array(t),r=0;r<t;r++)n[r]=arguments[r];return wn()(e=l()(n).call(n,(function(e){return!!e})).join(" ")).call(e)}var _n=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e=this.props,t=e.fullscreen,n=e.full,r=xn()(e,jn);if(t)return q.a.createElement("section",r);var a="swagger-container"+(n?"-full":"");return q.a.createElement("section",bn()({},r,{className:Cn(r.className,a)}))}}]),n}(q.a.Component),An={mobile:"",tablet:"-tablet",desktop:"-desktop",large:"-hd"},kn=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e,t=this.props,n=t.hide,r=t.keepContents,a=(t.mobile,t.tablet,t.desktop,t.large,xn()(t,On));if(n&&!r)return q.a.createElement("span",null);var o=[];for(var i in An)if(Object.prototype.hasOwnProperty.call(An,i)){var s=An[i];if(i in this.props){var u=this.props[i];if(u<1){o.push("none"+s);continue}o.push("block"+s),o.push("col-"+u+s)}}n&&o.push("hidden");var l=Cn.apply(void 0,c()(e=[a.className]).call(e,o));return q.a.createElement("section",bn()({},a,{className:l}))}}]),n}(q.a.Component),In=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){return q.a.createElement("div",bn()({},this.props,{className:Cn(this.props.className,"wrapper")}))}}]),n}(q.a.Component),Pn=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){return q.a.createElement("button",bn()({},this.props,{className:Cn(this.props.className,"button")}))}}]),n}(q.a.Component);y()(Pn,"defaultProps",{className:""});var Nn=function(e){return q.a.createElement("textarea",e)},Tn=function(e){return q.a.createElement("input",e)},Rn=function(e){ye()(n,e);var t=Ee()(n);function n(e,r){var a,o;return E()(this,n),a=t.call(this,e,r),y()(ve()(a),"onChange",(function(e){var t,n,r=a.props,o=r.onChange,i=r.multiple,s=A()([]).call(e.target.options);i?t=R()(n=l()(s).call(s,(function(e){return e.selected}))).call(n,(function(e){return e.value})):t=e.target.value;a.setState({value:t}),o&&o(t)})),o=e.value?e.value:e.multiple?[""]:"",a.state={value:o},a}return S()(n,[{key:"UNSAFE_componentWillReceiveProps",value:function(e){e.value!==this.props.value&&this.setState({value:e.value})}},{key:"render",value:function(){var e,t,n=this.props,r=n.allowedValues,a=n.multiple,o=n.allowEmptyValue,i=n.disabled,s=(null===(e=this.state.value)||void 0===e||null===(t=e.toJS)||void 0===t?void 0:t.call(e))||this.state.value;return q.a.createElement("select",{className:this.props.className,multiple:a,value:s,onChange:this.onChange,disabled:i},o?q.a.createElement("option",{value:""},"--"):null,R()(r).call(r,(function(e,t){return q.a.createElement("option",{key:t,value:String(e)},String(e))})))}}]),n}(q.a.Component);y()(Rn,"defaultProps",{multiple:!1,allowEmptyValue:!0});var Mn=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){return q.a.createElement("a",bn()({},this.props,{rel:"noopener noreferrer",className:Cn(this.props.className,"link")}))}}]),n}(q.a.Component),qn=function(e){var t=e.children;return q.a.createElement("div",{className:"no-margin"}," ",t," ")},Dn=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"renderNotAnimated",value:function(){return this.props.isOpened?q.a.createElement(qn,null,this.props.children):q.a.createElement("noscript",null)}},{key:"render",value:function(){var e=this.props,t=e.animated,n=e.isOpened,r=e.children;return t?(r=n?r:null,q.a.createElement(qn,null,r)):this.renderNotAnimated()}}]),n}(q.a.Component);y()(Dn,"defaultProps",{isOpened:!1,animated:!1});var Bn=function(e){ye()(n,e);var t=Ee()(n);function n(){var e,r,a;E()(this,n);for(var o=arguments.length,i=new Array(o),s=0;s<o;s++)i[s]=arguments[s];return(a=t.call.apply(t,c()(e=[this]).call(e,i))).setTagShown=j()(r=a._setTagShown).call(r,ve()(a)),a}return S()(n,[{key:"_setTagShown",value:function(e,t){this.props.layoutActions.show(e,t)}},{key:"showOp",value:function(e,t){this.props.layoutActions.show(e,t)}},{key:"render",value:function(){var e=this.props,t=e.specSelectors,n=e.layoutSelectors,r=e.layoutActions,a=e.getComponent,o=t.taggedOperations(),i=a("Collapse");return q.a.createElement("div",null,q.a.createElement("h4",{className:"overview-title"},"Overview"),R()(o).call(o,(function(e,t){var a=e.get("operations"),o=["overview-tags",t],s=n.isShown(o,!0);return q.a.createElement("div",{key:"overview-"+t},q.a.createElement("h4",{onClick:function(){return r.show(o,!s)},className:"link overview-tag"}," ",s?"-":"+",t),q.a.createElement(i,{isOpened:s,animated:!0},R()(a).call(a,(function(e){var t=e.toObject(),a=t.path,o=t.method,i=t.id,s="operations",c=i,u=n.isShown([s,c]);return q.a.createElement(Ln,{key:i,path:a,method:o,id:a+"-"+o,shown:u,showOpId:c,showOpIdPrefix:s,href:"#operation-".concat(c),onClick:r.show})})).toArray()))})).toArray(),o.size<1&&q.a.createElement("h3",null," No operations defined in spec! "))}}]),n}(q.a.Component),Ln=function(e){ye()(n,e);var t=Ee()(n);function n(e){var r,a;return E()(this,n),(a=t.call(this,e)).onClick=j()(r=a._onClick).call(r,ve()(a)),a}return S()(n,[{key:"_onClick",value:function(){var e=this.props,t=e.showOpId,n=e.showOpIdPrefix;(0,e.onClick)([n,t],!e.shown)}},{key:"render",value:function(){var e=this.props,t=e.id,n=e.method,r=e.shown,a=e.href;return q.a.createElement(Mn,{href:a,onClick:this.onClick,className:"block opblock-link ".concat(r?"shown":"")},q.a.createElement("div",null,q.a.createElement("small",{className:"bold-label-".concat(n)},n.toUpperCase()),q.a.createElement("span",{className:"bold-label"},t)))}}]),n}(q.a.Component),Un=["value","defaultValue","initialValue"],Vn=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"componentDidMount",value:function(){this.props.initialValue&&(this.inputRef.value=this.props.initialValue)}},{key:"render",value:function(){var e=this,t=this.props,n=(t.value,t.defaultValue,t.initialValue,xn()(t,Un));return q.a.createElement("input",bn()({},n,{ref:function(t){return e.inputRef=t}}))}}]),n}(q.a.Component),zn=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e=this.props,t=e.host,n=e.basePath;return q.a.createElement("pre",{className:"base-url"},"[ Base URL: ",t,n," ]")}}]),n}(q.a.Component),Fn=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e=this.props,t=e.data,n=e.getComponent,r=e.selectedServer,a=e.url,o=t.get("name")||"the developer",i=ut(t.get("url"),a,{selectedServer:r}),s=t.get("email"),c=n("Link");return q.a.createElement("div",{className:"info__contact"},i&&q.a.createElement("div",null,q.a.createElement(c,{href:Object(H.F)(i),target:"_blank"},o," - Website")),s&&q.a.createElement(c,{href:Object(H.F)("mailto:".concat(s))},i?"Send email to ".concat(o):"Contact ".concat(o)))}}]),n}(q.a.Component),Jn=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e=this.props,t=e.license,n=e.getComponent,r=e.selectedServer,a=e.url,o=n("Link"),i=t.get("name")||"License",s=ut(t.get("url"),a,{selectedServer:r});return q.a.createElement("div",{className:"info__license"},s?q.a.createElement(o,{target:"_blank",href:Object(H.F)(s)},i):q.a.createElement("span",null,i))}}]),n}(q.a.Component),Wn=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e=this.props,t=e.url,n=(0,e.getComponent)("Link");return q.a.createElement(n,{target:"_blank",href:Object(H.F)(t)},q.a.createElement("span",{className:"url"}," ",t))}}]),n}(q.a.PureComponent),Hn=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e=this.props,t=e.info,n=e.url,r=e.host,a=e.basePath,o=e.getComponent,i=e.externalDocs,s=e.selectedServer,c=e.url,u=t.get("version"),l=t.get("description"),p=t.get("title"),f=ut(t.get("termsOfService"),c,{selectedServer:s}),d=t.get("contact"),h=t.get("license"),m=ut(i&&i.get("url"),c,{selectedServer:s}),v=i&&i.get("description"),g=o("Markdown",!0),y=o("Link"),b=o("VersionStamp"),E=o("InfoUrl"),x=o("InfoBasePath");return q.a.createElement("div",{className:"info"},q.a.createElement("hgroup",{className:"main"},q.a.createElement("h2",{className:"title"},p,u&&q.a.createElement(b,{version:u})),r||a?q.a.createElement(x,{host:r,basePath:a}):null,n&&q.a.createElement(E,{getComponent:o,url:n})),q.a.createElement("div",{className:"description"},q.a.createElement(g,{source:l})),f&&q.a.createElement("div",{className:"info__tos"},q.a.createElement(y,{target:"_blank",href:Object(H.F)(f)},"Terms of service")),d&&d.size?q.a.createElement(Fn,{getComponent:o,data:d,selectedServer:s,url:n}):null,h&&h.size?q.a.createElement(Jn,{getComponent:o,license:h,selectedServer:s,url:n}):null,m?q.a.createElement(y,{className:"info__extdocs",target:"_blank",href:Object(H.F)(m)},v||m):null)}}]),n}(q.a.Component),$n=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e=this.props,t=e.specSelectors,n=e.getComponent,r=e.oas3Selectors,a=t.info(),o=t.url(),i=t.basePath(),s=t.host(),c=t.externalDocs(),u=r.selectedServer(),l=n("info");return q.a.createElement("div",null,a&&a.count()?q.a.createElement(l,{info:a,url:o,host:s,basePath:i,externalDocs:c,getComponent:n,selectedServer:u}):null)}}]),n}(q.a.Component),Yn=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){return null}}]),n}(q.a.Component),Kn=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){return q.a.createElement("div",{className:"footer"})}}]),n}(q.a.Component),Gn=function(e){ye()(n,e);var t=Ee()(n);function n(){var e,r;E()(this,n);for(var a=arguments.length,o=new Array(a),i=0;i<a;i++)o[i]=arguments[i];return r=t.call.apply(t,c()(e=[this]).call(e,o)),y()(ve()(r),"onFilterChange",(function(e){var t=e.target.value;r.props.layoutActions.updateFilter(t)})),r}return S()(n,[{key:"render",value:function(){var e=this.props,t=e.specSelectors,n=e.layoutSelectors,r=(0,e.getComponent)("Col"),a="loading"===t.loadingStatus(),o="failed"===t.loadingStatus(),i=n.currentFilter(),s=["operation-filter-input"];return o&&s.push("failed"),a&&s.push("loading"),q.a.createElement("div",null,null===i||!1===i||"false"===i?null:q.a.createElement("div",{className:"filter-container"},q.a.createElement(r,{className:"filter wrapper",mobile:12},q.a.createElement("input",{className:s.join(" "),placeholder:"Filter by tag",type:"text",onChange:this.onFilterChange,value:!0===i||"true"===i?"":i,disabled:a}))))}}]),n}(q.a.Component),Zn=Function.prototype,Xn=function(e){ye()(n,e);var t=Ee()(n);function n(e,r){var a;return E()(this,n),a=t.call(this,e,r),y()(ve()(a),"updateValues",(function(e){var t=e.param,n=e.isExecute,r=e.consumesValue,o=void 0===r?"":r,i=/xml/i.test(o),s=/json/i.test(o),c=i?t.get("value_xml"):t.get("value");if(void 0!==c){var u=!c&&s?"{}":c;a.setState({value:u}),a.onChange(u,{isXml:i,isEditBox:n})}else i?a.onChange(a.sample("xml"),{isXml:i,isEditBox:n}):a.onChange(a.sample(),{isEditBox:n})})),y()(ve()(a),"sample",(function(e){var t=a.props,n=t.param,r=(0,t.fn.inferSchema)(n.toJS());return Object(H.o)(r,e,{includeWriteOnly:!0})})),y()(ve()(a),"onChange",(function(e,t){var n=t.isEditBox,r=t.isXml;a.setState({value:e,isEditBox:n}),a._onChange(e,r)})),y()(ve()(a),"_onChange",(function(e,t){(a.props.onChange||Zn)(e,t)})),y()(ve()(a),"handleOnChange",(function(e){var t=a.props.consumesValue,n=/xml/i.test(t),r=e.target.value;a.onChange(r,{isXml:n,isEditBox:a.state.isEditBox})})),y()(ve()(a),"toggleIsEditBox",(function(){return a.setState((function(e){return{isEditBox:!e.isEditBox}}))})),a.state={isEditBox:!1,value:""},a}return S()(n,[{key:"componentDidMount",value:function(){this.updateValues.call(this,this.props)}},{key:"UNSAFE_componentWillReceiveProps",value:function(e){this.updateValues.call(this,e)}},{key:"render",value:function(){var e=this.props,t=e.onChangeConsumes,r=e.param,a=e.isExecute,o=e.specSelectors,i=e.pathMethod,s=e.getConfigs,c=e.getComponent,u=c("Button"),l=c("TextArea"),p=c("highlightCode"),f=c("contentType"),d=(o?o.parameterWithMetaByIdentity(i,r):r).get("errors",Object(B.List)()),h=o.contentTypeValues(i).get("requestContentType"),m=this.props.consumes&&this.props.consumes.size?this.props.consumes:n.defaultProp.consumes,v=this.state,g=v.value,y=v.isEditBox,b=null;return Object(zt.a)(g)&&(b="json"),q.a.createElement("div",{className:"body-param","data-param-name":r.get("name"),"data-param-in":r.get("in")},y&&a?q.a.createElement(l,{className:"body-param__text"+(d.count()?" invalid":""),value:g,onChange:this.handleOnChange}):g&&q.a.createElement(p,{className:"body-param__example",language:b,getConfigs:s,value:g}),q.a.createElement("div",{className:"body-param-options"},a?q.a.createElement("div",{className:"body-param-edit"},q.a.createElement(u,{className:y?"btn cancel body-param__example-edit":"btn edit body-param__example-edit",onClick:this.toggleIsEditBox},y?"Cancel":"Edit")):null,q.a.createElement("label",{htmlFor:""},q.a.createElement("span",null,"Parameter content type"),q.a.createElement(f,{value:h,contentTypes:m,onChange:t,className:"body-param-content-type",ariaLabel:"Parameter content type"}))))}}]),n}(M.PureComponent);y()(Xn,"defaultProp",{consumes:Object(B.fromJS)(["application/json"]),param:Object(B.fromJS)({}),onChange:Zn,onChangeConsumes:Zn});var Qn=n(141),er=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e=this.props,t=e.request,n=e.getConfigs,r=Object(Qn.requestSnippetGenerator_curl_bash)(t),a=n(),o=kt()(a,"syntaxHighlight.activated")?q.a.createElement(_t.a,{language:"bash",className:"curl microlight",onWheel:this.preventYScrollingBeyondElement,style:Object(_t.b)(kt()(a,"syntaxHighlight.theme"))},r):q.a.createElement("textarea",{readOnly:!0,className:"curl",value:r});return q.a.createElement("div",{className:"curl-command"},q.a.createElement("h4",null,"Curl"),q.a.createElement("div",{className:"copy-to-clipboard"},q.a.createElement(Rt.CopyToClipboard,{text:r},q.a.createElement("button",null))),q.a.createElement("div",null,o))}}]),n}(q.a.Component),tr=function(e){ye()(n,e);var t=Ee()(n);function n(){var e,r;E()(this,n);for(var a=arguments.length,o=new Array(a),i=0;i<a;i++)o[i]=arguments[i];return r=t.call.apply(t,c()(e=[this]).call(e,o)),y()(ve()(r),"onChange",(function(e){r.setScheme(e.target.value)})),y()(ve()(r),"setScheme",(function(e){var t=r.props,n=t.path,a=t.method;t.specActions.setScheme(e,n,a)})),r}return S()(n,[{key:"UNSAFE_componentWillMount",value:function(){var e=this.props.schemes;this.setScheme(e.first())}},{key:"UNSAFE_componentWillReceiveProps",value:function(e){var t;this.props.currentScheme&&He()(t=e.schemes).call(t,this.props.currentScheme)||this.setScheme(e.schemes.first())}},{key:"render",value:function(){var e,t=this.props,n=t.schemes,r=t.currentScheme;return q.a.createElement("label",{htmlFor:"schemes"},q.a.createElement("span",{className:"schemes-title"},"Schemes"),q.a.createElement("select",{onChange:this.onChange,value:r},R()(e=n.valueSeq()).call(e,(function(e){return q.a.createElement("option",{value:e,key:e},e)})).toArray()))}}]),n}(q.a.Component),nr=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e=this.props,t=e.specActions,n=e.specSelectors,r=e.getComponent,a=n.operationScheme(),o=n.schemes(),i=r("schemes");return o&&o.size?q.a.createElement(i,{currentScheme:a,schemes:o,specActions:t}):null}}]),n}(q.a.Component),rr=function(e){ye()(n,e);var t=Ee()(n);function n(e,r){var a;E()(this,n),a=t.call(this,e,r),y()(ve()(a),"toggleCollapsed",(function(){a.props.onToggle&&a.props.onToggle(a.props.modelName,!a.state.expanded),a.setState({expanded:!a.state.expanded})})),y()(ve()(a),"onLoad",(function(e){if(e&&a.props.layoutSelectors){var t=a.props.layoutSelectors.getScrollToKey();L.a.is(t,a.props.specPath)&&a.toggleCollapsed(),a.props.layoutActions.readyToScroll(a.props.specPath,e.parentElement)}}));var o=a.props,i=o.expanded,s=o.collapsedContent;return a.state={expanded:i,collapsedContent:s||n.defaultProps.collapsedContent},a}return S()(n,[{key:"componentDidMount",value:function(){var e=this.props,t=e.hideSelfOnExpand,n=e.expanded,r=e.modelName;t&&n&&this.props.onToggle(r,n)}},{key:"UNSAFE_componentWillReceiveProps",value:function(e){this.props.expanded!==e.expanded&&this.setState({expanded:e.expanded})}},{key:"render",value:function(){var e=this.props,t=e.title,n=e.classes;return this.state.expanded&&this.props.hideSelfOnExpand?q.a.createElement("span",{className:n||""},this.props.children):q.a.createElement("span",{className:n||"",ref:this.onLoad},q.a.createElement("button",{"aria-expanded":this.state.expanded,className:"model-box-control",onClick:this.toggleCollapsed},t&&q.a.createElement("span",{className:"pointer"},t),q.a.createElement("span",{className:"model-toggle"+(this.state.expanded?"":" collapsed")}),!this.state.expanded&&q.a.createElement("span",null,this.state.collapsedContent)),this.state.expanded&&this.props.children)}}]),n}(M.Component);y()(rr,"defaultProps",{collapsedContent:"{...}",expanded:!1,title:null,onToggle:function(){},hideSelfOnExpand:!1,specPath:L.a.List([])});var ar=n(108),or=n.n(ar),ir=function(e){ye()(n,e);var t=Ee()(n);function n(e,r){var a;E()(this,n),a=t.call(this,e,r),y()(ve()(a),"activeTab",(function(e){var t=e.target.dataset.name;a.setState({activeTab:t})}));var o=a.props,i=o.getConfigs,s=o.isExecute,c=i().defaultModelRendering,u=c;return"example"!==c&&"model"!==c&&(u="example"),s&&(u="example"),a.state={activeTab:u},a}return S()(n,[{key:"UNSAFE_componentWillReceiveProps",value:function(e){e.isExecute&&!this.props.isExecute&&this.props.example&&this.setState({activeTab:"example"})}},{key:"render",value:function(){var e=this.props,t=e.getComponent,n=e.specSelectors,r=e.schema,a=e.example,o=e.isExecute,i=e.getConfigs,s=e.specPath,c=e.includeReadOnly,u=e.includeWriteOnly,l=i().defaultModelExpandDepth,p=t("ModelWrapper"),f=t("highlightCode"),d=or()(5).toString("base64"),h=or()(5).toString("base64"),m=or()(5).toString("base64"),v=or()(5).toString("base64"),g=n.isOAS3();return q.a.createElement("div",{className:"model-example"},q.a.createElement("ul",{className:"tab",role:"tablist"},q.a.createElement("li",{className:Ct()("tabitem",{active:"example"===this.state.activeTab}),role:"presentation"},q.a.createElement("button",{"aria-controls":h,"aria-selected":"example"===this.state.activeTab,className:"tablinks","data-name":"example",id:d,onClick:this.activeTab,role:"tab"},o?"Edit Value":"Example Value")),r&&q.a.createElement("li",{className:Ct()("tabitem",{active:"model"===this.state.activeTab}),role:"presentation"},q.a.createElement("button",{"aria-controls":v,"aria-selected":"model"===this.state.activeTab,className:Ct()("tablinks",{inactive:o}),"data-name":"model",id:m,onClick:this.activeTab,role:"tab"},g?"Schema":"Model"))),"example"===this.state.activeTab&&q.a.createElement("div",{"aria-hidden":"example"!==this.state.activeTab,"aria-labelledby":d,"data-name":"examplePanel",id:h,role:"tabpanel",tabIndex:"0"},a||q.a.createElement(f,{value:"(no example available)",getConfigs:i})),"model"===this.state.activeTab&&q.a.createElement("div",{"aria-hidden":"example"===this.state.activeTab,"aria-labelledby":m,"data-name":"modelPanel",id:v,role:"tabpanel",tabIndex:"0"},q.a.createElement(p,{schema:r,getComponent:t,getConfigs:i,specSelectors:n,expandDepth:l,specPath:s,includeReadOnly:c,includeWriteOnly:u})))}}]),n}(q.a.Component),sr=function(e){ye()(n,e);var t=Ee()(n);function n(){var e,r;E()(this,n);for(var a=arguments.length,o=new Array(a),i=0;i<a;i++)o[i]=arguments[i];return r=t.call.apply(t,c()(e=[this]).call(e,o)),y()(ve()(r),"onToggle",(function(e,t){r.props.layoutActions&&r.props.layoutActions.show(r.props.fullPath,t)})),r}return S()(n,[{key:"render",value:function(){var e,t=this.props,n=t.getComponent,r=t.getConfigs,a=n("Model");return this.props.layoutSelectors&&(e=this.props.layoutSelectors.isShown(this.props.fullPath)),q.a.createElement("div",{className:"model-box"},q.a.createElement(a,bn()({},this.props,{getConfigs:r,expanded:e,depth:1,onToggle:this.onToggle,expandDepth:this.props.expandDepth||0})))}}]),n}(M.Component),cr=n(179),ur=function(e){ye()(n,e);var t=Ee()(n);function n(){var e,r;E()(this,n);for(var a=arguments.length,o=new Array(a),i=0;i<a;i++)o[i]=arguments[i];return r=t.call.apply(t,c()(e=[this]).call(e,o)),y()(ve()(r),"getSchemaBasePath",(function(){return r.props.specSelectors.isOAS3()?["components","schemas"]:["definitions"]})),y()(ve()(r),"getCollapsedContent",(function(){return" "})),y()(ve()(r),"handleToggle",(function(e,t){var n,a;(r.props.layoutActions.show(c()(n=[]).call(n,Qt()(r.getSchemaBasePath()),[e]),t),t)&&r.props.specActions.requestResolvedSubtree(c()(a=[]).call(a,Qt()(r.getSchemaBasePath()),[e]))})),y()(ve()(r),"onLoadModels",(function(e){e&&r.props.layoutActions.readyToScroll(r.getSchemaBasePath(),e)})),y()(ve()(r),"onLoadModel",(function(e){if(e){var t,n=e.getAttribute("data-name");r.props.layoutActions.readyToScroll(c()(t=[]).call(t,Qt()(r.getSchemaBasePath()),[n]),e)}})),r}return S()(n,[{key:"render",value:function(){var e,t=this,n=this.props,r=n.specSelectors,a=n.getComponent,o=n.layoutSelectors,i=n.layoutActions,s=n.getConfigs,u=r.definitions(),l=s(),p=l.docExpansion,f=l.defaultModelsExpandDepth;if(!u.size||f<0)return null;var d=this.getSchemaBasePath(),h=o.isShown(d,f>0&&"none"!==p),m=r.isOAS3(),v=a("ModelWrapper"),g=a("Collapse"),y=a("ModelCollapse"),b=a("JumpToPath");return q.a.createElement("section",{className:h?"models is-open":"models",ref:this.onLoadModels},q.a.createElement("h4",null,q.a.createElement("button",{"aria-expanded":h,className:"models-control",onClick:function(){return i.show(d,!h)}},q.a.createElement("span",null,m?"Schemas":"Models"),q.a.createElement("svg",{width:"20",height:"20","aria-hidden":"true",focusable:"false"},q.a.createElement("use",{xlinkHref:h?"#large-arrow-up":"#large-arrow-down"})))),q.a.createElement(g,{isOpened:h},R()(e=u.entrySeq()).call(e,(function(e){var n,u=Et()(e,1)[0],l=c()(n=[]).call(n,Qt()(d),[u]),p=L.a.List(l),h=r.specResolvedSubtree(l),m=r.specJson().getIn(l),g=B.Map.isMap(h)?h:L.a.Map(),E=B.Map.isMap(m)?m:L.a.Map(),x=g.get("title")||E.get("title")||u,S=o.isShown(l,!1);S&&0===g.size&&E.size>0&&t.props.specActions.requestResolvedSubtree(l);var w=q.a.createElement(v,{name:u,expandDepth:f,schema:g||L.a.Map(),displayName:x,fullPath:l,specPath:p,getComponent:a,specSelectors:r,getConfigs:s,layoutSelectors:o,layoutActions:i,includeReadOnly:!0,includeWriteOnly:!0}),j=q.a.createElement("span",{className:"model-box"},q.a.createElement("span",{className:"model model-title"},x));return q.a.createElement("div",{id:"model-".concat(u),className:"model-container",key:"models-section-".concat(u),"data-name":u,ref:t.onLoadModel},q.a.createElement("span",{className:"models-jump-to-path"},q.a.createElement(b,{specPath:p})),q.a.createElement(y,{classes:"model-box",collapsedContent:t.getCollapsedContent(u),onToggle:t.handleToggle,title:j,displayName:x,modelName:u,specPath:p,layoutSelectors:o,layoutActions:i,hideSelfOnExpand:!0,expanded:f>0&&S},w))})).toArray()))}}]),n}(M.Component),lr=function(e){var t=e.value,n=(0,e.getComponent)("ModelCollapse"),r=q.a.createElement("span",null,"Array [ ",t.count()," ]");return q.a.createElement("span",{className:"prop-enum"},"Enum:",q.a.createElement("br",null),q.a.createElement(n,{collapsedContent:r},"[ ",t.join(", ")," ]"))},pr=["schema","name","displayName","isRef","getComponent","getConfigs","depth","onToggle","expanded","specPath"],fr=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e,t,n,r,a=this.props,o=a.schema,i=a.name,s=a.displayName,u=a.isRef,p=a.getComponent,f=a.getConfigs,d=a.depth,m=a.onToggle,v=a.expanded,g=a.specPath,y=xn()(a,pr),b=y.specSelectors,E=y.expandDepth,x=y.includeReadOnly,S=y.includeWriteOnly,w=b.isOAS3;if(!o)return null;var j=f().showExtensions,O=o.get("description"),C=o.get("properties"),_=o.get("additionalProperties"),k=o.get("title")||s||i,I=o.get("required"),P=l()(o).call(o,(function(e,t){var n;return-1!==Se()(n=["maxProperties","minProperties","nullable","example"]).call(n,t)})),N=o.get("deprecated"),T=p("JumpToPath",!0),M=p("Markdown",!0),D=p("Model"),L=p("ModelCollapse"),U=p("Property"),V=function(){return q.a.createElement("span",{className:"model-jump-to-path"},q.a.createElement(T,{specPath:g}))},z=q.a.createElement("span",null,q.a.createElement("span",null,"{"),"...",q.a.createElement("span",null,"}"),u?q.a.createElement(V,null):""),F=b.isOAS3()?o.get("anyOf"):null,J=b.isOAS3()?o.get("oneOf"):null,W=b.isOAS3()?o.get("not"):null,H=k&&q.a.createElement("span",{className:"model-title"},u&&o.get("$$ref")&&q.a.createElement("span",{className:"model-hint"},o.get("$$ref")),q.a.createElement("span",{className:"model-title__text"},k));return q.a.createElement("span",{className:"model"},q.a.createElement(L,{modelName:i,title:H,onToggle:m,expanded:!!v||d<=E,collapsedContent:z},q.a.createElement("span",{className:"brace-open object"},"{"),u?q.a.createElement(V,null):null,q.a.createElement("span",{className:"inner-object"},q.a.createElement("table",{className:"model"},q.a.createElement("tbody",null,O?q.a.createElement("tr",{className:"description"},q.a.createElement("td",null,"description:"),q.a.createElement("td",null,q.a.createElement(M,{source:O}))):null,N?q.a.createElement("tr",{className:"property"},q.a.createElement("td",null,"deprecated:"),q.a.createElement("td",null,"true")):null,C&&C.size?R()(e=l()(t=C.entrySeq()).call(t,(function(e){var t=Et()(e,2)[1];return(!t.get("readOnly")||x)&&(!t.get("writeOnly")||S)}))).call(e,(function(e){var t,n,r=Et()(e,2),a=r[0],o=r[1],s=w()&&o.get("deprecated"),u=B.List.isList(I)&&I.contains(a),l=["property-row"];return s&&l.push("deprecated"),u&&l.push("required"),q.a.createElement("tr",{key:a,className:l.join(" ")},q.a.createElement("td",null,a,u&&q.a.createElement("span",{className:"star"},"*")),q.a.createElement("td",null,q.a.createElement(D,bn()({key:c()(t=c()(n="object-".concat(i,"-")).call(n,a,"_")).call(t,o)},y,{required:u,getComponent:p,specPath:g.push("properties",a),getConfigs:f,schema:o,depth:d+1}))))})).toArray():null,j?q.a.createElement("tr",null,q.a.createElement("td",null," ")):null,j?R()(n=o.entrySeq()).call(n,(function(e){var t=Et()(e,2),n=t[0],r=t[1];if("x-"===A()(n).call(n,0,2)){var a=r?r.toJS?r.toJS():r:null;return q.a.createElement("tr",{key:n,className:"extension"},q.a.createElement("td",null,n),q.a.createElement("td",null,h()(a)))}})).toArray():null,_&&_.size?q.a.createElement("tr",null,q.a.createElement("td",null,"< * >:"),q.a.createElement("td",null,q.a.createElement(D,bn()({},y,{required:!1,getComponent:p,specPath:g.push("additionalProperties"),getConfigs:f,schema:_,depth:d+1})))):null,F?q.a.createElement("tr",null,q.a.createElement("td",null,"anyOf ->"),q.a.createElement("td",null,R()(F).call(F,(function(e,t){return q.a.createElement("div",{key:t},q.a.createElement(D,bn()({},y,{required:!1,getComponent:p,specPath:g.push("anyOf",t),getConfigs:f,schema:e,depth:d+1})))})))):null,J?q.a.createElement("tr",null,q.a.createElement("td",null,"oneOf ->"),q.a.createElement("td",null,R()(J).call(J,(function(e,t){return q.a.createElement("div",{key:t},q.a.createElement(D,bn()({},y,{required:!1,getComponent:p,specPath:g.push("oneOf",t),getConfigs:f,schema:e,depth:d+1})))})))):null,W?q.a.createElement("tr",null,q.a.createElement("td",null,"not ->"),q.a.createElement("td",null,q.a.createElement("div",null,q.a.createElement(D,bn()({},y,{required:!1,getComponent:p,specPath:g.push("not"),getConfigs:f,schema:W,depth:d+1}))))):null))),q.a.createElement("span",{className:"brace-close"},"}")),P.size?R()(r=P.entrySeq()).call(r,(function(e){var t,n=Et()(e,2),r=n[0],a=n[1];return q.a.createElement(U,{key:c()(t="".concat(r,"-")).call(t,a),propKey:r,propVal:a,propClass:"property"})})):null)}}]),n}(M.Component),dr=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e,t=this.props,n=t.getComponent,r=t.getConfigs,a=t.schema,o=t.depth,i=t.expandDepth,s=t.name,u=t.displayName,p=t.specPath,f=a.get("description"),d=a.get("items"),h=a.get("title")||u||s,m=l()(a).call(a,(function(e,t){var n;return-1===Se()(n=["type","items","description","$$ref"]).call(n,t)})),v=n("Markdown",!0),g=n("ModelCollapse"),y=n("Model"),b=n("Property"),E=h&&q.a.createElement("span",{className:"model-title"},q.a.createElement("span",{className:"model-title__text"},h));return q.a.createElement("span",{className:"model"},q.a.createElement(g,{title:E,expanded:o<=i,collapsedContent:"[...]"},"[",m.size?R()(e=m.entrySeq()).call(e,(function(e){var t,n=Et()(e,2),r=n[0],a=n[1];return q.a.createElement(b,{key:c()(t="".concat(r,"-")).call(t,a),propKey:r,propVal:a,propClass:"property"})})):null,f?q.a.createElement(v,{source:f}):m.size?q.a.createElement("div",{className:"markdown"}):null,q.a.createElement("span",null,q.a.createElement(y,bn()({},this.props,{getConfigs:r,specPath:p.push("items"),name:null,schema:d,required:!1,depth:o+1}))),"]"))}}]),n}(M.Component),hr="property primitive",mr=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e,t,n,r=this.props,a=r.schema,o=r.getComponent,i=r.getConfigs,s=r.name,u=r.displayName,p=r.depth,f=i().showExtensions;if(!a||!a.get)return q.a.createElement("div",null);var d=a.get("type"),h=a.get("format"),m=a.get("xml"),v=a.get("enum"),g=a.get("title")||u||s,y=a.get("description"),b=Object(H.m)(a),E=l()(a).call(a,(function(e,t){var n;return-1===Se()(n=["enum","type","format","description","$$ref"]).call(n,t)})).filterNot((function(e,t){return b.has(t)})),x=o("Markdown",!0),S=o("EnumModel"),w=o("Property");return q.a.createElement("span",{className:"model"},q.a.createElement("span",{className:"prop"},s&&q.a.createElement("span",{className:"".concat(1===p&&"model-title"," prop-name")},g),q.a.createElement("span",{className:"prop-type"},d),h&&q.a.createElement("span",{className:"prop-format"},"($",h,")"),E.size?R()(e=E.entrySeq()).call(e,(function(e){var t,n=Et()(e,2),r=n[0],a=n[1];return q.a.createElement(w,{key:c()(t="".concat(r,"-")).call(t,a),propKey:r,propVal:a,propClass:hr})})):null,f&&b.size?R()(t=b.entrySeq()).call(t,(function(e){var t,n=Et()(e,2),r=n[0],a=n[1];return q.a.createElement(w,{key:c()(t="".concat(r,"-")).call(t,a),propKey:r,propVal:a,propClass:hr})})):null,y?q.a.createElement(x,{source:y}):null,m&&m.size?q.a.createElement("span",null,q.a.createElement("br",null),q.a.createElement("span",{className:hr},"xml:"),R()(n=m.entrySeq()).call(n,(function(e){var t,n=Et()(e,2),r=n[0],a=n[1];return q.a.createElement("span",{key:c()(t="".concat(r,"-")).call(t,a),className:hr},q.a.createElement("br",null),"   ",r,": ",String(a))})).toArray()):null,v&&q.a.createElement(S,{value:v,getComponent:o})))}}]),n}(M.Component),vr=function(e){var t=e.propKey,n=e.propVal,r=e.propClass;return q.a.createElement("span",{className:r},q.a.createElement("br",null),t,": ",String(n))},gr=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e=this.props,t=e.onTryoutClick,n=e.onCancelClick,r=e.onResetClick,a=e.enabled,o=e.hasUserEditedBody,i=e.isOAS3&&o;return q.a.createElement("div",{className:i?"try-out btn-group":"try-out"},a?q.a.createElement("button",{className:"btn try-out__btn cancel",onClick:n},"Cancel"):q.a.createElement("button",{className:"btn try-out__btn",onClick:t},"Try it out "),i&&q.a.createElement("button",{className:"btn try-out__btn reset",onClick:r},"Reset"))}}]),n}(q.a.Component);y()(gr,"defaultProps",{onTryoutClick:Function.prototype,onCancelClick:Function.prototype,onResetClick:Function.prototype,enabled:!1,hasUserEditedBody:!1,isOAS3:!1});var yr=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e=this.props,t=e.bypass,n=e.isSwagger2,r=e.isOAS3,a=e.alsoShow;return t?q.a.createElement("div",null,this.props.children):n&&r?q.a.createElement("div",{className:"version-pragma"},a,q.a.createElement("div",{className:"version-pragma__message version-pragma__message--ambiguous"},q.a.createElement("div",null,q.a.createElement("h3",null,"Unable to render this definition"),q.a.createElement("p",null,q.a.createElement("code",null,"swagger")," and ",q.a.createElement("code",null,"openapi")," fields cannot be present in the same Swagger or OpenAPI definition. Please remove one of the fields."),q.a.createElement("p",null,"Supported version fields are ",q.a.createElement("code",null,"swagger: ",'"2.0"')," and those that match ",q.a.createElement("code",null,"openapi: 3.0.n")," (for example, ",q.a.createElement("code",null,"openapi: 3.0.0"),").")))):n||r?q.a.createElement("div",null,this.props.children):q.a.createElement("div",{className:"version-pragma"},a,q.a.createElement("div",{className:"version-pragma__message version-pragma__message--missing"},q.a.createElement("div",null,q.a.createElement("h3",null,"Unable to render this definition"),q.a.createElement("p",null,"The provided definition does not specify a valid version field."),q.a.createElement("p",null,"Please indicate a valid Swagger or OpenAPI version field. Supported version fields are ",q.a.createElement("code",null,"swagger: ",'"2.0"')," and those that match ",q.a.createElement("code",null,"openapi: 3.0.n")," (for example, ",q.a.createElement("code",null,"openapi: 3.0.0"),")."))))}}]),n}(q.a.PureComponent);y()(yr,"defaultProps",{alsoShow:null,children:null,bypass:!1});var br=function(e){var t=e.version;return q.a.createElement("small",null,q.a.createElement("pre",{className:"version"}," ",t," "))},Er=function(e){var t=e.enabled,n=e.path,r=e.text;return q.a.createElement("a",{className:"nostyle",onClick:t?function(e){return e.preventDefault()}:null,href:t?"#/".concat(n):null},q.a.createElement("span",null,r))},xr=function(){return q.a.createElement("div",null,q.a.createElement("svg",{xmlns:"http://www.w3.org/2000/svg",xmlnsXlink:"http://www.w3.org/1999/xlink",className:"svg-assets"},q.a.createElement("defs",null,q.a.createElement("symbol",{viewBox:"0 0 20 20",id:"unlocked"},q.a.createElement("path",{d:"M15.8 8H14V5.6C14 2.703 12.665 1 10 1 7.334 1 6 2.703 6 5.6V6h2v-.801C8 3.754 8.797 3 10 3c1.203 0 2 .754 2 2.199V8H4c-.553 0-1 .646-1 1.199V17c0 .549.428 1.139.951 1.307l1.197.387C5.672 18.861 6.55 19 7.1 19h5.8c.549 0 1.428-.139 1.951-.307l1.196-.387c.524-.167.953-.757.953-1.306V9.199C17 8.646 16.352 8 15.8 8z"})),q.a.createElement("symbol",{viewBox:"0 0 20 20",id:"locked"},q.a.createElement("path",{d:"M15.8 8H14V5.6C14 2.703 12.665 1 10 1 7.334 1 6 2.703 6 5.6V8H4c-.553 0-1 .646-1 1.199V17c0 .549.428 1.139.951 1.307l1.197.387C5.672 18.861 6.55 19 7.1 19h5.8c.549 0 1.428-.139 1.951-.307l1.196-.387c.524-.167.953-.757.953-1.306V9.199C17 8.646 16.352 8 15.8 8zM12 8H8V5.199C8 3.754 8.797 3 10 3c1.203 0 2 .754 2 2.199V8z"})),q.a.createElement("symbol",{viewBox:"0 0 20 20",id:"close"},q.a.createElement("path",{d:"M14.348 14.849c-.469.469-1.229.469-1.697 0L10 11.819l-2.651 3.029c-.469.469-1.229.469-1.697 0-.469-.469-.469-1.229 0-1.697l2.758-3.15-2.759-3.152c-.469-.469-.469-1.228 0-1.697.469-.469 1.228-.469 1.697 0L10 8.183l2.651-3.031c.469-.469 1.228-.469 1.697 0 .469.469.469 1.229 0 1.697l-2.758 3.152 2.758 3.15c.469.469.469 1.229 0 1.698z"})),q.a.createElement("symbol",{viewBox:"0 0 20 20",id:"large-arrow"},q.a.createElement("path",{d:"M13.25 10L6.109 2.58c-.268-.27-.268-.707 0-.979.268-.27.701-.27.969 0l7.83 7.908c.268.271.268.709 0 .979l-7.83 7.908c-.268.271-.701.27-.969 0-.268-.269-.268-.707 0-.979L13.25 10z"})),q.a.createElement("symbol",{viewBox:"0 0 20 20",id:"large-arrow-down"},q.a.createElement("path",{d:"M17.418 6.109c.272-.268.709-.268.979 0s.271.701 0 .969l-7.908 7.83c-.27.268-.707.268-.979 0l-7.908-7.83c-.27-.268-.27-.701 0-.969.271-.268.709-.268.979 0L10 13.25l7.418-7.141z"})),q.a.createElement("symbol",{viewBox:"0 0 20 20",id:"large-arrow-up"},q.a.createElement("path",{d:"M 17.418 14.908 C 17.69 15.176 18.127 15.176 18.397 14.908 C 18.667 14.64 18.668 14.207 18.397 13.939 L 10.489 6.109 C 10.219 5.841 9.782 5.841 9.51 6.109 L 1.602 13.939 C 1.332 14.207 1.332 14.64 1.602 14.908 C 1.873 15.176 2.311 15.176 2.581 14.908 L 10 7.767 L 17.418 14.908 Z"})),q.a.createElement("symbol",{viewBox:"0 0 24 24",id:"jump-to"},q.a.createElement("path",{d:"M19 7v4H5.83l3.58-3.59L8 6l-6 6 6 6 1.41-1.41L5.83 13H21V7z"})),q.a.createElement("symbol",{viewBox:"0 0 24 24",id:"expand"},q.a.createElement("path",{d:"M10 18h4v-2h-4v2zM3 6v2h18V6H3zm3 7h12v-2H6v2z"})))))},Sr=n(181),wr=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"render",value:function(){var e=this.props,t=e.errSelectors,n=e.specSelectors,r=e.getComponent,a=r("SvgAssets"),o=r("InfoContainer",!0),i=r("VersionPragmaFilter"),s=r("operations",!0),c=r("Models",!0),u=r("Row"),l=r("Col"),p=r("errors",!0),f=r("ServersContainer",!0),d=r("SchemesContainer",!0),h=r("AuthorizeBtnContainer",!0),m=r("FilterContainer",!0),v=n.isSwagger2(),g=n.isOAS3(),y=!n.specStr(),b=n.loadingStatus(),E=null;if("loading"===b&&(E=q.a.createElement("div",{className:"info"},q.a.createElement("div",{className:"loading-container"},q.a.createElement("div",{className:"loading"})))),"failed"===b&&(E=q.a.createElement("div",{className:"info"},q.a.createElement("div",{className:"loading-container"},q.a.createElement("h4",{className:"title"},"Failed to load API definition."),q.a.createElement(p,null)))),"failedConfig"===b){var x=t.lastError(),S=x?x.get("message"):"";E=q.a.createElement("div",{className:"info failed-config"},q.a.createElement("div",{className:"loading-container"},q.a.createElement("h4",{className:"title"},"Failed to load remote configuration."),q.a.createElement("p",null,S)))}if(!E&&y&&(E=q.a.createElement("h4",null,"No API definition provided.")),E)return q.a.createElement("div",{className:"swagger-ui"},q.a.createElement("div",{className:"loading-container"},E));var w=n.servers(),j=n.schemes(),O=w&&w.size,C=j&&j.size,_=!!n.securityDefinitions();return q.a.createElement("div",{className:"swagger-ui"},q.a.createElement(a,null),q.a.createElement(i,{isSwagger2:v,isOAS3:g,alsoShow:q.a.createElement(p,null)},q.a.createElement(p,null),q.a.createElement(u,{className:"information-container"},q.a.createElement(l,{mobile:12},q.a.createElement(o,null))),O||C||_?q.a.createElement("div",{className:"scheme-container"},q.a.createElement(l,{className:"schemes wrapper",mobile:12},O?q.a.createElement(f,null):null,C?q.a.createElement(d,null):null,_?q.a.createElement(h,null):null)):null,q.a.createElement(m,null),q.a.createElement(u,null,q.a.createElement(l,{mobile:12,desktop:12},q.a.createElement(s,null))),q.a.createElement(u,null,q.a.createElement(l,{mobile:12,desktop:12},q.a.createElement(c,null)))))}}]),n}(q.a.Component),jr=n(287),Or=n.n(jr),Cr={value:"",onChange:function(){},schema:{},keyName:"",required:!1,errors:Object(B.List)()},_r=function(e){ye()(n,e);var t=Ee()(n);function n(){return E()(this,n),t.apply(this,arguments)}return S()(n,[{key:"componentDidMount",value:function(){var e=this.props,t=e.dispatchInitialValue,n=e.value,r=e.onChange;t?r(n):!1===t&&r("")}},{key:"render",value:function(){var e,t=this.props,n=t.schema,r=t.errors,a=t.value,o=t.onChange,i=t.getComponent,s=t.fn,u=t.disabled,l=n&&n.get?n.get("format"):null,p=n&&n.get?n.get("type"):null,f=function(e){return i(e,!1,{failSilently:!0})},d=p?f(l?c()(e="JsonSchema_".concat(p,"_")).call(e,l):"JsonSchema_".concat(p)):i("JsonSchema_string");return d||(d=i("JsonSchema_string")),q.a.createElement(d,bn()({},this.props,{errors:r,fn:s,getComponent:i,value:a,onChange:o,schema:n,disabled:u}))}}]),n}(M.Component);y()(_r,"defaultProps",Cr);var Ar=function(e){ye()(n,e);var t=Ee()(n);function n(){var e,r;E()(this,n);for(var a=arguments.length,o=new Array(a),i=0;i<a;i++)o[i]=arguments[i];return r=t.call.apply(t,c()(e=[this]).call(e,o)),y()(ve()(r),"onChange",(function(e){var t=r.props.schema&&"file"===r.props.schema.get("type")?e.target.files[0]:e.target.value;r.props.onChange(t,r.props.keyName)})),y()(ve()(r),"onEnumChange",(function(e){return r.props.onChange(e)})),r}return S()(n,[{key:"render",value:function(){var e=this.props,t=e.getComponent,n=e.value,r=e.schema,a=e.errors,o=e.required,i=e.description,s=e.disabled,c=r&&r.get?r.get("enum"):null,u=r&&r.get?r.get("format"):null,l=r&&r.get?r.get("type"):null,p=r&&r.get?r.get("in"):null;if(n||(n=""),a=a.toJS?a.toJS():[],c){var f=t("Select");return q.a.createElement(f,{className:a.length?"invalid":"",title:a.length?a:"",allowedValues:c,value:n,allowEmptyValue:!o,disabled:s,onChange:this.onEnumChange})}var d=s||p&&"formData"===p&&!("FormData"in window),h=t("Input");return l&&"file"===l?q.a.createElement(h,{type:"file",className:a.length?"invalid":"",title:a.length?a:"",onChange:this.onChange,disabled:d}):q.a.createElement(Or.a,{type:u&&"password"===u?"password":"text",className:a.length?"invalid":"",title:a.length?a:"",value:n,minLength:0,debounceTimeout:350,placeholder:i,onChange:this.onChange,disabled:d})}}]),n}(M.Component);y()(Ar,"defaultProps",Cr);var kr=function(e){ye()(n,e);var t=Ee()(n);function n(e,r){var a;return E()(this,n),a=t.call(this,e,r),y()(ve()(a),"onChange",(function(){a.props.onChange(a.state.value)})),y()(ve()(a),"onItemChange",(function(e,t){a.setState((function(n){return{value:n.value.set(t,e)}}),a.onChange)})),y()(ve()(a),"removeItem",(function(e){a.setState((function(t){return{value:t.value.delete(e)}}),a.onChange)})),y()(ve()(a),"addItem",(function(){var e=Mr(a.state.value);a.setState((function(){return{value:e.push(Object(H.o)(a.state.schema.get("items"),!1,{includeWriteOnly:!0}))}}),a.onChange)})),y()(ve()(a),"onEnumChange",(function(e){a.setState((function(){return{value:e}}),a.onChange)})),a.state={value:Mr(e.value),schema:e.schema},a}return S()(n,[{key:"UNSAFE_componentWillReceiveProps",value:function(e){var t=Mr(e.value);t!==this.state.value&&this.setState({value:t}),e.schema!==this.state.schema&&this.setState({schema:e.schema})}},{key:"render",value:function(){var e,t=this,n=this.props,r=n.getComponent,a=n.required,o=n.schema,i=n.errors,s=n.fn,u=n.disabled;i=i.toJS?i.toJS():I()(i)?i:[];var p,f,d=l()(i).call(i,(function(e){return"string"==typeof e})),h=R()(e=l()(i).call(i,(function(e){return void 0!==e.needRemove}))).call(e,(function(e){return e.error})),m=this.state.value,v=!!(m&&m.count&&m.count()>0),g=o.getIn(["items","enum"]),y=o.getIn(["items","type"]),b=o.getIn(["items","format"]),E=o.get("items"),x=!1,S="file"===y||"string"===y&&"binary"===b;y&&b?p=r(c()(f="JsonSchema_".concat(y,"_")).call(f,b)):"boolean"!==y&&"array"!==y&&"object"!==y||(p=r("JsonSchema_".concat(y)));if(p||S||(x=!0),g){var w=r("Select");return q.a.createElement(w,{className:i.length?"invalid":"",title:i.length?i:"",multiple:!0,value:m,disabled:u,allowedValues:g,allowEmptyValue:!a,onChange:this.onEnumChange})}var j=r("Button");return q.a.createElement("div",{className:"json-schema-array"},v?R()(m).call(m,(function(e,n){var a,o=Object(B.fromJS)(Qt()(R()(a=l()(i).call(i,(function(e){return e.index===n}))).call(a,(function(e){return e.error}))));return q.a.createElement("div",{key:n,className:"json-schema-form-item"},S?q.a.createElement(Pr,{value:e,onChange:function(e){return t.onItemChange(e,n)},disabled:u,errors:o,getComponent:r}):x?q.a.createElement(Ir,{value:e,onChange:function(e){return t.onItemChange(e,n)},disabled:u,errors:o}):q.a.createElement(p,bn()({},t.props,{value:e,onChange:function(e){return t.onItemChange(e,n)},disabled:u,errors:o,schema:E,getComponent:r,fn:s})),u?null:q.a.createElement(j,{className:"btn btn-sm json-schema-form-item-remove ".concat(h.length?"invalid":null),title:h.length?h:"",onClick:function(){return t.removeItem(n)}}," - "))})):null,u?null:q.a.createElement(j,{className:"btn btn-sm json-schema-form-item-add ".concat(d.length?"invalid":null),title:d.length?d:"",onClick:this.addItem},"Add ",y?"".concat(y," "):"","item"))}}]),n}(M.PureComponent);y()(kr,"defaultProps",Cr);var Ir=function(e){ye()(n,e);var t=Ee()(n);function n(){var e,r;E()(this,n);for(var a=arguments.length,o=new Array(a),i=0;i<a;i++)o[i]=arguments[i];return r=t.call.apply(t,c()(e=[this]).call(e,o)),y()(ve()(r),"onChange",(function(e){var t=e.target.value;r.props.onChange(t,r.props.keyName)})),r}return S()(n,[{key:"render",value:function(){var e=this.props,t=e.value,n=e.errors,r=e.description,a=e.disabled;return t||(t=""),n=n.toJS?n.toJS():[],q.a.createElement(Or.a,{type:"text",className:n.length?"invalid":"",title:n.length?n:"",value:t,minLength:0,debounceTimeout:350,placeholder:r,onChange:this.onChange,disabled:a})}}]),n}(M.Component);y()(Ir,"defaultProps",Cr);var Pr=function(e){ye()(n,e);var t=Ee()(n);function n(){var e,r;E()(this,n);for(var a=arguments.length,o=new Array(a),i=0;i<a;i++)o[i]=arguments[i];return r=t.call.apply(t,c()(e=[this]).call(e,o)),y()(ve()(r),"onFileChange",(function(e){var t=e.target.files[0];r.props.onChange(t,r.props.keyName)})),r}return S()(n,[{key:"render",value:function(){var e=this.props,t=e.getComponent,n=e.errors,r=e.disabled,a=t("Input"),o=r||!("FormData"in window);return q.a.createElement(a,{type:"file",className:n.length?"invalid":"",title:n.length?n:"",onChange:this.onFileChange,disabled:o})}}]),n}(M.Component);y()(Pr,"defaultProps",Cr);var Nr=function(e){ye()(n,e);var t=Ee()(n);function n(){var e,r;E()(this,n);for(var a=arguments.length,o=new Array(a),i=0;i<a;i++)o[i]=arguments[i];return r=t.call.apply(t,c()(e=[this]).call(e,o)),y()(ve()(r),"onEnumChange",(function(e){return r.props.onChange(e)})),r}return S()(n,[{key:"render",value:function(){var e=this.props,t=e.getComponent,n=e.value,r=e.errors,a=e.schema,o=e.required,i=e.disabled;r=r.toJS?r.toJS():[];var s=a&&a.get?a.get("enum"):null,c=!s||!o,u=!s&&Object(B.fromJS)(["true","false"]),l=t("Select");return q.a.createElement(l,{className:r.length?"invalid":"",title:r.length?r:"",value:String(n),disabled:i,allowedValues:s||u,allowEmptyValue:c,onChange:this.onEnumChange})}}]),n}(M.Component);y()(Nr,"defaultProps",Cr);var Tr=function(e){return R()(e).call(e,(function(e){var t,n=void 0!==e.propKey?e.propKey:e.index,r="string"==typeof e?e:"string"==typeof e.error?e.error:null;if(!n&&r)return r;for(var a=e.error,o="/".concat(e.propKey);"object"===i()(a);){var s=void 0!==a.propKey?a.propKey:a.index;if(void 0===s)break;if(o+="/".concat(s),!a.error)break;a=a.error}return c()(t="".concat(o,": ")).call(t,a)}))},Rr=function(e){ye()(n,e);var t=Ee()(n);function n(){var e;return E()(this,n),e=t.call(this),y()(ve()(e),"onChange",(function(t){e.props.onChange(t)})),y()(ve()(e),"handleOnChange",(function(t){var n=t.target.value;e.onChange(n)})),e}return S()(n,[{key:"render",value:function(){var e=this.props,t=e.getComponent,n=e.value,r=e.errors,a=e.disabled,o=t("TextArea");return r=r.toJS?r.toJS():I()(r)?r:[],q.a.createElement("div",null,q.a.createElement(o,{className:Ct()({invalid:r.length}),title:r.length?Tr(r).join(", "):"",value:Object(H.I)(n),disabled:a,onChange:this.handleOnChange}))}}]),n}(M.PureComponent);function Mr(e){return B.List.isList(e)?e:I()(e)?Object(B.fromJS)(e):Object(B.List)()}y()(Rr,"defaultProps",Cr);var qr=function(){var e={components:{App:Oe,authorizationPopup:Ce,authorizeBtn:_e,AuthorizeBtnContainer:Ae,authorizeOperationBtn:ke,auths:Ie,AuthItem:Pe,authError:Ne,oauth2:Ke,apiKeyAuth:Te,basicAuth:Re,clear:Ge,liveResponse:Qe,InitializedInput:Vn,info:Hn,InfoContainer:$n,JumpToPath:Yn,onlineValidatorBadge:et.a,operations:rt,operation:pt,OperationSummary:ht,OperationSummaryMethod:mt,OperationSummaryPath:yt,highlightCode:qt,responses:Dt,response:Ft,ResponseExtension:Jt,responseBody:Zt,parameters:nn,parameterRow:cn,execute:pn,headers:fn,errors:dn,contentType:gn,overview:Bn,footer:Kn,FilterContainer:Gn,ParamBody:Xn,curl:er,schemes:tr,SchemesContainer:nr,modelExample:ir,ModelWrapper:sr,ModelCollapse:rr,Model:cr.a,Models:ur,EnumModel:lr,ObjectModel:fr,ArrayModel:dr,PrimitiveModel:mr,Property:vr,TryItOutButton:gr,Markdown:Sr.a,BaseLayout:wr,VersionPragmaFilter:yr,VersionStamp:br,OperationExt:xt,OperationExtRow:St,ParameterExt:rn,ParameterIncludeEmpty:on,OperationTag:lt,OperationContainer:je,DeepLink:Er,InfoUrl:Wn,InfoBasePath:zn,SvgAssets:xr,Example:Me,ExamplesSelect:Be,ExamplesSelectValueRetainer:Ue}},t={components:r},n={components:a};return[pe.default,ue.default,ie.default,re.default,ne.default,ee.default,te.default,ae.default,e,t,se.default,n,ce.default,le.default,fe.default,de.default,he.default,oe.default]},Dr=n(255);function Br(){return[qr,Dr.default]}var Lr=n(276),Ur=!0,Vr="gb71446c",zr="4.1.0",Fr="Mon, 08 Nov 2021 13:01:36 GMT";function Jr(e){var t;W.a.versions=W.a.versions||{},W.a.versions.swaggerUi={version:zr,gitRevision:Vr,gitDirty:Ur,buildTimestamp:Fr};var n={dom_id:null,domNode:null,spec:{},url:"",urls:null,layout:"BaseLayout",docExpansion:"list",maxDisplayedTags:null,filter:null,validatorUrl:"https://validator.swagger.io/validator",oauth2RedirectUrl:c()(t="".concat(window.location.protocol,"//")).call(t,window.location.host,"/oauth2-redirect.html"),persistAuthorization:!1,configs:{},custom:{},displayOperationId:!1,displayRequestDuration:!1,deepLinking:!1,tryItOutEnabled:!1,requestInterceptor:function(e){return e},responseInterceptor:function(e){return e},showMutatedRequest:!0,defaultModelRendering:"example",defaultModelExpandDepth:1,defaultModelsExpandDepth:1,showExtensions:!1,showCommonExtensions:!1,withCredentials:void 0,requestSnippetsEnabled:!1,requestSnippets:{generators:{curl_bash:{title:"cURL (bash)",syntax:"bash"},curl_powershell:{title:"cURL (PowerShell)",syntax:"powershell"},curl_cmd:{title:"cURL (CMD)",syntax:"bash"}},defaultExpanded:!0,languages:null},supportedSubmitMethods:["get","put","post","delete","options","head","patch","trace"],presets:[Br],plugins:[],pluginsOptions:{pluginLoadType:"legacy"},initialState:{},fn:{},components:{},syntaxHighlight:{activated:!0,theme:"agate"}},r=Object(H.C)(),a=e.domNode;delete e.domNode;var o=v()({},n,e,r),s={system:{configs:o.configs},plugins:o.presets,pluginsOptions:o.pluginsOptions,state:v()({layout:{layout:o.layout,filter:l()(o)},spec:{spec:"",url:o.url},requestSnippets:o.requestSnippets},o.initialState)};if(o.initialState)for(var u in o.initialState)Object.prototype.hasOwnProperty.call(o.initialState,u)&&void 0===o.initialState[u]&&delete s.state[u];var p=new Y(s);p.register([o.plugins,function(){return{fn:o.fn,components:o.components,state:o.state}}]);var d=p.getSystem(),m=function(e){var t=d.specSelectors.getLocalConfig?d.specSelectors.getLocalConfig():{},n=v()({},t,o,e||{},r);if(a&&(n.domNode=a),p.setConfigs(n),d.configsActions.loaded(),null!==e&&(!r.url&&"object"===i()(n.spec)&&f()(n.spec).length?(d.specActions.updateUrl(""),d.specActions.updateLoadingStatus("success"),d.specActions.updateSpec(h()(n.spec))):d.specActions.download&&n.url&&!n.urls&&(d.specActions.updateUrl(n.url),d.specActions.download(n.url))),n.domNode)d.render(n.domNode,"App");else if(n.dom_id){var s=document.querySelector(n.dom_id);d.render(s,"App")}else null===n.dom_id||null===n.domNode||console.error("Skipped rendering: no `dom_id` or `domNode` was specified");return d},g=r.config||o.configUrl;return g&&d.specActions&&d.specActions.getConfigByUrl?(d.specActions.getConfigByUrl({url:g,loadRemoteConfig:!0,requestInterceptor:o.requestInterceptor,responseInterceptor:o.responseInterceptor},m),d):m()}Jr.presets={apis:Br},Jr.plugins=Lr.default;t.default=Jr}]).default;
 //# sourceMappingURL=swagger-ui-es-bundle-core.js.map
\ No newline at end of file
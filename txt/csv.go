@@ -16,11 +16,8 @@ package txt
 
 import (
 	"encoding/csv"
-	"encoding/json"
 	"io"
 	"os"
-	"strconv"
-	"strings"
 )
 
 func getTextFromCsv(path string) (string, error) {
@@ -32,12 +29,13 @@ func getTextFromCsv(path string) (string, error) {
 
 	r := csv.NewReader(file)
 	r.LazyQuotes = true
+	r.FieldsPerRecord = -1
 	headers, err := r.Read()
 	if err != nil {
 		return "", err
 	}
 
-	result := []string{}
+	rows := [][]string{}
 	for {
 		record, err := r.Read()
 		if err == io.EOF {
@@ -47,24 +45,8 @@ func getTextFromCsv(path string) (string, error) {
 			return "", err
 		}
 
-		m := make(map[string]interface{})
-		for i, header := range headers {
-			header = strings.TrimSpace(header)
-			value := strings.TrimSpace(record[i])
-
-			if intValue, convErr := strconv.Atoi(value); convErr == nil {
-				m[header] = intValue
-			} else {
-				m[header] = value
-			}
-		}
-
-		jsonData, err := json.Marshal(m)
-		if err != nil {
-			return "", err
-		}
-		result = append(result, string(jsonData))
+		rows = append(rows, record)
 	}
 
-	return strings.Join(result, "\n"), nil
+	return getMarkdownTable(headers, rows), nil
 }
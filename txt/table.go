@@ -0,0 +1,62 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txt
+
+import (
+	"strings"
+)
+
+func isEmptyRow(row []string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func getMarkdownTableRow(cells []string) string {
+	texts := []string{}
+	for _, cell := range cells {
+		cell = strings.Join(strings.Fields(cell), " ")
+		texts = append(texts, strings.ReplaceAll(cell, "|", "\\|"))
+	}
+	return "| " + strings.Join(texts, " | ") + " |"
+}
+
+// getMarkdownTable writes the rows as a markdown table, one line per row, so
+// that the table split provider can cut it between rows. Rows are padded or cut
+// to the header width and empty rows are skipped.
+func getMarkdownTable(header []string, rows [][]string) string {
+	lines := []string{getMarkdownTableRow(header)}
+
+	separators := []string{}
+	for range header {
+		separators = append(separators, "---")
+	}
+	lines = append(lines, "| "+strings.Join(separators, " | ")+" |")
+
+	for _, row := range rows {
+		if isEmptyRow(row) {
+			continue
+		}
+
+		cells := make([]string, len(header))
+		copy(cells, row)
+		lines = append(lines, getMarkdownTableRow(cells))
+	}
+
+	return strings.Join(lines, "\n")
+}
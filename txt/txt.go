@@ -24,7 +24,19 @@ import (
 )
 
 func GetSupportedFileTypes() []string {
-	return []string{".txt", ".md", ".yaml", ".csv", ".pdf", ".docx", ".xlsx", ".pptx"}
+	return append([]string{".txt", ".md", ".yaml", ".csv", ".pdf", ".docx", ".xlsx", ".pptx"}, codeFileTypes...)
+}
+
+// codeFileTypes are the source files read as plain text and split by symbols.
+var codeFileTypes = []string{".go", ".py", ".ts", ".tsx", ".js", ".jsx", ".java"}
+
+func isCodeFileType(ext string) bool {
+	for _, fileType := range codeFileTypes {
+		if ext == fileType {
+			return true
+		}
+	}
+	return false
 }
 
 func GetParsedTextFromUrl(url string, ext string, lang string) (string, error) {
@@ -46,7 +58,7 @@ func GetParsedTextFromUrl(url string, ext string, lang string) (string, error) {
 	}
 
 	var res string
-	if ext == "" || ext == ".txt" || ext == ".md" || ext == ".yaml" {
+	if ext == "" || ext == ".txt" || ext == ".md" || ext == ".yaml" || isCodeFileType(ext) {
 		res, err = getTextFromPlain(path)
 	} else if ext == ".csv" {
 		res, err = getTextFromCsv(path)
@@ -15,26 +15,47 @@
 package txt
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/tealeg/xlsx"
 )
 
+// getTextFromXlsx writes every sheet as a markdown table under a heading with
+// the sheet name, the first non-empty row being the header.
 func getTextFromXlsx(path string) (string, error) {
 	xlFile, err := xlsx.OpenFile(path)
 	if err != nil {
 		return "", err
 	}
-	var result string
+
+	sheets := []string{}
 	for _, sheet := range xlFile.Sheets {
+		rows := [][]string{}
 		for _, row := range sheet.Rows {
+			if row == nil {
+				continue
+			}
+
+			texts := []string{}
 			for _, cell := range row.Cells {
 				text, err := cell.FormattedValue()
 				if err != nil {
 					return "", err
 				}
-				result += text + " "
+				texts = append(texts, text)
 			}
-			result += "\n"
+			rows = append(rows, texts)
 		}
+
+		for len(rows) > 0 && isEmptyRow(rows[0]) {
+			rows = rows[1:]
+		}
+		if len(rows) == 0 {
+			continue
+		}
+
+		sheets = append(sheets, fmt.Sprintf("## %s\n\n%s", sheet.Name, getMarkdownTable(rows[0], rows[1:])))
 	}
-	return result, nil
+	return strings.Join(sheets, "\n\n"), nil
 }
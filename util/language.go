@@ -0,0 +1,112 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"strings"
+	"unicode"
+)
+
+var languageStopWords = map[string][]string{
+	"en": {"the", "and", "is", "are", "of", "to", "in", "what", "how", "with", "for", "this", "that"},
+	"de": {"der", "die", "das", "und", "ist", "nicht", "ein", "eine", "wie", "mit", "für", "ich", "was"},
+	"fr": {"le", "la", "les", "et", "est", "des", "une", "un", "pour", "dans", "que", "qui", "comment"},
+	"es": {"el", "la", "los", "las", "y", "es", "de", "que", "una", "para", "con", "por", "cómo"},
+	"id": {"yang", "dan", "di", "ini", "itu", "dengan", "untuk", "adalah", "tidak", "apa", "bagaimana", "dari"},
+}
+
+// DetectLanguage guesses the language of the text and returns one of the
+// language codes used by the i18n locales: "zh", "ja", "ko", "ru", "en", "de",
+// "fr", "es" or "id". Scripts decide the CJK and Cyrillic languages, stop words
+// decide between the Latin ones. An empty string is returned for text without
+// any letters.
+func DetectLanguage(text string) string {
+	hanCount, kanaCount, hangulCount, cyrillicCount, letterCount := 0, 0, 0, 0, 0
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r):
+			kanaCount++
+		case unicode.Is(unicode.Han, r):
+			hanCount++
+		case unicode.Is(unicode.Hangul, r):
+			hangulCount++
+		case unicode.Is(unicode.Cyrillic, r):
+			cyrillicCount++
+		case unicode.IsLetter(r):
+			letterCount++
+		}
+	}
+
+	if kanaCount > 0 && kanaCount*5 >= hanCount {
+		return "ja"
+	}
+	if hangulCount > 0 && hangulCount >= hanCount {
+		return "ko"
+	}
+	if hanCount > 0 && hanCount*2 >= letterCount {
+		return "zh"
+	}
+	if cyrillicCount > 0 && cyrillicCount >= letterCount {
+		return "ru"
+	}
+	if letterCount == 0 && hanCount == 0 {
+		return ""
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	wordMap := map[string]bool{}
+	for _, word := range words {
+		wordMap[word] = true
+	}
+
+	res := "en"
+	bestCount := 0
+	for _, language := range []string{"en", "de", "fr", "es", "id"} {
+		count := 0
+		for _, stopWord := range languageStopWords[language] {
+			if wordMap[stopWord] {
+				count++
+			}
+		}
+		if count > bestCount {
+			res = language
+			bestCount = count
+		}
+	}
+	return res
+}
+
+var languageNames = map[string]string{
+	"en": "English",
+	"zh": "Chinese",
+	"ja": "Japanese",
+	"ko": "Korean",
+	"ru": "Russian",
+	"de": "German",
+	"fr": "French",
+	"es": "Spanish",
+	"id": "Indonesian",
+}
+
+// GetLanguageName returns the English name of a language code returned by
+// DetectLanguage, to be written in the model prompts.
+func GetLanguageName(language string) string {
+	if name, ok := languageNames[language]; ok {
+		return name
+	}
+	return language
+}
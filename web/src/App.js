@@ -66,6 +66,8 @@ import RecordListPage from "./RecordListPage";
 import RecordEditPage from "./RecordEditPage";
 import WorkflowListPage from "./WorkflowListPage";
 import WorkflowEditPage from "./WorkflowEditPage";
+import EvaluationListPage from "./EvaluationListPage";
+import EvaluationEditPage from "./EvaluationEditPage";
 import TaskListPage from "./TaskListPage";
 import TaskEditPage from "./TaskEditPage";
 import FormListPage from "./FormListPage";
@@ -210,6 +212,8 @@ class App extends Component {
       this.setState({selectedMenuKey: "/providers"});
     } else if (uri.includes("/vectors")) {
       this.setState({selectedMenuKey: "/vectors"});
+    } else if (uri.includes("/evaluations")) {
+      this.setState({selectedMenuKey: "/evaluations"});
     } else if (uri.includes("/chats")) {
       this.setState({selectedMenuKey: "/chats"});
     } else if (uri.includes("/messages")) {
@@ -683,6 +687,7 @@ class App extends Component {
         Setting.getItem(<Link to="/files">{i18next.t("general:Files")}</Link>, "/files"),
         Setting.getItem(<Link to="/providers">{i18next.t("general:Providers")}</Link>, "/providers"),
         Setting.getItem(<Link to="/vectors">{i18next.t("general:Vectors")}</Link>, "/vectors"),
+        Setting.getItem(<Link to="/evaluations">{i18next.t("general:Evaluations")}</Link>, "/evaluations"),
       ]));
 
       res.push(Setting.getItem(<Link style={{color: textColor}} to="/nodes">{i18next.t("general:Cloud Resources")}</Link>, "/cloud", <CloudTwoTone twoToneColor={twoToneColor} />, [
@@ -814,6 +819,8 @@ class App extends Component {
         <Route exact path="/files/:fileName" render={(props) => this.renderSigninIfNotSignedIn(<FileEditPage account={this.state.account} {...props} />)} />
         <Route exact path="/vectors" render={(props) => this.renderSigninIfNotSignedIn(<VectorListPage account={this.state.account} {...props} />)} />
         <Route exact path="/vectors/:vectorName" render={(props) => this.renderSigninIfNotSignedIn(<VectorEditPage account={this.state.account} {...props} />)} />
+        <Route exact path="/evaluations" render={(props) => this.renderSigninIfNotSignedIn(<EvaluationListPage account={this.state.account} {...props} />)} />
+        <Route exact path="/evaluations/:evaluationName" render={(props) => this.renderSigninIfNotSignedIn(<EvaluationEditPage account={this.state.account} {...props} />)} />
         <Route exact path="/chats" render={(props) => this.renderSigninIfNotSignedIn(<ChatListPage account={this.state.account} {...props} />)} />
         <Route exact path="/chats/:chatName" render={(props) => this.renderSigninIfNotSignedIn(<ChatEditPage account={this.state.account} {...props} />)} />
         <Route exact path="/messages" render={(props) => this.renderSigninIfNotSignedIn(<MessageListPage account={this.state.account} {...props} />)} />
@@ -259,17 +259,29 @@ class ChatPage extends BaseListPage {
     if (this.state.messages && this.state.messages.length > 0) {
       const lastMessage = this.state.messages[this.state.messages.length - 1];
       if (lastMessage.author === "AI" && this.state.messageLoading) {
-        MessageBackend.closeMessageEventSource(lastMessage.owner, lastMessage.name);
-
-        MessageBackend.updateMessage(lastMessage.owner, lastMessage.name, lastMessage)
+        // The server saves the answer so far with its token counts when the
+        // answer is stopped, the message is only saved here when it is not
+        // being answered any more.
+        MessageBackend.stopMessageAnswer(lastMessage.owner, lastMessage.name)
           .then((res) => {
-            if (res.status === "ok") {
+            MessageBackend.closeMessageEventSource(lastMessage.owner, lastMessage.name);
+            if (res.status === "ok" && res.data) {
               this.setState({
                 messageLoading: false,
               });
-            } else {
-              Setting.showMessage("error", `${i18next.t("general:Failed to save")}: ${res.msg}`);
+              return;
             }
+
+            return MessageBackend.updateMessage(lastMessage.owner, lastMessage.name, lastMessage)
+              .then((res) => {
+                if (res.status === "ok") {
+                  this.setState({
+                    messageLoading: false,
+                  });
+                } else {
+                  Setting.showMessage("error", `${i18next.t("general:Failed to save")}: ${res.msg}`);
+                }
+              });
           })
           .catch(error => {
             Setting.showMessage("error", `${i18next.t("general:Failed to connect to server")}: ${error}`);
@@ -340,6 +352,7 @@ class ChatPage extends BaseListPage {
           if (lastMessage.author === "AI" && lastMessage.replyTo !== "" && lastMessage.text === "") {
             let text = "";
             let reasonText = "";
+            let sources = [];
             this.setState({
               messageLoading: true,
             });
@@ -366,6 +379,7 @@ class ChatPage extends BaseListPage {
                 return;
               }
               lastMessage2.text = parsedResult.finalAnswer;
+              lastMessage2.sources = sources;
 
               // Preserve reasoning if it exists
               if (res.data[res.data.length - 1].reasonText) {
@@ -446,6 +460,7 @@ class ChatPage extends BaseListPage {
               }
               lastMessage2.text = parsedResult.finalAnswer;
               lastMessage2.suggestions = parsedResult.suggestionArray;
+              lastMessage2.sources = sources;
 
               res.data[res.data.length - 1] = lastMessage2;
               res.data.map((message, index) => {
@@ -469,6 +484,8 @@ class ChatPage extends BaseListPage {
                   this.chatBox.current.toggleMessageReadState(lastMessage2);
                 }
               }
+            }, (data) => {
+              sources = data;
             });
           } else {
             this.setState({
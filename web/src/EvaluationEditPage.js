@@ -0,0 +1,361 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import React from "react";
+import {Button, Card, Col, Input, InputNumber, Row, Select, Table} from "antd";
+import * as EvaluationBackend from "./backend/EvaluationBackend";
+import * as StoreBackend from "./backend/StoreBackend";
+import * as ProviderBackend from "./backend/ProviderBackend";
+import * as Setting from "./Setting";
+import i18next from "i18next";
+import {getEvaluationStateTag, getMetricText} from "./EvaluationListPage";
+
+const {Option} = Select;
+const {TextArea} = Input;
+
+class EvaluationEditPage extends React.Component {
+  constructor(props) {
+    super(props);
+    this.state = {
+      classes: props,
+      evaluationName: props.match.params.evaluationName,
+      evaluation: null,
+      evaluations: [],
+      stores: [],
+      modelProviders: [],
+      embeddingProviders: [],
+      comparison: null,
+    };
+  }
+
+  UNSAFE_componentWillMount() {
+    this.getEvaluation();
+    this.getEvaluations();
+    this.getStores();
+    this.getProviders();
+  }
+
+  componentWillUnmount() {
+    clearTimeout(this.timer);
+  }
+
+  getEvaluation() {
+    EvaluationBackend.getEvaluation(this.props.account.name, this.state.evaluationName)
+      .then((res) => {
+        if (res.status === "ok") {
+          this.setState({
+            evaluation: res.data,
+          });
+
+          // Follow a running evaluation until it ends.
+          clearTimeout(this.timer);
+          if (res.data?.state === "Running") {
+            this.timer = setTimeout(() => this.getEvaluation(), 3000);
+          }
+        } else {
+          Setting.showMessage("error", `${i18next.t("general:Failed to get")}: ${res.msg}`);
+        }
+      });
+  }
+
+  getEvaluations() {
+    EvaluationBackend.getEvaluations(this.props.account.name)
+      .then((res) => {
+        if (res.status === "ok") {
+          this.setState({
+            evaluations: res.data,
+          });
+        } else {
+          Setting.showMessage("error", `${i18next.t("general:Failed to get")}: ${res.msg}`);
+        }
+      });
+  }
+
+  getStores() {
+    StoreBackend.getStores(this.props.account.name)
+      .then((res) => {
+        if (res.status === "ok") {
+          this.setState({
+            stores: res.data,
+          });
+        } else {
+          Setting.showMessage("error", `${i18next.t("general:Failed to get")}: ${res.msg}`);
+        }
+      });
+  }
+
+  getProviders() {
+    ProviderBackend.getProviders(this.props.account.name)
+      .then((res) => {
+        if (res.status === "ok") {
+          this.setState({
+            modelProviders: res.data.filter(provider => provider.category === "Model"),
+            embeddingProviders: res.data.filter(provider => provider.category === "Embedding"),
+          });
+        } else {
+          Setting.showMessage("error", `${i18next.t("general:Failed to get")}: ${res.msg}`);
+        }
+      });
+  }
+
+  parseEvaluationField(key, value) {
+    if (["knowledgeCount"].includes(key)) {
+      value = Setting.myParseInt(value);
+    }
+    return value;
+  }
+
+  updateEvaluationField(key, value) {
+    value = this.parseEvaluationField(key, value);
+
+    const evaluation = this.state.evaluation;
+    evaluation[key] = value;
+    this.setState({
+      evaluation: evaluation,
+    });
+  }
+
+  runEvaluation() {
+    EvaluationBackend.runEvaluation(this.state.evaluation.owner, this.state.evaluationName)
+      .then((res) => {
+        if (res.status === "ok") {
+          Setting.showMessage("success", i18next.t("evaluation:Evaluation started"));
+          this.getEvaluation();
+        } else {
+          Setting.showMessage("error", `${i18next.t("evaluation:Failed to run")}: ${res.msg}`);
+        }
+      })
+      .catch(error => {
+        Setting.showMessage("error", `${i18next.t("evaluation:Failed to run")}: ${error}`);
+      });
+  }
+
+  compareEvaluation(baseName) {
+    if (!baseName) {
+      this.setState({comparison: null});
+      return;
+    }
+
+    EvaluationBackend.compareEvaluations(this.state.evaluation.owner, baseName, this.state.evaluationName)
+      .then((res) => {
+        if (res.status === "ok") {
+          this.setState({
+            comparison: res.data,
+          });
+        } else {
+          Setting.showMessage("error", `${i18next.t("general:Failed to get")}: ${res.msg}`);
+        }
+      });
+  }
+
+  renderProviderSelect(key, providers) {
+    return (
+      <Select virtual={false} style={{width: "100%"}} value={this.state.evaluation[key]} onChange={(value => {this.updateEvaluationField(key, value);})}>
+        <Option key="none" value="">
+          {i18next.t("evaluation:Same as store")}
+        </Option>
+        {
+          providers.map((provider, index) =>
+            <Option key={index} value={provider.name}>
+              <img width={20} height={20} style={{marginBottom: "3px", marginRight: "10px"}}
+                src={Setting.getProviderLogoURL({category: provider.category, type: provider.type})}
+                alt={provider.name} />
+              {provider.displayName} ({provider.name})
+            </Option>
+          )
+        }
+      </Select>
+    );
+  }
+
+  renderRow(label, tooltip, content) {
+    return (
+      <Row style={{marginTop: "20px"}} >
+        <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
+          {Setting.getLabel(label, tooltip)} :
+        </Col>
+        <Col span={22} >
+          {content}
+        </Col>
+      </Row>
+    );
+  }
+
+  renderResults() {
+    const columns = [
+      {title: i18next.t("general:ID"), dataIndex: "id", key: "id", width: "100px"},
+      {title: i18next.t("task:Question"), dataIndex: "question", key: "question", render: (text) => Setting.getShortText(text, 80)},
+      {title: i18next.t("evaluation:Expected answer"), dataIndex: "expectedAnswer", key: "expectedAnswer", render: (text) => Setting.getShortText(text, 50)},
+      {title: i18next.t("evaluation:Answer"), dataIndex: "answer", key: "answer", render: (text, record) => record.errorText ? <span style={{color: "red"}}>{record.errorText}</span> : Setting.getShortText(text, 50)},
+      {title: i18next.t("evaluation:Retrieved files"), dataIndex: "retrievedFiles", key: "retrievedFiles", render: (files) => (files ?? []).join(", ")},
+      {title: i18next.t("evaluation:Recall@K"), dataIndex: "recallAtK", key: "recallAtK", width: "90px", render: (value) => getMetricText(value)},
+      {title: i18next.t("evaluation:Reciprocal rank"), dataIndex: "reciprocalRank", key: "reciprocalRank", width: "90px", render: (value) => getMetricText(value)},
+      {title: i18next.t("evaluation:Exact match"), dataIndex: "isExactMatch", key: "isExactMatch", width: "90px", render: (value, record) => record.expectedAnswer ? (value ? "✓" : "✗") : ""},
+      {title: i18next.t("evaluation:Faithfulness"), dataIndex: "faithfulness", key: "faithfulness", width: "90px", render: (value, record) => record.isJudged ? getMetricText(value) : ""},
+    ];
+
+    return (
+      <Table size="small" bordered columns={columns} dataSource={this.state.evaluation.results ?? []} rowKey="id" pagination={{pageSize: 20}} />
+    );
+  }
+
+  renderComparison() {
+    const evaluations = this.state.evaluations.filter(evaluation => evaluation.name !== this.state.evaluationName);
+    const comparison = this.state.comparison;
+
+    const metricColumns = [
+      {title: i18next.t("general:Name"), dataIndex: "name", key: "name"},
+      {title: i18next.t("evaluation:Base"), dataIndex: "base", key: "base", render: (value) => getMetricText(value)},
+      {title: i18next.t("evaluation:Target"), dataIndex: "target", key: "target", render: (value) => getMetricText(value)},
+      {title: i18next.t("evaluation:Delta"), dataIndex: "delta", key: "delta", render: (value) => <span style={{color: value > 0 ? "green" : (value < 0 ? "red" : "inherit")}}>{getMetricText(value)}</span>},
+    ];
+    const caseColumns = [
+      {title: i18next.t("general:ID"), dataIndex: "id", key: "id", width: "100px"},
+      {title: i18next.t("task:Question"), dataIndex: "question", key: "question", render: (text) => Setting.getShortText(text, 80)},
+      {title: i18next.t("evaluation:Base"), dataIndex: "base", key: "base", render: (result) => result ? Setting.getShortText(result.errorText || result.answer, 60) : ""},
+      {title: i18next.t("evaluation:Target"), dataIndex: "target", key: "target", render: (result) => result ? Setting.getShortText(result.errorText || result.answer, 60) : ""},
+    ];
+
+    return (
+      <div>
+        <Select virtual={false} allowClear style={{width: "100%"}} placeholder={i18next.t("evaluation:Select a base evaluation")} onChange={(value => {this.compareEvaluation(value);})}
+          options={evaluations.map((evaluation) => Setting.getOption(`${evaluation.displayName} (${evaluation.name})`, evaluation.name))} />
+        {
+          comparison === null ? null : (
+            <div>
+              <Table style={{marginTop: "10px"}} size="small" bordered columns={metricColumns} dataSource={comparison.metrics} rowKey="name" pagination={false} />
+              <Table style={{marginTop: "10px"}} size="small" bordered columns={caseColumns} dataSource={comparison.cases} rowKey="id" pagination={{pageSize: 20}} />
+            </div>
+          )
+        }
+      </div>
+    );
+  }
+
+  renderEvaluation() {
+    const evaluation = this.state.evaluation;
+    const metrics = evaluation.metrics ?? {};
+
+    return (
+      <Card size="small" title={
+        <div>
+          {i18next.t("evaluation:Edit Evaluation")}&nbsp;&nbsp;&nbsp;&nbsp;
+          <Button onClick={() => this.submitEvaluationEdit(false)}>{i18next.t("general:Save")}</Button>
+          <Button style={{marginLeft: "20px"}} type="primary" onClick={() => this.submitEvaluationEdit(true)}>{i18next.t("general:Save & Exit")}</Button>
+          <Button style={{marginLeft: "20px"}} disabled={evaluation.state === "Running"} onClick={() => this.runEvaluation()}>{i18next.t("evaluation:Run")}</Button>
+        </div>
+      } style={{marginLeft: "5px"}} type="inner">
+        {this.renderRow(i18next.t("general:Name"), i18next.t("general:Name - Tooltip"),
+          <Input value={evaluation.name} onChange={e => {
+            this.updateEvaluationField("name", e.target.value);
+          }} />
+        )}
+        {this.renderRow(i18next.t("general:Display name"), i18next.t("general:Display name - Tooltip"),
+          <Input value={evaluation.displayName} onChange={e => {
+            this.updateEvaluationField("displayName", e.target.value);
+          }} />
+        )}
+        {this.renderRow(i18next.t("general:Store"), i18next.t("general:Store - Tooltip"),
+          <Select virtual={false} style={{width: "100%"}} value={evaluation.store} onChange={(value => {this.updateEvaluationField("store", value);})}
+            options={this.state.stores.map((store) => Setting.getOption(`${store.displayName} (${store.name})`, store.name))} />
+        )}
+        {this.renderRow(i18next.t("store:Model provider"), i18next.t("store:Model provider - Tooltip"),
+          this.renderProviderSelect("modelProvider", this.state.modelProviders)
+        )}
+        {this.renderRow(i18next.t("store:Embedding provider"), i18next.t("store:Embedding provider - Tooltip"),
+          this.renderProviderSelect("embeddingProvider", this.state.embeddingProviders)
+        )}
+        {this.renderRow(i18next.t("store:Search provider"), i18next.t("store:Search provider - Tooltip"),
+          <Select virtual={false} style={{width: "100%"}} value={evaluation.searchProvider} onChange={(value => {this.updateEvaluationField("searchProvider", value);})}
+            options={[{name: "", label: i18next.t("evaluation:Same as store")}, {name: "Default"}, {name: "Hierarchy"}, {name: "Hybrid"}, {name: "Multi-Query"}, {name: "HyDE"}, {name: "Graph"}].map((provider) => Setting.getOption(provider.label ?? provider.name, provider.name))} />
+        )}
+        {this.renderRow(i18next.t("evaluation:Judge provider"), i18next.t("evaluation:Judge provider - Tooltip"),
+          this.renderProviderSelect("judgeProvider", this.state.modelProviders)
+        )}
+        {this.renderRow(i18next.t("store:Knowledge count"), i18next.t("store:Knowledge count - Tooltip"),
+          <InputNumber min={0} value={evaluation.knowledgeCount} onChange={value => {
+            this.updateEvaluationField("knowledgeCount", value);
+          }} />
+        )}
+        {this.renderRow(i18next.t("evaluation:Dataset"), i18next.t("evaluation:Dataset - Tooltip"),
+          <TextArea autoSize={{minRows: 5, maxRows: 20}} style={{fontFamily: "monospace"}} value={evaluation.dataset} onChange={e => {
+            this.updateEvaluationField("dataset", e.target.value);
+          }} />
+        )}
+        {this.renderRow(i18next.t("general:State"), i18next.t("general:State - Tooltip"),
+          <div style={{marginTop: "5px"}}>
+            {getEvaluationStateTag(evaluation.state)}
+            {evaluation.errorText ? <span style={{color: "red"}}>{evaluation.errorText}</span> : null}
+          </div>
+        )}
+        {this.renderRow(i18next.t("evaluation:Metrics"), i18next.t("evaluation:Metrics - Tooltip"),
+          <div style={{marginTop: "5px"}}>
+            {`${i18next.t("evaluation:Recall@K")}: ${getMetricText(metrics.recallAtK)}, ${i18next.t("evaluation:MRR")}: ${getMetricText(metrics.mrr)}, ${i18next.t("evaluation:Exact match")}: ${getMetricText(metrics.exactMatch)}, ${i18next.t("evaluation:Faithfulness")}: ${getMetricText(metrics.faithfulness)}, ${i18next.t("general:Tokens")}: ${evaluation.tokenCount}, ${i18next.t("chat:Price")}: ${Setting.getDisplayPrice(evaluation.price, evaluation.currency)}`}
+          </div>
+        )}
+        {this.renderRow(i18next.t("evaluation:Results"), i18next.t("evaluation:Results - Tooltip"),
+          this.renderResults()
+        )}
+        {this.renderRow(i18next.t("evaluation:Compare"), i18next.t("evaluation:Compare - Tooltip"),
+          this.renderComparison()
+        )}
+      </Card>
+    );
+  }
+
+  submitEvaluationEdit(exitAfterSave) {
+    const evaluation = Setting.deepCopy(this.state.evaluation);
+    EvaluationBackend.updateEvaluation(this.state.evaluation.owner, this.state.evaluationName, evaluation)
+      .then((res) => {
+        if (res.status === "ok") {
+          if (res.data) {
+            Setting.showMessage("success", i18next.t("general:Successfully saved"));
+            this.setState({
+              evaluationName: this.state.evaluation.name,
+            });
+            if (exitAfterSave) {
+              this.props.history.push("/evaluations");
+            } else {
+              this.props.history.push(`/evaluations/${this.state.evaluation.name}`);
+              this.getEvaluation();
+            }
+          } else {
+            Setting.showMessage("error", i18next.t("general:Failed to save"));
+            this.updateEvaluationField("name", this.state.evaluationName);
+          }
+        } else {
+          Setting.showMessage("error", `${i18next.t("general:Failed to save")}: ${res.msg}`);
+        }
+      })
+      .catch(error => {
+        Setting.showMessage("error", `${i18next.t("general:Failed to save")}: ${error}`);
+      });
+  }
+
+  render() {
+    return (
+      <div>
+        {
+          this.state.evaluation !== null ? this.renderEvaluation() : null
+        }
+        <div style={{marginTop: "20px", marginLeft: "40px"}}>
+          <Button size="large" onClick={() => this.submitEvaluationEdit(false)}>{i18next.t("general:Save")}</Button>
+          <Button style={{marginLeft: "20px"}} type="primary" size="large" onClick={() => this.submitEvaluationEdit(true)}>{i18next.t("general:Save & Exit")}</Button>
+        </div>
+      </div>
+    );
+  }
+}
+
+export default EvaluationEditPage;
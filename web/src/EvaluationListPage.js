@@ -0,0 +1,301 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import React from "react";
+import {Link} from "react-router-dom";
+import {Button, Popconfirm, Table, Tag} from "antd";
+import moment from "moment";
+import BaseListPage from "./BaseListPage";
+import * as Setting from "./Setting";
+import * as EvaluationBackend from "./backend/EvaluationBackend";
+import i18next from "i18next";
+import {DeleteOutlined} from "@ant-design/icons";
+
+export function getEvaluationStateTag(state) {
+  const colorMap = {
+    "Pending": "default",
+    "Running": "processing",
+    "Finished": "success",
+    "Failed": "error",
+  };
+  return <Tag color={colorMap[state] ?? "default"}>{i18next.t(`evaluation:${state || "Pending"}`)}</Tag>;
+}
+
+export function getMetricText(value) {
+  return (value ?? 0).toFixed(3);
+}
+
+class EvaluationListPage extends BaseListPage {
+  constructor(props) {
+    super(props);
+  }
+
+  newEvaluation() {
+    const randomName = Setting.getRandomName();
+    return {
+      owner: this.props.account.name,
+      name: `evaluation_${randomName}`,
+      createdTime: moment().format(),
+      displayName: `New Evaluation - ${randomName}`,
+      store: "",
+      modelProvider: "",
+      embeddingProvider: "",
+      searchProvider: "",
+      judgeProvider: "",
+      knowledgeCount: 0,
+      dataset: "",
+      state: "Pending",
+      results: [],
+    };
+  }
+
+  addEvaluation() {
+    const newEvaluation = this.newEvaluation();
+    EvaluationBackend.addEvaluation(newEvaluation)
+      .then((res) => {
+        if (res.status === "ok") {
+          Setting.showMessage("success", i18next.t("general:Successfully added"));
+          this.setState({
+            data: Setting.prependRow(this.state.data, newEvaluation),
+            pagination: {
+              ...this.state.pagination,
+              total: this.state.pagination.total + 1,
+            },
+          });
+        } else {
+          Setting.showMessage("error", `${i18next.t("general:Failed to add")}: ${res.msg}`);
+        }
+      })
+      .catch(error => {
+        Setting.showMessage("error", `${i18next.t("general:Failed to add")}: ${error}`);
+      });
+  }
+
+  deleteItem = async(i) => {
+    return EvaluationBackend.deleteEvaluation(this.state.data[i]);
+  };
+
+  deleteEvaluation(record) {
+    EvaluationBackend.deleteEvaluation(record)
+      .then((res) => {
+        if (res.status === "ok") {
+          Setting.showMessage("success", i18next.t("general:Successfully deleted"));
+          this.setState({
+            data: this.state.data.filter((item) => item.name !== record.name),
+            pagination: {
+              ...this.state.pagination,
+              total: this.state.pagination.total - 1,
+            },
+          });
+        } else {
+          Setting.showMessage("error", `${i18next.t("general:Failed to delete")}: ${res.msg}`);
+        }
+      })
+      .catch(error => {
+        Setting.showMessage("error", `${i18next.t("general:Failed to delete")}: ${error}`);
+      });
+  }
+
+  runEvaluation(record) {
+    EvaluationBackend.runEvaluation(record.owner, record.name)
+      .then((res) => {
+        if (res.status === "ok") {
+          Setting.showMessage("success", i18next.t("evaluation:Evaluation started"));
+          this.setState({
+            data: this.state.data.map((item) => item.name === record.name ? {...item, state: "Running"} : item),
+          });
+        } else {
+          Setting.showMessage("error", `${i18next.t("evaluation:Failed to run")}: ${res.msg}`);
+        }
+      })
+      .catch(error => {
+        Setting.showMessage("error", `${i18next.t("evaluation:Failed to run")}: ${error}`);
+      });
+  }
+
+  renderTable(evaluations) {
+    const columns = [
+      {
+        title: i18next.t("general:Name"),
+        dataIndex: "name",
+        key: "name",
+        width: "160px",
+        sorter: (a, b) => a.name.localeCompare(b.name),
+        ...this.getColumnSearchProps("name"),
+        render: (text, record, index) => {
+          return (
+            <Link to={`/evaluations/${text}`}>
+              {text}
+            </Link>
+          );
+        },
+      },
+      {
+        title: i18next.t("general:Display name"),
+        dataIndex: "displayName",
+        key: "displayName",
+        width: "200px",
+        sorter: (a, b) => a.displayName.localeCompare(b.displayName),
+        ...this.getColumnSearchProps("displayName"),
+      },
+      {
+        title: i18next.t("general:Store"),
+        dataIndex: "store",
+        key: "store",
+        width: "140px",
+        sorter: (a, b) => a.store.localeCompare(b.store),
+        ...this.getColumnSearchProps("store"),
+      },
+      {
+        title: i18next.t("store:Model provider"),
+        dataIndex: "modelProvider",
+        key: "modelProvider",
+        width: "140px",
+        sorter: (a, b) => a.modelProvider.localeCompare(b.modelProvider),
+      },
+      {
+        title: i18next.t("store:Search provider"),
+        dataIndex: "searchProvider",
+        key: "searchProvider",
+        width: "120px",
+        sorter: (a, b) => a.searchProvider.localeCompare(b.searchProvider),
+      },
+      {
+        title: i18next.t("general:State"),
+        dataIndex: "state",
+        key: "state",
+        width: "110px",
+        sorter: (a, b) => a.state.localeCompare(b.state),
+        render: (text, record, index) => {
+          return getEvaluationStateTag(text);
+        },
+      },
+      {
+        title: i18next.t("evaluation:Recall@K"),
+        dataIndex: "metrics",
+        key: "recallAtK",
+        width: "100px",
+        render: (metrics, record, index) => getMetricText(metrics?.recallAtK),
+      },
+      {
+        title: i18next.t("evaluation:MRR"),
+        dataIndex: "metrics",
+        key: "mrr",
+        width: "100px",
+        render: (metrics, record, index) => getMetricText(metrics?.mrr),
+      },
+      {
+        title: i18next.t("evaluation:Exact match"),
+        dataIndex: "metrics",
+        key: "exactMatch",
+        width: "100px",
+        render: (metrics, record, index) => getMetricText(metrics?.exactMatch),
+      },
+      {
+        title: i18next.t("evaluation:Faithfulness"),
+        dataIndex: "metrics",
+        key: "faithfulness",
+        width: "100px",
+        render: (metrics, record, index) => getMetricText(metrics?.faithfulness),
+      },
+      {
+        title: i18next.t("general:Action"),
+        dataIndex: "action",
+        key: "action",
+        width: "240px",
+        fixed: (Setting.isMobile()) ? "false" : "right",
+        render: (text, record, index) => {
+          return (
+            <div>
+              <Button style={{marginTop: "10px", marginBottom: "10px", marginRight: "10px"}} disabled={record.state === "Running"} onClick={() => this.runEvaluation(record)}>{i18next.t("evaluation:Run")}</Button>
+              <Button style={{marginTop: "10px", marginBottom: "10px", marginRight: "10px"}} type="primary" onClick={() => this.props.history.push(`/evaluations/${record.name}`)}>{i18next.t("general:Edit")}</Button>
+              <Popconfirm
+                placement="topLeft"
+                title={`${i18next.t("general:Sure to delete")}: ${record.name} ?`}
+                onConfirm={() => this.deleteEvaluation(record)}
+                okText={i18next.t("general:OK")}
+                cancelText={i18next.t("general:Cancel")}
+              >
+                <Button style={{marginBottom: "10px"}} type="primary" danger>{i18next.t("general:Delete")}</Button>
+              </Popconfirm>
+            </div>
+          );
+        },
+      },
+    ];
+    const filteredColumns = Setting.filterTableColumns(columns, this.props.formItems ?? this.state.formItems);
+    const paginationProps = {
+      total: this.state.pagination.total,
+      showQuickJumper: true,
+      showSizeChanger: true,
+      pageSizeOptions: ["10", "20", "50", "100", "1000", "10000", "100000"],
+      showTotal: () => i18next.t("general:{total} in total").replace("{total}", this.state.pagination.total),
+    };
+
+    return (
+      <div>
+        <Table scroll={{x: "max-content"}} columns={filteredColumns} dataSource={evaluations} rowKey="name" rowSelection={this.getRowSelection()} size="middle" bordered pagination={paginationProps}
+          title={() => (
+            <div>
+              {i18next.t("general:Evaluations")}&nbsp;&nbsp;&nbsp;&nbsp;
+              <Button type="primary" size="small" onClick={this.addEvaluation.bind(this)}>{i18next.t("general:Add")}</Button>
+              {this.state.selectedRowKeys.length > 0 && (
+                <Popconfirm title={`${i18next.t("general:Sure to delete")}: ${this.state.selectedRowKeys.length} ${i18next.t("general:items")} ?`} onConfirm={() => this.performBulkDelete(this.state.selectedRows, this.state.selectedRowKeys)} okText={i18next.t("general:OK")} cancelText={i18next.t("general:Cancel")}>
+                  <Button type="primary" danger size="small" icon={<DeleteOutlined />} style={{marginLeft: 8}}>
+                    {i18next.t("general:Delete")} ({this.state.selectedRowKeys.length})
+                  </Button>
+                </Popconfirm>
+              )}
+            </div>
+          )}
+          loading={this.state.loading}
+          onChange={this.handleTableChange}
+        />
+      </div>
+    );
+  }
+
+  fetch = (params = {}) => {
+    const field = params.searchedColumn, value = params.searchText;
+    const sortField = params.sortField, sortOrder = params.sortOrder;
+    this.setState({loading: true});
+    EvaluationBackend.getEvaluations(this.props.account.name, params.pagination.current, params.pagination.pageSize, field, value, sortField, sortOrder)
+      .then((res) => {
+        this.setState({
+          loading: false,
+        });
+        if (res.status === "ok") {
+          this.setState({
+            data: res.data,
+            pagination: {
+              ...params.pagination,
+              total: res.data2,
+            },
+            searchText: params.searchText,
+            searchedColumn: params.searchedColumn,
+          });
+        } else {
+          if (Setting.isResponseDenied(res)) {
+            this.setState({
+              isAuthorized: false,
+            });
+          } else {
+            Setting.showMessage("error", res.msg);
+          }
+        }
+      });
+  };
+}
+
+export default EvaluationListPage;
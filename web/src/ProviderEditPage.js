@@ -122,7 +122,7 @@ class ProviderEditPage extends React.Component {
   }
 
   getClientSecretLabel(provider) {
-    if (["Storage", "Embedding", "Text-to-Speech", "Speech-to-Text"].includes(provider.category)) {
+    if (["Storage", "Embedding", "Rerank", "Vector Database", "Text-to-Speech", "Speech-to-Text"].includes(provider.category)) {
       if (provider.type === "Baidu Cloud") {
         return Setting.getLabel(i18next.t("general:Access secret"), i18next.t("general:Access secret - Tooltip"));
       }
@@ -304,6 +304,11 @@ class ProviderEditPage extends React.Component {
               } else if (value === "Embedding") {
                 this.updateProviderField("type", "OpenAI");
                 this.updateProviderField("subType", "AdaSimilarity");
+              } else if (value === "Rerank") {
+                this.updateProviderField("type", "Cohere");
+                this.updateProviderField("subType", "rerank-v3.5");
+              } else if (value === "Vector Database") {
+                this.updateProviderField("type", "pgvector");
               } else if (value === "Agent") {
                 this.updateProviderField("type", "MCP");
                 this.updateProviderField("subType", "Default");
@@ -330,6 +335,8 @@ class ProviderEditPage extends React.Component {
                   {id: "Storage", name: "Storage"},
                   {id: "Model", name: "Model"},
                   {id: "Embedding", name: "Embedding"},
+                  {id: "Rerank", name: "Rerank"},
+                  {id: "Vector Database", name: "Vector Database"},
                   {id: "Agent", name: "Agent"},
                   {id: "Public Cloud", name: "Public Cloud"},
                   {id: "Private Cloud", name: "Private Cloud"},
@@ -423,6 +430,14 @@ class ProviderEditPage extends React.Component {
                 } else if (value === "Dummy") {
                   this.updateProviderField("subType", "Dummy");
                 }
+              } else if (this.state.provider.category === "Rerank") {
+                if (value === "Cohere") {
+                  this.updateProviderField("subType", "rerank-v3.5");
+                } else if (value === "Jina") {
+                  this.updateProviderField("subType", "jina-reranker-v2-base-multilingual");
+                } else if (value === "Local") {
+                  this.updateProviderField("subType", "custom-rerank");
+                }
               } else if (this.state.provider.category === "Agent") {
                 if (value === "MCP") {
                   this.updateProviderField("subType", "Default");
@@ -460,7 +475,7 @@ class ProviderEditPage extends React.Component {
           </Col>
         </Row>
         {
-          !["Model", "Embedding", "Agent", "Text-to-Speech", "Speech-to-Text", "Bot"].includes(this.state.provider.category) ? null : (
+          !["Model", "Embedding", "Rerank", "Agent", "Text-to-Speech", "Speech-to-Text", "Bot"].includes(this.state.provider.category) ? null : (
             <Row style={{marginTop: "20px"}} >
               <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
                 {Setting.getLabel(i18next.t("provider:Sub type"), i18next.t("provider:Sub type - Tooltip"))} :
@@ -528,7 +543,7 @@ class ProviderEditPage extends React.Component {
             (this.state.provider.category === "Model" && this.state.provider.type === "MiniMax") ||
             (this.state.provider.category === "Blockchain" && !["ChainMaker", "Ethereum"].includes(this.state.provider.type)) ||
             ((this.state.provider.category === "Model" || this.state.provider.category === "Embedding") && this.state.provider.type === "Azure") ||
-            (!(["Storage", "Model", "Embedding", "Text-to-Speech", "Speech-to-Text", "Agent", "Blockchain"].includes(this.state.provider.category)))
+            (!(["Storage", "Model", "Embedding", "Rerank", "Vector Database", "Text-to-Speech", "Speech-to-Text", "Agent", "Blockchain"].includes(this.state.provider.category)))
           ) ? (
               <Row style={{marginTop: "20px"}} >
                 <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
@@ -591,7 +606,7 @@ class ProviderEditPage extends React.Component {
           )
         }
         {
-          !(this.state.provider.category === "Embedding" && (this.state.provider.type === "Local" || this.state.provider.type === "Ollama")) ? null : (
+          !(["Embedding", "Rerank"].includes(this.state.provider.category) && (this.state.provider.type === "Local" || this.state.provider.type === "Ollama")) ? null : (
             <>
               <Row style={{marginTop: "20px"}} >
                 <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
@@ -752,7 +767,7 @@ class ProviderEditPage extends React.Component {
           )
         }
         {
-          ["Storage", "Model", "Embedding", "Agent", "Text-to-Speech", "Speech-to-Text"].includes(this.state.provider.category) || (this.state.provider.category === "Blockchain" && this.state.provider.type === "Ethereum") || (this.state.provider.category === "Private Cloud" && this.state.provider.type === "Kubernetes") ? null : (
+          ["Storage", "Model", "Embedding", "Rerank", "Vector Database", "Agent", "Text-to-Speech", "Speech-to-Text"].includes(this.state.provider.category) || (this.state.provider.category === "Blockchain" && this.state.provider.type === "Ethereum") || (this.state.provider.category === "Private Cloud" && this.state.provider.type === "Kubernetes") ? null : (
             <Row style={{marginTop: "20px"}} >
               <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
                 {this.getRegionLabel(this.state.provider)} :
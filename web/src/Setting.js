@@ -853,6 +853,30 @@ export function getOtherProviderInfo() {
         url: "",
       },
     },
+    Rerank: {
+      "Cohere": {
+        logo: `${StaticBaseUrl}/img/social_cohere.png`,
+        url: "https://cohere.com/rerank",
+      },
+      "Jina": {
+        logo: `${StaticBaseUrl}/img/social_jina.png`,
+        url: "https://jina.ai/reranker/",
+      },
+      "Local": {
+        logo: `${StaticBaseUrl}/img/social_local.jpg`,
+        url: "",
+      },
+    },
+    "Vector Database": {
+      "pgvector": {
+        logo: `${StaticBaseUrl}/img/social_postgresql.png`,
+        url: "https://github.com/pgvector/pgvector",
+      },
+      "Qdrant": {
+        logo: `${StaticBaseUrl}/img/social_qdrant.png`,
+        url: "https://qdrant.tech",
+      },
+    },
     Storage: {
       "Local File System": {
         logo: `${StaticBaseUrl}/img/social_file.png`,
@@ -1160,6 +1184,21 @@ export function getProviderTypeOptions(category) {
         {id: "Dummy", name: "Dummy"},
       ]
     );
+  } else if (category === "Rerank") {
+    return (
+      [
+        {id: "Cohere", name: "Cohere"},
+        {id: "Jina", name: "Jina"},
+        {id: "Local", name: "Local"},
+      ]
+    );
+  } else if (category === "Vector Database") {
+    return (
+      [
+        {id: "pgvector", name: "pgvector"},
+        {id: "Qdrant", name: "Qdrant"},
+      ]
+    );
   } else if (category === "Agent") {
     return ([
       {id: "MCP", name: "MCP"},
@@ -1752,6 +1791,26 @@ export function getProviderSubTypeOptions(category, type) {
     return getModelSubTypeOptions(type);
   } else if (category === "Embedding") {
     return getEmbeddingSubTypeOptions(type);
+  } else if (category === "Rerank") {
+    if (type === "Cohere") {
+      return [
+        {id: "rerank-v3.5", name: "rerank-v3.5"},
+        {id: "rerank-english-v3.0", name: "rerank-english-v3.0"},
+        {id: "rerank-multilingual-v3.0", name: "rerank-multilingual-v3.0"},
+      ];
+    } else if (type === "Jina") {
+      return [
+        {id: "jina-reranker-v2-base-multilingual", name: "jina-reranker-v2-base-multilingual"},
+        {id: "jina-reranker-v1-base-en", name: "jina-reranker-v1-base-en"},
+        {id: "jina-colbert-v2", name: "jina-colbert-v2"},
+      ];
+    } else if (type === "Local") {
+      return [
+        {id: "custom-rerank", name: "custom-rerank"},
+      ];
+    } else {
+      return [];
+    }
   } else if (category === "Agent") {
     if (type === "MCP") {
       return [
@@ -47,6 +47,8 @@ class StoreEditPage extends React.Component {
       textToSpeechProviders: [],
       speechToTextProviders: [],
       agentProviders: [],
+      rerankProviders: [],
+      vectorDatabaseProviders: [],
       builtinTools: [],
       enableTtsStreaming: false,
       store: null,
@@ -126,6 +128,8 @@ class StoreEditPage extends React.Component {
             textToSpeechProviders: res.data.filter(provider => provider.category === "Text-to-Speech"),
             speechToTextProviders: res.data.filter(provider => provider.category === "Speech-to-Text"),
             agentProviders: res.data.filter(provider => provider.category === "Agent"),
+            rerankProviders: res.data.filter(provider => provider.category === "Rerank"),
+            vectorDatabaseProviders: res.data.filter(provider => provider.category === "Vector Database"),
           });
         } else {
           Setting.showMessage("error", `${i18next.t("general:Failed to get")}: ${res.msg}`);
@@ -342,17 +346,53 @@ class StoreEditPage extends React.Component {
           </Col>
           <Col span={22} >
             <Select virtual={false} style={{width: "100%"}} value={this.state.store.splitProvider} onChange={(value => {this.updateStoreField("splitProvider", value);})}
-              options={[{name: "Default"}, {name: "Basic"}, {name: "QA"}, {name: "Markdown"}].map((provider) => Setting.getOption(provider.name, provider.name))
+              options={[{name: "Default"}, {name: "Basic"}, {name: "QA"}, {name: "Markdown"}, {name: "Recursive"}].map((provider) => Setting.getOption(provider.name, provider.name))
               } />
           </Col>
         </Row>
+        {
+          this.state.store.splitProvider !== "Recursive" ? null : (
+            <React.Fragment>
+              <Row style={{marginTop: "20px"}} >
+                <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
+                  {Setting.getLabel(i18next.t("store:Chunk size"), i18next.t("store:Chunk size - Tooltip"))} :
+                </Col>
+                <Col span={22} >
+                  <InputNumber min={0} max={8192} step={64} value={this.state.store.chunkSize} onChange={value => {
+                    this.updateStoreField("chunkSize", value);
+                  }} />
+                </Col>
+              </Row>
+              <Row style={{marginTop: "20px"}} >
+                <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
+                  {Setting.getLabel(i18next.t("store:Chunk overlap"), i18next.t("store:Chunk overlap - Tooltip"))} :
+                </Col>
+                <Col span={22} >
+                  <InputNumber min={0} max={4096} step={16} value={this.state.store.chunkOverlap} onChange={value => {
+                    this.updateStoreField("chunkOverlap", value);
+                  }} />
+                </Col>
+              </Row>
+            </React.Fragment>
+          )
+        }
         <Row style={{marginTop: "20px"}} >
           <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
             {Setting.getLabel(i18next.t("store:Search provider"), i18next.t("store:Search provider - Tooltip"))} :
           </Col>
           <Col span={22} >
             <Select virtual={false} style={{width: "100%"}} value={this.state.store.searchProvider} onChange={(value => {this.updateStoreField("searchProvider", value);})}
-              options={[{name: "Default"}, {name: "Hierarchy"}].map((provider) => Setting.getOption(provider.name, provider.name))
+              options={[{name: "Default"}, {name: "Hierarchy"}, {name: "Hybrid"}, {name: "Multi-Query"}, {name: "HyDE"}, {name: "Graph"}].map((provider) => Setting.getOption(provider.name, provider.name))
+              } />
+          </Col>
+        </Row>
+        <Row style={{marginTop: "20px"}} >
+          <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
+            {Setting.getLabel(i18next.t("store:Cross-lingual search"), i18next.t("store:Cross-lingual search - Tooltip"))} :
+          </Col>
+          <Col span={22} >
+            <Select virtual={false} style={{width: "100%"}} value={this.state.store.crossLingualSearch} onChange={(value => {this.updateStoreField("crossLingualSearch", value);})}
+              options={[{id: "", name: i18next.t("general:None")}, {id: "Translate", name: "Translate"}, {id: "Multi-Query", name: "Multi-Query"}].map((item) => Setting.getOption(item.name, item.id))
               } />
           </Col>
         </Row>
@@ -389,6 +429,46 @@ class StoreEditPage extends React.Component {
             </Select>
           </Col>
         </Row>
+        <Row style={{marginTop: "20px"}} >
+          <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
+            {Setting.getLabel(i18next.t("store:Vector database provider"), i18next.t("store:Vector database provider - Tooltip"))} :
+          </Col>
+          <Col span={22} >
+            <Select virtual={false} style={{width: "100%"}} value={this.state.store.vectorDatabaseProvider} onChange={(value => {this.updateStoreField("vectorDatabaseProvider", value);})}>
+              <Option key="Empty" value="">{i18next.t("general:empty")}</Option>
+              {
+                this.state.vectorDatabaseProviders.map((provider, index) => this.renderProviderOption(provider, index))
+              }
+            </Select>
+          </Col>
+        </Row>
+        {
+          this.state.store.vectorDatabaseProvider ? null : (
+            <Row style={{marginTop: "20px"}} >
+              <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
+                {Setting.getLabel(i18next.t("store:Vector quantization"), i18next.t("store:Vector quantization - Tooltip"))} :
+              </Col>
+              <Col span={22} >
+                <Select virtual={false} style={{width: "100%"}} value={this.state.store.vectorQuantization} onChange={(value => {this.updateStoreField("vectorQuantization", value);})}
+                  options={[{id: "", name: i18next.t("general:None")}, {id: "Int8", name: "Int8"}, {id: "Binary", name: "Binary"}].map((item) => Setting.getOption(item.name, item.id))
+                  } />
+              </Col>
+            </Row>
+          )
+        }
+        <Row style={{marginTop: "20px"}} >
+          <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
+            {Setting.getLabel(i18next.t("store:Rerank provider"), i18next.t("store:Rerank provider - Tooltip"))} :
+          </Col>
+          <Col span={22} >
+            <Select virtual={false} style={{width: "100%"}} value={this.state.store.rerankProvider} onChange={(value => {this.updateStoreField("rerankProvider", value);})}>
+              <Option key="Empty" value="">{i18next.t("general:empty")}</Option>
+              {
+                this.state.rerankProviders.map((provider, index) => this.renderProviderOption(provider, index))
+              }
+            </Select>
+          </Col>
+        </Row>
         <Row style={{marginTop: "20px"}} >
           <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
             {Setting.getLabel(i18next.t("store:Agent provider"), i18next.t("store:Agent provider - Tooltip"))} :
@@ -543,6 +623,102 @@ class StoreEditPage extends React.Component {
             }} />
           </Col>
         </Row>
+        {
+          this.state.store.searchProvider !== "Multi-Query" ? null : (
+            <Row style={{marginTop: "20px"}} >
+              <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
+                {Setting.getLabel(i18next.t("store:Query count"), i18next.t("store:Query count - Tooltip"))} :
+              </Col>
+              <Col span={22} >
+                <InputNumber min={0} max={10} value={this.state.store.queryCount} onChange={value => {
+                  this.updateStoreField("queryCount", value);
+                }} />
+              </Col>
+            </Row>
+          )
+        }
+        <Row style={{marginTop: "20px"}} >
+          <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
+            {Setting.getLabel(i18next.t("store:Context expansion"), i18next.t("store:Context expansion - Tooltip"))} :
+          </Col>
+          <Col span={22} >
+            <Select virtual={false} style={{width: "100%"}} value={this.state.store.contextExpansion} onChange={(value => {this.updateStoreField("contextExpansion", value);})}
+              options={[{id: "", name: i18next.t("general:None")}, {id: "Neighbors", name: "Neighbors"}, {id: "Section", name: "Section"}].map((item) => Setting.getOption(item.name, item.id))
+              } />
+          </Col>
+        </Row>
+        {
+          this.state.store.contextExpansion !== "Neighbors" ? null : (
+            <Row style={{marginTop: "20px"}} >
+              <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
+                {Setting.getLabel(i18next.t("store:Context window"), i18next.t("store:Context window - Tooltip"))} :
+              </Col>
+              <Col span={22} >
+                <InputNumber min={0} max={10} value={this.state.store.contextWindow} onChange={value => {
+                  this.updateStoreField("contextWindow", value);
+                }} />
+              </Col>
+            </Row>
+          )
+        }
+        {
+          !this.state.store.contextExpansion ? null : (
+            <Row style={{marginTop: "20px"}} >
+              <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
+                {Setting.getLabel(i18next.t("store:Context token limit"), i18next.t("store:Context token limit - Tooltip"))} :
+              </Col>
+              <Col span={22} >
+                <InputNumber min={0} max={100000} step={500} value={this.state.store.contextTokenLimit} onChange={value => {
+                  this.updateStoreField("contextTokenLimit", value);
+                }} />
+              </Col>
+            </Row>
+          )
+        }
+        <Row style={{marginTop: "20px"}} >
+          <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
+            {Setting.getLabel(i18next.t("store:Enable graph"), i18next.t("store:Enable graph - Tooltip"))} :
+          </Col>
+          <Col span={1}>
+            <Switch checked={this.state.store.enableGraph} onChange={checked => {
+              this.updateStoreField("enableGraph", checked);
+            }} />
+          </Col>
+        </Row>
+        <Row style={{marginTop: "20px"}} >
+          <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
+            {Setting.getLabel(i18next.t("store:Duplicate threshold"), i18next.t("store:Duplicate threshold - Tooltip"))} :
+          </Col>
+          <Col span={22} >
+            <InputNumber min={0} max={1} step={0.01} value={this.state.store.duplicateThreshold} onChange={value => {
+              this.updateStoreField("duplicateThreshold", value);
+            }} />
+          </Col>
+        </Row>
+        <Row style={{marginTop: "20px"}} >
+          <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
+            {Setting.getLabel(i18next.t("store:Enable answer cache"), i18next.t("store:Enable answer cache - Tooltip"))} :
+          </Col>
+          <Col span={1}>
+            <Switch checked={this.state.store.enableAnswerCache} onChange={checked => {
+              this.updateStoreField("enableAnswerCache", checked);
+            }} />
+          </Col>
+        </Row>
+        {
+          !this.state.store.enableAnswerCache ? null : (
+            <Row style={{marginTop: "20px"}} >
+              <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
+                {Setting.getLabel(i18next.t("store:Cache threshold"), i18next.t("store:Cache threshold - Tooltip"))} :
+              </Col>
+              <Col span={22} >
+                <InputNumber min={0} max={1} step={0.01} value={this.state.store.cacheThreshold} onChange={value => {
+                  this.updateStoreField("cacheThreshold", value);
+                }} />
+              </Col>
+            </Row>
+          )
+        }
         <Row style={{marginTop: "20px"}} >
           <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
             {Setting.getLabel(i18next.t("store:Suggestion count"), i18next.t("store:Suggestion count - Tooltip"))} :
@@ -697,6 +873,44 @@ class StoreEditPage extends React.Component {
             </Select>
           </Col>
         </Row>
+        <Row style={{marginTop: "20px"}} >
+          <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
+            {Setting.getLabel(i18next.t("store:Model routing"), i18next.t("store:Model routing - Tooltip"))} :
+          </Col>
+          <Col span={22} >
+            <Select virtual={false} style={{width: "100%"}} value={this.state.store.modelRouting} onChange={(value => {this.updateStoreField("modelRouting", value);})}
+              options={[{id: "", name: i18next.t("general:None")}, {id: "Fallback", name: "Fallback"}, {id: "Cheapest", name: "Cheapest"}, {id: "Fastest", name: "Fastest"}, {id: "Question Length", name: "Question Length"}].map((item) => Setting.getOption(item.name, item.id))
+              } />
+          </Col>
+        </Row>
+        {
+          this.state.store.modelRouting === "Question Length" ? (
+            <Row style={{marginTop: "20px"}} >
+              <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
+                {Setting.getLabel(i18next.t("store:Routing threshold"), i18next.t("store:Routing threshold - Tooltip"))} :
+              </Col>
+              <Col span={22} >
+                <InputNumber min={0} value={this.state.store.routingThreshold} onChange={value => {
+                  this.updateStoreField("routingThreshold", value);
+                }} />
+              </Col>
+            </Row>
+          ) : null
+        }
+        {
+          this.state.store.modelRouting ? (
+            <Row style={{marginTop: "20px"}} >
+              <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
+                {Setting.getLabel(i18next.t("store:Model timeout"), i18next.t("store:Model timeout - Tooltip"))} :
+              </Col>
+              <Col span={22} >
+                <InputNumber min={0} value={this.state.store.modelTimeout} onChange={value => {
+                  this.updateStoreField("modelTimeout", value);
+                }} />
+              </Col>
+            </Row>
+          ) : null
+        }
         <Row style={{marginTop: "20px"}} >
           <Col style={{marginTop: "5px"}} span={(Setting.isMobile()) ? 22 : 2}>
             {Setting.getLabel(i18next.t("store:Forbidden words"), i18next.t("store:Forbidden words - Tooltip"))} :
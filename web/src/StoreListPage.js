@@ -206,7 +206,8 @@ class StoreListPage extends BaseListPage {
     StoreBackend.refreshStoreVectors(this.state.data[i])
       .then((res) => {
         if (res.status === "ok") {
-          Setting.showMessage("success", i18next.t("general:Vectors generated successfully"));
+          const result = res.data;
+          Setting.showMessage("success", `${i18next.t("general:Vectors generated successfully")}: ${i18next.t("store:Added")} ${result.added}, ${i18next.t("store:Updated")} ${result.updated}, ${i18next.t("store:Deleted")} ${result.deleted}, ${i18next.t("store:Skipped")} ${result.skipped}`);
         } else {
           Setting.showMessage("error", `${i18next.t("general:Vectors failed to generate")}: ${res.msg}`);
         }
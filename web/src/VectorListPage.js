@@ -182,6 +182,21 @@ class VectorListPage extends BaseListPage {
         width: "80px",
         sorter: (a, b) => a.index - b.index,
       },
+      {
+        title: i18next.t("vector:Canonical"),
+        dataIndex: "canonical",
+        key: "canonical",
+        width: "140px",
+        sorter: (a, b) => a.canonical.localeCompare(b.canonical),
+        ...this.getColumnSearchProps("canonical"),
+        render: (text, record, index) => {
+          return (
+            <Link to={`/vectors/${text}`}>
+              {text}
+            </Link>
+          );
+        },
+      },
       {
         title: i18next.t("general:Text"),
         dataIndex: "text",
@@ -213,7 +228,6 @@ class VectorListPage extends BaseListPage {
         dataIndex: "data",
         key: "data",
         width: "200px",
-        sorter: (a, b) => a.data.localeCompare(b.data),
         render: (text, record, index) => {
           return (
             <Tooltip placement="left" title={Setting.getShortText(JSON.stringify(text), 1000)}>
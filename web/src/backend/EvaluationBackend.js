@@ -0,0 +1,101 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import * as Setting from "../Setting";
+
+export function getGlobalEvaluations() {
+  return fetch(`${Setting.ServerUrl}/api/get-global-evaluations`, {
+    method: "GET",
+    credentials: "include",
+    headers: {
+      "Accept-Language": Setting.getAcceptLanguage(),
+    },
+  }).then(res => res.json());
+}
+
+export function getEvaluations(owner, page = "", pageSize = "", field = "", value = "", sortField = "", sortOrder = "") {
+  return fetch(`${Setting.ServerUrl}/api/get-evaluations?owner=${owner}&p=${page}&pageSize=${pageSize}&field=${field}&value=${value}&sortField=${sortField}&sortOrder=${sortOrder}`, {
+    method: "GET",
+    credentials: "include",
+    headers: {
+      "Accept-Language": Setting.getAcceptLanguage(),
+    },
+  }).then(res => res.json());
+}
+
+export function getEvaluation(owner, name) {
+  return fetch(`${Setting.ServerUrl}/api/get-evaluation?id=${owner}/${encodeURIComponent(name)}`, {
+    method: "GET",
+    credentials: "include",
+    headers: {
+      "Accept-Language": Setting.getAcceptLanguage(),
+    },
+  }).then(res => res.json());
+}
+
+export function updateEvaluation(owner, name, evaluation) {
+  const newEvaluation = Setting.deepCopy(evaluation);
+  return fetch(`${Setting.ServerUrl}/api/update-evaluation?id=${owner}/${encodeURIComponent(name)}`, {
+    method: "POST",
+    credentials: "include",
+    headers: {
+      "Accept-Language": Setting.getAcceptLanguage(),
+    },
+    body: JSON.stringify(newEvaluation),
+  }).then(res => res.json());
+}
+
+export function addEvaluation(evaluation) {
+  const newEvaluation = Setting.deepCopy(evaluation);
+  return fetch(`${Setting.ServerUrl}/api/add-evaluation`, {
+    method: "POST",
+    credentials: "include",
+    headers: {
+      "Accept-Language": Setting.getAcceptLanguage(),
+    },
+    body: JSON.stringify(newEvaluation),
+  }).then(res => res.json());
+}
+
+export function deleteEvaluation(evaluation) {
+  const newEvaluation = Setting.deepCopy(evaluation);
+  return fetch(`${Setting.ServerUrl}/api/delete-evaluation`, {
+    method: "POST",
+    credentials: "include",
+    headers: {
+      "Accept-Language": Setting.getAcceptLanguage(),
+    },
+    body: JSON.stringify(newEvaluation),
+  }).then(res => res.json());
+}
+
+export function runEvaluation(owner, name) {
+  return fetch(`${Setting.ServerUrl}/api/run-evaluation?id=${owner}/${encodeURIComponent(name)}`, {
+    method: "POST",
+    credentials: "include",
+    headers: {
+      "Accept-Language": Setting.getAcceptLanguage(),
+    },
+  }).then(res => res.json());
+}
+
+export function compareEvaluations(owner, baseName, targetName) {
+  return fetch(`${Setting.ServerUrl}/api/compare-evaluations?base=${owner}/${encodeURIComponent(baseName)}&target=${owner}/${encodeURIComponent(targetName)}`, {
+    method: "GET",
+    credentials: "include",
+    headers: {
+      "Accept-Language": Setting.getAcceptLanguage(),
+    },
+  }).then(res => res.json());
+}
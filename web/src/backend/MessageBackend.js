@@ -46,7 +46,7 @@ export function getChatMessages(owner, chat) {
 
 const eventSourceMap = new Map();
 
-export function getMessageAnswer(owner, name, onMessage, onReason, onError, onEnd) {
+export function getMessageAnswer(owner, name, onMessage, onReason, onError, onEnd, onSources) {
   if (eventSourceMap.has(`${owner}/${name}`)) {
     return;
   }
@@ -63,6 +63,12 @@ export function getMessageAnswer(owner, name, onMessage, onReason, onError, onEn
     onReason(e.data);
   });
 
+  eventSource.addEventListener("sources", (e) => {
+    if (onSources) {
+      onSources(JSON.parse(e.data));
+    }
+  });
+
   eventSource.addEventListener("myerror", (e) => {
     onError(e.data);
     eventSource.close();
@@ -129,6 +135,16 @@ export function closeMessageEventSource(owner, name) {
   return false;
 }
 
+export function stopMessageAnswer(owner, name) {
+  return fetch(`${Setting.ServerUrl}/api/stop-message-answer?id=${owner}/${encodeURIComponent(name)}`, {
+    method: "POST",
+    credentials: "include",
+    headers: {
+      "Accept-Language": Setting.getAcceptLanguage(),
+    },
+  }).then(res => res.json());
+}
+
 export function addMessage(message) {
   const newMessage = Setting.deepCopy(message);
   return fetch(`${Setting.ServerUrl}/api/add-message`, {
@@ -22,6 +22,7 @@ import {AvatarErrorUrl} from "../Conf";
 import {renderText} from "../ChatMessageRender";
 import MessageActions from "./MessageActions";
 import MessageSuggestions from "./MessageSuggestions";
+import MessageSources from "./MessageSources";
 import MessageEdit from "./MessageEdit";
 import {MessageCarrier} from "./MessageCarrier";
 
@@ -295,6 +296,7 @@ const MessageItem = ({
                   isRegenerating={isRegenerating}
                 />
               )}
+              <MessageSources message={message} />
               {message.author === "AI" && isLastMessage && (
                 <MessageSuggestions message={message} sendMessage={sendMessage} />
               )}
@@ -0,0 +1,46 @@
+// Copyright 2025 The Casibase Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import React from "react";
+import {Tag, Tooltip} from "antd";
+import i18next from "i18next";
+
+const MessageSources = ({message}) => {
+  if (message.author !== "AI" || !message.sources || !Array.isArray(message.sources) || message.sources.length === 0) {
+    return null;
+  }
+
+  // Once the answer is saved, only the cited sources are shown if there are any
+  let sources = message.sources.filter(source => source.isCited);
+  if (sources.length === 0) {
+    sources = message.sources;
+  }
+
+  return (
+    <div style={{display: "flex", flexWrap: "wrap", alignItems: "center", gap: "4px", marginTop: "8px"}}>
+      <span style={{marginRight: "4px"}}>{i18next.t("chat:Sources")}:</span>
+      {sources.map((source) => {
+        return (
+          <Tooltip key={source.index} title={`${source.snippet} (${i18next.t("video:Score")}: ${source.score.toFixed(3)})`}>
+            <Tag color={source.isCited ? "blue" : "default"} style={{cursor: "default"}}>
+              {`[${source.index}] ${source.file !== "" ? source.file : source.vector} #${source.chunkIndex}`}
+            </Tag>
+          </Tooltip>
+        );
+      })}
+    </div>
+  );
+};
+
+export default MessageSources;
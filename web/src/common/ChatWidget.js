@@ -360,17 +360,29 @@ class ChatWidget extends React.Component {
     if (this.state.messages && this.state.messages.length > 0) {
       const lastMessage = this.state.messages[this.state.messages.length - 1];
       if (lastMessage.author === "AI" && this.state.messageLoading) {
-        MessageBackend.closeMessageEventSource(lastMessage.owner, lastMessage.name);
-
-        MessageBackend.updateMessage(lastMessage.owner, lastMessage.name, lastMessage)
+        // The server saves the answer so far with its token counts when the
+        // answer is stopped, the message is only saved here when it is not
+        // being answered any more.
+        MessageBackend.stopMessageAnswer(lastMessage.owner, lastMessage.name)
           .then((res) => {
-            if (res.status === "ok") {
+            MessageBackend.closeMessageEventSource(lastMessage.owner, lastMessage.name);
+            if (res.status === "ok" && res.data) {
               this.setState({
                 messageLoading: false,
               });
-            } else {
-              Setting.showMessage("error", `${i18next.t("general:Failed to save")}: ${res.msg}`);
+              return;
             }
+
+            return MessageBackend.updateMessage(lastMessage.owner, lastMessage.name, lastMessage)
+              .then((res) => {
+                if (res.status === "ok") {
+                  this.setState({
+                    messageLoading: false,
+                  });
+                } else {
+                  Setting.showMessage("error", `${i18next.t("general:Failed to save")}: ${res.msg}`);
+                }
+              });
           })
           .catch(error => {
             Setting.showMessage("error", `${i18next.t("general:Failed to connect to server")}: ${error}`);